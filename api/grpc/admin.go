@@ -0,0 +1,88 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+)
+
+// adminServer represents a grpc server that can serve the node administration API.
+type adminServer struct {
+	pb.UnimplementedAdmin_APIServer
+	n perun.NodeAPI
+}
+
+// ListSessions wraps node.ListSessions.
+func (a *adminServer) ListSessions(context.Context, *pb.ListSessionsReq) (*pb.ListSessionsResp, error) {
+	return &pb.ListSessionsResp{
+		SessionsInfo: pb.FromSessionsInfo(a.n.ListSessions()),
+	}, nil
+}
+
+// GetSessionInfo wraps node.GetSessionInfo.
+func (a *adminServer) GetSessionInfo(_ context.Context, req *pb.GetSessionInfoReq) (*pb.GetSessionInfoResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetSessionInfoResp {
+		return &pb.GetSessionInfoResp{
+			Response: &pb.GetSessionInfoResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sessionInfo, err := a.n.GetSessionInfo(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetSessionInfoResp{
+		Response: &pb.GetSessionInfoResp_MsgSuccess_{
+			MsgSuccess: &pb.GetSessionInfoResp_MsgSuccess{
+				SessionInfo: pb.FromSessionInfo(sessionInfo),
+			},
+		},
+	}, nil
+}
+
+// CloseSession wraps payment.CloseNodeSession.
+func (a *adminServer) CloseSession(_ context.Context, req *pb.AdminCloseSessionReq) (
+	*pb.AdminCloseSessionResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.AdminCloseSessionResp {
+		return &pb.AdminCloseSessionResp{
+			Response: &pb.AdminCloseSessionResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	openPayChsInfo, err := payment.CloseNodeSession(a.n, req.SessionID, req.Force)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.AdminCloseSessionResp{
+		Response: &pb.AdminCloseSessionResp_MsgSuccess_{
+			MsgSuccess: &pb.AdminCloseSessionResp_MsgSuccess{
+				OpenPayChsInfo: pb.FromPayChsInfo(openPayChsInfo),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,448 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	psync "polycry.pt/poly-go/sync"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/app"
+)
+
+// appChAPIServer represents a grpc server that can serve the app channel API.
+type appChAPIServer struct {
+	pb.UnimplementedApp_APIServer
+	n perun.NodeAPI
+
+	// The mutex should be used when accessing the map data structures.
+	psync.Mutex
+
+	// These maps are used to hold a signal channel for each active
+	// subscription. See payChAPIServer for details.
+	chProposalsNotif map[string]chan bool
+	chUpdatesNotif   map[string]map[string]chan bool
+}
+
+// newAppChAPIServer returns an app channel API server that serves the
+// requests using the node API instance.
+func newAppChAPIServer(n perun.NodeAPI) *appChAPIServer {
+	return &appChAPIServer{
+		n:                n,
+		chProposalsNotif: make(map[string]chan bool),
+		chUpdatesNotif:   make(map[string]map[string]chan bool),
+	}
+}
+
+// OpenAppCh wraps app.OpenAppCh.
+func (a *appChAPIServer) OpenAppCh(ctx context.Context, req *pb.OpenAppChReq) (*pb.OpenAppChResp, error) {
+	errResponse := func(err perun.APIError) *pb.OpenAppChResp {
+		return &pb.OpenAppChResp{
+			Response: &pb.OpenAppChResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	appDef, err := a.n.GetApp(req.App)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	openingBalInfo := pb.ToBalInfo(req.OpeningBalInfo)
+	appChInfo, err := app.OpenAppCh(ctx, sess, appDef, req.InitParams, openingBalInfo, req.ChallengeDurSecs)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.OpenAppChResp{
+		Response: &pb.OpenAppChResp_MsgSuccess_{
+			MsgSuccess: &pb.OpenAppChResp_MsgSuccess{
+				OpenedAppChInfo: pb.FromAppChInfo(appChInfo),
+			},
+		},
+	}, nil
+}
+
+// GetAppChsInfo wraps app.GetAppChsInfo.
+func (a *appChAPIServer) GetAppChsInfo(_ context.Context, req *pb.GetAppChsInfoReq) (*pb.GetAppChsInfoResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetAppChsInfoResp {
+		return &pb.GetAppChsInfoResp{
+			Response: &pb.GetAppChsInfoResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetAppChsInfoResp{
+		Response: &pb.GetAppChsInfoResp_MsgSuccess_{
+			MsgSuccess: &pb.GetAppChsInfoResp_MsgSuccess{
+				OpenAppChsInfo: pb.FromAppChsInfo(app.GetAppChsInfo(sess)),
+			},
+		},
+	}, nil
+}
+
+// SubAppChProposals wraps app.SubAppChProposals.
+func (a *appChAPIServer) SubAppChProposals(req *pb.SubAppChProposalsReq,
+	srv pb.App_API_SubAppChProposalsServer,
+) error {
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	notifier := func(notif app.ChProposalNotif) {
+		err := srv.Send(&pb.SubAppChProposalsResp{Response: &pb.SubAppChProposalsResp_Notify_{
+			Notify: &pb.SubAppChProposalsResp_Notify{
+				ProposalID:       notif.ProposalID,
+				App:              notif.App,
+				Data:             notif.Data,
+				OpeningBalInfo:   pb.FromBalInfo(notif.OpeningBalInfo),
+				ChallengeDurSecs: notif.ChallengeDurSecs,
+				Expiry:           notif.Expiry,
+			},
+		}})
+		_ = err
+	}
+	err = app.SubAppChProposals(sess, notifier)
+	if err != nil {
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	signal := make(chan bool)
+	a.Lock()
+	a.chProposalsNotif[req.SessionID] = signal
+	a.Unlock()
+
+	<-signal
+	return nil
+}
+
+// UnsubAppChProposals wraps app.UnsubAppChProposals.
+func (a *appChAPIServer) UnsubAppChProposals(_ context.Context, req *pb.UnsubAppChProposalsReq) (
+	*pb.UnsubAppChProposalsResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.UnsubAppChProposalsResp {
+		return &pb.UnsubAppChProposalsResp{
+			Response: &pb.UnsubAppChProposalsResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	err = app.UnsubAppChProposals(sess)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	a.closeGrpcAppChProposalSub(req.SessionID)
+
+	return &pb.UnsubAppChProposalsResp{
+		Response: &pb.UnsubAppChProposalsResp_MsgSuccess_{
+			MsgSuccess: &pb.UnsubAppChProposalsResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+func (a *appChAPIServer) closeGrpcAppChProposalSub(sessionID string) {
+	a.Lock()
+	signal, ok := a.chProposalsNotif[sessionID]
+	delete(a.chProposalsNotif, sessionID)
+	a.Unlock()
+	if ok {
+		close(signal)
+	}
+}
+
+// RespondAppChProposal wraps app.RespondAppChProposal.
+func (a *appChAPIServer) RespondAppChProposal(ctx context.Context, req *pb.RespondAppChProposalReq) (
+	*pb.RespondAppChProposalResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.RespondAppChProposalResp {
+		return &pb.RespondAppChProposalResp{
+			Response: &pb.RespondAppChProposalResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	openedAppChInfo, err := app.RespondAppChProposal(ctx, sess, req.ProposalID, req.Accept)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.RespondAppChProposalResp{
+		Response: &pb.RespondAppChProposalResp_MsgSuccess_{
+			MsgSuccess: &pb.RespondAppChProposalResp_MsgSuccess{
+				OpenedAppChInfo: pb.FromAppChInfo(openedAppChInfo),
+			},
+		},
+	}, nil
+}
+
+// SendAppAction wraps app.SendAppAction.
+func (a *appChAPIServer) SendAppAction(ctx context.Context, req *pb.SendAppActionReq) (
+	*pb.SendAppActionResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.SendAppActionResp {
+		return &pb.SendAppActionResp{
+			Response: &pb.SendAppActionResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	updatedAppChInfo, err := app.SendAppAction(ctx, ch, req.Action)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.SendAppActionResp{
+		Response: &pb.SendAppActionResp_MsgSuccess_{
+			MsgSuccess: &pb.SendAppActionResp_MsgSuccess{
+				UpdatedAppChInfo: pb.FromAppChInfo(updatedAppChInfo),
+			},
+		},
+	}, nil
+}
+
+// SubAppChUpdates wraps app.SubAppChUpdates.
+func (a *appChAPIServer) SubAppChUpdates(req *pb.SubAppChUpdatesReq, srv pb.App_API_SubAppChUpdatesServer) error {
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	notifier := func(notif app.ChUpdateNotif) {
+		var notifErr *pb.MsgError
+		if notif.Error != nil {
+			notifErr = pb.FromError(notif.Error)
+		}
+
+		err := srv.Send(&pb.SubAppChUpdatesResp{Response: &pb.SubAppChUpdatesResp_Notify_{
+			Notify: &pb.SubAppChUpdatesResp_Notify{
+				UpdateID:          notif.UpdateID,
+				ProposedAppChInfo: pb.FromAppChInfo(notif.ProposedAppChInfo),
+				Type:              toGrpcAppChUpdateType[notif.Type],
+				Expiry:            notif.Expiry,
+				Error:             notifErr,
+			},
+		}})
+		_ = err
+
+		// Close grpc subscription function (SubAppChUpdates) that will be running in the background.
+		if perun.ChUpdateTypeClosed == notif.Type {
+			a.closeGrpcAppChUpdateSub(req.SessionID, req.ChID)
+		}
+	}
+	err = app.SubAppChUpdates(ch, notifier)
+	if err != nil {
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	signal := make(chan bool)
+	a.Lock()
+	if _, ok := a.chUpdatesNotif[req.SessionID]; !ok {
+		a.chUpdatesNotif[req.SessionID] = make(map[string]chan bool)
+	}
+	a.chUpdatesNotif[req.SessionID][req.ChID] = signal
+	a.Unlock()
+
+	<-signal
+	return nil
+}
+
+// toGrpcAppChUpdateType maps enums from ChUpdateType type defined in
+// perun-node to ChUpdateType type defined for app channels in grpc package.
+var toGrpcAppChUpdateType = map[perun.ChUpdateType]pb.SubAppChUpdatesResp_Notify_ChUpdateType{
+	perun.ChUpdateTypeOpen:   pb.SubAppChUpdatesResp_Notify_open,
+	perun.ChUpdateTypeFinal:  pb.SubAppChUpdatesResp_Notify_final,
+	perun.ChUpdateTypeClosed: pb.SubAppChUpdatesResp_Notify_closed,
+}
+
+// UnsubAppChUpdates wraps app.UnsubAppChUpdates.
+func (a *appChAPIServer) UnsubAppChUpdates(_ context.Context, req *pb.UnsubAppChUpdatesReq) (
+	*pb.UnsubAppChUpdatesResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.UnsubAppChUpdatesResp {
+		return &pb.UnsubAppChUpdatesResp{
+			Response: &pb.UnsubAppChUpdatesResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	err = app.UnsubAppChUpdates(ch)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	a.closeGrpcAppChUpdateSub(req.SessionID, req.ChID)
+
+	return &pb.UnsubAppChUpdatesResp{
+		Response: &pb.UnsubAppChUpdatesResp_MsgSuccess_{
+			MsgSuccess: &pb.UnsubAppChUpdatesResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+func (a *appChAPIServer) closeGrpcAppChUpdateSub(sessionID, chID string) {
+	a.Lock()
+	signal, ok := a.chUpdatesNotif[sessionID][chID]
+	delete(a.chUpdatesNotif[sessionID], chID)
+	a.Unlock()
+	if ok {
+		close(signal)
+	}
+}
+
+// RespondAppChUpdate wraps app.RespondAppChUpdate.
+func (a *appChAPIServer) RespondAppChUpdate(ctx context.Context, req *pb.RespondAppChUpdateReq) (
+	*pb.RespondAppChUpdateResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.RespondAppChUpdateResp {
+		return &pb.RespondAppChUpdateResp{
+			Response: &pb.RespondAppChUpdateResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	updatedAppChInfo, err := app.RespondAppChUpdate(ctx, ch, req.UpdateID, req.Accept)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.RespondAppChUpdateResp{
+		Response: &pb.RespondAppChUpdateResp_MsgSuccess_{
+			MsgSuccess: &pb.RespondAppChUpdateResp_MsgSuccess{
+				UpdatedAppChInfo: pb.FromAppChInfo(updatedAppChInfo),
+			},
+		},
+	}, nil
+}
+
+// GetAppChInfo wraps app.GetAppChInfo.
+func (a *appChAPIServer) GetAppChInfo(_ context.Context, req *pb.GetAppChInfoReq) (*pb.GetAppChInfoResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetAppChInfoResp {
+		return &pb.GetAppChInfoResp{
+			Response: &pb.GetAppChInfoResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetAppChInfoResp{
+		Response: &pb.GetAppChInfoResp_MsgSuccess_{
+			MsgSuccess: &pb.GetAppChInfoResp_MsgSuccess{
+				AppChInfo: pb.FromAppChInfo(app.GetAppChInfo(ch)),
+			},
+		},
+	}, nil
+}
+
+// CloseAppCh wraps app.CloseAppCh.
+func (a *appChAPIServer) CloseAppCh(ctx context.Context, req *pb.CloseAppChReq) (*pb.CloseAppChResp, error) {
+	errResponse := func(err perun.APIError) *pb.CloseAppChResp {
+		return &pb.CloseAppChResp{
+			Response: &pb.CloseAppChResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	closedAppChInfo, err := app.CloseAppCh(ctx, ch)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.CloseAppChResp{
+		Response: &pb.CloseAppChResp_MsgSuccess_{
+			MsgSuccess: &pb.CloseAppChResp_MsgSuccess{
+				ClosedAppChInfo: pb.FromAppChInfo(closedAppChInfo),
+			},
+		},
+	}, nil
+}
@@ -18,6 +18,10 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
 	psync "polycry.pt/poly-go/sync"
@@ -25,8 +29,22 @@ import (
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
 	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/app/payment/invoice"
+	"github.com/hyperledger-labs/perun-node/session"
 )
 
+// payStreamsCloseHandlerID is the ID of the session close handler that stops
+// the payment streams of the session.
+const payStreamsCloseHandlerID = "grpc/payment-streams"
+
+// invoicesCloseHandlerID is the ID of the session close handler that closes
+// the invoice manager of the session.
+const invoicesCloseHandlerID = "grpc/invoices"
+
+// invoicesFileName is the name of the file within the database directory of
+// the session, where the invoices created and paid in it are persisted.
+const invoicesFileName = "invoices.yaml"
+
 // payChAPIServer represents a grpc server that can serve payment channel API.
 type payChAPIServer struct {
 	pb.UnimplementedPayment_APIServer
@@ -45,9 +63,23 @@ type payChAPIServer struct {
 	// of session id to signaling channel.
 	// chUpdatesNotif works on a per channel basis and hence this is a map of session id to
 	// channel id to signaling channel.
+	// peerStatusNotif works on a per peer basis and hence this is a map of session id to
+	// peer alias to signaling channel.
 
 	chProposalsNotif map[string]chan bool
 	chUpdatesNotif   map[string]map[string]chan bool
+	peerStatusNotif  map[string]map[string]chan bool
+
+	// payStreams holds the payment streams started via this server, as a map
+	// of session id to the streams of that session.
+	// payStreamsNotif works on a per stream basis and hence this is a map of
+	// session id to stream id to signaling channel.
+	payStreams      map[string]*payment.Streams
+	payStreamsNotif map[string]map[string]chan bool
+
+	// invoices holds the invoice managers of the sessions, as a map of
+	// session id to the manager of that session.
+	invoices map[string]*invoice.Manager
 }
 
 // GetConfig wraps node.GetConfig.
@@ -112,7 +144,11 @@ func (a *payChAPIServer) OpenSession(_ context.Context, req *pb.OpenSessionReq)
 		}
 	}
 
-	sessionID, restoredChs, err := payment.OpenSession(a.n, req.ConfigFile)
+	passwords := perun.WalletPasswords{
+		OnChain:  req.OnChainPassword,
+		OffChain: req.OffChainPassword,
+	}
+	sessionID, restoredChs, err := payment.OpenSession(a.n, req.ConfigFile, passwords)
 	if err != nil {
 		return errResponse(err), nil
 	}
@@ -120,6 +156,7 @@ func (a *payChAPIServer) OpenSession(_ context.Context, req *pb.OpenSessionReq)
 	a.Lock()
 	a.chUpdatesNotif[sessionID] = make(map[string]chan bool)
 	a.Unlock()
+	a.initInvoices(sessionID)
 
 	return &pb.OpenSessionResp{
 		Response: &pb.OpenSessionResp_MsgSuccess_{
@@ -146,10 +183,11 @@ func (a *payChAPIServer) AddPeerID(_ context.Context, req *pb.AddPeerIDReq) (*pb
 		return errResponse(err), nil
 	}
 	err = sess.AddPeerID(perun.PeerID{
-		Alias:              req.PeerID.Alias,
-		OffChainAddrString: req.PeerID.OffChainAddress,
-		CommAddr:           req.PeerID.CommAddress,
-		CommType:           req.PeerID.CommType,
+		Alias:               req.PeerID.Alias,
+		OffChainAddrString:  req.PeerID.OffChainAddress,
+		CommAddr:            req.PeerID.CommAddress,
+		CommType:            req.PeerID.CommType,
+		CommCertFingerprint: req.PeerID.CommCertFingerprint,
 	})
 	if err != nil {
 		return errResponse(err), nil
@@ -186,17 +224,202 @@ func (a *payChAPIServer) GetPeerID(_ context.Context, req *pb.GetPeerIDReq) (*pb
 	return &pb.GetPeerIDResp{
 		Response: &pb.GetPeerIDResp_MsgSuccess_{
 			MsgSuccess: &pb.GetPeerIDResp_MsgSuccess{
-				PeerID: &pb.PeerID{
-					Alias:           peerID.Alias,
-					OffChainAddress: peerID.OffChainAddrString,
-					CommAddress:     peerID.CommAddr,
-					CommType:        peerID.CommType,
-				},
+				PeerID: pb.FromPeerID(peerID),
+			},
+		},
+	}, nil
+}
+
+// ListPeerIDs wraps session.ListPeerIDs.
+func (a *payChAPIServer) ListPeerIDs(_ context.Context, req *pb.ListPeerIDsReq) (*pb.ListPeerIDsResp, error) {
+	errResponse := func(err perun.APIError) *pb.ListPeerIDsResp {
+		return &pb.ListPeerIDsResp{
+			Response: &pb.ListPeerIDsResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	peerIDs, err := sess.ListPeerIDs()
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.ListPeerIDsResp{
+		Response: &pb.ListPeerIDsResp_MsgSuccess_{
+			MsgSuccess: &pb.ListPeerIDsResp_MsgSuccess{
+				PeerIDs: pb.FromPeerIDs(peerIDs),
+			},
+		},
+	}, nil
+}
+
+// UpdatePeerID wraps payment.UpdatePeerID.
+func (a *payChAPIServer) UpdatePeerID(_ context.Context, req *pb.UpdatePeerIDReq) (*pb.UpdatePeerIDResp, error) {
+	errResponse := func(err perun.APIError) *pb.UpdatePeerIDResp {
+		return &pb.UpdatePeerIDResp{
+			Response: &pb.UpdatePeerIDResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	err = payment.UpdatePeerID(sess, pb.ToPeerID(req.PeerID))
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.UpdatePeerIDResp{
+		Response: &pb.UpdatePeerIDResp_MsgSuccess_{
+			MsgSuccess: &pb.UpdatePeerIDResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+// DeletePeerID wraps payment.DeletePeerID.
+func (a *payChAPIServer) DeletePeerID(_ context.Context, req *pb.DeletePeerIDReq) (*pb.DeletePeerIDResp, error) {
+	errResponse := func(err perun.APIError) *pb.DeletePeerIDResp {
+		return &pb.DeletePeerIDResp{
+			Response: &pb.DeletePeerIDResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	err = payment.DeletePeerID(sess, req.Alias)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.DeletePeerIDResp{
+		Response: &pb.DeletePeerIDResp_MsgSuccess_{
+			MsgSuccess: &pb.DeletePeerIDResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+// GetPeerStatus wraps session.GetPeerStatus.
+func (a *payChAPIServer) GetPeerStatus(ctx context.Context, req *pb.GetPeerStatusReq) (
+	*pb.GetPeerStatusResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.GetPeerStatusResp {
+		return &pb.GetPeerStatusResp{
+			Response: &pb.GetPeerStatusResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	peerStatus, err := sess.GetPeerStatus(ctx, req.Alias)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetPeerStatusResp{
+		Response: &pb.GetPeerStatusResp_MsgSuccess_{
+			MsgSuccess: &pb.GetPeerStatusResp_MsgSuccess{
+				PeerStatus: pb.FromPeerStatus(peerStatus),
+			},
+		},
+	}, nil
+}
+
+// SubPeerStatus wraps session.SubPeerStatus.
+func (a *payChAPIServer) SubPeerStatus(req *pb.SubPeerStatusReq, srv pb.Payment_API_SubPeerStatusServer) error {
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		// TODO: (mano) Return a error response and not a protocol error.
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	notifier := func(notif perun.PeerStatus) {
+		err := srv.Send(&pb.SubPeerStatusResp{Response: &pb.SubPeerStatusResp_Notify_{
+			Notify: &pb.SubPeerStatusResp_Notify{
+				PeerStatus: pb.FromPeerStatus(notif),
+			},
+		}})
+		_ = err
+		// if err != nil {
+		// 	// TODO: (mano) Error handling when sending notification.
+		// }
+	}
+	err = sess.SubPeerStatus(req.Alias, notifier)
+	if err != nil {
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	signal := make(chan bool)
+	a.Lock()
+	if _, ok := a.peerStatusNotif[req.SessionID]; !ok {
+		a.peerStatusNotif[req.SessionID] = make(map[string]chan bool)
+	}
+	a.peerStatusNotif[req.SessionID][req.Alias] = signal
+	a.Unlock()
+
+	<-signal
+	return nil
+}
+
+// UnsubPeerStatus wraps session.UnsubPeerStatus.
+func (a *payChAPIServer) UnsubPeerStatus(_ context.Context, req *pb.UnsubPeerStatusReq) (
+	*pb.UnsubPeerStatusResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.UnsubPeerStatusResp {
+		return &pb.UnsubPeerStatusResp{
+			Response: &pb.UnsubPeerStatusResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	err = sess.UnsubPeerStatus(req.Alias)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	a.closeGrpcPeerStatusSub(req.SessionID, req.Alias)
+
+	return &pb.UnsubPeerStatusResp{
+		Response: &pb.UnsubPeerStatusResp_MsgSuccess_{
+			MsgSuccess: &pb.UnsubPeerStatusResp_MsgSuccess{
+				Success: true,
 			},
 		},
 	}, nil
 }
 
+func (a *payChAPIServer) closeGrpcPeerStatusSub(sessionID, alias string) {
+	a.Lock()
+	signal := a.peerStatusNotif[sessionID][alias]
+	delete(a.peerStatusNotif[sessionID], alias)
+	a.Unlock()
+	close(signal)
+}
+
 // OpenPayCh wraps payment.OpenPayCh.
 func (a *payChAPIServer) OpenPayCh(ctx context.Context, req *pb.OpenPayChReq) (*pb.OpenPayChResp, error) {
 	errResponse := func(err perun.APIError) *pb.OpenPayChResp {
@@ -230,6 +453,48 @@ func (a *payChAPIServer) OpenPayCh(ctx context.Context, req *pb.OpenPayChReq) (*
 	}, nil
 }
 
+// OpenVirtualPayCh wraps payment.OpenVirtualPayCh.
+func (a *payChAPIServer) OpenVirtualPayCh(ctx context.Context, req *pb.OpenVirtualPayChReq) (
+	*pb.OpenVirtualPayChResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.OpenVirtualPayChResp {
+		return &pb.OpenVirtualPayChResp{
+			Response: &pb.OpenVirtualPayChResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	// Checked here only to avoid truncation, the value is validated by the session.
+	if req.PeerParentIdx > math.MaxUint16 {
+		err = perun.NewAPIErrInvalidArgument(errors.New("should be 0 or 1"),
+			perun.ArgNamePeerIdx, fmt.Sprint(req.PeerParentIdx))
+		return errResponse(err), nil
+	}
+	parents := perun.VirtualChParents{
+		ChID:     req.ParentChID,
+		PeerChID: req.PeerParentChID,
+		PeerIdx:  uint16(req.PeerParentIdx),
+	}
+	openingBalInfo := pb.ToBalInfo(req.OpeningBalInfo)
+	payChInfo, err := payment.OpenVirtualPayCh(ctx, sess, parents, openingBalInfo, req.ChallengeDurSecs)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.OpenVirtualPayChResp{
+		Response: &pb.OpenVirtualPayChResp_MsgSuccess_{
+			MsgSuccess: &pb.OpenVirtualPayChResp_MsgSuccess{
+				OpenedPayChInfo: pb.FromPayChInfo(payChInfo),
+			},
+		},
+	}, nil
+}
+
 // GetPayChsInfo wraps payment.GetPayChs.
 func (a *payChAPIServer) GetPayChsInfo(_ context.Context, req *pb.GetPayChsInfoReq) (*pb.GetPayChsInfoResp, error) {
 	errResponse := func(err perun.APIError) *pb.GetPayChsInfoResp {
@@ -275,6 +540,8 @@ func (a *payChAPIServer) SubPayChProposals(req *pb.SubPayChProposalsReq,
 				OpeningBalInfo:   pb.FromBalInfo(notif.OpeningBalInfo),
 				ChallengeDurSecs: notif.ChallengeDurSecs,
 				Expiry:           notif.Expiry,
+				ParentChID:       notif.ParentChID,
+				AutoResponse:     toGrpcChProposalAutoResponse[notif.AutoResponse],
 			},
 		}})
 		_ = err
@@ -297,6 +564,14 @@ func (a *payChAPIServer) SubPayChProposals(req *pb.SubPayChProposalsReq,
 	return nil
 }
 
+// toGrpcChProposalAutoResponse maps enums from AutoResponse type defined in
+// perun-node to AutoResponse type defined for channel proposals in grpc package.
+var toGrpcChProposalAutoResponse = map[perun.AutoResponse]pb.SubPayChProposalsResp_Notify_AutoResponse{
+	perun.AutoResponseNone:     pb.SubPayChProposalsResp_Notify_none,
+	perun.AutoResponseAccepted: pb.SubPayChProposalsResp_Notify_accepted,
+	perun.AutoResponseRejected: pb.SubPayChProposalsResp_Notify_rejected,
+}
+
 // UnsubPayChProposals wraps payment.UnsubPayChProposals.
 func (a *payChAPIServer) UnsubPayChProposals(_ context.Context, req *pb.UnsubPayChProposalsReq) (
 	*pb.UnsubPayChProposalsResp, error,
@@ -367,6 +642,36 @@ func (a *payChAPIServer) RespondPayChProposal(ctx context.Context, req *pb.Respo
 	}, nil
 }
 
+// RespondVirtualPayChProposal wraps payment.RespondVirtualPayChProposal.
+func (a *payChAPIServer) RespondVirtualPayChProposal(ctx context.Context, req *pb.RespondVirtualPayChProposalReq) (
+	*pb.RespondVirtualPayChProposalResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.RespondVirtualPayChProposalResp {
+		return &pb.RespondVirtualPayChProposalResp{
+			Response: &pb.RespondVirtualPayChProposalResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	openedPayChInfo, err := payment.RespondVirtualPayChProposal(ctx, sess, req.ProposalID, req.Accept)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.RespondVirtualPayChProposalResp{
+		Response: &pb.RespondVirtualPayChProposalResp_MsgSuccess_{
+			MsgSuccess: &pb.RespondVirtualPayChProposalResp_MsgSuccess{
+				OpenedPayChInfo: pb.FromPayChInfo(openedPayChInfo),
+			},
+		},
+	}, nil
+}
+
 // CloseSession wraps payment.CloseSession.
 func (a *payChAPIServer) CloseSession(_ context.Context, req *pb.CloseSessionReq) (*pb.CloseSessionResp, error) {
 	errResponse := func(err perun.APIError) *pb.CloseSessionResp {
@@ -484,6 +789,7 @@ func (a *payChAPIServer) SubPayChUpdates(req *pb.SubpayChUpdatesReq, srv pb.Paym
 				Type:              ToGrpcChUpdateType[notif.Type],
 				Expiry:            notif.Expiry,
 				Error:             notifErr,
+				AutoResponse:      toGrpcChUpdateAutoResponse[notif.AutoResponse],
 			},
 		}})
 		_ = err
@@ -504,6 +810,11 @@ func (a *payChAPIServer) SubPayChUpdates(req *pb.SubpayChUpdatesReq, srv pb.Paym
 
 	signal := make(chan bool)
 	a.Lock()
+	// Sessions re-opened by the node on restart are not opened via this
+	// server, hence the entry may not have been initialized.
+	if _, ok := a.chUpdatesNotif[req.SessionID]; !ok {
+		a.chUpdatesNotif[req.SessionID] = make(map[string]chan bool)
+	}
 	a.chUpdatesNotif[req.SessionID][req.ChID] = signal
 	a.Unlock()
 
@@ -519,6 +830,14 @@ var ToGrpcChUpdateType = map[perun.ChUpdateType]pb.SubPayChUpdatesResp_Notify_Ch
 	perun.ChUpdateTypeClosed: pb.SubPayChUpdatesResp_Notify_closed,
 }
 
+// toGrpcChUpdateAutoResponse maps enums from AutoResponse type defined in
+// perun-node to AutoResponse type defined for channel updates in grpc package.
+var toGrpcChUpdateAutoResponse = map[perun.AutoResponse]pb.SubPayChUpdatesResp_Notify_AutoResponse{
+	perun.AutoResponseNone:     pb.SubPayChUpdatesResp_Notify_none,
+	perun.AutoResponseAccepted: pb.SubPayChUpdatesResp_Notify_accepted,
+	perun.AutoResponseRejected: pb.SubPayChUpdatesResp_Notify_rejected,
+}
+
 // UnsubPayChUpdates wraps payment.UnsubPayChUpdates.
 func (a *payChAPIServer) UnsubPayChUpdates(_ context.Context, req *pb.UnsubPayChUpdatesReq) (
 	*pb.UnsubPayChUpdatesResp, error,
@@ -629,6 +948,40 @@ func (a *payChAPIServer) GetPayChInfo(_ context.Context, req *pb.GetPayChInfoReq
 	}, nil
 }
 
+// GetPayChHistory wraps payment.GetPayChHistory.
+func (a *payChAPIServer) GetPayChHistory(_ context.Context, req *pb.GetPayChHistoryReq) (
+	*pb.GetPayChHistoryResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.GetPayChHistoryResp {
+		return &pb.GetPayChHistoryResp{
+			Response: &pb.GetPayChHistoryResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	entries, err := payment.GetPayChHistory(ch, req.FromVersion, req.Limit)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetPayChHistoryResp{
+		Response: &pb.GetPayChHistoryResp_MsgSuccess_{
+			MsgSuccess: &pb.GetPayChHistoryResp_MsgSuccess{
+				Entries: pb.FromChHistoryEntries(entries),
+			},
+		},
+	}, nil
+}
+
 // ClosePayCh wraps payment.ClosePayCh.
 func (a *payChAPIServer) ClosePayCh(ctx context.Context, req *pb.ClosePayChReq) (*pb.ClosePayChResp, error) {
 	errResponse := func(err perun.APIError) *pb.ClosePayChResp {
@@ -660,3 +1013,550 @@ func (a *payChAPIServer) ClosePayCh(ctx context.Context, req *pb.ClosePayChReq)
 		},
 	}, nil
 }
+
+// StartPaymentStream wraps payment.Streams.StartPaymentStream.
+func (a *payChAPIServer) StartPaymentStream(_ context.Context, req *pb.StartPaymentStreamReq) (
+	*pb.StartPaymentStreamResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.StartPaymentStreamResp {
+		return &pb.StartPaymentStreamResp{
+			Response: &pb.StartPaymentStreamResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	interval := time.Duration(req.IntervalMillis) * time.Millisecond
+	status, err := a.payStreamsOf(sess).StartPaymentStream(ch, req.Payee, req.Currency,
+		req.AmountPerInterval, interval, req.Cap)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.StartPaymentStreamResp{
+		Response: &pb.StartPaymentStreamResp_MsgSuccess_{
+			MsgSuccess: &pb.StartPaymentStreamResp_MsgSuccess{
+				Status: pb.FromPaymentStreamStatus(status),
+			},
+		},
+	}, nil
+}
+
+// StopPaymentStream wraps payment.Streams.StopPaymentStream.
+func (a *payChAPIServer) StopPaymentStream(_ context.Context, req *pb.StopPaymentStreamReq) (
+	*pb.StopPaymentStreamResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.StopPaymentStreamResp {
+		return &pb.StopPaymentStreamResp{
+			Response: &pb.StopPaymentStreamResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	status, err := a.payStreamsOf(sess).StopPaymentStream(req.StreamID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.StopPaymentStreamResp{
+		Response: &pb.StopPaymentStreamResp_MsgSuccess_{
+			MsgSuccess: &pb.StopPaymentStreamResp_MsgSuccess{
+				Status: pb.FromPaymentStreamStatus(status),
+			},
+		},
+	}, nil
+}
+
+// SubPaymentStreamStatus wraps payment.Streams.SubPaymentStreamStatus. The
+// subscription ends when the stream ends, when it is unsubscribed or when the
+// client cancels the request.
+func (a *payChAPIServer) SubPaymentStreamStatus(req *pb.SubPaymentStreamStatusReq,
+	srv pb.Payment_API_SubPaymentStreamStatusServer,
+) error {
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+	streams := a.payStreamsOf(sess)
+
+	signal := make(chan bool)
+	a.Lock()
+	if _, ok := a.payStreamsNotif[req.SessionID]; !ok {
+		a.payStreamsNotif[req.SessionID] = make(map[string]chan bool)
+	}
+	if _, ok := a.payStreamsNotif[req.SessionID][req.StreamID]; ok {
+		a.Unlock()
+		err := perun.NewAPIErrResourceExists(perun.ResTypePayStreamSub, req.StreamID)
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+	a.payStreamsNotif[req.SessionID][req.StreamID] = signal
+	a.Unlock()
+
+	ended := make(chan struct{})
+	notifier := func(status payment.StreamStatus) {
+		err := srv.Send(&pb.SubPaymentStreamStatusResp{Response: &pb.SubPaymentStreamStatusResp_Notify_{
+			Notify: &pb.SubPaymentStreamStatusResp_Notify{
+				Status: pb.FromPaymentStreamStatus(status),
+			},
+		}})
+		_ = err
+		// if err != nil {
+		// 	// TODO: (mano) Error handling when sending notification.
+		// }
+
+		if status.State != payment.StreamRunning {
+			close(ended)
+		}
+	}
+	if err := streams.SubPaymentStreamStatus(req.StreamID, notifier); err != nil {
+		a.closeGrpcPayStreamSub(req.SessionID, req.StreamID)
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	select {
+	case <-signal: // Unsubscribed by the user.
+		return nil
+	case <-ended:
+	case <-srv.Context().Done():
+	}
+	a.closeGrpcPayStreamSub(req.SessionID, req.StreamID)
+	// Error is ignored, as the subscription would have been deleted only by an unsubscribe call.
+	streams.UnsubPaymentStreamStatus(req.StreamID) //nolint:errcheck
+	return nil
+}
+
+// UnsubPaymentStreamStatus wraps payment.Streams.UnsubPaymentStreamStatus.
+func (a *payChAPIServer) UnsubPaymentStreamStatus(_ context.Context, req *pb.UnsubPaymentStreamStatusReq) (
+	*pb.UnsubPaymentStreamStatusResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.UnsubPaymentStreamStatusResp {
+		return &pb.UnsubPaymentStreamStatusResp{
+			Response: &pb.UnsubPaymentStreamStatusResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	if err = a.payStreamsOf(sess).UnsubPaymentStreamStatus(req.StreamID); err != nil {
+		return errResponse(err), nil
+	}
+	a.closeGrpcPayStreamSub(req.SessionID, req.StreamID)
+
+	return &pb.UnsubPaymentStreamStatusResp{
+		Response: &pb.UnsubPaymentStreamStatusResp_MsgSuccess_{
+			MsgSuccess: &pb.UnsubPaymentStreamStatusResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+// CreatePaymentSchedule wraps session.CreatePaymentSchedule.
+func (a *payChAPIServer) CreatePaymentSchedule(_ context.Context, req *pb.CreatePaymentScheduleReq) (
+	*pb.CreatePaymentScheduleResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.CreatePaymentScheduleResp {
+		return &pb.CreatePaymentScheduleResp{
+			Response: &pb.CreatePaymentScheduleResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	schedule, err := sess.CreatePaymentSchedule(pb.ToPaymentSchedule(req.Schedule))
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.CreatePaymentScheduleResp{
+		Response: &pb.CreatePaymentScheduleResp_MsgSuccess_{
+			MsgSuccess: &pb.CreatePaymentScheduleResp_MsgSuccess{
+				Schedule: pb.FromPaymentSchedule(schedule),
+			},
+		},
+	}, nil
+}
+
+// ListPaymentSchedules wraps session.ListPaymentSchedules.
+func (a *payChAPIServer) ListPaymentSchedules(_ context.Context, req *pb.ListPaymentSchedulesReq) (
+	*pb.ListPaymentSchedulesResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.ListPaymentSchedulesResp {
+		return &pb.ListPaymentSchedulesResp{
+			Response: &pb.ListPaymentSchedulesResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	schedules, err := sess.ListPaymentSchedules()
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.ListPaymentSchedulesResp{
+		Response: &pb.ListPaymentSchedulesResp_MsgSuccess_{
+			MsgSuccess: &pb.ListPaymentSchedulesResp_MsgSuccess{
+				Schedules: pb.FromPaymentSchedules(schedules),
+			},
+		},
+	}, nil
+}
+
+// CancelPaymentSchedule wraps session.CancelPaymentSchedule.
+func (a *payChAPIServer) CancelPaymentSchedule(_ context.Context, req *pb.CancelPaymentScheduleReq) (
+	*pb.CancelPaymentScheduleResp, error,
+) {
+	errResponse := func(err perun.APIError) *pb.CancelPaymentScheduleResp {
+		return &pb.CancelPaymentScheduleResp{
+			Response: &pb.CancelPaymentScheduleResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	schedule, err := sess.CancelPaymentSchedule(req.ScheduleID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.CancelPaymentScheduleResp{
+		Response: &pb.CancelPaymentScheduleResp_MsgSuccess_{
+			MsgSuccess: &pb.CancelPaymentScheduleResp_MsgSuccess{
+				Schedule: pb.FromPaymentSchedule(schedule),
+			},
+		},
+	}, nil
+}
+
+// payStreamsOf returns the payment streams of the session, initializing them
+// if required.
+//
+// When initialized, a close handler is set on the session to stop the
+// streams, so that they are stopped irrespective of the API used for closing
+// the session.
+func (a *payChAPIServer) payStreamsOf(sess perun.SessionAPI) *payment.Streams {
+	sessionID := sess.ID()
+	a.Lock()
+	streams, ok := a.payStreams[sessionID]
+	if !ok {
+		streams = payment.NewStreams()
+		a.payStreams[sessionID] = streams
+	}
+	a.Unlock()
+	if !ok {
+		// Handler is set without holding the lock, as it is invoked right away if the session is closed.
+		sess.SetCloseHandler(payStreamsCloseHandlerID, func() { a.closePayStreams(sessionID) })
+	}
+	return streams
+}
+
+// closeGrpcPayStreamSub signals the grpc subscription function
+// (SubPaymentStreamStatus) of the stream to end, if it is running.
+func (a *payChAPIServer) closeGrpcPayStreamSub(sessionID, streamID string) {
+	a.Lock()
+	signal, ok := a.payStreamsNotif[sessionID][streamID]
+	delete(a.payStreamsNotif[sessionID], streamID)
+	a.Unlock()
+	if ok {
+		close(signal)
+	}
+}
+
+// closePayStreams stops the payment streams of the session. The status
+// subscriptions end, once the final status of the streams is sent.
+func (a *payChAPIServer) closePayStreams(sessionID string) {
+	a.Lock()
+	streams, ok := a.payStreams[sessionID]
+	delete(a.payStreams, sessionID)
+	a.Unlock()
+	if ok {
+		streams.Close()
+	}
+}
+
+// Pay wraps session.Pay.
+func (a *payChAPIServer) Pay(ctx context.Context, req *pb.PayReq) (*pb.PayResp, error) {
+	errResponse := func(err perun.APIError) *pb.PayResp {
+		return &pb.PayResp{
+			Response: &pb.PayResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	routedPayment, err := sess.Pay(ctx, req.TargetAlias, req.Currency, req.Amount)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.PayResp{
+		Response: &pb.PayResp_MsgSuccess_{
+			MsgSuccess: &pb.PayResp_MsgSuccess{
+				Payment: pb.FromRoutedPayment(routedPayment),
+			},
+		},
+	}, nil
+}
+
+// CreateInvoice wraps invoice.Manager.Create.
+func (a *payChAPIServer) CreateInvoice(_ context.Context, req *pb.CreateInvoiceReq) (*pb.CreateInvoiceResp, error) {
+	errResponse := func(err perun.APIError) *pb.CreateInvoiceResp {
+		return &pb.CreateInvoiceResp{
+			Response: &pb.CreateInvoiceResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	invoices, err := a.invoicesOf(sess)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	expiry := time.Duration(req.ExpirySecs) * time.Second
+	inv, err := invoices.Create(req.Currency, req.Amount, req.Memo, expiry)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.CreateInvoiceResp{
+		Response: &pb.CreateInvoiceResp_MsgSuccess_{
+			MsgSuccess: &pb.CreateInvoiceResp_MsgSuccess{
+				Invoice: pb.FromInvoice(toInvoice(invoices, inv)),
+			},
+		},
+	}, nil
+}
+
+// GetInvoice wraps invoice.Manager.Get.
+func (a *payChAPIServer) GetInvoice(_ context.Context, req *pb.GetInvoiceReq) (*pb.GetInvoiceResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetInvoiceResp {
+		return &pb.GetInvoiceResp{
+			Response: &pb.GetInvoiceResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	invoices, err := a.invoicesOf(sess)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	inv, err := invoices.Get(req.InvoiceID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetInvoiceResp{
+		Response: &pb.GetInvoiceResp_MsgSuccess_{
+			MsgSuccess: &pb.GetInvoiceResp_MsgSuccess{
+				Invoice: pb.FromInvoice(toInvoice(invoices, inv)),
+			},
+		},
+	}, nil
+}
+
+// ListInvoices wraps invoice.Manager.List.
+func (a *payChAPIServer) ListInvoices(_ context.Context, req *pb.ListInvoicesReq) (*pb.ListInvoicesResp, error) {
+	errResponse := func(err perun.APIError) *pb.ListInvoicesResp {
+		return &pb.ListInvoicesResp{
+			Response: &pb.ListInvoicesResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	invoices, err := a.invoicesOf(sess)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	list := invoices.List()
+	result := make([]perun.Invoice, len(list))
+	for i := range list {
+		result[i] = toInvoice(invoices, list[i])
+	}
+
+	return &pb.ListInvoicesResp{
+		Response: &pb.ListInvoicesResp_MsgSuccess_{
+			MsgSuccess: &pb.ListInvoicesResp_MsgSuccess{
+				Invoices: pb.FromInvoices(result),
+			},
+		},
+	}, nil
+}
+
+// PayInvoice wraps invoice.Manager.PayInvoice.
+func (a *payChAPIServer) PayInvoice(ctx context.Context, req *pb.PayInvoiceReq) (*pb.PayInvoiceResp, error) {
+	errResponse := func(err perun.APIError) *pb.PayInvoiceResp {
+		return &pb.PayInvoiceResp{
+			Response: &pb.PayInvoiceResp_Error{
+				Error: pb.FromError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	invoices, err := a.invoicesOf(sess)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	inv, err := invoices.PayInvoice(ctx, req.EncodedInvoice)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.PayInvoiceResp{
+		Response: &pb.PayInvoiceResp_MsgSuccess_{
+			MsgSuccess: &pb.PayInvoiceResp_MsgSuccess{
+				Invoice: pb.FromInvoice(toInvoice(invoices, inv)),
+			},
+		},
+	}, nil
+}
+
+// invoicesOf returns the invoice manager of the session, initializing it if
+// required.
+//
+// The manager persists the invoices in the database directory of the session
+// and uses its response timeout, as read from its config file. When
+// initialized, a close handler is set on the session to close the manager, so
+// that it is closed irrespective of the API used for closing the session.
+func (a *payChAPIServer) invoicesOf(sess perun.SessionAPI) (*invoice.Manager, perun.APIError) {
+	sessionID := sess.ID()
+	a.Lock()
+	m, ok := a.invoices[sessionID]
+	if !ok {
+		cfg, apiErr := a.invoiceConfigOf(sessionID)
+		if apiErr != nil {
+			a.Unlock()
+			return nil, apiErr
+		}
+		// Manager is created while holding the lock, as only one manager can be used with a session.
+		if m, apiErr = invoice.New(sess, cfg); apiErr != nil {
+			a.Unlock()
+			return nil, apiErr
+		}
+		a.invoices[sessionID] = m
+	}
+	a.Unlock()
+	if !ok {
+		// Handler is set without holding the lock, as it is invoked right away if the session is closed.
+		sess.SetCloseHandler(invoicesCloseHandlerID, func() { a.closeInvoices(sessionID) })
+	}
+	return m, nil
+}
+
+// invoiceConfigOf returns the config for the invoice manager of the session.
+func (a *payChAPIServer) invoiceConfigOf(sessionID string) (invoice.Config, perun.APIError) {
+	sessInfo, apiErr := a.n.GetSessionInfo(sessionID)
+	if apiErr != nil {
+		return invoice.Config{}, apiErr
+	}
+	cfg, err := session.ParseConfig(sessInfo.ConfigFile)
+	if err != nil {
+		return invoice.Config{}, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "parsing session config"))
+	}
+	return invoice.Config{
+		StoreFile: filepath.Join(cfg.DatabaseDir, invoicesFileName),
+		Timeout:   cfg.ResponseTimeout,
+	}, nil
+}
+
+// initInvoices initializes the invoice manager of the session, so that the
+// payments for the invoices created by the user are received even before the
+// invoice APIs are used.
+func (a *payChAPIServer) initInvoices(sessionID string) {
+	sess, apiErr := a.n.GetSession(sessionID)
+	if apiErr != nil {
+		return
+	}
+	// If it fails, initialization is retried and the error is returned when the invoice APIs are used.
+	_, _ = a.invoicesOf(sess) //nolint: errcheck
+}
+
+// closeInvoices closes the invoice manager of the session.
+func (a *payChAPIServer) closeInvoices(sessionID string) {
+	a.Lock()
+	m, ok := a.invoices[sessionID]
+	delete(a.invoices, sessionID)
+	a.Unlock()
+	if ok {
+		m.Close() //nolint: errcheck,gosec // Session is closed, so unsubscribing from app messages fails.
+	}
+}
+
+// toInvoice converts the invoice used by the invoice manager to the one used
+// in the payment API.
+func toInvoice(m *invoice.Manager, inv invoice.Invoice) perun.Invoice {
+	result := perun.Invoice{
+		ID:        inv.ID,
+		Payee:     inv.Payee,
+		Currency:  inv.Currency,
+		Amount:    inv.Amount,
+		Memo:      inv.Memo,
+		CreatedAt: inv.CreatedAt,
+		Expiry:    inv.Expiry,
+		Status:    invoiceStatuses[inv.Status],
+		ChID:      inv.ChID,
+		Version:   inv.Version,
+		PaidAt:    inv.PaidAt,
+	}
+	if m.IsOwn(inv) {
+		result.Encoded = inv.Encode()
+	}
+	return result
+}
+
+var invoiceStatuses = map[invoice.Status]perun.InvoiceStatus{
+	invoice.StatusOpen:    perun.InvoiceOpen,
+	invoice.StatusPaid:    perun.InvoicePaid,
+	invoice.StatusExpired: perun.InvoiceExpired,
+}
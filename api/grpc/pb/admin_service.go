@@ -0,0 +1,44 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pb
+
+import (
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// FromSessionsInfo is a helper function to convert slice of SessionInfo struct
+// defined in perun-node to a slice of SessionInfo struct defined in grpc
+// package.
+func FromSessionsInfo(sessionsInfo []perun.SessionInfo) []*SessionInfo {
+	grpcSessionsInfo := make([]*SessionInfo, len(sessionsInfo))
+	for i := range sessionsInfo {
+		grpcSessionsInfo[i] = FromSessionInfo(sessionsInfo[i])
+	}
+	return grpcSessionsInfo
+}
+
+// FromSessionInfo is a helper function to convert SessionInfo struct defined
+// in perun-node to SessionInfo struct defined in grpc package.
+func FromSessionInfo(src perun.SessionInfo) *SessionInfo {
+	return &SessionInfo{
+		SessionID:  src.ID,
+		UserAlias:  src.UserAlias,
+		ConfigFile: src.ConfigFile,
+		OpenedAt:   src.OpenedAt,
+		OpenChs:    uint32(src.OpenChs),
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.3
+// source: admin_service.proto
+
+// Package pb contains proto3 definitions for user API and the corresponding
+// generated code for grpc server and client.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Admin_API_ListSessions_FullMethodName   = "/pb.Admin_API/ListSessions"
+	Admin_API_GetSessionInfo_FullMethodName = "/pb.Admin_API/GetSessionInfo"
+	Admin_API_CloseSession_FullMethodName   = "/pb.Admin_API/CloseSession"
+)
+
+// Admin_APIClient is the client API for Admin_API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type Admin_APIClient interface {
+	ListSessions(ctx context.Context, in *ListSessionsReq, opts ...grpc.CallOption) (*ListSessionsResp, error)
+	GetSessionInfo(ctx context.Context, in *GetSessionInfoReq, opts ...grpc.CallOption) (*GetSessionInfoResp, error)
+	CloseSession(ctx context.Context, in *AdminCloseSessionReq, opts ...grpc.CallOption) (*AdminCloseSessionResp, error)
+}
+
+type admin_APIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdmin_APIClient(cc grpc.ClientConnInterface) Admin_APIClient {
+	return &admin_APIClient{cc}
+}
+
+func (c *admin_APIClient) ListSessions(ctx context.Context, in *ListSessionsReq, opts ...grpc.CallOption) (*ListSessionsResp, error) {
+	out := new(ListSessionsResp)
+	err := c.cc.Invoke(ctx, Admin_API_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *admin_APIClient) GetSessionInfo(ctx context.Context, in *GetSessionInfoReq, opts ...grpc.CallOption) (*GetSessionInfoResp, error) {
+	out := new(GetSessionInfoResp)
+	err := c.cc.Invoke(ctx, Admin_API_GetSessionInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *admin_APIClient) CloseSession(ctx context.Context, in *AdminCloseSessionReq, opts ...grpc.CallOption) (*AdminCloseSessionResp, error) {
+	out := new(AdminCloseSessionResp)
+	err := c.cc.Invoke(ctx, Admin_API_CloseSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Admin_APIServer is the server API for Admin_API service.
+// All implementations must embed UnimplementedAdmin_APIServer
+// for forward compatibility
+type Admin_APIServer interface {
+	ListSessions(context.Context, *ListSessionsReq) (*ListSessionsResp, error)
+	GetSessionInfo(context.Context, *GetSessionInfoReq) (*GetSessionInfoResp, error)
+	CloseSession(context.Context, *AdminCloseSessionReq) (*AdminCloseSessionResp, error)
+	mustEmbedUnimplementedAdmin_APIServer()
+}
+
+// UnimplementedAdmin_APIServer must be embedded to have forward compatible implementations.
+type UnimplementedAdmin_APIServer struct {
+}
+
+func (UnimplementedAdmin_APIServer) ListSessions(context.Context, *ListSessionsReq) (*ListSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAdmin_APIServer) GetSessionInfo(context.Context, *GetSessionInfoReq) (*GetSessionInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionInfo not implemented")
+}
+func (UnimplementedAdmin_APIServer) CloseSession(context.Context, *AdminCloseSessionReq) (*AdminCloseSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
+}
+func (UnimplementedAdmin_APIServer) mustEmbedUnimplementedAdmin_APIServer() {}
+
+// UnsafeAdmin_APIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Admin_APIServer will
+// result in compilation errors.
+type UnsafeAdmin_APIServer interface {
+	mustEmbedUnimplementedAdmin_APIServer()
+}
+
+func RegisterAdmin_APIServer(s grpc.ServiceRegistrar, srv Admin_APIServer) {
+	s.RegisterService(&Admin_API_ServiceDesc, srv)
+}
+
+func _Admin_API_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Admin_APIServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_API_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Admin_APIServer).ListSessions(ctx, req.(*ListSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_API_GetSessionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Admin_APIServer).GetSessionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_API_GetSessionInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Admin_APIServer).GetSessionInfo(ctx, req.(*GetSessionInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_API_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCloseSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Admin_APIServer).CloseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_API_CloseSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Admin_APIServer).CloseSession(ctx, req.(*AdminCloseSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_API_ServiceDesc is the grpc.ServiceDesc for Admin_API service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_API_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Admin_API",
+	HandlerType: (*Admin_APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSessions",
+			Handler:    _Admin_API_ListSessions_Handler,
+		},
+		{
+			MethodName: "GetSessionInfo",
+			Handler:    _Admin_API_GetSessionInfo_Handler,
+		},
+		{
+			MethodName: "CloseSession",
+			Handler:    _Admin_API_CloseSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin_service.proto",
+}
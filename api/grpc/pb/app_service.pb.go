@@ -0,0 +1,3075 @@
+// Copyright (c) 2020 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.23.3
+// source: app_service.proto
+
+// Package pb contains proto3 definitions for user API and the corresponding
+// generated code for grpc server and client.
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubAppChUpdatesResp_Notify_ChUpdateType int32
+
+const (
+	SubAppChUpdatesResp_Notify_open   SubAppChUpdatesResp_Notify_ChUpdateType = 0
+	SubAppChUpdatesResp_Notify_final  SubAppChUpdatesResp_Notify_ChUpdateType = 1
+	SubAppChUpdatesResp_Notify_closed SubAppChUpdatesResp_Notify_ChUpdateType = 2
+)
+
+// Enum value maps for SubAppChUpdatesResp_Notify_ChUpdateType.
+var (
+	SubAppChUpdatesResp_Notify_ChUpdateType_name = map[int32]string{
+		0: "open",
+		1: "final",
+		2: "closed",
+	}
+	SubAppChUpdatesResp_Notify_ChUpdateType_value = map[string]int32{
+		"open":   0,
+		"final":  1,
+		"closed": 2,
+	}
+)
+
+func (x SubAppChUpdatesResp_Notify_ChUpdateType) Enum() *SubAppChUpdatesResp_Notify_ChUpdateType {
+	p := new(SubAppChUpdatesResp_Notify_ChUpdateType)
+	*p = x
+	return p
+}
+
+func (x SubAppChUpdatesResp_Notify_ChUpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubAppChUpdatesResp_Notify_ChUpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_app_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SubAppChUpdatesResp_Notify_ChUpdateType) Type() protoreflect.EnumType {
+	return &file_app_service_proto_enumTypes[0]
+}
+
+func (x SubAppChUpdatesResp_Notify_ChUpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubAppChUpdatesResp_Notify_ChUpdateType.Descriptor instead.
+func (SubAppChUpdatesResp_Notify_ChUpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{13, 0, 0}
+}
+
+type OpenAppChReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID        string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	App              string   `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	InitParams       []byte   `protobuf:"bytes,3,opt,name=initParams,proto3" json:"initParams,omitempty"`
+	OpeningBalInfo   *BalInfo `protobuf:"bytes,4,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
+	ChallengeDurSecs uint64   `protobuf:"varint,5,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
+}
+
+func (x *OpenAppChReq) Reset() {
+	*x = OpenAppChReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenAppChReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAppChReq) ProtoMessage() {}
+
+func (x *OpenAppChReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAppChReq.ProtoReflect.Descriptor instead.
+func (*OpenAppChReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenAppChReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *OpenAppChReq) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *OpenAppChReq) GetInitParams() []byte {
+	if x != nil {
+		return x.InitParams
+	}
+	return nil
+}
+
+func (x *OpenAppChReq) GetOpeningBalInfo() *BalInfo {
+	if x != nil {
+		return x.OpeningBalInfo
+	}
+	return nil
+}
+
+func (x *OpenAppChReq) GetChallengeDurSecs() uint64 {
+	if x != nil {
+		return x.ChallengeDurSecs
+	}
+	return 0
+}
+
+type OpenAppChResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*OpenAppChResp_MsgSuccess_
+	//	*OpenAppChResp_Error
+	Response isOpenAppChResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *OpenAppChResp) Reset() {
+	*x = OpenAppChResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenAppChResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAppChResp) ProtoMessage() {}
+
+func (x *OpenAppChResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAppChResp.ProtoReflect.Descriptor instead.
+func (*OpenAppChResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *OpenAppChResp) GetResponse() isOpenAppChResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *OpenAppChResp) GetMsgSuccess() *OpenAppChResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*OpenAppChResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *OpenAppChResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*OpenAppChResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isOpenAppChResp_Response interface {
+	isOpenAppChResp_Response()
+}
+
+type OpenAppChResp_MsgSuccess_ struct {
+	MsgSuccess *OpenAppChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type OpenAppChResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*OpenAppChResp_MsgSuccess_) isOpenAppChResp_Response() {}
+
+func (*OpenAppChResp_Error) isOpenAppChResp_Response() {}
+
+type GetAppChsInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *GetAppChsInfoReq) Reset() {
+	*x = GetAppChsInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppChsInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppChsInfoReq) ProtoMessage() {}
+
+func (x *GetAppChsInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppChsInfoReq.ProtoReflect.Descriptor instead.
+func (*GetAppChsInfoReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAppChsInfoReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type GetAppChsInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetAppChsInfoResp_MsgSuccess_
+	//	*GetAppChsInfoResp_Error
+	Response isGetAppChsInfoResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetAppChsInfoResp) Reset() {
+	*x = GetAppChsInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppChsInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppChsInfoResp) ProtoMessage() {}
+
+func (x *GetAppChsInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppChsInfoResp.ProtoReflect.Descriptor instead.
+func (*GetAppChsInfoResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *GetAppChsInfoResp) GetResponse() isGetAppChsInfoResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetAppChsInfoResp) GetMsgSuccess() *GetAppChsInfoResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetAppChsInfoResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetAppChsInfoResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetAppChsInfoResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetAppChsInfoResp_Response interface {
+	isGetAppChsInfoResp_Response()
+}
+
+type GetAppChsInfoResp_MsgSuccess_ struct {
+	MsgSuccess *GetAppChsInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetAppChsInfoResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetAppChsInfoResp_MsgSuccess_) isGetAppChsInfoResp_Response() {}
+
+func (*GetAppChsInfoResp_Error) isGetAppChsInfoResp_Response() {}
+
+type SubAppChProposalsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *SubAppChProposalsReq) Reset() {
+	*x = SubAppChProposalsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubAppChProposalsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAppChProposalsReq) ProtoMessage() {}
+
+func (x *SubAppChProposalsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAppChProposalsReq.ProtoReflect.Descriptor instead.
+func (*SubAppChProposalsReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubAppChProposalsReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type SubAppChProposalsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*SubAppChProposalsResp_Notify_
+	//	*SubAppChProposalsResp_Error
+	Response isSubAppChProposalsResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *SubAppChProposalsResp) Reset() {
+	*x = SubAppChProposalsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubAppChProposalsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAppChProposalsResp) ProtoMessage() {}
+
+func (x *SubAppChProposalsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAppChProposalsResp.ProtoReflect.Descriptor instead.
+func (*SubAppChProposalsResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *SubAppChProposalsResp) GetResponse() isSubAppChProposalsResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SubAppChProposalsResp) GetNotify() *SubAppChProposalsResp_Notify {
+	if x, ok := x.GetResponse().(*SubAppChProposalsResp_Notify_); ok {
+		return x.Notify
+	}
+	return nil
+}
+
+func (x *SubAppChProposalsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubAppChProposalsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSubAppChProposalsResp_Response interface {
+	isSubAppChProposalsResp_Response()
+}
+
+type SubAppChProposalsResp_Notify_ struct {
+	Notify *SubAppChProposalsResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+}
+
+type SubAppChProposalsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SubAppChProposalsResp_Notify_) isSubAppChProposalsResp_Response() {}
+
+func (*SubAppChProposalsResp_Error) isSubAppChProposalsResp_Response() {}
+
+type UnsubAppChProposalsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *UnsubAppChProposalsReq) Reset() {
+	*x = UnsubAppChProposalsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubAppChProposalsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubAppChProposalsReq) ProtoMessage() {}
+
+func (x *UnsubAppChProposalsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubAppChProposalsReq.ProtoReflect.Descriptor instead.
+func (*UnsubAppChProposalsReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnsubAppChProposalsReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type UnsubAppChProposalsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*UnsubAppChProposalsResp_MsgSuccess_
+	//	*UnsubAppChProposalsResp_Error
+	Response isUnsubAppChProposalsResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *UnsubAppChProposalsResp) Reset() {
+	*x = UnsubAppChProposalsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubAppChProposalsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubAppChProposalsResp) ProtoMessage() {}
+
+func (x *UnsubAppChProposalsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubAppChProposalsResp.ProtoReflect.Descriptor instead.
+func (*UnsubAppChProposalsResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *UnsubAppChProposalsResp) GetResponse() isUnsubAppChProposalsResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UnsubAppChProposalsResp) GetMsgSuccess() *UnsubAppChProposalsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubAppChProposalsResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *UnsubAppChProposalsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubAppChProposalsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUnsubAppChProposalsResp_Response interface {
+	isUnsubAppChProposalsResp_Response()
+}
+
+type UnsubAppChProposalsResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubAppChProposalsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type UnsubAppChProposalsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UnsubAppChProposalsResp_MsgSuccess_) isUnsubAppChProposalsResp_Response() {}
+
+func (*UnsubAppChProposalsResp_Error) isUnsubAppChProposalsResp_Response() {}
+
+type RespondAppChProposalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ProposalID string `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Accept     bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondAppChProposalReq) Reset() {
+	*x = RespondAppChProposalReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondAppChProposalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondAppChProposalReq) ProtoMessage() {}
+
+func (x *RespondAppChProposalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondAppChProposalReq.ProtoReflect.Descriptor instead.
+func (*RespondAppChProposalReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RespondAppChProposalReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *RespondAppChProposalReq) GetProposalID() string {
+	if x != nil {
+		return x.ProposalID
+	}
+	return ""
+}
+
+func (x *RespondAppChProposalReq) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondAppChProposalResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RespondAppChProposalResp_MsgSuccess_
+	//	*RespondAppChProposalResp_Error
+	Response isRespondAppChProposalResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *RespondAppChProposalResp) Reset() {
+	*x = RespondAppChProposalResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondAppChProposalResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondAppChProposalResp) ProtoMessage() {}
+
+func (x *RespondAppChProposalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondAppChProposalResp.ProtoReflect.Descriptor instead.
+func (*RespondAppChProposalResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *RespondAppChProposalResp) GetResponse() isRespondAppChProposalResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RespondAppChProposalResp) GetMsgSuccess() *RespondAppChProposalResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*RespondAppChProposalResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *RespondAppChProposalResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*RespondAppChProposalResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRespondAppChProposalResp_Response interface {
+	isRespondAppChProposalResp_Response()
+}
+
+type RespondAppChProposalResp_MsgSuccess_ struct {
+	MsgSuccess *RespondAppChProposalResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type RespondAppChProposalResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RespondAppChProposalResp_MsgSuccess_) isRespondAppChProposalResp_Response() {}
+
+func (*RespondAppChProposalResp_Error) isRespondAppChProposalResp_Response() {}
+
+type SendAppActionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Action    []byte `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *SendAppActionReq) Reset() {
+	*x = SendAppActionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAppActionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAppActionReq) ProtoMessage() {}
+
+func (x *SendAppActionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAppActionReq.ProtoReflect.Descriptor instead.
+func (*SendAppActionReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SendAppActionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SendAppActionReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *SendAppActionReq) GetAction() []byte {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type SendAppActionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*SendAppActionResp_MsgSuccess_
+	//	*SendAppActionResp_Error
+	Response isSendAppActionResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *SendAppActionResp) Reset() {
+	*x = SendAppActionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAppActionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAppActionResp) ProtoMessage() {}
+
+func (x *SendAppActionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAppActionResp.ProtoReflect.Descriptor instead.
+func (*SendAppActionResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *SendAppActionResp) GetResponse() isSendAppActionResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SendAppActionResp) GetMsgSuccess() *SendAppActionResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*SendAppActionResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *SendAppActionResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SendAppActionResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSendAppActionResp_Response interface {
+	isSendAppActionResp_Response()
+}
+
+type SendAppActionResp_MsgSuccess_ struct {
+	MsgSuccess *SendAppActionResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type SendAppActionResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SendAppActionResp_MsgSuccess_) isSendAppActionResp_Response() {}
+
+func (*SendAppActionResp_Error) isSendAppActionResp_Response() {}
+
+type SubAppChUpdatesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *SubAppChUpdatesReq) Reset() {
+	*x = SubAppChUpdatesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubAppChUpdatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAppChUpdatesReq) ProtoMessage() {}
+
+func (x *SubAppChUpdatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAppChUpdatesReq.ProtoReflect.Descriptor instead.
+func (*SubAppChUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubAppChUpdatesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SubAppChUpdatesReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type SubAppChUpdatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*SubAppChUpdatesResp_Notify_
+	//	*SubAppChUpdatesResp_Error
+	Response isSubAppChUpdatesResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *SubAppChUpdatesResp) Reset() {
+	*x = SubAppChUpdatesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubAppChUpdatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAppChUpdatesResp) ProtoMessage() {}
+
+func (x *SubAppChUpdatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAppChUpdatesResp.ProtoReflect.Descriptor instead.
+func (*SubAppChUpdatesResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *SubAppChUpdatesResp) GetResponse() isSubAppChUpdatesResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SubAppChUpdatesResp) GetNotify() *SubAppChUpdatesResp_Notify {
+	if x, ok := x.GetResponse().(*SubAppChUpdatesResp_Notify_); ok {
+		return x.Notify
+	}
+	return nil
+}
+
+func (x *SubAppChUpdatesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubAppChUpdatesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSubAppChUpdatesResp_Response interface {
+	isSubAppChUpdatesResp_Response()
+}
+
+type SubAppChUpdatesResp_Notify_ struct {
+	Notify *SubAppChUpdatesResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+}
+
+type SubAppChUpdatesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SubAppChUpdatesResp_Notify_) isSubAppChUpdatesResp_Response() {}
+
+func (*SubAppChUpdatesResp_Error) isSubAppChUpdatesResp_Response() {}
+
+type UnsubAppChUpdatesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *UnsubAppChUpdatesReq) Reset() {
+	*x = UnsubAppChUpdatesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubAppChUpdatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubAppChUpdatesReq) ProtoMessage() {}
+
+func (x *UnsubAppChUpdatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubAppChUpdatesReq.ProtoReflect.Descriptor instead.
+func (*UnsubAppChUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnsubAppChUpdatesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UnsubAppChUpdatesReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type UnsubAppChUpdatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*UnsubAppChUpdatesResp_MsgSuccess_
+	//	*UnsubAppChUpdatesResp_Error
+	Response isUnsubAppChUpdatesResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *UnsubAppChUpdatesResp) Reset() {
+	*x = UnsubAppChUpdatesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubAppChUpdatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubAppChUpdatesResp) ProtoMessage() {}
+
+func (x *UnsubAppChUpdatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubAppChUpdatesResp.ProtoReflect.Descriptor instead.
+func (*UnsubAppChUpdatesResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *UnsubAppChUpdatesResp) GetResponse() isUnsubAppChUpdatesResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UnsubAppChUpdatesResp) GetMsgSuccess() *UnsubAppChUpdatesResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubAppChUpdatesResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *UnsubAppChUpdatesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubAppChUpdatesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUnsubAppChUpdatesResp_Response interface {
+	isUnsubAppChUpdatesResp_Response()
+}
+
+type UnsubAppChUpdatesResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubAppChUpdatesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type UnsubAppChUpdatesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UnsubAppChUpdatesResp_MsgSuccess_) isUnsubAppChUpdatesResp_Response() {}
+
+func (*UnsubAppChUpdatesResp_Error) isUnsubAppChUpdatesResp_Response() {}
+
+type RespondAppChUpdateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	UpdateID  string `protobuf:"bytes,3,opt,name=updateID,proto3" json:"updateID,omitempty"`
+	Accept    bool   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondAppChUpdateReq) Reset() {
+	*x = RespondAppChUpdateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondAppChUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondAppChUpdateReq) ProtoMessage() {}
+
+func (x *RespondAppChUpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondAppChUpdateReq.ProtoReflect.Descriptor instead.
+func (*RespondAppChUpdateReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RespondAppChUpdateReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *RespondAppChUpdateReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *RespondAppChUpdateReq) GetUpdateID() string {
+	if x != nil {
+		return x.UpdateID
+	}
+	return ""
+}
+
+func (x *RespondAppChUpdateReq) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondAppChUpdateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RespondAppChUpdateResp_MsgSuccess_
+	//	*RespondAppChUpdateResp_Error
+	Response isRespondAppChUpdateResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *RespondAppChUpdateResp) Reset() {
+	*x = RespondAppChUpdateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondAppChUpdateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondAppChUpdateResp) ProtoMessage() {}
+
+func (x *RespondAppChUpdateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondAppChUpdateResp.ProtoReflect.Descriptor instead.
+func (*RespondAppChUpdateResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *RespondAppChUpdateResp) GetResponse() isRespondAppChUpdateResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RespondAppChUpdateResp) GetMsgSuccess() *RespondAppChUpdateResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*RespondAppChUpdateResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *RespondAppChUpdateResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*RespondAppChUpdateResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRespondAppChUpdateResp_Response interface {
+	isRespondAppChUpdateResp_Response()
+}
+
+type RespondAppChUpdateResp_MsgSuccess_ struct {
+	MsgSuccess *RespondAppChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type RespondAppChUpdateResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RespondAppChUpdateResp_MsgSuccess_) isRespondAppChUpdateResp_Response() {}
+
+func (*RespondAppChUpdateResp_Error) isRespondAppChUpdateResp_Response() {}
+
+type GetAppChInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *GetAppChInfoReq) Reset() {
+	*x = GetAppChInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppChInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppChInfoReq) ProtoMessage() {}
+
+func (x *GetAppChInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppChInfoReq.ProtoReflect.Descriptor instead.
+func (*GetAppChInfoReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAppChInfoReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *GetAppChInfoReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type GetAppChInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetAppChInfoResp_MsgSuccess_
+	//	*GetAppChInfoResp_Error
+	Response isGetAppChInfoResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetAppChInfoResp) Reset() {
+	*x = GetAppChInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppChInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppChInfoResp) ProtoMessage() {}
+
+func (x *GetAppChInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppChInfoResp.ProtoReflect.Descriptor instead.
+func (*GetAppChInfoResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *GetAppChInfoResp) GetResponse() isGetAppChInfoResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetAppChInfoResp) GetMsgSuccess() *GetAppChInfoResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetAppChInfoResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetAppChInfoResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetAppChInfoResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetAppChInfoResp_Response interface {
+	isGetAppChInfoResp_Response()
+}
+
+type GetAppChInfoResp_MsgSuccess_ struct {
+	MsgSuccess *GetAppChInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetAppChInfoResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetAppChInfoResp_MsgSuccess_) isGetAppChInfoResp_Response() {}
+
+func (*GetAppChInfoResp_Error) isGetAppChInfoResp_Response() {}
+
+type CloseAppChReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *CloseAppChReq) Reset() {
+	*x = CloseAppChReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseAppChReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAppChReq) ProtoMessage() {}
+
+func (x *CloseAppChReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAppChReq.ProtoReflect.Descriptor instead.
+func (*CloseAppChReq) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CloseAppChReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CloseAppChReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type CloseAppChResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CloseAppChResp_MsgSuccess_
+	//	*CloseAppChResp_Error
+	Response isCloseAppChResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *CloseAppChResp) Reset() {
+	*x = CloseAppChResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseAppChResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAppChResp) ProtoMessage() {}
+
+func (x *CloseAppChResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAppChResp.ProtoReflect.Descriptor instead.
+func (*CloseAppChResp) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *CloseAppChResp) GetResponse() isCloseAppChResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CloseAppChResp) GetMsgSuccess() *CloseAppChResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CloseAppChResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CloseAppChResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CloseAppChResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCloseAppChResp_Response interface {
+	isCloseAppChResp_Response()
+}
+
+type CloseAppChResp_MsgSuccess_ struct {
+	MsgSuccess *CloseAppChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CloseAppChResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CloseAppChResp_MsgSuccess_) isCloseAppChResp_Response() {}
+
+func (*CloseAppChResp_Error) isCloseAppChResp_Response() {}
+
+type OpenAppChResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedAppChInfo *AppChInfo `protobuf:"bytes,1,opt,name=openedAppChInfo,proto3" json:"openedAppChInfo,omitempty"`
+}
+
+func (x *OpenAppChResp_MsgSuccess) Reset() {
+	*x = OpenAppChResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenAppChResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAppChResp_MsgSuccess) ProtoMessage() {}
+
+func (x *OpenAppChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAppChResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*OpenAppChResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *OpenAppChResp_MsgSuccess) GetOpenedAppChInfo() *AppChInfo {
+	if x != nil {
+		return x.OpenedAppChInfo
+	}
+	return nil
+}
+
+type GetAppChsInfoResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenAppChsInfo []*AppChInfo `protobuf:"bytes,1,rep,name=openAppChsInfo,proto3" json:"openAppChsInfo,omitempty"`
+}
+
+func (x *GetAppChsInfoResp_MsgSuccess) Reset() {
+	*x = GetAppChsInfoResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppChsInfoResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppChsInfoResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetAppChsInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppChsInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetAppChsInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *GetAppChsInfoResp_MsgSuccess) GetOpenAppChsInfo() []*AppChInfo {
+	if x != nil {
+		return x.OpenAppChsInfo
+	}
+	return nil
+}
+
+type SubAppChProposalsResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalID       string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	App              string   `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	Data             []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	OpeningBalInfo   *BalInfo `protobuf:"bytes,4,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
+	ChallengeDurSecs uint64   `protobuf:"varint,5,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
+	Expiry           int64    `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (x *SubAppChProposalsResp_Notify) Reset() {
+	*x = SubAppChProposalsResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubAppChProposalsResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAppChProposalsResp_Notify) ProtoMessage() {}
+
+func (x *SubAppChProposalsResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAppChProposalsResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubAppChProposalsResp_Notify) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *SubAppChProposalsResp_Notify) GetProposalID() string {
+	if x != nil {
+		return x.ProposalID
+	}
+	return ""
+}
+
+func (x *SubAppChProposalsResp_Notify) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SubAppChProposalsResp_Notify) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SubAppChProposalsResp_Notify) GetOpeningBalInfo() *BalInfo {
+	if x != nil {
+		return x.OpeningBalInfo
+	}
+	return nil
+}
+
+func (x *SubAppChProposalsResp_Notify) GetChallengeDurSecs() uint64 {
+	if x != nil {
+		return x.ChallengeDurSecs
+	}
+	return 0
+}
+
+func (x *SubAppChProposalsResp_Notify) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+type UnsubAppChProposalsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubAppChProposalsResp_MsgSuccess) Reset() {
+	*x = UnsubAppChProposalsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubAppChProposalsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubAppChProposalsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubAppChProposalsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubAppChProposalsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubAppChProposalsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *UnsubAppChProposalsResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RespondAppChProposalResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedAppChInfo *AppChInfo `protobuf:"bytes,1,opt,name=openedAppChInfo,proto3" json:"openedAppChInfo,omitempty"`
+}
+
+func (x *RespondAppChProposalResp_MsgSuccess) Reset() {
+	*x = RespondAppChProposalResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondAppChProposalResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondAppChProposalResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RespondAppChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondAppChProposalResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RespondAppChProposalResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *RespondAppChProposalResp_MsgSuccess) GetOpenedAppChInfo() *AppChInfo {
+	if x != nil {
+		return x.OpenedAppChInfo
+	}
+	return nil
+}
+
+type SendAppActionResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedAppChInfo *AppChInfo `protobuf:"bytes,1,opt,name=updatedAppChInfo,proto3" json:"updatedAppChInfo,omitempty"`
+}
+
+func (x *SendAppActionResp_MsgSuccess) Reset() {
+	*x = SendAppActionResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAppActionResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAppActionResp_MsgSuccess) ProtoMessage() {}
+
+func (x *SendAppActionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAppActionResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*SendAppActionResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *SendAppActionResp_MsgSuccess) GetUpdatedAppChInfo() *AppChInfo {
+	if x != nil {
+		return x.UpdatedAppChInfo
+	}
+	return nil
+}
+
+type SubAppChUpdatesResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdateID          string                                  `protobuf:"bytes,1,opt,name=updateID,proto3" json:"updateID,omitempty"`
+	ProposedAppChInfo *AppChInfo                              `protobuf:"bytes,2,opt,name=proposedAppChInfo,proto3" json:"proposedAppChInfo,omitempty"`
+	Type              SubAppChUpdatesResp_Notify_ChUpdateType `protobuf:"varint,3,opt,name=Type,proto3,enum=pb.SubAppChUpdatesResp_Notify_ChUpdateType" json:"Type,omitempty"`
+	Expiry            int64                                   `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Error             *MsgError                               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SubAppChUpdatesResp_Notify) Reset() {
+	*x = SubAppChUpdatesResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubAppChUpdatesResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAppChUpdatesResp_Notify) ProtoMessage() {}
+
+func (x *SubAppChUpdatesResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAppChUpdatesResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubAppChUpdatesResp_Notify) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *SubAppChUpdatesResp_Notify) GetUpdateID() string {
+	if x != nil {
+		return x.UpdateID
+	}
+	return ""
+}
+
+func (x *SubAppChUpdatesResp_Notify) GetProposedAppChInfo() *AppChInfo {
+	if x != nil {
+		return x.ProposedAppChInfo
+	}
+	return nil
+}
+
+func (x *SubAppChUpdatesResp_Notify) GetType() SubAppChUpdatesResp_Notify_ChUpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return SubAppChUpdatesResp_Notify_open
+}
+
+func (x *SubAppChUpdatesResp_Notify) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *SubAppChUpdatesResp_Notify) GetError() *MsgError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UnsubAppChUpdatesResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubAppChUpdatesResp_MsgSuccess) Reset() {
+	*x = UnsubAppChUpdatesResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubAppChUpdatesResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubAppChUpdatesResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubAppChUpdatesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubAppChUpdatesResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubAppChUpdatesResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *UnsubAppChUpdatesResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RespondAppChUpdateResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedAppChInfo *AppChInfo `protobuf:"bytes,1,opt,name=updatedAppChInfo,proto3" json:"updatedAppChInfo,omitempty"`
+}
+
+func (x *RespondAppChUpdateResp_MsgSuccess) Reset() {
+	*x = RespondAppChUpdateResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondAppChUpdateResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondAppChUpdateResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RespondAppChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondAppChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RespondAppChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *RespondAppChUpdateResp_MsgSuccess) GetUpdatedAppChInfo() *AppChInfo {
+	if x != nil {
+		return x.UpdatedAppChInfo
+	}
+	return nil
+}
+
+type GetAppChInfoResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppChInfo *AppChInfo `protobuf:"bytes,1,opt,name=appChInfo,proto3" json:"appChInfo,omitempty"`
+}
+
+func (x *GetAppChInfoResp_MsgSuccess) Reset() {
+	*x = GetAppChInfoResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppChInfoResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppChInfoResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetAppChInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppChInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetAppChInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *GetAppChInfoResp_MsgSuccess) GetAppChInfo() *AppChInfo {
+	if x != nil {
+		return x.AppChInfo
+	}
+	return nil
+}
+
+type CloseAppChResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClosedAppChInfo *AppChInfo `protobuf:"bytes,1,opt,name=closedAppChInfo,proto3" json:"closedAppChInfo,omitempty"`
+}
+
+func (x *CloseAppChResp_MsgSuccess) Reset() {
+	*x = CloseAppChResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseAppChResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAppChResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CloseAppChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAppChResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CloseAppChResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *CloseAppChResp_MsgSuccess) GetClosedAppChInfo() *AppChInfo {
+	if x != nil {
+		return x.ClosedAppChInfo
+	}
+	return nil
+}
+
+var File_app_service_proto protoreflect.FileDescriptor
+
+var file_app_service_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x41,
+	0x70, 0x70, 0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x69,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x33, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65,
+	0x6e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37,
+	0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x43, 0x68,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
+	0x41, 0x70, 0x70, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x41, 0x70, 0x70,
+	0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xcf, 0x02, 0x0a,
+	0x15, 0x53, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x41,
+	0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xc7, 0x01, 0x0a, 0x06, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x0e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a,
+	0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65,
+	0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
+	0x0a, 0x16, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xbd, 0x01, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x48, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x70, 0x70,
+	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64,
+	0x41, 0x70, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x41,
+	0x70, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x53,
+	0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x68, 0x49, 0x44, 0x22, 0x93, 0x03, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x8f, 0x02, 0x0a, 0x06,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x44, 0x12, 0x3b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x43, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x0c,
+	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x6f, 0x70, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x02, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x14, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x68, 0x49, 0x44, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x41, 0x70, 0x70,
+	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x7d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0xdc,
+	0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68,
+	0x49, 0x44, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b,
+	0x0a, 0x09, 0x61, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x61, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x41, 0x70, 0x70, 0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x41, 0x70, 0x70, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x70, 0x70, 0x43,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x70, 0x70, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x89, 0x06, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x5f,
+	0x41, 0x50, 0x49, 0x12, 0x32, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x43, 0x68,
+	0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x43, 0x68, 0x52,
+	0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x43,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x41,
+	0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43,
+	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f,
+	0x53, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
+	0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x41, 0x70, 0x70,
+	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x70, 0x70, 0x43, 0x68, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x70, 0x70, 0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x70, 0x70, 0x43, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_app_service_proto_rawDescOnce sync.Once
+	file_app_service_proto_rawDescData = file_app_service_proto_rawDesc
+)
+
+func file_app_service_proto_rawDescGZIP() []byte {
+	file_app_service_proto_rawDescOnce.Do(func() {
+		file_app_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_service_proto_rawDescData)
+	})
+	return file_app_service_proto_rawDescData
+}
+
+var file_app_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_app_service_proto_goTypes = []interface{}{
+	(SubAppChUpdatesResp_Notify_ChUpdateType)(0), // 0: pb.SubAppChUpdatesResp.Notify.ChUpdateType
+	(*OpenAppChReq)(nil),                         // 1: pb.OpenAppChReq
+	(*OpenAppChResp)(nil),                        // 2: pb.OpenAppChResp
+	(*GetAppChsInfoReq)(nil),                     // 3: pb.GetAppChsInfoReq
+	(*GetAppChsInfoResp)(nil),                    // 4: pb.GetAppChsInfoResp
+	(*SubAppChProposalsReq)(nil),                 // 5: pb.SubAppChProposalsReq
+	(*SubAppChProposalsResp)(nil),                // 6: pb.SubAppChProposalsResp
+	(*UnsubAppChProposalsReq)(nil),               // 7: pb.UnsubAppChProposalsReq
+	(*UnsubAppChProposalsResp)(nil),              // 8: pb.UnsubAppChProposalsResp
+	(*RespondAppChProposalReq)(nil),              // 9: pb.RespondAppChProposalReq
+	(*RespondAppChProposalResp)(nil),             // 10: pb.RespondAppChProposalResp
+	(*SendAppActionReq)(nil),                     // 11: pb.SendAppActionReq
+	(*SendAppActionResp)(nil),                    // 12: pb.SendAppActionResp
+	(*SubAppChUpdatesReq)(nil),                   // 13: pb.SubAppChUpdatesReq
+	(*SubAppChUpdatesResp)(nil),                  // 14: pb.SubAppChUpdatesResp
+	(*UnsubAppChUpdatesReq)(nil),                 // 15: pb.UnsubAppChUpdatesReq
+	(*UnsubAppChUpdatesResp)(nil),                // 16: pb.UnsubAppChUpdatesResp
+	(*RespondAppChUpdateReq)(nil),                // 17: pb.RespondAppChUpdateReq
+	(*RespondAppChUpdateResp)(nil),               // 18: pb.RespondAppChUpdateResp
+	(*GetAppChInfoReq)(nil),                      // 19: pb.GetAppChInfoReq
+	(*GetAppChInfoResp)(nil),                     // 20: pb.GetAppChInfoResp
+	(*CloseAppChReq)(nil),                        // 21: pb.CloseAppChReq
+	(*CloseAppChResp)(nil),                       // 22: pb.CloseAppChResp
+	(*OpenAppChResp_MsgSuccess)(nil),             // 23: pb.OpenAppChResp.MsgSuccess
+	(*GetAppChsInfoResp_MsgSuccess)(nil),         // 24: pb.GetAppChsInfoResp.MsgSuccess
+	(*SubAppChProposalsResp_Notify)(nil),         // 25: pb.SubAppChProposalsResp.Notify
+	(*UnsubAppChProposalsResp_MsgSuccess)(nil),   // 26: pb.UnsubAppChProposalsResp.MsgSuccess
+	(*RespondAppChProposalResp_MsgSuccess)(nil),  // 27: pb.RespondAppChProposalResp.MsgSuccess
+	(*SendAppActionResp_MsgSuccess)(nil),         // 28: pb.SendAppActionResp.MsgSuccess
+	(*SubAppChUpdatesResp_Notify)(nil),           // 29: pb.SubAppChUpdatesResp.Notify
+	(*UnsubAppChUpdatesResp_MsgSuccess)(nil),     // 30: pb.UnsubAppChUpdatesResp.MsgSuccess
+	(*RespondAppChUpdateResp_MsgSuccess)(nil),    // 31: pb.RespondAppChUpdateResp.MsgSuccess
+	(*GetAppChInfoResp_MsgSuccess)(nil),          // 32: pb.GetAppChInfoResp.MsgSuccess
+	(*CloseAppChResp_MsgSuccess)(nil),            // 33: pb.CloseAppChResp.MsgSuccess
+	(*BalInfo)(nil),                              // 34: pb.BalInfo
+	(*MsgError)(nil),                             // 35: pb.MsgError
+	(*AppChInfo)(nil),                            // 36: pb.AppChInfo
+}
+var file_app_service_proto_depIdxs = []int32{
+	34, // 0: pb.OpenAppChReq.openingBalInfo:type_name -> pb.BalInfo
+	23, // 1: pb.OpenAppChResp.msgSuccess:type_name -> pb.OpenAppChResp.MsgSuccess
+	35, // 2: pb.OpenAppChResp.error:type_name -> pb.MsgError
+	24, // 3: pb.GetAppChsInfoResp.msgSuccess:type_name -> pb.GetAppChsInfoResp.MsgSuccess
+	35, // 4: pb.GetAppChsInfoResp.error:type_name -> pb.MsgError
+	25, // 5: pb.SubAppChProposalsResp.notify:type_name -> pb.SubAppChProposalsResp.Notify
+	35, // 6: pb.SubAppChProposalsResp.error:type_name -> pb.MsgError
+	26, // 7: pb.UnsubAppChProposalsResp.msgSuccess:type_name -> pb.UnsubAppChProposalsResp.MsgSuccess
+	35, // 8: pb.UnsubAppChProposalsResp.error:type_name -> pb.MsgError
+	27, // 9: pb.RespondAppChProposalResp.msgSuccess:type_name -> pb.RespondAppChProposalResp.MsgSuccess
+	35, // 10: pb.RespondAppChProposalResp.error:type_name -> pb.MsgError
+	28, // 11: pb.SendAppActionResp.msgSuccess:type_name -> pb.SendAppActionResp.MsgSuccess
+	35, // 12: pb.SendAppActionResp.error:type_name -> pb.MsgError
+	29, // 13: pb.SubAppChUpdatesResp.notify:type_name -> pb.SubAppChUpdatesResp.Notify
+	35, // 14: pb.SubAppChUpdatesResp.error:type_name -> pb.MsgError
+	30, // 15: pb.UnsubAppChUpdatesResp.msgSuccess:type_name -> pb.UnsubAppChUpdatesResp.MsgSuccess
+	35, // 16: pb.UnsubAppChUpdatesResp.error:type_name -> pb.MsgError
+	31, // 17: pb.RespondAppChUpdateResp.msgSuccess:type_name -> pb.RespondAppChUpdateResp.MsgSuccess
+	35, // 18: pb.RespondAppChUpdateResp.error:type_name -> pb.MsgError
+	32, // 19: pb.GetAppChInfoResp.msgSuccess:type_name -> pb.GetAppChInfoResp.MsgSuccess
+	35, // 20: pb.GetAppChInfoResp.error:type_name -> pb.MsgError
+	33, // 21: pb.CloseAppChResp.msgSuccess:type_name -> pb.CloseAppChResp.MsgSuccess
+	35, // 22: pb.CloseAppChResp.error:type_name -> pb.MsgError
+	36, // 23: pb.OpenAppChResp.MsgSuccess.openedAppChInfo:type_name -> pb.AppChInfo
+	36, // 24: pb.GetAppChsInfoResp.MsgSuccess.openAppChsInfo:type_name -> pb.AppChInfo
+	34, // 25: pb.SubAppChProposalsResp.Notify.openingBalInfo:type_name -> pb.BalInfo
+	36, // 26: pb.RespondAppChProposalResp.MsgSuccess.openedAppChInfo:type_name -> pb.AppChInfo
+	36, // 27: pb.SendAppActionResp.MsgSuccess.updatedAppChInfo:type_name -> pb.AppChInfo
+	36, // 28: pb.SubAppChUpdatesResp.Notify.proposedAppChInfo:type_name -> pb.AppChInfo
+	0,  // 29: pb.SubAppChUpdatesResp.Notify.Type:type_name -> pb.SubAppChUpdatesResp.Notify.ChUpdateType
+	35, // 30: pb.SubAppChUpdatesResp.Notify.error:type_name -> pb.MsgError
+	36, // 31: pb.RespondAppChUpdateResp.MsgSuccess.updatedAppChInfo:type_name -> pb.AppChInfo
+	36, // 32: pb.GetAppChInfoResp.MsgSuccess.appChInfo:type_name -> pb.AppChInfo
+	36, // 33: pb.CloseAppChResp.MsgSuccess.closedAppChInfo:type_name -> pb.AppChInfo
+	1,  // 34: pb.App_API.OpenAppCh:input_type -> pb.OpenAppChReq
+	3,  // 35: pb.App_API.GetAppChsInfo:input_type -> pb.GetAppChsInfoReq
+	5,  // 36: pb.App_API.SubAppChProposals:input_type -> pb.SubAppChProposalsReq
+	7,  // 37: pb.App_API.UnsubAppChProposals:input_type -> pb.UnsubAppChProposalsReq
+	9,  // 38: pb.App_API.RespondAppChProposal:input_type -> pb.RespondAppChProposalReq
+	11, // 39: pb.App_API.SendAppAction:input_type -> pb.SendAppActionReq
+	13, // 40: pb.App_API.SubAppChUpdates:input_type -> pb.SubAppChUpdatesReq
+	15, // 41: pb.App_API.UnsubAppChUpdates:input_type -> pb.UnsubAppChUpdatesReq
+	17, // 42: pb.App_API.RespondAppChUpdate:input_type -> pb.RespondAppChUpdateReq
+	19, // 43: pb.App_API.GetAppChInfo:input_type -> pb.GetAppChInfoReq
+	21, // 44: pb.App_API.CloseAppCh:input_type -> pb.CloseAppChReq
+	2,  // 45: pb.App_API.OpenAppCh:output_type -> pb.OpenAppChResp
+	4,  // 46: pb.App_API.GetAppChsInfo:output_type -> pb.GetAppChsInfoResp
+	6,  // 47: pb.App_API.SubAppChProposals:output_type -> pb.SubAppChProposalsResp
+	8,  // 48: pb.App_API.UnsubAppChProposals:output_type -> pb.UnsubAppChProposalsResp
+	10, // 49: pb.App_API.RespondAppChProposal:output_type -> pb.RespondAppChProposalResp
+	12, // 50: pb.App_API.SendAppAction:output_type -> pb.SendAppActionResp
+	14, // 51: pb.App_API.SubAppChUpdates:output_type -> pb.SubAppChUpdatesResp
+	16, // 52: pb.App_API.UnsubAppChUpdates:output_type -> pb.UnsubAppChUpdatesResp
+	18, // 53: pb.App_API.RespondAppChUpdate:output_type -> pb.RespondAppChUpdateResp
+	20, // 54: pb.App_API.GetAppChInfo:output_type -> pb.GetAppChInfoResp
+	22, // 55: pb.App_API.CloseAppCh:output_type -> pb.CloseAppChResp
+	45, // [45:56] is the sub-list for method output_type
+	34, // [34:45] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_app_service_proto_init() }
+func file_app_service_proto_init() {
+	if File_app_service_proto != nil {
+		return
+	}
+	file_nodetypes_proto_init()
+	file_errors_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_app_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenAppChReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenAppChResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppChsInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppChsInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubAppChProposalsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubAppChProposalsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubAppChProposalsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubAppChProposalsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondAppChProposalReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondAppChProposalResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAppActionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAppActionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubAppChUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubAppChUpdatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubAppChUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubAppChUpdatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondAppChUpdateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondAppChUpdateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppChInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppChInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAppChReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAppChResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenAppChResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppChsInfoResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubAppChProposalsResp_Notify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubAppChProposalsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondAppChProposalResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAppActionResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubAppChUpdatesResp_Notify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubAppChUpdatesResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondAppChUpdateResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppChInfoResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAppChResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_app_service_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*OpenAppChResp_MsgSuccess_)(nil),
+		(*OpenAppChResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*GetAppChsInfoResp_MsgSuccess_)(nil),
+		(*GetAppChsInfoResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*SubAppChProposalsResp_Notify_)(nil),
+		(*SubAppChProposalsResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*UnsubAppChProposalsResp_MsgSuccess_)(nil),
+		(*UnsubAppChProposalsResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*RespondAppChProposalResp_MsgSuccess_)(nil),
+		(*RespondAppChProposalResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*SendAppActionResp_MsgSuccess_)(nil),
+		(*SendAppActionResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*SubAppChUpdatesResp_Notify_)(nil),
+		(*SubAppChUpdatesResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*UnsubAppChUpdatesResp_MsgSuccess_)(nil),
+		(*UnsubAppChUpdatesResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*RespondAppChUpdateResp_MsgSuccess_)(nil),
+		(*RespondAppChUpdateResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*GetAppChInfoResp_MsgSuccess_)(nil),
+		(*GetAppChInfoResp_Error)(nil),
+	}
+	file_app_service_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*CloseAppChResp_MsgSuccess_)(nil),
+		(*CloseAppChResp_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_app_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_app_service_proto_goTypes,
+		DependencyIndexes: file_app_service_proto_depIdxs,
+		EnumInfos:         file_app_service_proto_enumTypes,
+		MessageInfos:      file_app_service_proto_msgTypes,
+	}.Build()
+	File_app_service_proto = out.File
+	file_app_service_proto_rawDesc = nil
+	file_app_service_proto_goTypes = nil
+	file_app_service_proto_depIdxs = nil
+}
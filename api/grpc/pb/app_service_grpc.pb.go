@@ -0,0 +1,553 @@
+// Copyright (c) 2020 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.3
+// source: app_service.proto
+
+// Package pb contains proto3 definitions for user API and the corresponding
+// generated code for grpc server and client.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	App_API_OpenAppCh_FullMethodName            = "/pb.App_API/OpenAppCh"
+	App_API_GetAppChsInfo_FullMethodName        = "/pb.App_API/GetAppChsInfo"
+	App_API_SubAppChProposals_FullMethodName    = "/pb.App_API/SubAppChProposals"
+	App_API_UnsubAppChProposals_FullMethodName  = "/pb.App_API/UnsubAppChProposals"
+	App_API_RespondAppChProposal_FullMethodName = "/pb.App_API/RespondAppChProposal"
+	App_API_SendAppAction_FullMethodName        = "/pb.App_API/SendAppAction"
+	App_API_SubAppChUpdates_FullMethodName      = "/pb.App_API/SubAppChUpdates"
+	App_API_UnsubAppChUpdates_FullMethodName    = "/pb.App_API/UnsubAppChUpdates"
+	App_API_RespondAppChUpdate_FullMethodName   = "/pb.App_API/RespondAppChUpdate"
+	App_API_GetAppChInfo_FullMethodName         = "/pb.App_API/GetAppChInfo"
+	App_API_CloseAppCh_FullMethodName           = "/pb.App_API/CloseAppCh"
+)
+
+// App_APIClient is the client API for App_API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type App_APIClient interface {
+	OpenAppCh(ctx context.Context, in *OpenAppChReq, opts ...grpc.CallOption) (*OpenAppChResp, error)
+	GetAppChsInfo(ctx context.Context, in *GetAppChsInfoReq, opts ...grpc.CallOption) (*GetAppChsInfoResp, error)
+	SubAppChProposals(ctx context.Context, in *SubAppChProposalsReq, opts ...grpc.CallOption) (App_API_SubAppChProposalsClient, error)
+	UnsubAppChProposals(ctx context.Context, in *UnsubAppChProposalsReq, opts ...grpc.CallOption) (*UnsubAppChProposalsResp, error)
+	RespondAppChProposal(ctx context.Context, in *RespondAppChProposalReq, opts ...grpc.CallOption) (*RespondAppChProposalResp, error)
+	SendAppAction(ctx context.Context, in *SendAppActionReq, opts ...grpc.CallOption) (*SendAppActionResp, error)
+	SubAppChUpdates(ctx context.Context, in *SubAppChUpdatesReq, opts ...grpc.CallOption) (App_API_SubAppChUpdatesClient, error)
+	UnsubAppChUpdates(ctx context.Context, in *UnsubAppChUpdatesReq, opts ...grpc.CallOption) (*UnsubAppChUpdatesResp, error)
+	RespondAppChUpdate(ctx context.Context, in *RespondAppChUpdateReq, opts ...grpc.CallOption) (*RespondAppChUpdateResp, error)
+	GetAppChInfo(ctx context.Context, in *GetAppChInfoReq, opts ...grpc.CallOption) (*GetAppChInfoResp, error)
+	CloseAppCh(ctx context.Context, in *CloseAppChReq, opts ...grpc.CallOption) (*CloseAppChResp, error)
+}
+
+type app_APIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApp_APIClient(cc grpc.ClientConnInterface) App_APIClient {
+	return &app_APIClient{cc}
+}
+
+func (c *app_APIClient) OpenAppCh(ctx context.Context, in *OpenAppChReq, opts ...grpc.CallOption) (*OpenAppChResp, error) {
+	out := new(OpenAppChResp)
+	err := c.cc.Invoke(ctx, App_API_OpenAppCh_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) GetAppChsInfo(ctx context.Context, in *GetAppChsInfoReq, opts ...grpc.CallOption) (*GetAppChsInfoResp, error) {
+	out := new(GetAppChsInfoResp)
+	err := c.cc.Invoke(ctx, App_API_GetAppChsInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) SubAppChProposals(ctx context.Context, in *SubAppChProposalsReq, opts ...grpc.CallOption) (App_API_SubAppChProposalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &App_API_ServiceDesc.Streams[0], App_API_SubAppChProposals_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &app_APISubAppChProposalsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type App_API_SubAppChProposalsClient interface {
+	Recv() (*SubAppChProposalsResp, error)
+	grpc.ClientStream
+}
+
+type app_APISubAppChProposalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *app_APISubAppChProposalsClient) Recv() (*SubAppChProposalsResp, error) {
+	m := new(SubAppChProposalsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *app_APIClient) UnsubAppChProposals(ctx context.Context, in *UnsubAppChProposalsReq, opts ...grpc.CallOption) (*UnsubAppChProposalsResp, error) {
+	out := new(UnsubAppChProposalsResp)
+	err := c.cc.Invoke(ctx, App_API_UnsubAppChProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) RespondAppChProposal(ctx context.Context, in *RespondAppChProposalReq, opts ...grpc.CallOption) (*RespondAppChProposalResp, error) {
+	out := new(RespondAppChProposalResp)
+	err := c.cc.Invoke(ctx, App_API_RespondAppChProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) SendAppAction(ctx context.Context, in *SendAppActionReq, opts ...grpc.CallOption) (*SendAppActionResp, error) {
+	out := new(SendAppActionResp)
+	err := c.cc.Invoke(ctx, App_API_SendAppAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) SubAppChUpdates(ctx context.Context, in *SubAppChUpdatesReq, opts ...grpc.CallOption) (App_API_SubAppChUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &App_API_ServiceDesc.Streams[1], App_API_SubAppChUpdates_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &app_APISubAppChUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type App_API_SubAppChUpdatesClient interface {
+	Recv() (*SubAppChUpdatesResp, error)
+	grpc.ClientStream
+}
+
+type app_APISubAppChUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *app_APISubAppChUpdatesClient) Recv() (*SubAppChUpdatesResp, error) {
+	m := new(SubAppChUpdatesResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *app_APIClient) UnsubAppChUpdates(ctx context.Context, in *UnsubAppChUpdatesReq, opts ...grpc.CallOption) (*UnsubAppChUpdatesResp, error) {
+	out := new(UnsubAppChUpdatesResp)
+	err := c.cc.Invoke(ctx, App_API_UnsubAppChUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) RespondAppChUpdate(ctx context.Context, in *RespondAppChUpdateReq, opts ...grpc.CallOption) (*RespondAppChUpdateResp, error) {
+	out := new(RespondAppChUpdateResp)
+	err := c.cc.Invoke(ctx, App_API_RespondAppChUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) GetAppChInfo(ctx context.Context, in *GetAppChInfoReq, opts ...grpc.CallOption) (*GetAppChInfoResp, error) {
+	out := new(GetAppChInfoResp)
+	err := c.cc.Invoke(ctx, App_API_GetAppChInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *app_APIClient) CloseAppCh(ctx context.Context, in *CloseAppChReq, opts ...grpc.CallOption) (*CloseAppChResp, error) {
+	out := new(CloseAppChResp)
+	err := c.cc.Invoke(ctx, App_API_CloseAppCh_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// App_APIServer is the server API for App_API service.
+// All implementations must embed UnimplementedApp_APIServer
+// for forward compatibility
+type App_APIServer interface {
+	OpenAppCh(context.Context, *OpenAppChReq) (*OpenAppChResp, error)
+	GetAppChsInfo(context.Context, *GetAppChsInfoReq) (*GetAppChsInfoResp, error)
+	SubAppChProposals(*SubAppChProposalsReq, App_API_SubAppChProposalsServer) error
+	UnsubAppChProposals(context.Context, *UnsubAppChProposalsReq) (*UnsubAppChProposalsResp, error)
+	RespondAppChProposal(context.Context, *RespondAppChProposalReq) (*RespondAppChProposalResp, error)
+	SendAppAction(context.Context, *SendAppActionReq) (*SendAppActionResp, error)
+	SubAppChUpdates(*SubAppChUpdatesReq, App_API_SubAppChUpdatesServer) error
+	UnsubAppChUpdates(context.Context, *UnsubAppChUpdatesReq) (*UnsubAppChUpdatesResp, error)
+	RespondAppChUpdate(context.Context, *RespondAppChUpdateReq) (*RespondAppChUpdateResp, error)
+	GetAppChInfo(context.Context, *GetAppChInfoReq) (*GetAppChInfoResp, error)
+	CloseAppCh(context.Context, *CloseAppChReq) (*CloseAppChResp, error)
+	mustEmbedUnimplementedApp_APIServer()
+}
+
+// UnimplementedApp_APIServer must be embedded to have forward compatible implementations.
+type UnimplementedApp_APIServer struct {
+}
+
+func (UnimplementedApp_APIServer) OpenAppCh(context.Context, *OpenAppChReq) (*OpenAppChResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenAppCh not implemented")
+}
+func (UnimplementedApp_APIServer) GetAppChsInfo(context.Context, *GetAppChsInfoReq) (*GetAppChsInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppChsInfo not implemented")
+}
+func (UnimplementedApp_APIServer) SubAppChProposals(*SubAppChProposalsReq, App_API_SubAppChProposalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubAppChProposals not implemented")
+}
+func (UnimplementedApp_APIServer) UnsubAppChProposals(context.Context, *UnsubAppChProposalsReq) (*UnsubAppChProposalsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubAppChProposals not implemented")
+}
+func (UnimplementedApp_APIServer) RespondAppChProposal(context.Context, *RespondAppChProposalReq) (*RespondAppChProposalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondAppChProposal not implemented")
+}
+func (UnimplementedApp_APIServer) SendAppAction(context.Context, *SendAppActionReq) (*SendAppActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAppAction not implemented")
+}
+func (UnimplementedApp_APIServer) SubAppChUpdates(*SubAppChUpdatesReq, App_API_SubAppChUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubAppChUpdates not implemented")
+}
+func (UnimplementedApp_APIServer) UnsubAppChUpdates(context.Context, *UnsubAppChUpdatesReq) (*UnsubAppChUpdatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubAppChUpdates not implemented")
+}
+func (UnimplementedApp_APIServer) RespondAppChUpdate(context.Context, *RespondAppChUpdateReq) (*RespondAppChUpdateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondAppChUpdate not implemented")
+}
+func (UnimplementedApp_APIServer) GetAppChInfo(context.Context, *GetAppChInfoReq) (*GetAppChInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppChInfo not implemented")
+}
+func (UnimplementedApp_APIServer) CloseAppCh(context.Context, *CloseAppChReq) (*CloseAppChResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAppCh not implemented")
+}
+func (UnimplementedApp_APIServer) mustEmbedUnimplementedApp_APIServer() {}
+
+// UnsafeApp_APIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to App_APIServer will
+// result in compilation errors.
+type UnsafeApp_APIServer interface {
+	mustEmbedUnimplementedApp_APIServer()
+}
+
+func RegisterApp_APIServer(s grpc.ServiceRegistrar, srv App_APIServer) {
+	s.RegisterService(&App_API_ServiceDesc, srv)
+}
+
+func _App_API_OpenAppCh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenAppChReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).OpenAppCh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_OpenAppCh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).OpenAppCh(ctx, req.(*OpenAppChReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_GetAppChsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppChsInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).GetAppChsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_GetAppChsInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).GetAppChsInfo(ctx, req.(*GetAppChsInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_SubAppChProposals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubAppChProposalsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(App_APIServer).SubAppChProposals(m, &app_APISubAppChProposalsServer{stream})
+}
+
+type App_API_SubAppChProposalsServer interface {
+	Send(*SubAppChProposalsResp) error
+	grpc.ServerStream
+}
+
+type app_APISubAppChProposalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *app_APISubAppChProposalsServer) Send(m *SubAppChProposalsResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _App_API_UnsubAppChProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubAppChProposalsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).UnsubAppChProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_UnsubAppChProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).UnsubAppChProposals(ctx, req.(*UnsubAppChProposalsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_RespondAppChProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondAppChProposalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).RespondAppChProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_RespondAppChProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).RespondAppChProposal(ctx, req.(*RespondAppChProposalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_SendAppAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAppActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).SendAppAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_SendAppAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).SendAppAction(ctx, req.(*SendAppActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_SubAppChUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubAppChUpdatesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(App_APIServer).SubAppChUpdates(m, &app_APISubAppChUpdatesServer{stream})
+}
+
+type App_API_SubAppChUpdatesServer interface {
+	Send(*SubAppChUpdatesResp) error
+	grpc.ServerStream
+}
+
+type app_APISubAppChUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *app_APISubAppChUpdatesServer) Send(m *SubAppChUpdatesResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _App_API_UnsubAppChUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubAppChUpdatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).UnsubAppChUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_UnsubAppChUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).UnsubAppChUpdates(ctx, req.(*UnsubAppChUpdatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_RespondAppChUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondAppChUpdateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).RespondAppChUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_RespondAppChUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).RespondAppChUpdate(ctx, req.(*RespondAppChUpdateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_GetAppChInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppChInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).GetAppChInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_GetAppChInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).GetAppChInfo(ctx, req.(*GetAppChInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_API_CloseAppCh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAppChReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(App_APIServer).CloseAppCh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_API_CloseAppCh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(App_APIServer).CloseAppCh(ctx, req.(*CloseAppChReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// App_API_ServiceDesc is the grpc.ServiceDesc for App_API service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var App_API_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.App_API",
+	HandlerType: (*App_APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenAppCh",
+			Handler:    _App_API_OpenAppCh_Handler,
+		},
+		{
+			MethodName: "GetAppChsInfo",
+			Handler:    _App_API_GetAppChsInfo_Handler,
+		},
+		{
+			MethodName: "UnsubAppChProposals",
+			Handler:    _App_API_UnsubAppChProposals_Handler,
+		},
+		{
+			MethodName: "RespondAppChProposal",
+			Handler:    _App_API_RespondAppChProposal_Handler,
+		},
+		{
+			MethodName: "SendAppAction",
+			Handler:    _App_API_SendAppAction_Handler,
+		},
+		{
+			MethodName: "UnsubAppChUpdates",
+			Handler:    _App_API_UnsubAppChUpdates_Handler,
+		},
+		{
+			MethodName: "RespondAppChUpdate",
+			Handler:    _App_API_RespondAppChUpdate_Handler,
+		},
+		{
+			MethodName: "GetAppChInfo",
+			Handler:    _App_API_GetAppChInfo_Handler,
+		},
+		{
+			MethodName: "CloseAppCh",
+			Handler:    _App_API_CloseAppCh_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubAppChProposals",
+			Handler:       _App_API_SubAppChProposals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubAppChUpdates",
+			Handler:       _App_API_SubAppChUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "app_service.proto",
+}
@@ -86,6 +86,11 @@ func ToError(grpcErr *MsgError) perun.APIError { //nolint:funlen
 		addInfo = perun.ErrInfoChainNotReachable{
 			ChainURL: info.ErrInfoChainNotReachable.ChainURL,
 		}
+	case *MsgError_ErrInfoTxReorged:
+		addInfo = perun.ErrInfoTxReorged{
+			TxType: info.ErrInfoTxReorged.TxType,
+			TxID:   info.ErrInfoTxReorged.TxID,
+		}
 	default:
 		addInfo = nil
 	}
@@ -188,6 +193,13 @@ func FromError(err perun.APIError) *MsgError { //nolint:funlen
 				ChainURL: info.ChainURL,
 			},
 		}
+	case perun.ErrInfoTxReorged:
+		grpcErr.AddInfo = &MsgError_ErrInfoTxReorged{
+			ErrInfoTxReorged: &ErrInfoTxReorged{
+				TxType: info.TxType,
+				TxID:   info.TxID,
+			},
+		}
 	default:
 		// It is Unknonwn Internal Error which has no additional info.
 		grpcErr.AddInfo = nil
@@ -110,6 +110,7 @@ const (
 	ErrorCode_ErrInvalidContracts     ErrorCode = 206
 	ErrorCode_ErrTxTimedOut           ErrorCode = 301
 	ErrorCode_ErrChainNotReachable    ErrorCode = 302
+	ErrorCode_ErrTxReorged            ErrorCode = 303
 	ErrorCode_ErrUnknownInternal      ErrorCode = 401
 )
 
@@ -129,6 +130,7 @@ var (
 		206: "ErrInvalidContracts",
 		301: "ErrTxTimedOut",
 		302: "ErrChainNotReachable",
+		303: "ErrTxReorged",
 		401: "ErrUnknownInternal",
 	}
 	ErrorCode_value = map[string]int32{
@@ -145,6 +147,7 @@ var (
 		"ErrInvalidContracts":     206,
 		"ErrTxTimedOut":           301,
 		"ErrChainNotReachable":    302,
+		"ErrTxReorged":            303,
 		"ErrUnknownInternal":      401,
 	}
 )
@@ -198,6 +201,7 @@ type MsgError struct {
 	//	*MsgError_ErrInfoInvalidContracts
 	//	*MsgError_ErrInfoTxTimedOut
 	//	*MsgError_ErrInfoChainNotReachable
+	//	*MsgError_ErrInfoTxReorged
 	AddInfo isMsgError_AddInfo `protobuf_oneof:"addInfo"`
 }
 
@@ -345,6 +349,13 @@ func (x *MsgError) GetErrInfoChainNotReachable() *ErrInfoChainNotReachable {
 	return nil
 }
 
+func (x *MsgError) GetErrInfoTxReorged() *ErrInfoTxReorged {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoTxReorged); ok {
+		return x.ErrInfoTxReorged
+	}
+	return nil
+}
+
 type isMsgError_AddInfo interface {
 	isMsgError_AddInfo()
 }
@@ -397,6 +408,10 @@ type MsgError_ErrInfoChainNotReachable struct {
 	ErrInfoChainNotReachable *ErrInfoChainNotReachable `protobuf:"bytes,16,opt,name=ErrInfoChainNotReachable,proto3,oneof"`
 }
 
+type MsgError_ErrInfoTxReorged struct {
+	ErrInfoTxReorged *ErrInfoTxReorged `protobuf:"bytes,17,opt,name=ErrInfoTxReorged,proto3,oneof"`
+}
+
 func (*MsgError_ErrInfoPeerRequestTimedOut) isMsgError_AddInfo() {}
 
 func (*MsgError_ErrInfoPeerRejected) isMsgError_AddInfo() {}
@@ -421,6 +436,8 @@ func (*MsgError_ErrInfoTxTimedOut) isMsgError_AddInfo() {}
 
 func (*MsgError_ErrInfoChainNotReachable) isMsgError_AddInfo() {}
 
+func (*MsgError_ErrInfoTxReorged) isMsgError_AddInfo() {}
+
 type ErrInfoPeerRequestTimedOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1128,12 +1145,67 @@ func (x *ErrInfoChainNotReachable) GetChainURL() string {
 	return ""
 }
 
+type ErrInfoTxReorged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxType string `protobuf:"bytes,1,opt,name=txType,proto3" json:"txType,omitempty"`
+	TxID   string `protobuf:"bytes,2,opt,name=txID,proto3" json:"txID,omitempty"`
+}
+
+func (x *ErrInfoTxReorged) Reset() {
+	*x = ErrInfoTxReorged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errors_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrInfoTxReorged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrInfoTxReorged) ProtoMessage() {}
+
+func (x *ErrInfoTxReorged) ProtoReflect() protoreflect.Message {
+	mi := &file_errors_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrInfoTxReorged.ProtoReflect.Descriptor instead.
+func (*ErrInfoTxReorged) Descriptor() ([]byte, []int) {
+	return file_errors_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ErrInfoTxReorged) GetTxType() string {
+	if x != nil {
+		return x.TxType
+	}
+	return ""
+}
+
+func (x *ErrInfoTxReorged) GetTxID() string {
+	if x != nil {
+		return x.TxID
+	}
+	return ""
+}
+
 var File_errors_proto protoreflect.FileDescriptor
 
 var file_errors_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
 	0x70, 0x62, 0x1a, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x09, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x09, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74,
 	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
@@ -1206,100 +1278,109 @@ var file_errors_proto_rawDesc = []byte{
 	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e,
 	0x66, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61,
 	0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x18, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42,
-	0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x54, 0x0a, 0x1a, 0x45, 0x72,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72,
-	0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65,
-	0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x22, 0x4b, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41,
-	0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72,
-	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x34, 0x0a,
-	0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46,
-	0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69,
-	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c,
-	0x69, 0x61, 0x73, 0x22, 0x55, 0x0a, 0x1b, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x17, 0x45, 0x72,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74,
-	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x15, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x16, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x1f,
-	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65,
-	0x43, 0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x68, 0x73, 0x12,
-	0x1f, 0x0a, 0x03, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x63, 0x68, 0x73,
-	0x22, 0x40, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x55, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x17, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x5d, 0x0a, 0x11, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x78, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x78, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x78, 0x54, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x22, 0x36, 0x0a, 0x18, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x52, 0x4c, 0x2a, 0x5c, 0x0a, 0x0d,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a,
-	0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x2a, 0xe7, 0x02, 0x0a, 0x09, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x00,
-	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x10, 0x65, 0x12, 0x13, 0x0a, 0x0f,
-	0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10,
-	0x66, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46,
-	0x75, 0x6e, 0x64, 0x65, 0x64, 0x10, 0x67, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f,
-	0x75, 0x74, 0x10, 0x68, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0xc9, 0x01, 0x12, 0x16,
-	0x0a, 0x11, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x10, 0xca, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0xcb, 0x01, 0x12,
-	0x1a, 0x0a, 0x15, 0x45, 0x72, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xcc, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x45,
-	0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10,
-	0xcd, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x10, 0xce, 0x01, 0x12, 0x12, 0x0a, 0x0d,
-	0x45, 0x72, 0x72, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x10, 0xad, 0x02,
-	0x12, 0x19, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52,
-	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x10, 0xae, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x45,
-	0x72, 0x72, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x10, 0x91, 0x03, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x42, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x6f, 0x72,
+	0x67, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x10, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x6f, 0x72,
+	0x67, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x54,
+	0x0a, 0x1a, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x34, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65,
+	0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65,
+	0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x55, 0x0a, 0x1b, 0x45, 0x72, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3d,
+	0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a,
+	0x15, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x16, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x42, 0x0a, 0x1f, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x43, 0x68, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x03, 0x63, 0x68, 0x73, 0x22, 0x40, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a,
+	0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x5d, 0x0a, 0x11, 0x45, 0x72, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x36, 0x0a, 0x18, 0x45, 0x72, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x52, 0x4c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x52, 0x4c,
+	0x22, 0x3e, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x6f,
+	0x72, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x78, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x44,
+	0x2a, 0x5c, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x2a, 0xfa,
+	0x02, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f,
+	0x64, 0x65, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x10, 0x65,
+	0x12, 0x13, 0x0a, 0x0f, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x10, 0x66, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x10, 0x67, 0x12, 0x1b, 0x0a, 0x17, 0x45,
+	0x72, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x10, 0x68, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10,
+	0xc9, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xca, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x10, 0xcb, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x72, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xcc, 0x01, 0x12,
+	0x15, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x10, 0xcd, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x10, 0xce, 0x01,
+	0x12, 0x12, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x10, 0xad, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x10, 0xae, 0x02, 0x12,
+	0x11, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x54, 0x78, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x64, 0x10,
+	0xaf, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x72, 0x72, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x10, 0x91, 0x03, 0x42, 0x06, 0x5a, 0x04, 0x2e,
+	0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1315,7 +1396,7 @@ func file_errors_proto_rawDescGZIP() []byte {
 }
 
 var file_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_errors_proto_goTypes = []interface{}{
 	(ErrorCategory)(0),                      // 0: pb.ErrorCategory
 	(ErrorCode)(0),                          // 1: pb.ErrorCode
@@ -1333,7 +1414,8 @@ var file_errors_proto_goTypes = []interface{}{
 	(*ErrInfoInvalidContracts)(nil),         // 13: pb.ErrInfoInvalidContracts
 	(*ErrInfoTxTimedOut)(nil),               // 14: pb.ErrInfoTxTimedOut
 	(*ErrInfoChainNotReachable)(nil),        // 15: pb.ErrInfoChainNotReachable
-	(*PayChInfo)(nil),                       // 16: pb.PayChInfo
+	(*ErrInfoTxReorged)(nil),                // 16: pb.ErrInfoTxReorged
+	(*PayChInfo)(nil),                       // 17: pb.PayChInfo
 }
 var file_errors_proto_depIdxs = []int32{
 	0,  // 0: pb.MsgError.category:type_name -> pb.ErrorCategory
@@ -1350,13 +1432,14 @@ var file_errors_proto_depIdxs = []int32{
 	13, // 11: pb.MsgError.ErrInfoInvalidContracts:type_name -> pb.ErrInfoInvalidContracts
 	14, // 12: pb.MsgError.ErrInfoTxTimedOut:type_name -> pb.ErrInfoTxTimedOut
 	15, // 13: pb.MsgError.ErrInfoChainNotReachable:type_name -> pb.ErrInfoChainNotReachable
-	16, // 14: pb.ErrInfoFailedPreCondUnclosedChs.chs:type_name -> pb.PayChInfo
-	12, // 15: pb.ErrInfoInvalidContracts.ContractErrInfos:type_name -> pb.ContractErrInfo
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	16, // 14: pb.MsgError.ErrInfoTxReorged:type_name -> pb.ErrInfoTxReorged
+	17, // 15: pb.ErrInfoFailedPreCondUnclosedChs.chs:type_name -> pb.PayChInfo
+	12, // 16: pb.ErrInfoInvalidContracts.ContractErrInfos:type_name -> pb.ContractErrInfo
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_errors_proto_init() }
@@ -1534,6 +1617,18 @@ func file_errors_proto_init() {
 				return nil
 			}
 		}
+		file_errors_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoTxReorged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_errors_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*MsgError_ErrInfoPeerRequestTimedOut)(nil),
@@ -1548,6 +1643,7 @@ func file_errors_proto_init() {
 		(*MsgError_ErrInfoInvalidContracts)(nil),
 		(*MsgError_ErrInfoTxTimedOut)(nil),
 		(*MsgError_ErrInfoChainNotReachable)(nil),
+		(*MsgError_ErrInfoTxReorged)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1555,7 +1651,7 @@ func file_errors_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_errors_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -45,5 +45,6 @@ func Test_ErrorCode(t *testing.T) {
 	assert.Equal(t, pb.ErrorCode_ErrInvalidContracts, pb.ErrorCode(perun.ErrInvalidContracts))
 	assert.Equal(t, pb.ErrorCode_ErrTxTimedOut, pb.ErrorCode(perun.ErrTxTimedOut))
 	assert.Equal(t, pb.ErrorCode_ErrChainNotReachable, pb.ErrorCode(perun.ErrChainNotReachable))
+	assert.Equal(t, pb.ErrorCode_ErrTxReorged, pb.ErrorCode(perun.ErrTxReorged))
 	assert.Equal(t, pb.ErrorCode_ErrUnknownInternal, pb.ErrorCode(perun.ErrUnknownInternal))
 }
@@ -18,6 +18,7 @@ package pb
 
 import (
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app"
 	"github.com/hyperledger-labs/perun-node/app/payment"
 )
 
@@ -37,6 +38,7 @@ func FromPayments(payments []payment.Payment) []*Payment {
 func FromPayment(src payment.Payment) *Payment {
 	return &Payment{
 		Currency: src.Currency,
+		Payer:    src.Payer,
 		Payee:    src.Payee,
 		Amount:   src.Amount,
 	}
@@ -57,6 +59,7 @@ func ToPayments(payments []*Payment) []payment.Payment {
 func ToPayment(src *Payment) payment.Payment {
 	return payment.Payment{
 		Currency: src.Currency,
+		Payer:    src.Payer,
 		Payee:    src.Payee,
 		Amount:   src.Amount,
 	}
@@ -83,6 +86,29 @@ func FromPayChInfo(src payment.PayChInfo) *PayChInfo {
 	}
 }
 
+// FromAppChsInfo is a helper function to convert slice of ChInfo struct
+// defined in app package to a slice of AppChInfo struct defined in grpc
+// package.
+func FromAppChsInfo(appChsInfo []app.ChInfo) []*AppChInfo {
+	grpcAppChsInfo := make([]*AppChInfo, len(appChsInfo))
+	for i := range appChsInfo {
+		grpcAppChsInfo[i] = FromAppChInfo(appChsInfo[i])
+	}
+	return grpcAppChsInfo
+}
+
+// FromAppChInfo is a helper function to convert ChInfo struct defined in app
+// package to AppChInfo struct defined in grpc package.
+func FromAppChInfo(src app.ChInfo) *AppChInfo {
+	return &AppChInfo{
+		ChID:    src.ChID,
+		App:     src.App,
+		Data:    src.Data,
+		BalInfo: FromBalInfo(src.BalInfo),
+		Version: src.Version,
+	}
+}
+
 // ToBalInfo is a helper function to convert BalInfo struct defined in grpc package
 // to BalInfo struct defined in perun-node.
 func ToBalInfo(src *BalInfo) perun.BalInfo {
@@ -131,3 +157,199 @@ func ToPayChInfo(src *PayChInfo) payment.PayChInfo {
 		Version: src.Version,
 	}
 }
+
+// FromPeerIDs is a helper function to convert a slice of PeerID struct
+// defined in perun-node to a slice of PeerID struct defined in grpc package.
+func FromPeerIDs(peerIDs []perun.PeerID) []*PeerID {
+	grpcPeerIDs := make([]*PeerID, len(peerIDs))
+	for i := range peerIDs {
+		grpcPeerIDs[i] = FromPeerID(peerIDs[i])
+	}
+	return grpcPeerIDs
+}
+
+// FromPeerID is a helper function to convert PeerID struct defined in
+// perun-node to PeerID struct defined in grpc package.
+func FromPeerID(src perun.PeerID) *PeerID {
+	return &PeerID{
+		Alias:               src.Alias,
+		OffChainAddress:     src.OffChainAddrString,
+		CommAddress:         src.CommAddr,
+		CommType:            src.CommType,
+		CommCertFingerprint: src.CommCertFingerprint,
+	}
+}
+
+// FromPeerStatus is a helper function to convert PeerStatus struct defined in
+// perun-node to PeerStatus struct defined in grpc package.
+func FromPeerStatus(src perun.PeerStatus) *PeerStatus {
+	var lastSeen int64
+	if !src.LastSeen.IsZero() {
+		lastSeen = src.LastSeen.Unix()
+	}
+	return &PeerStatus{
+		Alias:         src.Alias,
+		Reachable:     src.Reachable,
+		LastSeen:      lastSeen,
+		LatencyMicros: src.Latency.Microseconds(),
+	}
+}
+
+// ToPeerID is a helper function to convert PeerID struct defined in grpc
+// package to PeerID struct defined in perun-node.
+func ToPeerID(src *PeerID) perun.PeerID {
+	return perun.PeerID{
+		Alias:               src.Alias,
+		OffChainAddrString:  src.OffChainAddress,
+		CommAddr:            src.CommAddress,
+		CommType:            src.CommType,
+		CommCertFingerprint: src.CommCertFingerprint,
+	}
+}
+
+// FromChHistoryEntries is a helper function to convert slice of
+// ChHistoryEntry struct defined in perun-node to a slice of ChHistoryEntry
+// struct defined in grpc package.
+func FromChHistoryEntries(entries []perun.ChHistoryEntry) []*ChHistoryEntry {
+	grpcEntries := make([]*ChHistoryEntry, len(entries))
+	for i := range entries {
+		grpcEntries[i] = FromChHistoryEntry(entries[i])
+	}
+	return grpcEntries
+}
+
+// FromChHistoryEntry is a helper function to convert ChHistoryEntry struct
+// defined in perun-node to ChHistoryEntry struct defined in grpc package.
+func FromChHistoryEntry(src perun.ChHistoryEntry) *ChHistoryEntry {
+	return &ChHistoryEntry{
+		ChID:         src.ChID,
+		Version:      src.Version,
+		Timestamp:    src.Timestamp,
+		Direction:    ChHistoryEntry_Direction(src.Direction),
+		Counterparty: src.Counterparty,
+		Currencies:   src.Currencies,
+		Deltas:       src.Deltas,
+	}
+}
+
+// FromPaymentStreamStatus is a helper function to convert StreamStatus struct
+// defined in payment package to PaymentStreamStatus struct defined in grpc
+// package.
+func FromPaymentStreamStatus(src payment.StreamStatus) *PaymentStreamStatus {
+	status := &PaymentStreamStatus{
+		StreamID:          src.StreamID,
+		ChID:              src.ChID,
+		Payee:             src.Payee,
+		Currency:          src.Currency,
+		AmountPerInterval: src.AmountPerInterval,
+		IntervalMillis:    uint64(src.Interval.Milliseconds()),
+		Cap:               src.Cap,
+		State:             fromStreamState[src.State],
+		Paid:              src.Paid,
+		Ticks:             src.Ticks,
+		Updates:           src.Updates,
+	}
+	if src.Error != nil {
+		status.Error = FromError(src.Error)
+	}
+	return status
+}
+
+// fromStreamState maps enums from StreamState type defined in payment package
+// to State type defined for payment streams in grpc package.
+var fromStreamState = map[payment.StreamState]PaymentStreamStatus_State{
+	payment.StreamRunning:   PaymentStreamStatus_running,
+	payment.StreamCompleted: PaymentStreamStatus_completed,
+	payment.StreamStopped:   PaymentStreamStatus_stopped,
+	payment.StreamFailed:    PaymentStreamStatus_failed,
+}
+
+// FromPaymentSchedules is a helper function to convert slice of
+// PaymentSchedule struct defined in perun-node to a slice of PaymentSchedule
+// struct defined in grpc package.
+func FromPaymentSchedules(schedules []perun.PaymentSchedule) []*PaymentSchedule {
+	grpcSchedules := make([]*PaymentSchedule, len(schedules))
+	for i := range schedules {
+		grpcSchedules[i] = FromPaymentSchedule(schedules[i])
+	}
+	return grpcSchedules
+}
+
+// FromPaymentSchedule is a helper function to convert PaymentSchedule struct
+// defined in perun-node to PaymentSchedule struct defined in grpc package.
+func FromPaymentSchedule(src perun.PaymentSchedule) *PaymentSchedule {
+	return &PaymentSchedule{
+		Id:         src.ID,
+		ChID:       src.ChID,
+		Payee:      src.Payee,
+		Currency:   src.Currency,
+		Amount:     src.Amount,
+		Every:      src.Every,
+		Unit:       PaymentSchedule_Unit(src.Unit),
+		NextAt:     src.NextAt,
+		Payments:   src.Payments,
+		LastPaidAt: src.LastPaidAt,
+		Status:     PaymentSchedule_Status(src.Status),
+		LastError:  src.LastError,
+	}
+}
+
+// ToPaymentSchedule is a helper function to convert PaymentSchedule struct
+// defined in grpc package to PaymentSchedule struct defined in perun-node.
+func ToPaymentSchedule(src *PaymentSchedule) perun.PaymentSchedule {
+	return perun.PaymentSchedule{
+		ID:         src.Id,
+		ChID:       src.ChID,
+		Payee:      src.Payee,
+		Currency:   src.Currency,
+		Amount:     src.Amount,
+		Every:      src.Every,
+		Unit:       perun.ScheduleUnit(src.Unit),
+		NextAt:     src.NextAt,
+		Payments:   src.Payments,
+		LastPaidAt: src.LastPaidAt,
+		Status:     perun.PaymentScheduleStatus(src.Status),
+		LastError:  src.LastError,
+	}
+}
+
+// FromRoutedPayment is a helper function to convert RoutedPayment struct
+// defined in perun-node to RoutedPayment struct defined in grpc package.
+func FromRoutedPayment(src perun.RoutedPayment) *RoutedPayment {
+	return &RoutedPayment{
+		Hash:     src.Hash,
+		Preimage: src.Preimage,
+		Route:    src.Route,
+		Amount:   src.Amount,
+		Fee:      src.Fee,
+	}
+}
+
+// FromInvoices is a helper function to convert slice of Invoice struct
+// defined in perun-node to a slice of Invoice struct defined in grpc package.
+func FromInvoices(invoices []perun.Invoice) []*Invoice {
+	grpcInvoices := make([]*Invoice, len(invoices))
+	for i := range invoices {
+		grpcInvoices[i] = FromInvoice(invoices[i])
+	}
+	return grpcInvoices
+}
+
+// FromInvoice is a helper function to convert Invoice struct defined in
+// perun-node to Invoice struct defined in grpc package.
+func FromInvoice(src perun.Invoice) *Invoice {
+	return &Invoice{
+		Id:        src.ID,
+		Payee:     src.Payee,
+		Currency:  src.Currency,
+		Amount:    src.Amount,
+		Memo:      src.Memo,
+		CreatedAt: src.CreatedAt,
+		Expiry:    src.Expiry,
+		Encoded:   src.Encoded,
+		Status:    Invoice_Status(src.Status),
+		ChID:      src.ChID,
+		Version:   src.Version,
+		PaidAt:    src.PaidAt,
+	}
+}
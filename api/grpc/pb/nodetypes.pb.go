@@ -39,6 +39,211 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ChHistoryEntry_Direction int32
+
+const (
+	ChHistoryEntry_none     ChHistoryEntry_Direction = 0
+	ChHistoryEntry_incoming ChHistoryEntry_Direction = 1
+	ChHistoryEntry_outgoing ChHistoryEntry_Direction = 2
+	ChHistoryEntry_mixed    ChHistoryEntry_Direction = 3
+)
+
+// Enum value maps for ChHistoryEntry_Direction.
+var (
+	ChHistoryEntry_Direction_name = map[int32]string{
+		0: "none",
+		1: "incoming",
+		2: "outgoing",
+		3: "mixed",
+	}
+	ChHistoryEntry_Direction_value = map[string]int32{
+		"none":     0,
+		"incoming": 1,
+		"outgoing": 2,
+		"mixed":    3,
+	}
+)
+
+func (x ChHistoryEntry_Direction) Enum() *ChHistoryEntry_Direction {
+	p := new(ChHistoryEntry_Direction)
+	*p = x
+	return p
+}
+
+func (x ChHistoryEntry_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChHistoryEntry_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_nodetypes_proto_enumTypes[0].Descriptor()
+}
+
+func (ChHistoryEntry_Direction) Type() protoreflect.EnumType {
+	return &file_nodetypes_proto_enumTypes[0]
+}
+
+func (x ChHistoryEntry_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChHistoryEntry_Direction.Descriptor instead.
+func (ChHistoryEntry_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type PaymentSchedule_Unit int32
+
+const (
+	PaymentSchedule_minute PaymentSchedule_Unit = 0
+	PaymentSchedule_hour   PaymentSchedule_Unit = 1
+	PaymentSchedule_day    PaymentSchedule_Unit = 2
+	PaymentSchedule_week   PaymentSchedule_Unit = 3
+	PaymentSchedule_month  PaymentSchedule_Unit = 4
+)
+
+// Enum value maps for PaymentSchedule_Unit.
+var (
+	PaymentSchedule_Unit_name = map[int32]string{
+		0: "minute",
+		1: "hour",
+		2: "day",
+		3: "week",
+		4: "month",
+	}
+	PaymentSchedule_Unit_value = map[string]int32{
+		"minute": 0,
+		"hour":   1,
+		"day":    2,
+		"week":   3,
+		"month":  4,
+	}
+)
+
+func (x PaymentSchedule_Unit) Enum() *PaymentSchedule_Unit {
+	p := new(PaymentSchedule_Unit)
+	*p = x
+	return p
+}
+
+func (x PaymentSchedule_Unit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentSchedule_Unit) Descriptor() protoreflect.EnumDescriptor {
+	return file_nodetypes_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentSchedule_Unit) Type() protoreflect.EnumType {
+	return &file_nodetypes_proto_enumTypes[1]
+}
+
+func (x PaymentSchedule_Unit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentSchedule_Unit.Descriptor instead.
+func (PaymentSchedule_Unit) EnumDescriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type PaymentSchedule_Status int32
+
+const (
+	PaymentSchedule_active    PaymentSchedule_Status = 0
+	PaymentSchedule_cancelled PaymentSchedule_Status = 1
+	PaymentSchedule_failed    PaymentSchedule_Status = 2
+)
+
+// Enum value maps for PaymentSchedule_Status.
+var (
+	PaymentSchedule_Status_name = map[int32]string{
+		0: "active",
+		1: "cancelled",
+		2: "failed",
+	}
+	PaymentSchedule_Status_value = map[string]int32{
+		"active":    0,
+		"cancelled": 1,
+		"failed":    2,
+	}
+)
+
+func (x PaymentSchedule_Status) Enum() *PaymentSchedule_Status {
+	p := new(PaymentSchedule_Status)
+	*p = x
+	return p
+}
+
+func (x PaymentSchedule_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentSchedule_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_nodetypes_proto_enumTypes[2].Descriptor()
+}
+
+func (PaymentSchedule_Status) Type() protoreflect.EnumType {
+	return &file_nodetypes_proto_enumTypes[2]
+}
+
+func (x PaymentSchedule_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentSchedule_Status.Descriptor instead.
+func (PaymentSchedule_Status) EnumDescriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{6, 1}
+}
+
+type Invoice_Status int32
+
+const (
+	Invoice_open    Invoice_Status = 0
+	Invoice_paid    Invoice_Status = 1
+	Invoice_expired Invoice_Status = 2
+)
+
+// Enum value maps for Invoice_Status.
+var (
+	Invoice_Status_name = map[int32]string{
+		0: "open",
+		1: "paid",
+		2: "expired",
+	}
+	Invoice_Status_value = map[string]int32{
+		"open":    0,
+		"paid":    1,
+		"expired": 2,
+	}
+)
+
+func (x Invoice_Status) Enum() *Invoice_Status {
+	p := new(Invoice_Status)
+	*p = x
+	return p
+}
+
+func (x Invoice_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Invoice_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_nodetypes_proto_enumTypes[3].Descriptor()
+}
+
+func (Invoice_Status) Type() protoreflect.EnumType {
+	return &file_nodetypes_proto_enumTypes[3]
+}
+
+func (x Invoice_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Invoice_Status.Descriptor instead.
+func (Invoice_Status) EnumDescriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{8, 0}
+}
+
 // Peer ID represents the data required to identify and communicate
 // with a participant in the the off-chain network.
 type PeerID struct {
@@ -46,29 +251,512 @@ type PeerID struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Alias           string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
-	OffChainAddress string `protobuf:"bytes,2,opt,name=offChainAddress,proto3" json:"offChainAddress,omitempty"`
-	CommAddress     string `protobuf:"bytes,3,opt,name=commAddress,proto3" json:"commAddress,omitempty"`
-	CommType        string `protobuf:"bytes,4,opt,name=commType,proto3" json:"commType,omitempty"`
+	Alias               string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	OffChainAddress     string `protobuf:"bytes,2,opt,name=offChainAddress,proto3" json:"offChainAddress,omitempty"`
+	CommAddress         string `protobuf:"bytes,3,opt,name=commAddress,proto3" json:"commAddress,omitempty"`
+	CommType            string `protobuf:"bytes,4,opt,name=commType,proto3" json:"commType,omitempty"`
+	CommCertFingerprint string `protobuf:"bytes,5,opt,name=commCertFingerprint,proto3" json:"commCertFingerprint,omitempty"`
+}
+
+func (x *PeerID) Reset() {
+	*x = PeerID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nodetypes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerID) ProtoMessage() {}
+
+func (x *PeerID) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerID.ProtoReflect.Descriptor instead.
+func (*PeerID) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeerID) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *PeerID) GetOffChainAddress() string {
+	if x != nil {
+		return x.OffChainAddress
+	}
+	return ""
+}
+
+func (x *PeerID) GetCommAddress() string {
+	if x != nil {
+		return x.CommAddress
+	}
+	return ""
+}
+
+func (x *PeerID) GetCommType() string {
+	if x != nil {
+		return x.CommType
+	}
+	return ""
+}
+
+func (x *PeerID) GetCommCertFingerprint() string {
+	if x != nil {
+		return x.CommCertFingerprint
+	}
+	return ""
+}
+
+// PeerStatus represents the connectivity status of a peer, as observed in the
+// last attempt to reach it. LastSeen is the unix time (in seconds) when the
+// peer was last reachable and is zero if it was never reachable. LatencyMicros
+// is the round-trip latency (in microseconds) measured at that time.
+type PeerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias         string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Reachable     bool   `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	LastSeen      int64  `protobuf:"varint,3,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	LatencyMicros int64  `protobuf:"varint,4,opt,name=latencyMicros,proto3" json:"latencyMicros,omitempty"`
+}
+
+func (x *PeerStatus) Reset() {
+	*x = PeerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nodetypes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerStatus) ProtoMessage() {}
+
+func (x *PeerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerStatus.ProtoReflect.Descriptor instead.
+func (*PeerStatus) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PeerStatus) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *PeerStatus) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *PeerStatus) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *PeerStatus) GetLatencyMicros() int64 {
+	if x != nil {
+		return x.LatencyMicros
+	}
+	return 0
+}
+
+// BalInfo represents the balance information of the channel: Currency and the channel balance.
+// Balance is represented as two corresponding lists:
+// Parts contains the list of aliases of the channel participants and
+// Balance list contains the amount held by each channel participant in the give currency.
+//
+// A valid BalInfo should meet the following conditions, it should be validated when using them.
+//  1. Lengths of Parts list and Balance list are equal.
+//  2. All entries in Parts list are unique.
+//  3. Parts list has an entry "self", that represents the user of the session.
+//  4. No amount in Balance must be negative.
+type BalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currencies []string      `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	Parts      []string      `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	Bals       []*BalInfoBal `protobuf:"bytes,3,rep,name=bals,proto3" json:"bals,omitempty"`
+}
+
+func (x *BalInfo) Reset() {
+	*x = BalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nodetypes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalInfo) ProtoMessage() {}
+
+func (x *BalInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalInfo.ProtoReflect.Descriptor instead.
+func (*BalInfo) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BalInfo) GetCurrencies() []string {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *BalInfo) GetParts() []string {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *BalInfo) GetBals() []*BalInfoBal {
+	if x != nil {
+		return x.Bals
+	}
+	return nil
+}
+
+type PayChInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChID    string   `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	BalInfo *BalInfo `protobuf:"bytes,2,opt,name=balInfo,proto3" json:"balInfo,omitempty"`
+	Version string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *PayChInfo) Reset() {
+	*x = PayChInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nodetypes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayChInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayChInfo) ProtoMessage() {}
+
+func (x *PayChInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayChInfo.ProtoReflect.Descriptor instead.
+func (*PayChInfo) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PayChInfo) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *PayChInfo) GetBalInfo() *BalInfo {
+	if x != nil {
+		return x.BalInfo
+	}
+	return nil
+}
+
+func (x *PayChInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// AppChInfo represents the info of a channel with an app. The app is
+// identified by its name and data is the binary encoding of the app data.
+// Both are empty for channels without an app.
+type AppChInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChID    string   `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	App     string   `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	Data    []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	BalInfo *BalInfo `protobuf:"bytes,4,opt,name=balInfo,proto3" json:"balInfo,omitempty"`
+	Version string   `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *AppChInfo) Reset() {
+	*x = AppChInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nodetypes_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppChInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppChInfo) ProtoMessage() {}
+
+func (x *AppChInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppChInfo.ProtoReflect.Descriptor instead.
+func (*AppChInfo) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AppChInfo) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *AppChInfo) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *AppChInfo) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AppChInfo) GetBalInfo() *BalInfo {
+	if x != nil {
+		return x.BalInfo
+	}
+	return nil
+}
+
+func (x *AppChInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// ChHistoryEntry represents an accepted update on a channel and the change
+// in the balance of the user due to it. Deltas are negative for the amounts
+// paid by the user.
+type ChHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChID         string                   `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	Version      uint64                   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp    int64                    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Direction    ChHistoryEntry_Direction `protobuf:"varint,4,opt,name=direction,proto3,enum=pb.ChHistoryEntry_Direction" json:"direction,omitempty"`
+	Counterparty string                   `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Currencies   []string                 `protobuf:"bytes,6,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	Deltas       []string                 `protobuf:"bytes,7,rep,name=deltas,proto3" json:"deltas,omitempty"`
+}
+
+func (x *ChHistoryEntry) Reset() {
+	*x = ChHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nodetypes_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChHistoryEntry) ProtoMessage() {}
+
+func (x *ChHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChHistoryEntry.ProtoReflect.Descriptor instead.
+func (*ChHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChHistoryEntry) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *ChHistoryEntry) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ChHistoryEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChHistoryEntry) GetDirection() ChHistoryEntry_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return ChHistoryEntry_none
+}
+
+func (x *ChHistoryEntry) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *ChHistoryEntry) GetCurrencies() []string {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *ChHistoryEntry) GetDeltas() []string {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+// PaymentSchedule represents a recurring payment from the user to a peer on a
+// channel. A payment is made once every "every" units. nextAt and lastPaidAt
+// are in unix seconds; if nextAt is zero when creating a schedule, the first
+// payment is made immediately. lastError is set only when the status is
+// failed.
+type PaymentSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChID       string                 `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Payee      string                 `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	Currency   string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount     string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Every      uint64                 `protobuf:"varint,6,opt,name=every,proto3" json:"every,omitempty"`
+	Unit       PaymentSchedule_Unit   `protobuf:"varint,7,opt,name=unit,proto3,enum=pb.PaymentSchedule_Unit" json:"unit,omitempty"`
+	NextAt     int64                  `protobuf:"varint,8,opt,name=nextAt,proto3" json:"nextAt,omitempty"`
+	Payments   uint64                 `protobuf:"varint,9,opt,name=payments,proto3" json:"payments,omitempty"`
+	LastPaidAt int64                  `protobuf:"varint,10,opt,name=lastPaidAt,proto3" json:"lastPaidAt,omitempty"`
+	Status     PaymentSchedule_Status `protobuf:"varint,11,opt,name=status,proto3,enum=pb.PaymentSchedule_Status" json:"status,omitempty"`
+	LastError  string                 `protobuf:"bytes,12,opt,name=lastError,proto3" json:"lastError,omitempty"`
 }
 
-func (x *PeerID) Reset() {
-	*x = PeerID{}
+func (x *PaymentSchedule) Reset() {
+	*x = PaymentSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nodetypes_proto_msgTypes[0]
+		mi := &file_nodetypes_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeerID) String() string {
+func (x *PaymentSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeerID) ProtoMessage() {}
+func (*PaymentSchedule) ProtoMessage() {}
 
-func (x *PeerID) ProtoReflect() protoreflect.Message {
-	mi := &file_nodetypes_proto_msgTypes[0]
+func (x *PaymentSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -79,76 +767,128 @@ func (x *PeerID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeerID.ProtoReflect.Descriptor instead.
-func (*PeerID) Descriptor() ([]byte, []int) {
-	return file_nodetypes_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PaymentSchedule.ProtoReflect.Descriptor instead.
+func (*PaymentSchedule) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PeerID) GetAlias() string {
+func (x *PaymentSchedule) GetId() string {
 	if x != nil {
-		return x.Alias
+		return x.Id
 	}
 	return ""
 }
 
-func (x *PeerID) GetOffChainAddress() string {
+func (x *PaymentSchedule) GetChID() string {
 	if x != nil {
-		return x.OffChainAddress
+		return x.ChID
 	}
 	return ""
 }
 
-func (x *PeerID) GetCommAddress() string {
+func (x *PaymentSchedule) GetPayee() string {
 	if x != nil {
-		return x.CommAddress
+		return x.Payee
 	}
 	return ""
 }
 
-func (x *PeerID) GetCommType() string {
+func (x *PaymentSchedule) GetCurrency() string {
 	if x != nil {
-		return x.CommType
+		return x.Currency
 	}
 	return ""
 }
 
-// BalInfo represents the balance information of the channel: Currency and the channel balance.
-// Balance is represented as two corresponding lists:
-// Parts contains the list of aliases of the channel participants and
-// Balance list contains the amount held by each channel participant in the give currency.
-//
-// A valid BalInfo should meet the following conditions, it should be validated when using them.
-//  1. Lengths of Parts list and Balance list are equal.
-//  2. All entries in Parts list are unique.
-//  3. Parts list has an entry "self", that represents the user of the session.
-//  4. No amount in Balance must be negative.
-type BalInfo struct {
+func (x *PaymentSchedule) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaymentSchedule) GetEvery() uint64 {
+	if x != nil {
+		return x.Every
+	}
+	return 0
+}
+
+func (x *PaymentSchedule) GetUnit() PaymentSchedule_Unit {
+	if x != nil {
+		return x.Unit
+	}
+	return PaymentSchedule_minute
+}
+
+func (x *PaymentSchedule) GetNextAt() int64 {
+	if x != nil {
+		return x.NextAt
+	}
+	return 0
+}
+
+func (x *PaymentSchedule) GetPayments() uint64 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *PaymentSchedule) GetLastPaidAt() int64 {
+	if x != nil {
+		return x.LastPaidAt
+	}
+	return 0
+}
+
+func (x *PaymentSchedule) GetStatus() PaymentSchedule_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentSchedule_active
+}
+
+func (x *PaymentSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// RoutedPayment represents a payment made to a peer through a path of
+// channels using the htlc app. hash and preimage are hex encoded; route has
+// the off-chain addresses of the hops, excluding the payer; amount includes
+// the fee paid to the intermediaries.
+type RoutedPayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Currencies []string      `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
-	Parts      []string      `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
-	Bals       []*BalInfoBal `protobuf:"bytes,3,rep,name=bals,proto3" json:"bals,omitempty"`
+	Hash     string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Preimage string   `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	Route    []string `protobuf:"bytes,3,rep,name=route,proto3" json:"route,omitempty"`
+	Amount   string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee      string   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *BalInfo) Reset() {
-	*x = BalInfo{}
+func (x *RoutedPayment) Reset() {
+	*x = RoutedPayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nodetypes_proto_msgTypes[1]
+		mi := &file_nodetypes_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BalInfo) String() string {
+func (x *RoutedPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalInfo) ProtoMessage() {}
+func (*RoutedPayment) ProtoMessage() {}
 
-func (x *BalInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_nodetypes_proto_msgTypes[1]
+func (x *RoutedPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,59 +899,86 @@ func (x *BalInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalInfo.ProtoReflect.Descriptor instead.
-func (*BalInfo) Descriptor() ([]byte, []int) {
-	return file_nodetypes_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RoutedPayment.ProtoReflect.Descriptor instead.
+func (*RoutedPayment) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BalInfo) GetCurrencies() []string {
+func (x *RoutedPayment) GetHash() string {
 	if x != nil {
-		return x.Currencies
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *BalInfo) GetParts() []string {
+func (x *RoutedPayment) GetPreimage() string {
 	if x != nil {
-		return x.Parts
+		return x.Preimage
 	}
-	return nil
+	return ""
 }
 
-func (x *BalInfo) GetBals() []*BalInfoBal {
+func (x *RoutedPayment) GetRoute() []string {
 	if x != nil {
-		return x.Bals
+		return x.Route
 	}
 	return nil
 }
 
-type PayChInfo struct {
+func (x *RoutedPayment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RoutedPayment) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+// Invoice represents a payment request created by the payee. createdAt,
+// expiry and paidAt are in unix seconds; zero expiry means the invoice does
+// not expire. encoded is set only for the invoices created by the user and
+// chID, version and paidAt are set only when the invoice is paid.
+type Invoice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChID    string   `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
-	BalInfo *BalInfo `protobuf:"bytes,2,opt,name=balInfo,proto3" json:"balInfo,omitempty"`
-	Version string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Id        string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payee     string         `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	Currency  string         `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount    string         `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo      string         `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	CreatedAt int64          `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Expiry    int64          `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Encoded   string         `protobuf:"bytes,8,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	Status    Invoice_Status `protobuf:"varint,9,opt,name=status,proto3,enum=pb.Invoice_Status" json:"status,omitempty"`
+	ChID      string         `protobuf:"bytes,10,opt,name=chID,proto3" json:"chID,omitempty"`
+	Version   uint64         `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	PaidAt    int64          `protobuf:"varint,12,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
 }
 
-func (x *PayChInfo) Reset() {
-	*x = PayChInfo{}
+func (x *Invoice) Reset() {
+	*x = Invoice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nodetypes_proto_msgTypes[2]
+		mi := &file_nodetypes_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PayChInfo) String() string {
+func (x *Invoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayChInfo) ProtoMessage() {}
+func (*Invoice) ProtoMessage() {}
 
-func (x *PayChInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_nodetypes_proto_msgTypes[2]
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_nodetypes_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,32 +989,98 @@ func (x *PayChInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayChInfo.ProtoReflect.Descriptor instead.
-func (*PayChInfo) Descriptor() ([]byte, []int) {
-	return file_nodetypes_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_nodetypes_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PayChInfo) GetChID() string {
+func (x *Invoice) GetId() string {
 	if x != nil {
-		return x.ChID
+		return x.Id
 	}
 	return ""
 }
 
-func (x *PayChInfo) GetBalInfo() *BalInfo {
+func (x *Invoice) GetPayee() string {
 	if x != nil {
-		return x.BalInfo
+		return x.Payee
 	}
-	return nil
+	return ""
 }
 
-func (x *PayChInfo) GetVersion() string {
+func (x *Invoice) GetCurrency() string {
 	if x != nil {
-		return x.Version
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Invoice) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *Invoice) GetEncoded() string {
+	if x != nil {
+		return x.Encoded
+	}
+	return ""
+}
+
+func (x *Invoice) GetStatus() Invoice_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Invoice_open
+}
+
+func (x *Invoice) GetChID() string {
+	if x != nil {
+		return x.ChID
 	}
 	return ""
 }
 
+func (x *Invoice) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+// Payment represents a single payment in a payment channel update. Payer is
+// optional in a two party channel and is required in channels with more than
+// two participants.
 type Payment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -256,12 +1089,13 @@ type Payment struct {
 	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	Payee    string `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
 	Amount   string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payer    string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty"`
 }
 
 func (x *Payment) Reset() {
 	*x = Payment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nodetypes_proto_msgTypes[3]
+		mi := &file_nodetypes_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -274,7 +1108,7 @@ func (x *Payment) String() string {
 func (*Payment) ProtoMessage() {}
 
 func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_nodetypes_proto_msgTypes[3]
+	mi := &file_nodetypes_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +1121,7 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Payment.ProtoReflect.Descriptor instead.
 func (*Payment) Descriptor() ([]byte, []int) {
-	return file_nodetypes_proto_rawDescGZIP(), []int{3}
+	return file_nodetypes_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Payment) GetCurrency() string {
@@ -311,6 +1145,13 @@ func (x *Payment) GetAmount() string {
 	return ""
 }
 
+func (x *Payment) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
+}
+
 type BalInfoBal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -322,7 +1163,7 @@ type BalInfoBal struct {
 func (x *BalInfoBal) Reset() {
 	*x = BalInfoBal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nodetypes_proto_msgTypes[4]
+		mi := &file_nodetypes_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +1176,7 @@ func (x *BalInfoBal) String() string {
 func (*BalInfoBal) ProtoMessage() {}
 
 func (x *BalInfoBal) ProtoReflect() protoreflect.Message {
-	mi := &file_nodetypes_proto_msgTypes[4]
+	mi := &file_nodetypes_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +1189,7 @@ func (x *BalInfoBal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BalInfoBal.ProtoReflect.Descriptor instead.
 func (*BalInfoBal) Descriptor() ([]byte, []int) {
-	return file_nodetypes_proto_rawDescGZIP(), []int{1, 0}
+	return file_nodetypes_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *BalInfoBal) GetBal() []string {
@@ -362,7 +1203,7 @@ var File_nodetypes_proto protoreflect.FileDescriptor
 
 var file_nodetypes_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x86, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
 	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61,
 	0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -370,28 +1211,128 @@ var file_nodetypes_proto_rawDesc = []byte{
 	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7d,
-	0x0a, 0x07, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x12,
-	0x23, 0x0a, 0x04, 0x62, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x62, 0x61, 0x6c, 0x52, 0x04,
-	0x62, 0x61, 0x6c, 0x73, 0x1a, 0x17, 0x0a, 0x03, 0x62, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x62,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x62, 0x61, 0x6c, 0x22, 0x60, 0x0a,
-	0x09, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x25,
-	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30,
+	0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d,
+	0x6d, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x22, 0x82, 0x01, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12,
+	0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0x7d, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x62, 0x61, 0x6c, 0x52, 0x04, 0x62, 0x61, 0x6c, 0x73, 0x1a, 0x17, 0x0a, 0x03, 0x62,
+	0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x62, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x09, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x43, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x61,
 	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0x53, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xb2, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3a,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x22, 0x3c, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x6d, 0x69, 0x78,
+	0x65, 0x64, 0x10, 0x03, 0x22, 0xd6, 0x03, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x61, 0x79, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79,
+	0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x04,
+	0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65,
+	0x78, 0x74, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x69, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x32,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x10, 0x01, 0x12, 0x07,
+	0x0a, 0x03, 0x64, 0x61, 0x79, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x10,
+	0x03, 0x12, 0x09, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x10, 0x04, 0x22, 0x2f, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x22, 0x7f, 0x0a,
+	0x0d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xe4,
+	0x02, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x79, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x22, 0x29, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x10, 0x02, 0x22, 0x69, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x61, 0x79, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79,
+	0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72,
+	0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -406,22 +1347,38 @@ func file_nodetypes_proto_rawDescGZIP() []byte {
 	return file_nodetypes_proto_rawDescData
 }
 
-var file_nodetypes_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_nodetypes_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_nodetypes_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_nodetypes_proto_goTypes = []interface{}{
-	(*PeerID)(nil),     // 0: pb.PeerID
-	(*BalInfo)(nil),    // 1: pb.BalInfo
-	(*PayChInfo)(nil),  // 2: pb.PayChInfo
-	(*Payment)(nil),    // 3: pb.Payment
-	(*BalInfoBal)(nil), // 4: pb.BalInfo.bal
+	(ChHistoryEntry_Direction)(0), // 0: pb.ChHistoryEntry.Direction
+	(PaymentSchedule_Unit)(0),     // 1: pb.PaymentSchedule.Unit
+	(PaymentSchedule_Status)(0),   // 2: pb.PaymentSchedule.Status
+	(Invoice_Status)(0),           // 3: pb.Invoice.Status
+	(*PeerID)(nil),                // 4: pb.PeerID
+	(*PeerStatus)(nil),            // 5: pb.PeerStatus
+	(*BalInfo)(nil),               // 6: pb.BalInfo
+	(*PayChInfo)(nil),             // 7: pb.PayChInfo
+	(*AppChInfo)(nil),             // 8: pb.AppChInfo
+	(*ChHistoryEntry)(nil),        // 9: pb.ChHistoryEntry
+	(*PaymentSchedule)(nil),       // 10: pb.PaymentSchedule
+	(*RoutedPayment)(nil),         // 11: pb.RoutedPayment
+	(*Invoice)(nil),               // 12: pb.Invoice
+	(*Payment)(nil),               // 13: pb.Payment
+	(*BalInfoBal)(nil),            // 14: pb.BalInfo.bal
 }
 var file_nodetypes_proto_depIdxs = []int32{
-	4, // 0: pb.BalInfo.bals:type_name -> pb.BalInfo.bal
-	1, // 1: pb.PayChInfo.balInfo:type_name -> pb.BalInfo
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	14, // 0: pb.BalInfo.bals:type_name -> pb.BalInfo.bal
+	6,  // 1: pb.PayChInfo.balInfo:type_name -> pb.BalInfo
+	6,  // 2: pb.AppChInfo.balInfo:type_name -> pb.BalInfo
+	0,  // 3: pb.ChHistoryEntry.direction:type_name -> pb.ChHistoryEntry.Direction
+	1,  // 4: pb.PaymentSchedule.unit:type_name -> pb.PaymentSchedule.Unit
+	2,  // 5: pb.PaymentSchedule.status:type_name -> pb.PaymentSchedule.Status
+	3,  // 6: pb.Invoice.status:type_name -> pb.Invoice.Status
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_nodetypes_proto_init() }
@@ -443,7 +1400,7 @@ func file_nodetypes_proto_init() {
 			}
 		}
 		file_nodetypes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalInfo); i {
+			switch v := v.(*PeerStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -455,7 +1412,7 @@ func file_nodetypes_proto_init() {
 			}
 		}
 		file_nodetypes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PayChInfo); i {
+			switch v := v.(*BalInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -467,7 +1424,7 @@ func file_nodetypes_proto_init() {
 			}
 		}
 		file_nodetypes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Payment); i {
+			switch v := v.(*PayChInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -479,6 +1436,78 @@ func file_nodetypes_proto_init() {
 			}
 		}
 		file_nodetypes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppChInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nodetypes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nodetypes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nodetypes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoutedPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nodetypes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nodetypes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nodetypes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BalInfoBal); i {
 			case 0:
 				return &v.state
@@ -496,13 +1525,14 @@ func file_nodetypes_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nodetypes_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_nodetypes_proto_goTypes,
 		DependencyIndexes: file_nodetypes_proto_depIdxs,
+		EnumInfos:         file_nodetypes_proto_enumTypes,
 		MessageInfos:      file_nodetypes_proto_msgTypes,
 	}.Build()
 	File_nodetypes_proto = out.File
@@ -39,6 +39,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SubPayChProposalsResp_Notify_AutoResponse int32
+
+const (
+	SubPayChProposalsResp_Notify_none     SubPayChProposalsResp_Notify_AutoResponse = 0
+	SubPayChProposalsResp_Notify_accepted SubPayChProposalsResp_Notify_AutoResponse = 1
+	SubPayChProposalsResp_Notify_rejected SubPayChProposalsResp_Notify_AutoResponse = 2
+)
+
+// Enum value maps for SubPayChProposalsResp_Notify_AutoResponse.
+var (
+	SubPayChProposalsResp_Notify_AutoResponse_name = map[int32]string{
+		0: "none",
+		1: "accepted",
+		2: "rejected",
+	}
+	SubPayChProposalsResp_Notify_AutoResponse_value = map[string]int32{
+		"none":     0,
+		"accepted": 1,
+		"rejected": 2,
+	}
+)
+
+func (x SubPayChProposalsResp_Notify_AutoResponse) Enum() *SubPayChProposalsResp_Notify_AutoResponse {
+	p := new(SubPayChProposalsResp_Notify_AutoResponse)
+	*p = x
+	return p
+}
+
+func (x SubPayChProposalsResp_Notify_AutoResponse) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubPayChProposalsResp_Notify_AutoResponse) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SubPayChProposalsResp_Notify_AutoResponse) Type() protoreflect.EnumType {
+	return &file_payment_service_proto_enumTypes[0]
+}
+
+func (x SubPayChProposalsResp_Notify_AutoResponse) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubPayChProposalsResp_Notify_AutoResponse.Descriptor instead.
+func (SubPayChProposalsResp_Notify_AutoResponse) EnumDescriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{33, 0, 0}
+}
+
 type SubPayChUpdatesResp_Notify_ChUpdateType int32
 
 const (
@@ -72,11 +121,11 @@ func (x SubPayChUpdatesResp_Notify_ChUpdateType) String() string {
 }
 
 func (SubPayChUpdatesResp_Notify_ChUpdateType) Descriptor() protoreflect.EnumDescriptor {
-	return file_payment_service_proto_enumTypes[0].Descriptor()
+	return file_payment_service_proto_enumTypes[1].Descriptor()
 }
 
 func (SubPayChUpdatesResp_Notify_ChUpdateType) Type() protoreflect.EnumType {
-	return &file_payment_service_proto_enumTypes[0]
+	return &file_payment_service_proto_enumTypes[1]
 }
 
 func (x SubPayChUpdatesResp_Notify_ChUpdateType) Number() protoreflect.EnumNumber {
@@ -85,7 +134,108 @@ func (x SubPayChUpdatesResp_Notify_ChUpdateType) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use SubPayChUpdatesResp_Notify_ChUpdateType.Descriptor instead.
 func (SubPayChUpdatesResp_Notify_ChUpdateType) EnumDescriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{31, 0, 0}
+	return file_payment_service_proto_rawDescGZIP(), []int{47, 0, 0}
+}
+
+type SubPayChUpdatesResp_Notify_AutoResponse int32
+
+const (
+	SubPayChUpdatesResp_Notify_none     SubPayChUpdatesResp_Notify_AutoResponse = 0
+	SubPayChUpdatesResp_Notify_accepted SubPayChUpdatesResp_Notify_AutoResponse = 1
+	SubPayChUpdatesResp_Notify_rejected SubPayChUpdatesResp_Notify_AutoResponse = 2
+)
+
+// Enum value maps for SubPayChUpdatesResp_Notify_AutoResponse.
+var (
+	SubPayChUpdatesResp_Notify_AutoResponse_name = map[int32]string{
+		0: "none",
+		1: "accepted",
+		2: "rejected",
+	}
+	SubPayChUpdatesResp_Notify_AutoResponse_value = map[string]int32{
+		"none":     0,
+		"accepted": 1,
+		"rejected": 2,
+	}
+)
+
+func (x SubPayChUpdatesResp_Notify_AutoResponse) Enum() *SubPayChUpdatesResp_Notify_AutoResponse {
+	p := new(SubPayChUpdatesResp_Notify_AutoResponse)
+	*p = x
+	return p
+}
+
+func (x SubPayChUpdatesResp_Notify_AutoResponse) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubPayChUpdatesResp_Notify_AutoResponse) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_service_proto_enumTypes[2].Descriptor()
+}
+
+func (SubPayChUpdatesResp_Notify_AutoResponse) Type() protoreflect.EnumType {
+	return &file_payment_service_proto_enumTypes[2]
+}
+
+func (x SubPayChUpdatesResp_Notify_AutoResponse) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubPayChUpdatesResp_Notify_AutoResponse.Descriptor instead.
+func (SubPayChUpdatesResp_Notify_AutoResponse) EnumDescriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{47, 0, 1}
+}
+
+type PaymentStreamStatus_State int32
+
+const (
+	PaymentStreamStatus_running   PaymentStreamStatus_State = 0
+	PaymentStreamStatus_completed PaymentStreamStatus_State = 1
+	PaymentStreamStatus_stopped   PaymentStreamStatus_State = 2
+	PaymentStreamStatus_failed    PaymentStreamStatus_State = 3
+)
+
+// Enum value maps for PaymentStreamStatus_State.
+var (
+	PaymentStreamStatus_State_name = map[int32]string{
+		0: "running",
+		1: "completed",
+		2: "stopped",
+		3: "failed",
+	}
+	PaymentStreamStatus_State_value = map[string]int32{
+		"running":   0,
+		"completed": 1,
+		"stopped":   2,
+		"failed":    3,
+	}
+)
+
+func (x PaymentStreamStatus_State) Enum() *PaymentStreamStatus_State {
+	p := new(PaymentStreamStatus_State)
+	*p = x
+	return p
+}
+
+func (x PaymentStreamStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStreamStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_service_proto_enumTypes[3].Descriptor()
+}
+
+func (PaymentStreamStatus_State) Type() protoreflect.EnumType {
+	return &file_payment_service_proto_enumTypes[3]
+}
+
+func (x PaymentStreamStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStreamStatus_State.Descriptor instead.
+func (PaymentStreamStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{58, 0}
 }
 
 type GetConfigReq struct {
@@ -211,6 +361,10 @@ type OpenSessionReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	ConfigFile string `protobuf:"bytes,1,opt,name=configFile,proto3" json:"configFile,omitempty"`
+	// Passwords entered by the user for the wallets whose password is
+	// configured as prompt in the session config file.
+	OnChainPassword  string `protobuf:"bytes,2,opt,name=onChainPassword,proto3" json:"onChainPassword,omitempty"`
+	OffChainPassword string `protobuf:"bytes,3,opt,name=offChainPassword,proto3" json:"offChainPassword,omitempty"`
 }
 
 func (x *OpenSessionReq) Reset() {
@@ -252,6 +406,20 @@ func (x *OpenSessionReq) GetConfigFile() string {
 	return ""
 }
 
+func (x *OpenSessionReq) GetOnChainPassword() string {
+	if x != nil {
+		return x.OnChainPassword
+	}
+	return ""
+}
+
+func (x *OpenSessionReq) GetOffChainPassword() string {
+	if x != nil {
+		return x.OffChainPassword
+	}
+	return ""
+}
+
 type OpenSessionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -911,18 +1079,16 @@ func (*GetPeerIDResp_MsgSuccess_) isGetPeerIDResp_Response() {}
 
 func (*GetPeerIDResp_Error) isGetPeerIDResp_Response() {}
 
-type OpenPayChReq struct {
+type ListPeerIDsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID        string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	OpeningBalInfo   *BalInfo `protobuf:"bytes,2,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
-	ChallengeDurSecs uint64   `protobuf:"varint,3,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
-func (x *OpenPayChReq) Reset() {
-	*x = OpenPayChReq{}
+func (x *ListPeerIDsReq) Reset() {
+	*x = ListPeerIDsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,13 +1096,13 @@ func (x *OpenPayChReq) Reset() {
 	}
 }
 
-func (x *OpenPayChReq) String() string {
+func (x *ListPeerIDsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenPayChReq) ProtoMessage() {}
+func (*ListPeerIDsReq) ProtoMessage() {}
 
-func (x *OpenPayChReq) ProtoReflect() protoreflect.Message {
+func (x *ListPeerIDsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -948,46 +1114,32 @@ func (x *OpenPayChReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPayChReq.ProtoReflect.Descriptor instead.
-func (*OpenPayChReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPeerIDsReq.ProtoReflect.Descriptor instead.
+func (*ListPeerIDsReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *OpenPayChReq) GetSessionID() string {
+func (x *ListPeerIDsReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *OpenPayChReq) GetOpeningBalInfo() *BalInfo {
-	if x != nil {
-		return x.OpeningBalInfo
-	}
-	return nil
-}
-
-func (x *OpenPayChReq) GetChallengeDurSecs() uint64 {
-	if x != nil {
-		return x.ChallengeDurSecs
-	}
-	return 0
-}
-
-type OpenPayChResp struct {
+type ListPeerIDsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*OpenPayChResp_MsgSuccess_
-	//	*OpenPayChResp_Error
-	Response isOpenPayChResp_Response `protobuf_oneof:"response"`
+	//	*ListPeerIDsResp_MsgSuccess_
+	//	*ListPeerIDsResp_Error
+	Response isListPeerIDsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *OpenPayChResp) Reset() {
-	*x = OpenPayChResp{}
+func (x *ListPeerIDsResp) Reset() {
+	*x = ListPeerIDsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -995,13 +1147,13 @@ func (x *OpenPayChResp) Reset() {
 	}
 }
 
-func (x *OpenPayChResp) String() string {
+func (x *ListPeerIDsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenPayChResp) ProtoMessage() {}
+func (*ListPeerIDsResp) ProtoMessage() {}
 
-func (x *OpenPayChResp) ProtoReflect() protoreflect.Message {
+func (x *ListPeerIDsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1013,58 +1165,59 @@ func (x *OpenPayChResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPayChResp.ProtoReflect.Descriptor instead.
-func (*OpenPayChResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPeerIDsResp.ProtoReflect.Descriptor instead.
+func (*ListPeerIDsResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (m *OpenPayChResp) GetResponse() isOpenPayChResp_Response {
+func (m *ListPeerIDsResp) GetResponse() isListPeerIDsResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *OpenPayChResp) GetMsgSuccess() *OpenPayChResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*OpenPayChResp_MsgSuccess_); ok {
+func (x *ListPeerIDsResp) GetMsgSuccess() *ListPeerIDsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ListPeerIDsResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *OpenPayChResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*OpenPayChResp_Error); ok {
+func (x *ListPeerIDsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ListPeerIDsResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isOpenPayChResp_Response interface {
-	isOpenPayChResp_Response()
+type isListPeerIDsResp_Response interface {
+	isListPeerIDsResp_Response()
 }
 
-type OpenPayChResp_MsgSuccess_ struct {
-	MsgSuccess *OpenPayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type ListPeerIDsResp_MsgSuccess_ struct {
+	MsgSuccess *ListPeerIDsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type OpenPayChResp_Error struct {
+type ListPeerIDsResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*OpenPayChResp_MsgSuccess_) isOpenPayChResp_Response() {}
+func (*ListPeerIDsResp_MsgSuccess_) isListPeerIDsResp_Response() {}
 
-func (*OpenPayChResp_Error) isOpenPayChResp_Response() {}
+func (*ListPeerIDsResp_Error) isListPeerIDsResp_Response() {}
 
-type GetPayChsInfoReq struct {
+type UpdatePeerIDReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SessionID string  `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	PeerID    *PeerID `protobuf:"bytes,2,opt,name=peerID,proto3" json:"peerID,omitempty"`
 }
 
-func (x *GetPayChsInfoReq) Reset() {
-	*x = GetPayChsInfoReq{}
+func (x *UpdatePeerIDReq) Reset() {
+	*x = UpdatePeerIDReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1072,13 +1225,13 @@ func (x *GetPayChsInfoReq) Reset() {
 	}
 }
 
-func (x *GetPayChsInfoReq) String() string {
+func (x *UpdatePeerIDReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChsInfoReq) ProtoMessage() {}
+func (*UpdatePeerIDReq) ProtoMessage() {}
 
-func (x *GetPayChsInfoReq) ProtoReflect() protoreflect.Message {
+func (x *UpdatePeerIDReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1090,32 +1243,39 @@ func (x *GetPayChsInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChsInfoReq.ProtoReflect.Descriptor instead.
-func (*GetPayChsInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePeerIDReq.ProtoReflect.Descriptor instead.
+func (*UpdatePeerIDReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetPayChsInfoReq) GetSessionID() string {
+func (x *UpdatePeerIDReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-type GetPayChsInfoResp struct {
+func (x *UpdatePeerIDReq) GetPeerID() *PeerID {
+	if x != nil {
+		return x.PeerID
+	}
+	return nil
+}
+
+type UpdatePeerIDResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*GetPayChsInfoResp_MsgSuccess_
-	//	*GetPayChsInfoResp_Error
-	Response isGetPayChsInfoResp_Response `protobuf_oneof:"response"`
+	//	*UpdatePeerIDResp_MsgSuccess_
+	//	*UpdatePeerIDResp_Error
+	Response isUpdatePeerIDResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetPayChsInfoResp) Reset() {
-	*x = GetPayChsInfoResp{}
+func (x *UpdatePeerIDResp) Reset() {
+	*x = UpdatePeerIDResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1123,13 +1283,13 @@ func (x *GetPayChsInfoResp) Reset() {
 	}
 }
 
-func (x *GetPayChsInfoResp) String() string {
+func (x *UpdatePeerIDResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChsInfoResp) ProtoMessage() {}
+func (*UpdatePeerIDResp) ProtoMessage() {}
 
-func (x *GetPayChsInfoResp) ProtoReflect() protoreflect.Message {
+func (x *UpdatePeerIDResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1141,58 +1301,59 @@ func (x *GetPayChsInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChsInfoResp.ProtoReflect.Descriptor instead.
-func (*GetPayChsInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePeerIDResp.ProtoReflect.Descriptor instead.
+func (*UpdatePeerIDResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (m *GetPayChsInfoResp) GetResponse() isGetPayChsInfoResp_Response {
+func (m *UpdatePeerIDResp) GetResponse() isUpdatePeerIDResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *GetPayChsInfoResp) GetMsgSuccess() *GetPayChsInfoResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*GetPayChsInfoResp_MsgSuccess_); ok {
+func (x *UpdatePeerIDResp) GetMsgSuccess() *UpdatePeerIDResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UpdatePeerIDResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *GetPayChsInfoResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*GetPayChsInfoResp_Error); ok {
+func (x *UpdatePeerIDResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UpdatePeerIDResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isGetPayChsInfoResp_Response interface {
-	isGetPayChsInfoResp_Response()
+type isUpdatePeerIDResp_Response interface {
+	isUpdatePeerIDResp_Response()
 }
 
-type GetPayChsInfoResp_MsgSuccess_ struct {
-	MsgSuccess *GetPayChsInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type UpdatePeerIDResp_MsgSuccess_ struct {
+	MsgSuccess *UpdatePeerIDResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type GetPayChsInfoResp_Error struct {
+type UpdatePeerIDResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*GetPayChsInfoResp_MsgSuccess_) isGetPayChsInfoResp_Response() {}
+func (*UpdatePeerIDResp_MsgSuccess_) isUpdatePeerIDResp_Response() {}
 
-func (*GetPayChsInfoResp_Error) isGetPayChsInfoResp_Response() {}
+func (*UpdatePeerIDResp_Error) isUpdatePeerIDResp_Response() {}
 
-type SubPayChProposalsReq struct {
+type DeletePeerIDReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Alias     string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *SubPayChProposalsReq) Reset() {
-	*x = SubPayChProposalsReq{}
+func (x *DeletePeerIDReq) Reset() {
+	*x = DeletePeerIDReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,13 +1361,13 @@ func (x *SubPayChProposalsReq) Reset() {
 	}
 }
 
-func (x *SubPayChProposalsReq) String() string {
+func (x *DeletePeerIDReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChProposalsReq) ProtoMessage() {}
+func (*DeletePeerIDReq) ProtoMessage() {}
 
-func (x *SubPayChProposalsReq) ProtoReflect() protoreflect.Message {
+func (x *DeletePeerIDReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1218,32 +1379,39 @@ func (x *SubPayChProposalsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChProposalsReq.ProtoReflect.Descriptor instead.
-func (*SubPayChProposalsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePeerIDReq.ProtoReflect.Descriptor instead.
+func (*DeletePeerIDReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SubPayChProposalsReq) GetSessionID() string {
+func (x *DeletePeerIDReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-type SubPayChProposalsResp struct {
+func (x *DeletePeerIDReq) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type DeletePeerIDResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*SubPayChProposalsResp_Notify_
-	//	*SubPayChProposalsResp_Error
-	Response isSubPayChProposalsResp_Response `protobuf_oneof:"response"`
+	//	*DeletePeerIDResp_MsgSuccess_
+	//	*DeletePeerIDResp_Error
+	Response isDeletePeerIDResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SubPayChProposalsResp) Reset() {
-	*x = SubPayChProposalsResp{}
+func (x *DeletePeerIDResp) Reset() {
+	*x = DeletePeerIDResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,13 +1419,13 @@ func (x *SubPayChProposalsResp) Reset() {
 	}
 }
 
-func (x *SubPayChProposalsResp) String() string {
+func (x *DeletePeerIDResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChProposalsResp) ProtoMessage() {}
+func (*DeletePeerIDResp) ProtoMessage() {}
 
-func (x *SubPayChProposalsResp) ProtoReflect() protoreflect.Message {
+func (x *DeletePeerIDResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,58 +1437,59 @@ func (x *SubPayChProposalsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChProposalsResp.ProtoReflect.Descriptor instead.
-func (*SubPayChProposalsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePeerIDResp.ProtoReflect.Descriptor instead.
+func (*DeletePeerIDResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *SubPayChProposalsResp) GetResponse() isSubPayChProposalsResp_Response {
+func (m *DeletePeerIDResp) GetResponse() isDeletePeerIDResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *SubPayChProposalsResp) GetNotify() *SubPayChProposalsResp_Notify {
-	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Notify_); ok {
-		return x.Notify
+func (x *DeletePeerIDResp) GetMsgSuccess() *DeletePeerIDResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*DeletePeerIDResp_MsgSuccess_); ok {
+		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *SubPayChProposalsResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Error); ok {
+func (x *DeletePeerIDResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*DeletePeerIDResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isSubPayChProposalsResp_Response interface {
-	isSubPayChProposalsResp_Response()
+type isDeletePeerIDResp_Response interface {
+	isDeletePeerIDResp_Response()
 }
 
-type SubPayChProposalsResp_Notify_ struct {
-	Notify *SubPayChProposalsResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+type DeletePeerIDResp_MsgSuccess_ struct {
+	MsgSuccess *DeletePeerIDResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type SubPayChProposalsResp_Error struct {
+type DeletePeerIDResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*SubPayChProposalsResp_Notify_) isSubPayChProposalsResp_Response() {}
+func (*DeletePeerIDResp_MsgSuccess_) isDeletePeerIDResp_Response() {}
 
-func (*SubPayChProposalsResp_Error) isSubPayChProposalsResp_Response() {}
+func (*DeletePeerIDResp_Error) isDeletePeerIDResp_Response() {}
 
-type UnsubPayChProposalsReq struct {
+type GetPeerStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Alias     string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *UnsubPayChProposalsReq) Reset() {
-	*x = UnsubPayChProposalsReq{}
+func (x *GetPeerStatusReq) Reset() {
+	*x = GetPeerStatusReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1328,13 +1497,13 @@ func (x *UnsubPayChProposalsReq) Reset() {
 	}
 }
 
-func (x *UnsubPayChProposalsReq) String() string {
+func (x *GetPeerStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChProposalsReq) ProtoMessage() {}
+func (*GetPeerStatusReq) ProtoMessage() {}
 
-func (x *UnsubPayChProposalsReq) ProtoReflect() protoreflect.Message {
+func (x *GetPeerStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1346,32 +1515,39 @@ func (x *UnsubPayChProposalsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChProposalsReq.ProtoReflect.Descriptor instead.
-func (*UnsubPayChProposalsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPeerStatusReq.ProtoReflect.Descriptor instead.
+func (*GetPeerStatusReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UnsubPayChProposalsReq) GetSessionID() string {
+func (x *GetPeerStatusReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-type UnsubPayChProposalsResp struct {
+func (x *GetPeerStatusReq) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type GetPeerStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*UnsubPayChProposalsResp_MsgSuccess_
-	//	*UnsubPayChProposalsResp_Error
-	Response isUnsubPayChProposalsResp_Response `protobuf_oneof:"response"`
+	//	*GetPeerStatusResp_MsgSuccess_
+	//	*GetPeerStatusResp_Error
+	Response isGetPeerStatusResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *UnsubPayChProposalsResp) Reset() {
-	*x = UnsubPayChProposalsResp{}
+func (x *GetPeerStatusResp) Reset() {
+	*x = GetPeerStatusResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1379,13 +1555,13 @@ func (x *UnsubPayChProposalsResp) Reset() {
 	}
 }
 
-func (x *UnsubPayChProposalsResp) String() string {
+func (x *GetPeerStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChProposalsResp) ProtoMessage() {}
+func (*GetPeerStatusResp) ProtoMessage() {}
 
-func (x *UnsubPayChProposalsResp) ProtoReflect() protoreflect.Message {
+func (x *GetPeerStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1397,60 +1573,59 @@ func (x *UnsubPayChProposalsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChProposalsResp.ProtoReflect.Descriptor instead.
-func (*UnsubPayChProposalsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPeerStatusResp.ProtoReflect.Descriptor instead.
+func (*GetPeerStatusResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (m *UnsubPayChProposalsResp) GetResponse() isUnsubPayChProposalsResp_Response {
+func (m *GetPeerStatusResp) GetResponse() isGetPeerStatusResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *UnsubPayChProposalsResp) GetMsgSuccess() *UnsubPayChProposalsResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_MsgSuccess_); ok {
+func (x *GetPeerStatusResp) GetMsgSuccess() *GetPeerStatusResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPeerStatusResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *UnsubPayChProposalsResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_Error); ok {
+func (x *GetPeerStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPeerStatusResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isUnsubPayChProposalsResp_Response interface {
-	isUnsubPayChProposalsResp_Response()
+type isGetPeerStatusResp_Response interface {
+	isGetPeerStatusResp_Response()
 }
 
-type UnsubPayChProposalsResp_MsgSuccess_ struct {
-	MsgSuccess *UnsubPayChProposalsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type GetPeerStatusResp_MsgSuccess_ struct {
+	MsgSuccess *GetPeerStatusResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type UnsubPayChProposalsResp_Error struct {
+type GetPeerStatusResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*UnsubPayChProposalsResp_MsgSuccess_) isUnsubPayChProposalsResp_Response() {}
+func (*GetPeerStatusResp_MsgSuccess_) isGetPeerStatusResp_Response() {}
 
-func (*UnsubPayChProposalsResp_Error) isUnsubPayChProposalsResp_Response() {}
+func (*GetPeerStatusResp_Error) isGetPeerStatusResp_Response() {}
 
-type RespondPayChProposalReq struct {
+type SubPeerStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ProposalID string `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
-	Accept     bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Alias     string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *RespondPayChProposalReq) Reset() {
-	*x = RespondPayChProposalReq{}
+func (x *SubPeerStatusReq) Reset() {
+	*x = SubPeerStatusReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1458,13 +1633,13 @@ func (x *RespondPayChProposalReq) Reset() {
 	}
 }
 
-func (x *RespondPayChProposalReq) String() string {
+func (x *SubPeerStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChProposalReq) ProtoMessage() {}
+func (*SubPeerStatusReq) ProtoMessage() {}
 
-func (x *RespondPayChProposalReq) ProtoReflect() protoreflect.Message {
+func (x *SubPeerStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,46 +1651,39 @@ func (x *RespondPayChProposalReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChProposalReq.ProtoReflect.Descriptor instead.
-func (*RespondPayChProposalReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubPeerStatusReq.ProtoReflect.Descriptor instead.
+func (*SubPeerStatusReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *RespondPayChProposalReq) GetSessionID() string {
+func (x *SubPeerStatusReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *RespondPayChProposalReq) GetProposalID() string {
+func (x *SubPeerStatusReq) GetAlias() string {
 	if x != nil {
-		return x.ProposalID
+		return x.Alias
 	}
 	return ""
 }
 
-func (x *RespondPayChProposalReq) GetAccept() bool {
-	if x != nil {
-		return x.Accept
-	}
-	return false
-}
-
-type RespondPayChProposalResp struct {
+type SubPeerStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*RespondPayChProposalResp_MsgSuccess_
-	//	*RespondPayChProposalResp_Error
-	Response isRespondPayChProposalResp_Response `protobuf_oneof:"response"`
+	//	*SubPeerStatusResp_Notify_
+	//	*SubPeerStatusResp_Error
+	Response isSubPeerStatusResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *RespondPayChProposalResp) Reset() {
-	*x = RespondPayChProposalResp{}
+func (x *SubPeerStatusResp) Reset() {
+	*x = SubPeerStatusResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1523,13 +1691,13 @@ func (x *RespondPayChProposalResp) Reset() {
 	}
 }
 
-func (x *RespondPayChProposalResp) String() string {
+func (x *SubPeerStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChProposalResp) ProtoMessage() {}
+func (*SubPeerStatusResp) ProtoMessage() {}
 
-func (x *RespondPayChProposalResp) ProtoReflect() protoreflect.Message {
+func (x *SubPeerStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1541,59 +1709,59 @@ func (x *RespondPayChProposalResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChProposalResp.ProtoReflect.Descriptor instead.
-func (*RespondPayChProposalResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubPeerStatusResp.ProtoReflect.Descriptor instead.
+func (*SubPeerStatusResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (m *RespondPayChProposalResp) GetResponse() isRespondPayChProposalResp_Response {
+func (m *SubPeerStatusResp) GetResponse() isSubPeerStatusResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *RespondPayChProposalResp) GetMsgSuccess() *RespondPayChProposalResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*RespondPayChProposalResp_MsgSuccess_); ok {
-		return x.MsgSuccess
+func (x *SubPeerStatusResp) GetNotify() *SubPeerStatusResp_Notify {
+	if x, ok := x.GetResponse().(*SubPeerStatusResp_Notify_); ok {
+		return x.Notify
 	}
 	return nil
 }
 
-func (x *RespondPayChProposalResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*RespondPayChProposalResp_Error); ok {
+func (x *SubPeerStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPeerStatusResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isRespondPayChProposalResp_Response interface {
-	isRespondPayChProposalResp_Response()
+type isSubPeerStatusResp_Response interface {
+	isSubPeerStatusResp_Response()
 }
 
-type RespondPayChProposalResp_MsgSuccess_ struct {
-	MsgSuccess *RespondPayChProposalResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type SubPeerStatusResp_Notify_ struct {
+	Notify *SubPeerStatusResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
 }
 
-type RespondPayChProposalResp_Error struct {
+type SubPeerStatusResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*RespondPayChProposalResp_MsgSuccess_) isRespondPayChProposalResp_Response() {}
+func (*SubPeerStatusResp_Notify_) isSubPeerStatusResp_Response() {}
 
-func (*RespondPayChProposalResp_Error) isRespondPayChProposalResp_Response() {}
+func (*SubPeerStatusResp_Error) isSubPeerStatusResp_Response() {}
 
-type CloseSessionReq struct {
+type UnsubPeerStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	Force     bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Alias     string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *CloseSessionReq) Reset() {
-	*x = CloseSessionReq{}
+func (x *UnsubPeerStatusReq) Reset() {
+	*x = UnsubPeerStatusReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1601,13 +1769,13 @@ func (x *CloseSessionReq) Reset() {
 	}
 }
 
-func (x *CloseSessionReq) String() string {
+func (x *UnsubPeerStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionReq) ProtoMessage() {}
+func (*UnsubPeerStatusReq) ProtoMessage() {}
 
-func (x *CloseSessionReq) ProtoReflect() protoreflect.Message {
+func (x *UnsubPeerStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1619,39 +1787,39 @@ func (x *CloseSessionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionReq.ProtoReflect.Descriptor instead.
-func (*CloseSessionReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubPeerStatusReq.ProtoReflect.Descriptor instead.
+func (*UnsubPeerStatusReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CloseSessionReq) GetSessionID() string {
+func (x *UnsubPeerStatusReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *CloseSessionReq) GetForce() bool {
+func (x *UnsubPeerStatusReq) GetAlias() string {
 	if x != nil {
-		return x.Force
+		return x.Alias
 	}
-	return false
+	return ""
 }
 
-type CloseSessionResp struct {
+type UnsubPeerStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*CloseSessionResp_MsgSuccess_
-	//	*CloseSessionResp_Error
-	Response isCloseSessionResp_Response `protobuf_oneof:"response"`
+	//	*UnsubPeerStatusResp_MsgSuccess_
+	//	*UnsubPeerStatusResp_Error
+	Response isUnsubPeerStatusResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *CloseSessionResp) Reset() {
-	*x = CloseSessionResp{}
+func (x *UnsubPeerStatusResp) Reset() {
+	*x = UnsubPeerStatusResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1659,13 +1827,13 @@ func (x *CloseSessionResp) Reset() {
 	}
 }
 
-func (x *CloseSessionResp) String() string {
+func (x *UnsubPeerStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionResp) ProtoMessage() {}
+func (*UnsubPeerStatusResp) ProtoMessage() {}
 
-func (x *CloseSessionResp) ProtoReflect() protoreflect.Message {
+func (x *UnsubPeerStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1677,59 +1845,60 @@ func (x *CloseSessionResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionResp.ProtoReflect.Descriptor instead.
-func (*CloseSessionResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubPeerStatusResp.ProtoReflect.Descriptor instead.
+func (*UnsubPeerStatusResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (m *CloseSessionResp) GetResponse() isCloseSessionResp_Response {
+func (m *UnsubPeerStatusResp) GetResponse() isUnsubPeerStatusResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *CloseSessionResp) GetMsgSuccess() *CloseSessionResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*CloseSessionResp_MsgSuccess_); ok {
+func (x *UnsubPeerStatusResp) GetMsgSuccess() *UnsubPeerStatusResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPeerStatusResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *CloseSessionResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*CloseSessionResp_Error); ok {
+func (x *UnsubPeerStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPeerStatusResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isCloseSessionResp_Response interface {
-	isCloseSessionResp_Response()
+type isUnsubPeerStatusResp_Response interface {
+	isUnsubPeerStatusResp_Response()
 }
 
-type CloseSessionResp_MsgSuccess_ struct {
-	MsgSuccess *CloseSessionResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type UnsubPeerStatusResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPeerStatusResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type CloseSessionResp_Error struct {
+type UnsubPeerStatusResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*CloseSessionResp_MsgSuccess_) isCloseSessionResp_Response() {}
+func (*UnsubPeerStatusResp_MsgSuccess_) isUnsubPeerStatusResp_Response() {}
 
-func (*CloseSessionResp_Error) isCloseSessionResp_Response() {}
+func (*UnsubPeerStatusResp_Error) isUnsubPeerStatusResp_Response() {}
 
-type DeployAssetERC20Req struct {
+type OpenPayChReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	TokenAddr string `protobuf:"bytes,2,opt,name=tokenAddr,proto3" json:"tokenAddr,omitempty"`
+	SessionID        string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	OpeningBalInfo   *BalInfo `protobuf:"bytes,2,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
+	ChallengeDurSecs uint64   `protobuf:"varint,3,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
 }
 
-func (x *DeployAssetERC20Req) Reset() {
-	*x = DeployAssetERC20Req{}
+func (x *OpenPayChReq) Reset() {
+	*x = OpenPayChReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1737,13 +1906,13 @@ func (x *DeployAssetERC20Req) Reset() {
 	}
 }
 
-func (x *DeployAssetERC20Req) String() string {
+func (x *OpenPayChReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployAssetERC20Req) ProtoMessage() {}
+func (*OpenPayChReq) ProtoMessage() {}
 
-func (x *DeployAssetERC20Req) ProtoReflect() protoreflect.Message {
+func (x *OpenPayChReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1755,39 +1924,46 @@ func (x *DeployAssetERC20Req) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployAssetERC20Req.ProtoReflect.Descriptor instead.
-func (*DeployAssetERC20Req) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenPayChReq.ProtoReflect.Descriptor instead.
+func (*OpenPayChReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeployAssetERC20Req) GetSessionID() string {
+func (x *OpenPayChReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *DeployAssetERC20Req) GetTokenAddr() string {
+func (x *OpenPayChReq) GetOpeningBalInfo() *BalInfo {
 	if x != nil {
-		return x.TokenAddr
+		return x.OpeningBalInfo
 	}
-	return ""
+	return nil
 }
 
-type DeployAssetERC20Resp struct {
+func (x *OpenPayChReq) GetChallengeDurSecs() uint64 {
+	if x != nil {
+		return x.ChallengeDurSecs
+	}
+	return 0
+}
+
+type OpenPayChResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*DeployAssetERC20Resp_MsgSuccess_
-	//	*DeployAssetERC20Resp_Error
-	Response isDeployAssetERC20Resp_Response `protobuf_oneof:"response"`
+	//	*OpenPayChResp_MsgSuccess_
+	//	*OpenPayChResp_Error
+	Response isOpenPayChResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *DeployAssetERC20Resp) Reset() {
-	*x = DeployAssetERC20Resp{}
+func (x *OpenPayChResp) Reset() {
+	*x = OpenPayChResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1795,13 +1971,13 @@ func (x *DeployAssetERC20Resp) Reset() {
 	}
 }
 
-func (x *DeployAssetERC20Resp) String() string {
+func (x *OpenPayChResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployAssetERC20Resp) ProtoMessage() {}
+func (*OpenPayChResp) ProtoMessage() {}
 
-func (x *DeployAssetERC20Resp) ProtoReflect() protoreflect.Message {
+func (x *OpenPayChResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1813,60 +1989,66 @@ func (x *DeployAssetERC20Resp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployAssetERC20Resp.ProtoReflect.Descriptor instead.
-func (*DeployAssetERC20Resp) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenPayChResp.ProtoReflect.Descriptor instead.
+func (*OpenPayChResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (m *DeployAssetERC20Resp) GetResponse() isDeployAssetERC20Resp_Response {
+func (m *OpenPayChResp) GetResponse() isOpenPayChResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *DeployAssetERC20Resp) GetMsgSuccess() *DeployAssetERC20Resp_MsgSuccess {
-	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_MsgSuccess_); ok {
+func (x *OpenPayChResp) GetMsgSuccess() *OpenPayChResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*OpenPayChResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *DeployAssetERC20Resp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_Error); ok {
+func (x *OpenPayChResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*OpenPayChResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isDeployAssetERC20Resp_Response interface {
-	isDeployAssetERC20Resp_Response()
+type isOpenPayChResp_Response interface {
+	isOpenPayChResp_Response()
 }
 
-type DeployAssetERC20Resp_MsgSuccess_ struct {
-	MsgSuccess *DeployAssetERC20Resp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type OpenPayChResp_MsgSuccess_ struct {
+	MsgSuccess *OpenPayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type DeployAssetERC20Resp_Error struct {
+type OpenPayChResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*DeployAssetERC20Resp_MsgSuccess_) isDeployAssetERC20Resp_Response() {}
+func (*OpenPayChResp_MsgSuccess_) isOpenPayChResp_Response() {}
 
-func (*DeployAssetERC20Resp_Error) isDeployAssetERC20Resp_Response() {}
+func (*OpenPayChResp_Error) isOpenPayChResp_Response() {}
 
-type SendPayChUpdateReq struct {
+// Virtual channel is funded from the ledger channels that the user and the
+// peer each have with a common intermediary. peerParentIdx is the index of the
+// peer in its ledger channel.
+type OpenVirtualPayChReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string     `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string     `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
-	Payments  []*Payment `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+	SessionID        string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ParentChID       string   `protobuf:"bytes,2,opt,name=parentChID,proto3" json:"parentChID,omitempty"`
+	PeerParentChID   string   `protobuf:"bytes,3,opt,name=peerParentChID,proto3" json:"peerParentChID,omitempty"`
+	PeerParentIdx    uint32   `protobuf:"varint,4,opt,name=peerParentIdx,proto3" json:"peerParentIdx,omitempty"`
+	OpeningBalInfo   *BalInfo `protobuf:"bytes,5,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
+	ChallengeDurSecs uint64   `protobuf:"varint,6,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
 }
 
-func (x *SendPayChUpdateReq) Reset() {
-	*x = SendPayChUpdateReq{}
+func (x *OpenVirtualPayChReq) Reset() {
+	*x = OpenVirtualPayChReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1874,13 +2056,13 @@ func (x *SendPayChUpdateReq) Reset() {
 	}
 }
 
-func (x *SendPayChUpdateReq) String() string {
+func (x *OpenVirtualPayChReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPayChUpdateReq) ProtoMessage() {}
+func (*OpenVirtualPayChReq) ProtoMessage() {}
 
-func (x *SendPayChUpdateReq) ProtoReflect() protoreflect.Message {
+func (x *OpenVirtualPayChReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,46 +2074,67 @@ func (x *SendPayChUpdateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPayChUpdateReq.ProtoReflect.Descriptor instead.
-func (*SendPayChUpdateReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenVirtualPayChReq.ProtoReflect.Descriptor instead.
+func (*OpenVirtualPayChReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SendPayChUpdateReq) GetSessionID() string {
+func (x *OpenVirtualPayChReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *SendPayChUpdateReq) GetChID() string {
+func (x *OpenVirtualPayChReq) GetParentChID() string {
 	if x != nil {
-		return x.ChID
+		return x.ParentChID
 	}
 	return ""
 }
 
-func (x *SendPayChUpdateReq) GetPayments() []*Payment {
+func (x *OpenVirtualPayChReq) GetPeerParentChID() string {
 	if x != nil {
-		return x.Payments
+		return x.PeerParentChID
+	}
+	return ""
+}
+
+func (x *OpenVirtualPayChReq) GetPeerParentIdx() uint32 {
+	if x != nil {
+		return x.PeerParentIdx
+	}
+	return 0
+}
+
+func (x *OpenVirtualPayChReq) GetOpeningBalInfo() *BalInfo {
+	if x != nil {
+		return x.OpeningBalInfo
 	}
 	return nil
 }
 
-type SendPayChUpdateResp struct {
+func (x *OpenVirtualPayChReq) GetChallengeDurSecs() uint64 {
+	if x != nil {
+		return x.ChallengeDurSecs
+	}
+	return 0
+}
+
+type OpenVirtualPayChResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*SendPayChUpdateResp_MsgSuccess_
-	//	*SendPayChUpdateResp_Error
-	Response isSendPayChUpdateResp_Response `protobuf_oneof:"response"`
+	//	*OpenVirtualPayChResp_MsgSuccess_
+	//	*OpenVirtualPayChResp_Error
+	Response isOpenVirtualPayChResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SendPayChUpdateResp) Reset() {
-	*x = SendPayChUpdateResp{}
+func (x *OpenVirtualPayChResp) Reset() {
+	*x = OpenVirtualPayChResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1939,13 +2142,13 @@ func (x *SendPayChUpdateResp) Reset() {
 	}
 }
 
-func (x *SendPayChUpdateResp) String() string {
+func (x *OpenVirtualPayChResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPayChUpdateResp) ProtoMessage() {}
+func (*OpenVirtualPayChResp) ProtoMessage() {}
 
-func (x *SendPayChUpdateResp) ProtoReflect() protoreflect.Message {
+func (x *OpenVirtualPayChResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1957,59 +2160,58 @@ func (x *SendPayChUpdateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPayChUpdateResp.ProtoReflect.Descriptor instead.
-func (*SendPayChUpdateResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenVirtualPayChResp.ProtoReflect.Descriptor instead.
+func (*OpenVirtualPayChResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (m *SendPayChUpdateResp) GetResponse() isSendPayChUpdateResp_Response {
+func (m *OpenVirtualPayChResp) GetResponse() isOpenVirtualPayChResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *SendPayChUpdateResp) GetMsgSuccess() *SendPayChUpdateResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*SendPayChUpdateResp_MsgSuccess_); ok {
+func (x *OpenVirtualPayChResp) GetMsgSuccess() *OpenVirtualPayChResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*OpenVirtualPayChResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *SendPayChUpdateResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*SendPayChUpdateResp_Error); ok {
+func (x *OpenVirtualPayChResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*OpenVirtualPayChResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isSendPayChUpdateResp_Response interface {
-	isSendPayChUpdateResp_Response()
+type isOpenVirtualPayChResp_Response interface {
+	isOpenVirtualPayChResp_Response()
 }
 
-type SendPayChUpdateResp_MsgSuccess_ struct {
-	MsgSuccess *SendPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type OpenVirtualPayChResp_MsgSuccess_ struct {
+	MsgSuccess *OpenVirtualPayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type SendPayChUpdateResp_Error struct {
+type OpenVirtualPayChResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*SendPayChUpdateResp_MsgSuccess_) isSendPayChUpdateResp_Response() {}
+func (*OpenVirtualPayChResp_MsgSuccess_) isOpenVirtualPayChResp_Response() {}
 
-func (*SendPayChUpdateResp_Error) isSendPayChUpdateResp_Response() {}
+func (*OpenVirtualPayChResp_Error) isOpenVirtualPayChResp_Response() {}
 
-type SubpayChUpdatesReq struct {
+type GetPayChsInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *SubpayChUpdatesReq) Reset() {
-	*x = SubpayChUpdatesReq{}
+func (x *GetPayChsInfoReq) Reset() {
+	*x = GetPayChsInfoReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2017,13 +2219,13 @@ func (x *SubpayChUpdatesReq) Reset() {
 	}
 }
 
-func (x *SubpayChUpdatesReq) String() string {
+func (x *GetPayChsInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubpayChUpdatesReq) ProtoMessage() {}
+func (*GetPayChsInfoReq) ProtoMessage() {}
 
-func (x *SubpayChUpdatesReq) ProtoReflect() protoreflect.Message {
+func (x *GetPayChsInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2035,39 +2237,32 @@ func (x *SubpayChUpdatesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubpayChUpdatesReq.ProtoReflect.Descriptor instead.
-func (*SubpayChUpdatesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPayChsInfoReq.ProtoReflect.Descriptor instead.
+func (*GetPayChsInfoReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SubpayChUpdatesReq) GetSessionID() string {
+func (x *GetPayChsInfoReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *SubpayChUpdatesReq) GetChID() string {
-	if x != nil {
-		return x.ChID
-	}
-	return ""
-}
-
-type SubPayChUpdatesResp struct {
+type GetPayChsInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*SubPayChUpdatesResp_Notify_
-	//	*SubPayChUpdatesResp_Error
-	Response isSubPayChUpdatesResp_Response `protobuf_oneof:"response"`
+	//	*GetPayChsInfoResp_MsgSuccess_
+	//	*GetPayChsInfoResp_Error
+	Response isGetPayChsInfoResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SubPayChUpdatesResp) Reset() {
-	*x = SubPayChUpdatesResp{}
+func (x *GetPayChsInfoResp) Reset() {
+	*x = GetPayChsInfoResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2075,13 +2270,13 @@ func (x *SubPayChUpdatesResp) Reset() {
 	}
 }
 
-func (x *SubPayChUpdatesResp) String() string {
+func (x *GetPayChsInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChUpdatesResp) ProtoMessage() {}
+func (*GetPayChsInfoResp) ProtoMessage() {}
 
-func (x *SubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
+func (x *GetPayChsInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2093,59 +2288,58 @@ func (x *SubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChUpdatesResp.ProtoReflect.Descriptor instead.
-func (*SubPayChUpdatesResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPayChsInfoResp.ProtoReflect.Descriptor instead.
+func (*GetPayChsInfoResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (m *SubPayChUpdatesResp) GetResponse() isSubPayChUpdatesResp_Response {
+func (m *GetPayChsInfoResp) GetResponse() isGetPayChsInfoResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *SubPayChUpdatesResp) GetNotify() *SubPayChUpdatesResp_Notify {
-	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Notify_); ok {
-		return x.Notify
+func (x *GetPayChsInfoResp) GetMsgSuccess() *GetPayChsInfoResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPayChsInfoResp_MsgSuccess_); ok {
+		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *SubPayChUpdatesResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Error); ok {
+func (x *GetPayChsInfoResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPayChsInfoResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isSubPayChUpdatesResp_Response interface {
-	isSubPayChUpdatesResp_Response()
+type isGetPayChsInfoResp_Response interface {
+	isGetPayChsInfoResp_Response()
 }
 
-type SubPayChUpdatesResp_Notify_ struct {
-	Notify *SubPayChUpdatesResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+type GetPayChsInfoResp_MsgSuccess_ struct {
+	MsgSuccess *GetPayChsInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type SubPayChUpdatesResp_Error struct {
+type GetPayChsInfoResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*SubPayChUpdatesResp_Notify_) isSubPayChUpdatesResp_Response() {}
+func (*GetPayChsInfoResp_MsgSuccess_) isGetPayChsInfoResp_Response() {}
 
-func (*SubPayChUpdatesResp_Error) isSubPayChUpdatesResp_Response() {}
+func (*GetPayChsInfoResp_Error) isGetPayChsInfoResp_Response() {}
 
-type UnsubPayChUpdatesReq struct {
+type SubPayChProposalsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *UnsubPayChUpdatesReq) Reset() {
-	*x = UnsubPayChUpdatesReq{}
+func (x *SubPayChProposalsReq) Reset() {
+	*x = SubPayChProposalsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2153,13 +2347,13 @@ func (x *UnsubPayChUpdatesReq) Reset() {
 	}
 }
 
-func (x *UnsubPayChUpdatesReq) String() string {
+func (x *SubPayChProposalsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChUpdatesReq) ProtoMessage() {}
+func (*SubPayChProposalsReq) ProtoMessage() {}
 
-func (x *UnsubPayChUpdatesReq) ProtoReflect() protoreflect.Message {
+func (x *SubPayChProposalsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2171,39 +2365,32 @@ func (x *UnsubPayChUpdatesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChUpdatesReq.ProtoReflect.Descriptor instead.
-func (*UnsubPayChUpdatesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubPayChProposalsReq.ProtoReflect.Descriptor instead.
+func (*SubPayChProposalsReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UnsubPayChUpdatesReq) GetSessionID() string {
+func (x *SubPayChProposalsReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *UnsubPayChUpdatesReq) GetChID() string {
-	if x != nil {
-		return x.ChID
-	}
-	return ""
-}
-
-type UnsubPayChUpdatesResp struct {
+type SubPayChProposalsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*UnsubPayChUpdatesResp_MsgSuccess_
-	//	*UnsubPayChUpdatesResp_Error
-	Response isUnsubPayChUpdatesResp_Response `protobuf_oneof:"response"`
+	//	*SubPayChProposalsResp_Notify_
+	//	*SubPayChProposalsResp_Error
+	Response isSubPayChProposalsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *UnsubPayChUpdatesResp) Reset() {
-	*x = UnsubPayChUpdatesResp{}
+func (x *SubPayChProposalsResp) Reset() {
+	*x = SubPayChProposalsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2211,13 +2398,13 @@ func (x *UnsubPayChUpdatesResp) Reset() {
 	}
 }
 
-func (x *UnsubPayChUpdatesResp) String() string {
+func (x *SubPayChProposalsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChUpdatesResp) ProtoMessage() {}
+func (*SubPayChProposalsResp) ProtoMessage() {}
 
-func (x *UnsubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
+func (x *SubPayChProposalsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2229,61 +2416,58 @@ func (x *UnsubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChUpdatesResp.ProtoReflect.Descriptor instead.
-func (*UnsubPayChUpdatesResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubPayChProposalsResp.ProtoReflect.Descriptor instead.
+func (*SubPayChProposalsResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (m *UnsubPayChUpdatesResp) GetResponse() isUnsubPayChUpdatesResp_Response {
+func (m *SubPayChProposalsResp) GetResponse() isSubPayChProposalsResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *UnsubPayChUpdatesResp) GetMsgSuccess() *UnsubPayChUpdatesResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_MsgSuccess_); ok {
-		return x.MsgSuccess
+func (x *SubPayChProposalsResp) GetNotify() *SubPayChProposalsResp_Notify {
+	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Notify_); ok {
+		return x.Notify
 	}
 	return nil
 }
 
-func (x *UnsubPayChUpdatesResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_Error); ok {
+func (x *SubPayChProposalsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isUnsubPayChUpdatesResp_Response interface {
-	isUnsubPayChUpdatesResp_Response()
+type isSubPayChProposalsResp_Response interface {
+	isSubPayChProposalsResp_Response()
 }
 
-type UnsubPayChUpdatesResp_MsgSuccess_ struct {
-	MsgSuccess *UnsubPayChUpdatesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type SubPayChProposalsResp_Notify_ struct {
+	Notify *SubPayChProposalsResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
 }
 
-type UnsubPayChUpdatesResp_Error struct {
+type SubPayChProposalsResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*UnsubPayChUpdatesResp_MsgSuccess_) isUnsubPayChUpdatesResp_Response() {}
+func (*SubPayChProposalsResp_Notify_) isSubPayChProposalsResp_Response() {}
 
-func (*UnsubPayChUpdatesResp_Error) isUnsubPayChUpdatesResp_Response() {}
+func (*SubPayChProposalsResp_Error) isSubPayChProposalsResp_Response() {}
 
-type RespondPayChUpdateReq struct {
+type UnsubPayChProposalsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
-	UpdateID  string `protobuf:"bytes,3,opt,name=updateID,proto3" json:"updateID,omitempty"`
-	Accept    bool   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-func (x *RespondPayChUpdateReq) Reset() {
-	*x = RespondPayChUpdateReq{}
+func (x *UnsubPayChProposalsReq) Reset() {
+	*x = UnsubPayChProposalsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2291,13 +2475,13 @@ func (x *RespondPayChUpdateReq) Reset() {
 	}
 }
 
-func (x *RespondPayChUpdateReq) String() string {
+func (x *UnsubPayChProposalsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChUpdateReq) ProtoMessage() {}
+func (*UnsubPayChProposalsReq) ProtoMessage() {}
 
-func (x *RespondPayChUpdateReq) ProtoReflect() protoreflect.Message {
+func (x *UnsubPayChProposalsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2309,53 +2493,32 @@ func (x *RespondPayChUpdateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChUpdateReq.ProtoReflect.Descriptor instead.
-func (*RespondPayChUpdateReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubPayChProposalsReq.ProtoReflect.Descriptor instead.
+func (*UnsubPayChProposalsReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RespondPayChUpdateReq) GetSessionID() string {
+func (x *UnsubPayChProposalsReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *RespondPayChUpdateReq) GetChID() string {
-	if x != nil {
-		return x.ChID
-	}
-	return ""
-}
-
-func (x *RespondPayChUpdateReq) GetUpdateID() string {
-	if x != nil {
-		return x.UpdateID
-	}
-	return ""
-}
-
-func (x *RespondPayChUpdateReq) GetAccept() bool {
-	if x != nil {
-		return x.Accept
-	}
-	return false
-}
-
-type RespondPayChUpdateResp struct {
+type UnsubPayChProposalsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*RespondPayChUpdateResp_MsgSuccess_
-	//	*RespondPayChUpdateResp_Error
-	Response isRespondPayChUpdateResp_Response `protobuf_oneof:"response"`
+	//	*UnsubPayChProposalsResp_MsgSuccess_
+	//	*UnsubPayChProposalsResp_Error
+	Response isUnsubPayChProposalsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *RespondPayChUpdateResp) Reset() {
-	*x = RespondPayChUpdateResp{}
+func (x *UnsubPayChProposalsResp) Reset() {
+	*x = UnsubPayChProposalsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2363,13 +2526,13 @@ func (x *RespondPayChUpdateResp) Reset() {
 	}
 }
 
-func (x *RespondPayChUpdateResp) String() string {
+func (x *UnsubPayChProposalsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChUpdateResp) ProtoMessage() {}
+func (*UnsubPayChProposalsResp) ProtoMessage() {}
 
-func (x *RespondPayChUpdateResp) ProtoReflect() protoreflect.Message {
+func (x *UnsubPayChProposalsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2381,59 +2544,60 @@ func (x *RespondPayChUpdateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChUpdateResp.ProtoReflect.Descriptor instead.
-func (*RespondPayChUpdateResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubPayChProposalsResp.ProtoReflect.Descriptor instead.
+func (*UnsubPayChProposalsResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (m *RespondPayChUpdateResp) GetResponse() isRespondPayChUpdateResp_Response {
+func (m *UnsubPayChProposalsResp) GetResponse() isUnsubPayChProposalsResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *RespondPayChUpdateResp) GetMsgSuccess() *RespondPayChUpdateResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_MsgSuccess_); ok {
+func (x *UnsubPayChProposalsResp) GetMsgSuccess() *UnsubPayChProposalsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *RespondPayChUpdateResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_Error); ok {
+func (x *UnsubPayChProposalsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isRespondPayChUpdateResp_Response interface {
-	isRespondPayChUpdateResp_Response()
+type isUnsubPayChProposalsResp_Response interface {
+	isUnsubPayChProposalsResp_Response()
 }
 
-type RespondPayChUpdateResp_MsgSuccess_ struct {
-	MsgSuccess *RespondPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type UnsubPayChProposalsResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPayChProposalsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type RespondPayChUpdateResp_Error struct {
+type UnsubPayChProposalsResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*RespondPayChUpdateResp_MsgSuccess_) isRespondPayChUpdateResp_Response() {}
+func (*UnsubPayChProposalsResp_MsgSuccess_) isUnsubPayChProposalsResp_Response() {}
 
-func (*RespondPayChUpdateResp_Error) isRespondPayChUpdateResp_Response() {}
+func (*UnsubPayChProposalsResp_Error) isUnsubPayChProposalsResp_Response() {}
 
-type GetPayChInfoReq struct {
+type RespondPayChProposalReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ProposalID string `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Accept     bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-func (x *GetPayChInfoReq) Reset() {
-	*x = GetPayChInfoReq{}
+func (x *RespondPayChProposalReq) Reset() {
+	*x = RespondPayChProposalReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2441,13 +2605,13 @@ func (x *GetPayChInfoReq) Reset() {
 	}
 }
 
-func (x *GetPayChInfoReq) String() string {
+func (x *RespondPayChProposalReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChInfoReq) ProtoMessage() {}
+func (*RespondPayChProposalReq) ProtoMessage() {}
 
-func (x *GetPayChInfoReq) ProtoReflect() protoreflect.Message {
+func (x *RespondPayChProposalReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2459,39 +2623,46 @@ func (x *GetPayChInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChInfoReq.ProtoReflect.Descriptor instead.
-func (*GetPayChInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RespondPayChProposalReq.ProtoReflect.Descriptor instead.
+func (*RespondPayChProposalReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetPayChInfoReq) GetSessionID() string {
+func (x *RespondPayChProposalReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *GetPayChInfoReq) GetChID() string {
+func (x *RespondPayChProposalReq) GetProposalID() string {
 	if x != nil {
-		return x.ChID
+		return x.ProposalID
 	}
 	return ""
 }
 
-type GetPayChInfoResp struct {
+func (x *RespondPayChProposalReq) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondPayChProposalResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*GetPayChInfoResp_MsgSuccess_
-	//	*GetPayChInfoResp_Error
-	Response isGetPayChInfoResp_Response `protobuf_oneof:"response"`
+	//	*RespondPayChProposalResp_MsgSuccess_
+	//	*RespondPayChProposalResp_Error
+	Response isRespondPayChProposalResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetPayChInfoResp) Reset() {
-	*x = GetPayChInfoResp{}
+func (x *RespondPayChProposalResp) Reset() {
+	*x = RespondPayChProposalResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2499,13 +2670,13 @@ func (x *GetPayChInfoResp) Reset() {
 	}
 }
 
-func (x *GetPayChInfoResp) String() string {
+func (x *RespondPayChProposalResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChInfoResp) ProtoMessage() {}
+func (*RespondPayChProposalResp) ProtoMessage() {}
 
-func (x *GetPayChInfoResp) ProtoReflect() protoreflect.Message {
+func (x *RespondPayChProposalResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2517,59 +2688,60 @@ func (x *GetPayChInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChInfoResp.ProtoReflect.Descriptor instead.
-func (*GetPayChInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RespondPayChProposalResp.ProtoReflect.Descriptor instead.
+func (*RespondPayChProposalResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (m *GetPayChInfoResp) GetResponse() isGetPayChInfoResp_Response {
+func (m *RespondPayChProposalResp) GetResponse() isRespondPayChProposalResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *GetPayChInfoResp) GetMsgSuccess() *GetPayChInfoResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*GetPayChInfoResp_MsgSuccess_); ok {
+func (x *RespondPayChProposalResp) GetMsgSuccess() *RespondPayChProposalResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*RespondPayChProposalResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *GetPayChInfoResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*GetPayChInfoResp_Error); ok {
+func (x *RespondPayChProposalResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*RespondPayChProposalResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isGetPayChInfoResp_Response interface {
-	isGetPayChInfoResp_Response()
+type isRespondPayChProposalResp_Response interface {
+	isRespondPayChProposalResp_Response()
 }
 
-type GetPayChInfoResp_MsgSuccess_ struct {
-	MsgSuccess *GetPayChInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type RespondPayChProposalResp_MsgSuccess_ struct {
+	MsgSuccess *RespondPayChProposalResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type GetPayChInfoResp_Error struct {
+type RespondPayChProposalResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*GetPayChInfoResp_MsgSuccess_) isGetPayChInfoResp_Response() {}
+func (*RespondPayChProposalResp_MsgSuccess_) isRespondPayChProposalResp_Response() {}
 
-func (*GetPayChInfoResp_Error) isGetPayChInfoResp_Response() {}
+func (*RespondPayChProposalResp_Error) isRespondPayChProposalResp_Response() {}
 
-type ClosePayChReq struct {
+type RespondVirtualPayChProposalReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ProposalID string `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Accept     bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-func (x *ClosePayChReq) Reset() {
-	*x = ClosePayChReq{}
+func (x *RespondVirtualPayChProposalReq) Reset() {
+	*x = RespondVirtualPayChProposalReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2577,13 +2749,13 @@ func (x *ClosePayChReq) Reset() {
 	}
 }
 
-func (x *ClosePayChReq) String() string {
+func (x *RespondVirtualPayChProposalReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClosePayChReq) ProtoMessage() {}
+func (*RespondVirtualPayChProposalReq) ProtoMessage() {}
 
-func (x *ClosePayChReq) ProtoReflect() protoreflect.Message {
+func (x *RespondVirtualPayChProposalReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2595,39 +2767,46 @@ func (x *ClosePayChReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClosePayChReq.ProtoReflect.Descriptor instead.
-func (*ClosePayChReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RespondVirtualPayChProposalReq.ProtoReflect.Descriptor instead.
+func (*RespondVirtualPayChProposalReq) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ClosePayChReq) GetSessionID() string {
+func (x *RespondVirtualPayChProposalReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *ClosePayChReq) GetChID() string {
+func (x *RespondVirtualPayChProposalReq) GetProposalID() string {
 	if x != nil {
-		return x.ChID
+		return x.ProposalID
 	}
 	return ""
 }
 
-type ClosePayChResp struct {
+func (x *RespondVirtualPayChProposalReq) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondVirtualPayChProposalResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*ClosePayChResp_MsgSuccess_
-	//	*ClosePayChResp_Error
-	Response isClosePayChResp_Response `protobuf_oneof:"response"`
+	//	*RespondVirtualPayChProposalResp_MsgSuccess_
+	//	*RespondVirtualPayChProposalResp_Error
+	Response isRespondVirtualPayChProposalResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *ClosePayChResp) Reset() {
-	*x = ClosePayChResp{}
+func (x *RespondVirtualPayChProposalResp) Reset() {
+	*x = RespondVirtualPayChProposalResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2635,13 +2814,13 @@ func (x *ClosePayChResp) Reset() {
 	}
 }
 
-func (x *ClosePayChResp) String() string {
+func (x *RespondVirtualPayChProposalResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClosePayChResp) ProtoMessage() {}
+func (*RespondVirtualPayChProposalResp) ProtoMessage() {}
 
-func (x *ClosePayChResp) ProtoReflect() protoreflect.Message {
+func (x *RespondVirtualPayChProposalResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2653,59 +2832,59 @@ func (x *ClosePayChResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClosePayChResp.ProtoReflect.Descriptor instead.
-func (*ClosePayChResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RespondVirtualPayChProposalResp.ProtoReflect.Descriptor instead.
+func (*RespondVirtualPayChProposalResp) Descriptor() ([]byte, []int) {
 	return file_payment_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (m *ClosePayChResp) GetResponse() isClosePayChResp_Response {
+func (m *RespondVirtualPayChProposalResp) GetResponse() isRespondVirtualPayChProposalResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *ClosePayChResp) GetMsgSuccess() *ClosePayChResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*ClosePayChResp_MsgSuccess_); ok {
+func (x *RespondVirtualPayChProposalResp) GetMsgSuccess() *RespondVirtualPayChProposalResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*RespondVirtualPayChProposalResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *ClosePayChResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*ClosePayChResp_Error); ok {
+func (x *RespondVirtualPayChProposalResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*RespondVirtualPayChProposalResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isClosePayChResp_Response interface {
-	isClosePayChResp_Response()
+type isRespondVirtualPayChProposalResp_Response interface {
+	isRespondVirtualPayChProposalResp_Response()
 }
 
-type ClosePayChResp_MsgSuccess_ struct {
-	MsgSuccess *ClosePayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type RespondVirtualPayChProposalResp_MsgSuccess_ struct {
+	MsgSuccess *RespondVirtualPayChProposalResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type ClosePayChResp_Error struct {
+type RespondVirtualPayChProposalResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*ClosePayChResp_MsgSuccess_) isClosePayChResp_Response() {}
+func (*RespondVirtualPayChProposalResp_MsgSuccess_) isRespondVirtualPayChProposalResp_Response() {}
 
-func (*ClosePayChResp_Error) isClosePayChResp_Response() {}
+func (*RespondVirtualPayChProposalResp_Error) isRespondVirtualPayChProposalResp_Response() {}
 
-type OpenSessionResp_MsgSuccess struct {
+type CloseSessionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID   string       `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	RestoredChs []*PayChInfo `protobuf:"bytes,2,rep,name=restoredChs,proto3" json:"restoredChs,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Force     bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *OpenSessionResp_MsgSuccess) Reset() {
-	*x = OpenSessionResp_MsgSuccess{}
+func (x *CloseSessionReq) Reset() {
+	*x = CloseSessionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2713,13 +2892,13 @@ func (x *OpenSessionResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *OpenSessionResp_MsgSuccess) String() string {
+func (x *CloseSessionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenSessionResp_MsgSuccess) ProtoMessage() {}
+func (*CloseSessionReq) ProtoMessage() {}
 
-func (x *OpenSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *CloseSessionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2731,35 +2910,39 @@ func (x *OpenSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*OpenSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use CloseSessionReq.ProtoReflect.Descriptor instead.
+func (*CloseSessionReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *OpenSessionResp_MsgSuccess) GetSessionID() string {
+func (x *CloseSessionReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *OpenSessionResp_MsgSuccess) GetRestoredChs() []*PayChInfo {
+func (x *CloseSessionReq) GetForce() bool {
 	if x != nil {
-		return x.RestoredChs
+		return x.Force
 	}
-	return nil
+	return false
 }
 
-type RegisterCurrencyResp_MsgSuccess struct {
+type CloseSessionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*CloseSessionResp_MsgSuccess_
+	//	*CloseSessionResp_Error
+	Response isCloseSessionResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *RegisterCurrencyResp_MsgSuccess) Reset() {
-	*x = RegisterCurrencyResp_MsgSuccess{}
+func (x *CloseSessionResp) Reset() {
+	*x = CloseSessionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2767,13 +2950,13 @@ func (x *RegisterCurrencyResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *RegisterCurrencyResp_MsgSuccess) String() string {
+func (x *CloseSessionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterCurrencyResp_MsgSuccess) ProtoMessage() {}
+func (*CloseSessionResp) ProtoMessage() {}
 
-func (x *RegisterCurrencyResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *CloseSessionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2785,28 +2968,59 @@ func (x *RegisterCurrencyResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterCurrencyResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*RegisterCurrencyResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use CloseSessionResp.ProtoReflect.Descriptor instead.
+func (*CloseSessionResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RegisterCurrencyResp_MsgSuccess) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
+func (m *CloseSessionResp) GetResponse() isCloseSessionResp_Response {
+	if m != nil {
+		return m.Response
 	}
-	return ""
+	return nil
 }
 
-type AddPeerIDResp_MsgSuccess struct {
+func (x *CloseSessionResp) GetMsgSuccess() *CloseSessionResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CloseSessionResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CloseSessionResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CloseSessionResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCloseSessionResp_Response interface {
+	isCloseSessionResp_Response()
+}
+
+type CloseSessionResp_MsgSuccess_ struct {
+	MsgSuccess *CloseSessionResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CloseSessionResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CloseSessionResp_MsgSuccess_) isCloseSessionResp_Response() {}
+
+func (*CloseSessionResp_Error) isCloseSessionResp_Response() {}
+
+type DeployAssetERC20Req struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	TokenAddr string `protobuf:"bytes,2,opt,name=tokenAddr,proto3" json:"tokenAddr,omitempty"`
 }
 
-func (x *AddPeerIDResp_MsgSuccess) Reset() {
-	*x = AddPeerIDResp_MsgSuccess{}
+func (x *DeployAssetERC20Req) Reset() {
+	*x = DeployAssetERC20Req{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2814,13 +3028,13 @@ func (x *AddPeerIDResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *AddPeerIDResp_MsgSuccess) String() string {
+func (x *DeployAssetERC20Req) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerIDResp_MsgSuccess) ProtoMessage() {}
+func (*DeployAssetERC20Req) ProtoMessage() {}
 
-func (x *AddPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *DeployAssetERC20Req) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2832,28 +3046,39 @@ func (x *AddPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*AddPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use DeployAssetERC20Req.ProtoReflect.Descriptor instead.
+func (*DeployAssetERC20Req) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AddPeerIDResp_MsgSuccess) GetSuccess() bool {
+func (x *DeployAssetERC20Req) GetSessionID() string {
 	if x != nil {
-		return x.Success
+		return x.SessionID
 	}
-	return false
+	return ""
 }
 
-type GetPeerIDResp_MsgSuccess struct {
+func (x *DeployAssetERC20Req) GetTokenAddr() string {
+	if x != nil {
+		return x.TokenAddr
+	}
+	return ""
+}
+
+type DeployAssetERC20Resp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerID *PeerID `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*DeployAssetERC20Resp_MsgSuccess_
+	//	*DeployAssetERC20Resp_Error
+	Response isDeployAssetERC20Resp_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetPeerIDResp_MsgSuccess) Reset() {
-	*x = GetPeerIDResp_MsgSuccess{}
+func (x *DeployAssetERC20Resp) Reset() {
+	*x = DeployAssetERC20Resp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2861,13 +3086,13 @@ func (x *GetPeerIDResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *GetPeerIDResp_MsgSuccess) String() string {
+func (x *DeployAssetERC20Resp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPeerIDResp_MsgSuccess) ProtoMessage() {}
+func (*DeployAssetERC20Resp) ProtoMessage() {}
 
-func (x *GetPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *DeployAssetERC20Resp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2879,42 +3104,74 @@ func (x *GetPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*GetPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use DeployAssetERC20Resp.ProtoReflect.Descriptor instead.
+func (*DeployAssetERC20Resp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetPeerIDResp_MsgSuccess) GetPeerID() *PeerID {
-	if x != nil {
-		return x.PeerID
+func (m *DeployAssetERC20Resp) GetResponse() isDeployAssetERC20Resp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type OpenPayChResp_MsgSuccess struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OpenedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+func (x *DeployAssetERC20Resp) GetMsgSuccess() *DeployAssetERC20Resp_MsgSuccess {
+	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
 }
 
-func (x *OpenPayChResp_MsgSuccess) Reset() {
-	*x = OpenPayChResp_MsgSuccess{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_service_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DeployAssetERC20Resp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_Error); ok {
+		return x.Error
 	}
+	return nil
 }
 
-func (x *OpenPayChResp_MsgSuccess) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+type isDeployAssetERC20Resp_Response interface {
+	isDeployAssetERC20Resp_Response()
+}
 
-func (*OpenPayChResp_MsgSuccess) ProtoMessage() {}
+type DeployAssetERC20Resp_MsgSuccess_ struct {
+	MsgSuccess *DeployAssetERC20Resp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
 
-func (x *OpenPayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+type DeployAssetERC20Resp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*DeployAssetERC20Resp_MsgSuccess_) isDeployAssetERC20Resp_Response() {}
+
+func (*DeployAssetERC20Resp_Error) isDeployAssetERC20Resp_Response() {}
+
+type SendPayChUpdateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string     `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string     `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Payments  []*Payment `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+}
+
+func (x *SendPayChUpdateReq) Reset() {
+	*x = SendPayChUpdateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendPayChUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPayChUpdateReq) ProtoMessage() {}
+
+func (x *SendPayChUpdateReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2926,28 +3183,46 @@ func (x *OpenPayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*OpenPayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use SendPayChUpdateReq.ProtoReflect.Descriptor instead.
+func (*SendPayChUpdateReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *OpenPayChResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+func (x *SendPayChUpdateReq) GetSessionID() string {
 	if x != nil {
-		return x.OpenedPayChInfo
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SendPayChUpdateReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *SendPayChUpdateReq) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
 	}
 	return nil
 }
 
-type GetPayChsInfoResp_MsgSuccess struct {
+type SendPayChUpdateResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*SendPayChUpdateResp_MsgSuccess_
+	//	*SendPayChUpdateResp_Error
+	Response isSendPayChUpdateResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetPayChsInfoResp_MsgSuccess) Reset() {
-	*x = GetPayChsInfoResp_MsgSuccess{}
+func (x *SendPayChUpdateResp) Reset() {
+	*x = SendPayChUpdateResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2955,13 +3230,13 @@ func (x *GetPayChsInfoResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *GetPayChsInfoResp_MsgSuccess) String() string {
+func (x *SendPayChUpdateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChsInfoResp_MsgSuccess) ProtoMessage() {}
+func (*SendPayChUpdateResp) ProtoMessage() {}
 
-func (x *GetPayChsInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *SendPayChUpdateResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2973,31 +3248,59 @@ func (x *GetPayChsInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChsInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*GetPayChsInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use SendPayChUpdateResp.ProtoReflect.Descriptor instead.
+func (*SendPayChUpdateResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetPayChsInfoResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
-	if x != nil {
-		return x.OpenPayChsInfo
+func (m *SendPayChUpdateResp) GetResponse() isSendPayChUpdateResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type SubPayChProposalsResp_Notify struct {
+func (x *SendPayChUpdateResp) GetMsgSuccess() *SendPayChUpdateResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*SendPayChUpdateResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *SendPayChUpdateResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SendPayChUpdateResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSendPayChUpdateResp_Response interface {
+	isSendPayChUpdateResp_Response()
+}
+
+type SendPayChUpdateResp_MsgSuccess_ struct {
+	MsgSuccess *SendPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type SendPayChUpdateResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SendPayChUpdateResp_MsgSuccess_) isSendPayChUpdateResp_Response() {}
+
+func (*SendPayChUpdateResp_Error) isSendPayChUpdateResp_Response() {}
+
+type SubpayChUpdatesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProposalID       string   `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
-	OpeningBalInfo   *BalInfo `protobuf:"bytes,4,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
-	ChallengeDurSecs uint64   `protobuf:"varint,5,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
-	Expiry           int64    `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *SubPayChProposalsResp_Notify) Reset() {
-	*x = SubPayChProposalsResp_Notify{}
+func (x *SubpayChUpdatesReq) Reset() {
+	*x = SubpayChUpdatesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3005,13 +3308,13 @@ func (x *SubPayChProposalsResp_Notify) Reset() {
 	}
 }
 
-func (x *SubPayChProposalsResp_Notify) String() string {
+func (x *SubpayChUpdatesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChProposalsResp_Notify) ProtoMessage() {}
+func (*SubpayChUpdatesReq) ProtoMessage() {}
 
-func (x *SubPayChProposalsResp_Notify) ProtoReflect() protoreflect.Message {
+func (x *SubpayChUpdatesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3023,49 +3326,39 @@ func (x *SubPayChProposalsResp_Notify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChProposalsResp_Notify.ProtoReflect.Descriptor instead.
-func (*SubPayChProposalsResp_Notify) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use SubpayChUpdatesReq.ProtoReflect.Descriptor instead.
+func (*SubpayChUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SubPayChProposalsResp_Notify) GetProposalID() string {
+func (x *SubpayChUpdatesReq) GetSessionID() string {
 	if x != nil {
-		return x.ProposalID
+		return x.SessionID
 	}
 	return ""
 }
 
-func (x *SubPayChProposalsResp_Notify) GetOpeningBalInfo() *BalInfo {
-	if x != nil {
-		return x.OpeningBalInfo
-	}
-	return nil
-}
-
-func (x *SubPayChProposalsResp_Notify) GetChallengeDurSecs() uint64 {
-	if x != nil {
-		return x.ChallengeDurSecs
-	}
-	return 0
-}
-
-func (x *SubPayChProposalsResp_Notify) GetExpiry() int64 {
+func (x *SubpayChUpdatesReq) GetChID() string {
 	if x != nil {
-		return x.Expiry
+		return x.ChID
 	}
-	return 0
+	return ""
 }
 
-type UnsubPayChProposalsResp_MsgSuccess struct {
+type SubPayChUpdatesResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*SubPayChUpdatesResp_Notify_
+	//	*SubPayChUpdatesResp_Error
+	Response isSubPayChUpdatesResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) Reset() {
-	*x = UnsubPayChProposalsResp_MsgSuccess{}
+func (x *SubPayChUpdatesResp) Reset() {
+	*x = SubPayChUpdatesResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3073,13 +3366,13 @@ func (x *UnsubPayChProposalsResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) String() string {
+func (x *SubPayChUpdatesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChProposalsResp_MsgSuccess) ProtoMessage() {}
+func (*SubPayChUpdatesResp) ProtoMessage() {}
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *SubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3091,28 +3384,59 @@ func (x *UnsubPayChProposalsResp_MsgSuccess) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChProposalsResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*UnsubPayChProposalsResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use SubPayChUpdatesResp.ProtoReflect.Descriptor instead.
+func (*SubPayChUpdatesResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (m *SubPayChUpdatesResp) GetResponse() isSubPayChUpdatesResp_Response {
+	if m != nil {
+		return m.Response
 	}
-	return false
+	return nil
 }
 
-type RespondPayChProposalResp_MsgSuccess struct {
+func (x *SubPayChUpdatesResp) GetNotify() *SubPayChUpdatesResp_Notify {
+	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Notify_); ok {
+		return x.Notify
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSubPayChUpdatesResp_Response interface {
+	isSubPayChUpdatesResp_Response()
+}
+
+type SubPayChUpdatesResp_Notify_ struct {
+	Notify *SubPayChUpdatesResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+}
+
+type SubPayChUpdatesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SubPayChUpdatesResp_Notify_) isSubPayChUpdatesResp_Response() {}
+
+func (*SubPayChUpdatesResp_Error) isSubPayChUpdatesResp_Response() {}
+
+type UnsubPayChUpdatesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OpenedPayChInfo *PayChInfo `protobuf:"bytes,2,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *RespondPayChProposalResp_MsgSuccess) Reset() {
-	*x = RespondPayChProposalResp_MsgSuccess{}
+func (x *UnsubPayChUpdatesReq) Reset() {
+	*x = UnsubPayChUpdatesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3120,13 +3444,13 @@ func (x *RespondPayChProposalResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *RespondPayChProposalResp_MsgSuccess) String() string {
+func (x *UnsubPayChUpdatesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChProposalResp_MsgSuccess) ProtoMessage() {}
+func (*UnsubPayChUpdatesReq) ProtoMessage() {}
 
-func (x *RespondPayChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *UnsubPayChUpdatesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3138,28 +3462,39 @@ func (x *RespondPayChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChProposalResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*RespondPayChProposalResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{23, 0}
+// Deprecated: Use UnsubPayChUpdatesReq.ProtoReflect.Descriptor instead.
+func (*UnsubPayChUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *RespondPayChProposalResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+func (x *UnsubPayChUpdatesReq) GetSessionID() string {
 	if x != nil {
-		return x.OpenedPayChInfo
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-type CloseSessionResp_MsgSuccess struct {
+func (x *UnsubPayChUpdatesReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type UnsubPayChUpdatesResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*UnsubPayChUpdatesResp_MsgSuccess_
+	//	*UnsubPayChUpdatesResp_Error
+	Response isUnsubPayChUpdatesResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *CloseSessionResp_MsgSuccess) Reset() {
-	*x = CloseSessionResp_MsgSuccess{}
+func (x *UnsubPayChUpdatesResp) Reset() {
+	*x = UnsubPayChUpdatesResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3167,13 +3502,13 @@ func (x *CloseSessionResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *CloseSessionResp_MsgSuccess) String() string {
+func (x *UnsubPayChUpdatesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionResp_MsgSuccess) ProtoMessage() {}
+func (*UnsubPayChUpdatesResp) ProtoMessage() {}
 
-func (x *CloseSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *UnsubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3185,28 +3520,61 @@ func (x *CloseSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*CloseSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use UnsubPayChUpdatesResp.ProtoReflect.Descriptor instead.
+func (*UnsubPayChUpdatesResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CloseSessionResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
-	if x != nil {
-		return x.OpenPayChsInfo
+func (m *UnsubPayChUpdatesResp) GetResponse() isUnsubPayChUpdatesResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type DeployAssetERC20Resp_MsgSuccess struct {
+func (x *UnsubPayChUpdatesResp) GetMsgSuccess() *UnsubPayChUpdatesResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *UnsubPayChUpdatesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUnsubPayChUpdatesResp_Response interface {
+	isUnsubPayChUpdatesResp_Response()
+}
+
+type UnsubPayChUpdatesResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPayChUpdatesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type UnsubPayChUpdatesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UnsubPayChUpdatesResp_MsgSuccess_) isUnsubPayChUpdatesResp_Response() {}
+
+func (*UnsubPayChUpdatesResp_Error) isUnsubPayChUpdatesResp_Response() {}
+
+type RespondPayChUpdateReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetAddr string `protobuf:"bytes,2,opt,name=AssetAddr,proto3" json:"AssetAddr,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	UpdateID  string `protobuf:"bytes,3,opt,name=updateID,proto3" json:"updateID,omitempty"`
+	Accept    bool   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-func (x *DeployAssetERC20Resp_MsgSuccess) Reset() {
-	*x = DeployAssetERC20Resp_MsgSuccess{}
+func (x *RespondPayChUpdateReq) Reset() {
+	*x = RespondPayChUpdateReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3214,13 +3582,13 @@ func (x *DeployAssetERC20Resp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *DeployAssetERC20Resp_MsgSuccess) String() string {
+func (x *RespondPayChUpdateReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployAssetERC20Resp_MsgSuccess) ProtoMessage() {}
+func (*RespondPayChUpdateReq) ProtoMessage() {}
 
-func (x *DeployAssetERC20Resp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *RespondPayChUpdateReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3232,28 +3600,53 @@ func (x *DeployAssetERC20Resp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployAssetERC20Resp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*DeployAssetERC20Resp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use RespondPayChUpdateReq.ProtoReflect.Descriptor instead.
+func (*RespondPayChUpdateReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeployAssetERC20Resp_MsgSuccess) GetAssetAddr() string {
+func (x *RespondPayChUpdateReq) GetSessionID() string {
 	if x != nil {
-		return x.AssetAddr
+		return x.SessionID
 	}
 	return ""
 }
 
-type SendPayChUpdateResp_MsgSuccess struct {
+func (x *RespondPayChUpdateReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *RespondPayChUpdateReq) GetUpdateID() string {
+	if x != nil {
+		return x.UpdateID
+	}
+	return ""
+}
+
+func (x *RespondPayChUpdateReq) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondPayChUpdateResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*RespondPayChUpdateResp_MsgSuccess_
+	//	*RespondPayChUpdateResp_Error
+	Response isRespondPayChUpdateResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SendPayChUpdateResp_MsgSuccess) Reset() {
-	*x = SendPayChUpdateResp_MsgSuccess{}
+func (x *RespondPayChUpdateResp) Reset() {
+	*x = RespondPayChUpdateResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3261,13 +3654,13 @@ func (x *SendPayChUpdateResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *SendPayChUpdateResp_MsgSuccess) String() string {
+func (x *RespondPayChUpdateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPayChUpdateResp_MsgSuccess) ProtoMessage() {}
+func (*RespondPayChUpdateResp) ProtoMessage() {}
 
-func (x *SendPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *RespondPayChUpdateResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3279,32 +3672,59 @@ func (x *SendPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*SendPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use RespondPayChUpdateResp.ProtoReflect.Descriptor instead.
+func (*RespondPayChUpdateResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SendPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
-	if x != nil {
-		return x.UpdatedPayChInfo
+func (m *RespondPayChUpdateResp) GetResponse() isRespondPayChUpdateResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type SubPayChUpdatesResp_Notify struct {
+func (x *RespondPayChUpdateResp) GetMsgSuccess() *RespondPayChUpdateResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *RespondPayChUpdateResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRespondPayChUpdateResp_Response interface {
+	isRespondPayChUpdateResp_Response()
+}
+
+type RespondPayChUpdateResp_MsgSuccess_ struct {
+	MsgSuccess *RespondPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type RespondPayChUpdateResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RespondPayChUpdateResp_MsgSuccess_) isRespondPayChUpdateResp_Response() {}
+
+func (*RespondPayChUpdateResp_Error) isRespondPayChUpdateResp_Response() {}
+
+type GetPayChInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpdateID          string                                  `protobuf:"bytes,1,opt,name=updateID,proto3" json:"updateID,omitempty"`
-	ProposedPayChInfo *PayChInfo                              `protobuf:"bytes,2,opt,name=proposedPayChInfo,proto3" json:"proposedPayChInfo,omitempty"`
-	Type              SubPayChUpdatesResp_Notify_ChUpdateType `protobuf:"varint,3,opt,name=Type,proto3,enum=pb.SubPayChUpdatesResp_Notify_ChUpdateType" json:"Type,omitempty"`
-	Expiry            int64                                   `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	Error             *MsgError                               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *SubPayChUpdatesResp_Notify) Reset() {
-	*x = SubPayChUpdatesResp_Notify{}
+func (x *GetPayChInfoReq) Reset() {
+	*x = GetPayChInfoReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3312,13 +3732,13 @@ func (x *SubPayChUpdatesResp_Notify) Reset() {
 	}
 }
 
-func (x *SubPayChUpdatesResp_Notify) String() string {
+func (x *GetPayChInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChUpdatesResp_Notify) ProtoMessage() {}
+func (*GetPayChInfoReq) ProtoMessage() {}
 
-func (x *SubPayChUpdatesResp_Notify) ProtoReflect() protoreflect.Message {
+func (x *GetPayChInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3330,56 +3750,39 @@ func (x *SubPayChUpdatesResp_Notify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChUpdatesResp_Notify.ProtoReflect.Descriptor instead.
-func (*SubPayChUpdatesResp_Notify) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use GetPayChInfoReq.ProtoReflect.Descriptor instead.
+func (*GetPayChInfoReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SubPayChUpdatesResp_Notify) GetUpdateID() string {
+func (x *GetPayChInfoReq) GetSessionID() string {
 	if x != nil {
-		return x.UpdateID
+		return x.SessionID
 	}
 	return ""
 }
 
-func (x *SubPayChUpdatesResp_Notify) GetProposedPayChInfo() *PayChInfo {
-	if x != nil {
-		return x.ProposedPayChInfo
-	}
-	return nil
-}
-
-func (x *SubPayChUpdatesResp_Notify) GetType() SubPayChUpdatesResp_Notify_ChUpdateType {
-	if x != nil {
-		return x.Type
-	}
-	return SubPayChUpdatesResp_Notify_open
-}
-
-func (x *SubPayChUpdatesResp_Notify) GetExpiry() int64 {
-	if x != nil {
-		return x.Expiry
-	}
-	return 0
-}
-
-func (x *SubPayChUpdatesResp_Notify) GetError() *MsgError {
+func (x *GetPayChInfoReq) GetChID() string {
 	if x != nil {
-		return x.Error
+		return x.ChID
 	}
-	return nil
+	return ""
 }
 
-type UnsubPayChUpdatesResp_MsgSuccess struct {
+type GetPayChInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*GetPayChInfoResp_MsgSuccess_
+	//	*GetPayChInfoResp_Error
+	Response isGetPayChInfoResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) Reset() {
-	*x = UnsubPayChUpdatesResp_MsgSuccess{}
+func (x *GetPayChInfoResp) Reset() {
+	*x = GetPayChInfoResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3387,13 +3790,13 @@ func (x *UnsubPayChUpdatesResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) String() string {
+func (x *GetPayChInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChUpdatesResp_MsgSuccess) ProtoMessage() {}
+func (*GetPayChInfoResp) ProtoMessage() {}
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *GetPayChInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3405,28 +3808,61 @@ func (x *UnsubPayChUpdatesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChUpdatesResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*UnsubPayChUpdatesResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{33, 0}
+// Deprecated: Use GetPayChInfoResp.ProtoReflect.Descriptor instead.
+func (*GetPayChInfoResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+func (m *GetPayChInfoResp) GetResponse() isGetPayChInfoResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
 }
 
-type RespondPayChUpdateResp_MsgSuccess struct {
+func (x *GetPayChInfoResp) GetMsgSuccess() *GetPayChInfoResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPayChInfoResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetPayChInfoResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPayChInfoResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetPayChInfoResp_Response interface {
+	isGetPayChInfoResp_Response()
+}
+
+type GetPayChInfoResp_MsgSuccess_ struct {
+	MsgSuccess *GetPayChInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetPayChInfoResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetPayChInfoResp_MsgSuccess_) isGetPayChInfoResp_Response() {}
+
+func (*GetPayChInfoResp_Error) isGetPayChInfoResp_Response() {}
+
+type GetPayChHistoryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+	SessionID   string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID        string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	FromVersion uint64 `protobuf:"varint,3,opt,name=fromVersion,proto3" json:"fromVersion,omitempty"`
+	Limit       uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *RespondPayChUpdateResp_MsgSuccess) Reset() {
-	*x = RespondPayChUpdateResp_MsgSuccess{}
+func (x *GetPayChHistoryReq) Reset() {
+	*x = GetPayChHistoryReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3434,13 +3870,13 @@ func (x *RespondPayChUpdateResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *RespondPayChUpdateResp_MsgSuccess) String() string {
+func (x *GetPayChHistoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChUpdateResp_MsgSuccess) ProtoMessage() {}
+func (*GetPayChHistoryReq) ProtoMessage() {}
 
-func (x *RespondPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *GetPayChHistoryReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3452,28 +3888,53 @@ func (x *RespondPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*RespondPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use GetPayChHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetPayChHistoryReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *RespondPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+func (x *GetPayChHistoryReq) GetSessionID() string {
 	if x != nil {
-		return x.UpdatedPayChInfo
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-type GetPayChInfoResp_MsgSuccess struct {
+func (x *GetPayChHistoryReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *GetPayChHistoryReq) GetFromVersion() uint64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *GetPayChHistoryReq) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPayChHistoryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=payChInfo,proto3" json:"payChInfo,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*GetPayChHistoryResp_MsgSuccess_
+	//	*GetPayChHistoryResp_Error
+	Response isGetPayChHistoryResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetPayChInfoResp_MsgSuccess) Reset() {
-	*x = GetPayChInfoResp_MsgSuccess{}
+func (x *GetPayChHistoryResp) Reset() {
+	*x = GetPayChHistoryResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3481,13 +3942,13 @@ func (x *GetPayChInfoResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *GetPayChInfoResp_MsgSuccess) String() string {
+func (x *GetPayChHistoryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChInfoResp_MsgSuccess) ProtoMessage() {}
+func (*GetPayChHistoryResp) ProtoMessage() {}
 
-func (x *GetPayChInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *GetPayChHistoryResp) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3499,28 +3960,59 @@ func (x *GetPayChInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*GetPayChInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{37, 0}
+// Deprecated: Use GetPayChHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetPayChHistoryResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetPayChInfoResp_MsgSuccess) GetPayChInfo() *PayChInfo {
-	if x != nil {
-		return x.PayChInfo
+func (m *GetPayChHistoryResp) GetResponse() isGetPayChHistoryResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type ClosePayChResp_MsgSuccess struct {
+func (x *GetPayChHistoryResp) GetMsgSuccess() *GetPayChHistoryResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPayChHistoryResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetPayChHistoryResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPayChHistoryResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetPayChHistoryResp_Response interface {
+	isGetPayChHistoryResp_Response()
+}
+
+type GetPayChHistoryResp_MsgSuccess_ struct {
+	MsgSuccess *GetPayChHistoryResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetPayChHistoryResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetPayChHistoryResp_MsgSuccess_) isGetPayChHistoryResp_Response() {}
+
+func (*GetPayChHistoryResp_Error) isGetPayChHistoryResp_Response() {}
+
+type ClosePayChReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClosedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=closedPayChInfo,proto3" json:"closedPayChInfo,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *ClosePayChResp_MsgSuccess) Reset() {
-	*x = ClosePayChResp_MsgSuccess{}
+func (x *ClosePayChReq) Reset() {
+	*x = ClosePayChReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_service_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3528,13 +4020,13 @@ func (x *ClosePayChResp_MsgSuccess) Reset() {
 	}
 }
 
-func (x *ClosePayChResp_MsgSuccess) String() string {
+func (x *ClosePayChReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClosePayChResp_MsgSuccess) ProtoMessage() {}
+func (*ClosePayChReq) ProtoMessage() {}
 
-func (x *ClosePayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+func (x *ClosePayChReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_service_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3546,634 +4038,5841 @@ func (x *ClosePayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClosePayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*ClosePayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use ClosePayChReq.ProtoReflect.Descriptor instead.
+func (*ClosePayChReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ClosePayChResp_MsgSuccess) GetClosedPayChInfo() *PayChInfo {
+func (x *ClosePayChReq) GetSessionID() string {
 	if x != nil {
-		return x.ClosedPayChInfo
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ClosePayChReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type ClosePayChResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ClosePayChResp_MsgSuccess_
+	//	*ClosePayChResp_Error
+	Response isClosePayChResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *ClosePayChResp) Reset() {
+	*x = ClosePayChResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePayChResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePayChResp) ProtoMessage() {}
+
+func (x *ClosePayChResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePayChResp.ProtoReflect.Descriptor instead.
+func (*ClosePayChResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (m *ClosePayChResp) GetResponse() isClosePayChResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-var File_payment_service_proto protoreflect.FileDescriptor
+func (x *ClosePayChResp) GetMsgSuccess() *ClosePayChResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ClosePayChResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
 
-var file_payment_service_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0f, 0x6e, 0x6f, 0x64,
-	0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x54, 0x48, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x54, 0x48, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
-	0x69, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65,
-	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a,
-	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x5b, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x68, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x68,
-	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09, 0x0a,
-	0x07, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x22, 0x1e, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0xb5, 0x01, 0x0a, 0x14,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
-	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x1a, 0x24, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x22, 0x1e,
-	0x0a, 0x08, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70,
-	0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x70, 0x69, 0x73, 0x22, 0x50,
-	0x0a, 0x0c, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06,
-	0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50,
-	0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x30, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x44, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
-	0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44,
-	0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45,
-	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f,
-	0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48,
-	0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61,
-	0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa9, 0x02, 0x0a, 0x15, 0x53,
-	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xa1, 0x01, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
-	0x44, 0x12, 0x33, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42,
-	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42,
-	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65,
-	0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x16, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xbd,
-	0x01, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0a, 0x6d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f,
-	0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22,
-	0xde, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a,
-	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d,
-	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a,
-	0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f,
-	0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x45, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a,
-	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
-	0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70,
-	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
-	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
-	0x2a, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12,
-	0x27, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e,
-	0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61,
-	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x46, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x70, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0x93, 0x03, 0x0a, 0x13, 0x53, 0x75,
-	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x38, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x1a, 0x8f, 0x02, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x2e, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x0c, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65,
-	0x64, 0x10, 0x02, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x48, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x55, 0x6e,
-	0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73,
-	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
-	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
-	0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
-	0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x47, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
-	0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47,
-	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61,
-	0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a,
-	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a,
-	0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44,
-	0x22, 0xca, 0x01, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73,
-	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x82, 0x0a,
-	0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x41, 0x50, 0x49, 0x12, 0x32, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x38, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x04, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x47, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x04, 0x48, 0x65, 0x6c,
-	0x70, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32,
-	0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e,
-	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61,
-	0x79, 0x43, 0x68, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x53, 0x75,
-	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12,
-	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75,
-	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12,
-	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
-	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a,
-	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x3b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x10,
-	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30,
-	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x53,
-	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x70, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
-	0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x4d, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *ClosePayChResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ClosePayChResp_Error); ok {
+		return x.Error
+	}
+	return nil
 }
 
-var (
-	file_payment_service_proto_rawDescOnce sync.Once
-	file_payment_service_proto_rawDescData = file_payment_service_proto_rawDesc
-)
+type isClosePayChResp_Response interface {
+	isClosePayChResp_Response()
+}
 
-func file_payment_service_proto_rawDescGZIP() []byte {
-	file_payment_service_proto_rawDescOnce.Do(func() {
-		file_payment_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_payment_service_proto_rawDescData)
-	})
-	return file_payment_service_proto_rawDescData
+type ClosePayChResp_MsgSuccess_ struct {
+	MsgSuccess *ClosePayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-var file_payment_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
-var file_payment_service_proto_goTypes = []interface{}{
-	(SubPayChUpdatesResp_Notify_ChUpdateType)(0), // 0: pb.SubPayChUpdatesResp.Notify.ChUpdateType
-	(*GetConfigReq)(nil),                         // 1: pb.GetConfigReq
-	(*GetConfigResp)(nil),                        // 2: pb.GetConfigResp
-	(*OpenSessionReq)(nil),                       // 3: pb.OpenSessionReq
-	(*OpenSessionResp)(nil),                      // 4: pb.OpenSessionResp
-	(*TimeReq)(nil),                              // 5: pb.TimeReq
-	(*TimeResp)(nil),                             // 6: pb.TimeResp
-	(*RegisterCurrencyReq)(nil),                  // 7: pb.RegisterCurrencyReq
-	(*RegisterCurrencyResp)(nil),                 // 8: pb.RegisterCurrencyResp
-	(*HelpReq)(nil),                              // 9: pb.HelpReq
-	(*HelpResp)(nil),                             // 10: pb.HelpResp
-	(*AddPeerIDReq)(nil),                         // 11: pb.AddPeerIDReq
-	(*AddPeerIDResp)(nil),                        // 12: pb.AddPeerIDResp
-	(*GetPeerIDReq)(nil),                         // 13: pb.GetPeerIDReq
-	(*GetPeerIDResp)(nil),                        // 14: pb.GetPeerIDResp
-	(*OpenPayChReq)(nil),                         // 15: pb.OpenPayChReq
-	(*OpenPayChResp)(nil),                        // 16: pb.OpenPayChResp
-	(*GetPayChsInfoReq)(nil),                     // 17: pb.GetPayChsInfoReq
-	(*GetPayChsInfoResp)(nil),                    // 18: pb.GetPayChsInfoResp
-	(*SubPayChProposalsReq)(nil),                 // 19: pb.SubPayChProposalsReq
-	(*SubPayChProposalsResp)(nil),                // 20: pb.SubPayChProposalsResp
-	(*UnsubPayChProposalsReq)(nil),               // 21: pb.UnsubPayChProposalsReq
-	(*UnsubPayChProposalsResp)(nil),              // 22: pb.UnsubPayChProposalsResp
-	(*RespondPayChProposalReq)(nil),              // 23: pb.RespondPayChProposalReq
-	(*RespondPayChProposalResp)(nil),             // 24: pb.RespondPayChProposalResp
-	(*CloseSessionReq)(nil),                      // 25: pb.CloseSessionReq
-	(*CloseSessionResp)(nil),                     // 26: pb.CloseSessionResp
-	(*DeployAssetERC20Req)(nil),                  // 27: pb.DeployAssetERC20Req
-	(*DeployAssetERC20Resp)(nil),                 // 28: pb.DeployAssetERC20Resp
-	(*SendPayChUpdateReq)(nil),                   // 29: pb.SendPayChUpdateReq
-	(*SendPayChUpdateResp)(nil),                  // 30: pb.SendPayChUpdateResp
-	(*SubpayChUpdatesReq)(nil),                   // 31: pb.SubpayChUpdatesReq
-	(*SubPayChUpdatesResp)(nil),                  // 32: pb.SubPayChUpdatesResp
-	(*UnsubPayChUpdatesReq)(nil),                 // 33: pb.UnsubPayChUpdatesReq
-	(*UnsubPayChUpdatesResp)(nil),                // 34: pb.UnsubPayChUpdatesResp
-	(*RespondPayChUpdateReq)(nil),                // 35: pb.RespondPayChUpdateReq
-	(*RespondPayChUpdateResp)(nil),               // 36: pb.RespondPayChUpdateResp
-	(*GetPayChInfoReq)(nil),                      // 37: pb.GetPayChInfoReq
-	(*GetPayChInfoResp)(nil),                     // 38: pb.GetPayChInfoResp
-	(*ClosePayChReq)(nil),                        // 39: pb.ClosePayChReq
-	(*ClosePayChResp)(nil),                       // 40: pb.ClosePayChResp
-	(*OpenSessionResp_MsgSuccess)(nil),           // 41: pb.OpenSessionResp.MsgSuccess
-	(*RegisterCurrencyResp_MsgSuccess)(nil),      // 42: pb.RegisterCurrencyResp.MsgSuccess
-	(*AddPeerIDResp_MsgSuccess)(nil),             // 43: pb.AddPeerIDResp.MsgSuccess
-	(*GetPeerIDResp_MsgSuccess)(nil),             // 44: pb.GetPeerIDResp.MsgSuccess
-	(*OpenPayChResp_MsgSuccess)(nil),             // 45: pb.OpenPayChResp.MsgSuccess
-	(*GetPayChsInfoResp_MsgSuccess)(nil),         // 46: pb.GetPayChsInfoResp.MsgSuccess
-	(*SubPayChProposalsResp_Notify)(nil),         // 47: pb.SubPayChProposalsResp.Notify
-	(*UnsubPayChProposalsResp_MsgSuccess)(nil),   // 48: pb.UnsubPayChProposalsResp.MsgSuccess
-	(*RespondPayChProposalResp_MsgSuccess)(nil),  // 49: pb.RespondPayChProposalResp.MsgSuccess
-	(*CloseSessionResp_MsgSuccess)(nil),          // 50: pb.CloseSessionResp.MsgSuccess
-	(*DeployAssetERC20Resp_MsgSuccess)(nil),      // 51: pb.DeployAssetERC20Resp.MsgSuccess
-	(*SendPayChUpdateResp_MsgSuccess)(nil),       // 52: pb.SendPayChUpdateResp.MsgSuccess
-	(*SubPayChUpdatesResp_Notify)(nil),           // 53: pb.SubPayChUpdatesResp.Notify
-	(*UnsubPayChUpdatesResp_MsgSuccess)(nil),     // 54: pb.UnsubPayChUpdatesResp.MsgSuccess
-	(*RespondPayChUpdateResp_MsgSuccess)(nil),    // 55: pb.RespondPayChUpdateResp.MsgSuccess
-	(*GetPayChInfoResp_MsgSuccess)(nil),          // 56: pb.GetPayChInfoResp.MsgSuccess
-	(*ClosePayChResp_MsgSuccess)(nil),            // 57: pb.ClosePayChResp.MsgSuccess
-	(*MsgError)(nil),                             // 58: pb.MsgError
-	(*PeerID)(nil),                               // 59: pb.PeerID
-	(*BalInfo)(nil),                              // 60: pb.BalInfo
-	(*Payment)(nil),                              // 61: pb.Payment
-	(*PayChInfo)(nil),                            // 62: pb.PayChInfo
+type ClosePayChResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
-var file_payment_service_proto_depIdxs = []int32{
-	41, // 0: pb.OpenSessionResp.msgSuccess:type_name -> pb.OpenSessionResp.MsgSuccess
-	58, // 1: pb.OpenSessionResp.error:type_name -> pb.MsgError
-	42, // 2: pb.RegisterCurrencyResp.msgSuccess:type_name -> pb.RegisterCurrencyResp.MsgSuccess
-	58, // 3: pb.RegisterCurrencyResp.error:type_name -> pb.MsgError
-	59, // 4: pb.AddPeerIDReq.peerID:type_name -> pb.PeerID
-	43, // 5: pb.AddPeerIDResp.msgSuccess:type_name -> pb.AddPeerIDResp.MsgSuccess
-	58, // 6: pb.AddPeerIDResp.error:type_name -> pb.MsgError
-	44, // 7: pb.GetPeerIDResp.msgSuccess:type_name -> pb.GetPeerIDResp.MsgSuccess
-	58, // 8: pb.GetPeerIDResp.error:type_name -> pb.MsgError
-	60, // 9: pb.OpenPayChReq.openingBalInfo:type_name -> pb.BalInfo
-	45, // 10: pb.OpenPayChResp.msgSuccess:type_name -> pb.OpenPayChResp.MsgSuccess
-	58, // 11: pb.OpenPayChResp.error:type_name -> pb.MsgError
-	46, // 12: pb.GetPayChsInfoResp.msgSuccess:type_name -> pb.GetPayChsInfoResp.MsgSuccess
-	58, // 13: pb.GetPayChsInfoResp.error:type_name -> pb.MsgError
-	47, // 14: pb.SubPayChProposalsResp.notify:type_name -> pb.SubPayChProposalsResp.Notify
-	58, // 15: pb.SubPayChProposalsResp.error:type_name -> pb.MsgError
-	48, // 16: pb.UnsubPayChProposalsResp.msgSuccess:type_name -> pb.UnsubPayChProposalsResp.MsgSuccess
-	58, // 17: pb.UnsubPayChProposalsResp.error:type_name -> pb.MsgError
-	49, // 18: pb.RespondPayChProposalResp.msgSuccess:type_name -> pb.RespondPayChProposalResp.MsgSuccess
-	58, // 19: pb.RespondPayChProposalResp.error:type_name -> pb.MsgError
-	50, // 20: pb.CloseSessionResp.msgSuccess:type_name -> pb.CloseSessionResp.MsgSuccess
-	58, // 21: pb.CloseSessionResp.error:type_name -> pb.MsgError
-	51, // 22: pb.DeployAssetERC20Resp.msgSuccess:type_name -> pb.DeployAssetERC20Resp.MsgSuccess
-	58, // 23: pb.DeployAssetERC20Resp.error:type_name -> pb.MsgError
-	61, // 24: pb.SendPayChUpdateReq.payments:type_name -> pb.Payment
-	52, // 25: pb.SendPayChUpdateResp.msgSuccess:type_name -> pb.SendPayChUpdateResp.MsgSuccess
-	58, // 26: pb.SendPayChUpdateResp.error:type_name -> pb.MsgError
-	53, // 27: pb.SubPayChUpdatesResp.notify:type_name -> pb.SubPayChUpdatesResp.Notify
-	58, // 28: pb.SubPayChUpdatesResp.error:type_name -> pb.MsgError
-	54, // 29: pb.UnsubPayChUpdatesResp.msgSuccess:type_name -> pb.UnsubPayChUpdatesResp.MsgSuccess
-	58, // 30: pb.UnsubPayChUpdatesResp.error:type_name -> pb.MsgError
-	55, // 31: pb.RespondPayChUpdateResp.msgSuccess:type_name -> pb.RespondPayChUpdateResp.MsgSuccess
-	58, // 32: pb.RespondPayChUpdateResp.error:type_name -> pb.MsgError
-	56, // 33: pb.GetPayChInfoResp.msgSuccess:type_name -> pb.GetPayChInfoResp.MsgSuccess
-	58, // 34: pb.GetPayChInfoResp.error:type_name -> pb.MsgError
-	57, // 35: pb.ClosePayChResp.msgSuccess:type_name -> pb.ClosePayChResp.MsgSuccess
-	58, // 36: pb.ClosePayChResp.error:type_name -> pb.MsgError
-	62, // 37: pb.OpenSessionResp.MsgSuccess.restoredChs:type_name -> pb.PayChInfo
-	59, // 38: pb.GetPeerIDResp.MsgSuccess.peerID:type_name -> pb.PeerID
-	62, // 39: pb.OpenPayChResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
-	62, // 40: pb.GetPayChsInfoResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
-	60, // 41: pb.SubPayChProposalsResp.Notify.openingBalInfo:type_name -> pb.BalInfo
-	62, // 42: pb.RespondPayChProposalResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
-	62, // 43: pb.CloseSessionResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
-	62, // 44: pb.SendPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
-	62, // 45: pb.SubPayChUpdatesResp.Notify.proposedPayChInfo:type_name -> pb.PayChInfo
-	0,  // 46: pb.SubPayChUpdatesResp.Notify.Type:type_name -> pb.SubPayChUpdatesResp.Notify.ChUpdateType
-	58, // 47: pb.SubPayChUpdatesResp.Notify.error:type_name -> pb.MsgError
-	62, // 48: pb.RespondPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
-	62, // 49: pb.GetPayChInfoResp.MsgSuccess.payChInfo:type_name -> pb.PayChInfo
-	62, // 50: pb.ClosePayChResp.MsgSuccess.closedPayChInfo:type_name -> pb.PayChInfo
-	1,  // 51: pb.Payment_API.GetConfig:input_type -> pb.GetConfigReq
-	3,  // 52: pb.Payment_API.OpenSession:input_type -> pb.OpenSessionReq
-	5,  // 53: pb.Payment_API.Time:input_type -> pb.TimeReq
-	7,  // 54: pb.Payment_API.RegisterCurrency:input_type -> pb.RegisterCurrencyReq
-	9,  // 55: pb.Payment_API.Help:input_type -> pb.HelpReq
-	11, // 56: pb.Payment_API.AddPeerID:input_type -> pb.AddPeerIDReq
-	13, // 57: pb.Payment_API.GetPeerID:input_type -> pb.GetPeerIDReq
-	15, // 58: pb.Payment_API.OpenPayCh:input_type -> pb.OpenPayChReq
-	17, // 59: pb.Payment_API.GetPayChsInfo:input_type -> pb.GetPayChsInfoReq
-	19, // 60: pb.Payment_API.SubPayChProposals:input_type -> pb.SubPayChProposalsReq
-	21, // 61: pb.Payment_API.UnsubPayChProposals:input_type -> pb.UnsubPayChProposalsReq
-	23, // 62: pb.Payment_API.RespondPayChProposal:input_type -> pb.RespondPayChProposalReq
-	25, // 63: pb.Payment_API.CloseSession:input_type -> pb.CloseSessionReq
-	27, // 64: pb.Payment_API.DeployAssetERC20:input_type -> pb.DeployAssetERC20Req
-	29, // 65: pb.Payment_API.SendPayChUpdate:input_type -> pb.SendPayChUpdateReq
-	31, // 66: pb.Payment_API.SubPayChUpdates:input_type -> pb.SubpayChUpdatesReq
-	33, // 67: pb.Payment_API.UnsubPayChUpdates:input_type -> pb.UnsubPayChUpdatesReq
-	35, // 68: pb.Payment_API.RespondPayChUpdate:input_type -> pb.RespondPayChUpdateReq
-	37, // 69: pb.Payment_API.GetPayChInfo:input_type -> pb.GetPayChInfoReq
-	39, // 70: pb.Payment_API.ClosePayCh:input_type -> pb.ClosePayChReq
-	2,  // 71: pb.Payment_API.GetConfig:output_type -> pb.GetConfigResp
-	4,  // 72: pb.Payment_API.OpenSession:output_type -> pb.OpenSessionResp
-	6,  // 73: pb.Payment_API.Time:output_type -> pb.TimeResp
-	8,  // 74: pb.Payment_API.RegisterCurrency:output_type -> pb.RegisterCurrencyResp
-	10, // 75: pb.Payment_API.Help:output_type -> pb.HelpResp
-	12, // 76: pb.Payment_API.AddPeerID:output_type -> pb.AddPeerIDResp
-	14, // 77: pb.Payment_API.GetPeerID:output_type -> pb.GetPeerIDResp
-	16, // 78: pb.Payment_API.OpenPayCh:output_type -> pb.OpenPayChResp
-	18, // 79: pb.Payment_API.GetPayChsInfo:output_type -> pb.GetPayChsInfoResp
-	20, // 80: pb.Payment_API.SubPayChProposals:output_type -> pb.SubPayChProposalsResp
-	22, // 81: pb.Payment_API.UnsubPayChProposals:output_type -> pb.UnsubPayChProposalsResp
-	24, // 82: pb.Payment_API.RespondPayChProposal:output_type -> pb.RespondPayChProposalResp
-	26, // 83: pb.Payment_API.CloseSession:output_type -> pb.CloseSessionResp
-	28, // 84: pb.Payment_API.DeployAssetERC20:output_type -> pb.DeployAssetERC20Resp
-	30, // 85: pb.Payment_API.SendPayChUpdate:output_type -> pb.SendPayChUpdateResp
-	32, // 86: pb.Payment_API.SubPayChUpdates:output_type -> pb.SubPayChUpdatesResp
-	34, // 87: pb.Payment_API.UnsubPayChUpdates:output_type -> pb.UnsubPayChUpdatesResp
-	36, // 88: pb.Payment_API.RespondPayChUpdate:output_type -> pb.RespondPayChUpdateResp
-	38, // 89: pb.Payment_API.GetPayChInfo:output_type -> pb.GetPayChInfoResp
-	40, // 90: pb.Payment_API.ClosePayCh:output_type -> pb.ClosePayChResp
-	71, // [71:91] is the sub-list for method output_type
-	51, // [51:71] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+
+func (*ClosePayChResp_MsgSuccess_) isClosePayChResp_Response() {}
+
+func (*ClosePayChResp_Error) isClosePayChResp_Response() {}
+
+// PaymentStreamStatus represents the status of a payment stream. All amounts
+// are in the units of the currency of the stream. Error is set only when the
+// state is failed.
+type PaymentStreamStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID          string                    `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	ChID              string                    `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Payee             string                    `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	Currency          string                    `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	AmountPerInterval string                    `protobuf:"bytes,5,opt,name=amountPerInterval,proto3" json:"amountPerInterval,omitempty"`
+	IntervalMillis    uint64                    `protobuf:"varint,6,opt,name=intervalMillis,proto3" json:"intervalMillis,omitempty"`
+	Cap               string                    `protobuf:"bytes,7,opt,name=cap,proto3" json:"cap,omitempty"`
+	State             PaymentStreamStatus_State `protobuf:"varint,8,opt,name=state,proto3,enum=pb.PaymentStreamStatus_State" json:"state,omitempty"`
+	Paid              string                    `protobuf:"bytes,9,opt,name=paid,proto3" json:"paid,omitempty"`
+	Ticks             uint64                    `protobuf:"varint,10,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Updates           uint64                    `protobuf:"varint,11,opt,name=updates,proto3" json:"updates,omitempty"`
+	Error             *MsgError                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PaymentStreamStatus) Reset() {
+	*x = PaymentStreamStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_payment_service_proto_init() }
-func file_payment_service_proto_init() {
-	if File_payment_service_proto != nil {
-		return
+func (x *PaymentStreamStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentStreamStatus) ProtoMessage() {}
+
+func (x *PaymentStreamStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	file_nodetypes_proto_init()
-	file_errors_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_payment_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigReq); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentStreamStatus.ProtoReflect.Descriptor instead.
+func (*PaymentStreamStatus) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PaymentStreamStatus) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *PaymentStreamStatus) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *PaymentStreamStatus) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *PaymentStreamStatus) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentStreamStatus) GetAmountPerInterval() string {
+	if x != nil {
+		return x.AmountPerInterval
+	}
+	return ""
+}
+
+func (x *PaymentStreamStatus) GetIntervalMillis() uint64 {
+	if x != nil {
+		return x.IntervalMillis
+	}
+	return 0
+}
+
+func (x *PaymentStreamStatus) GetCap() string {
+	if x != nil {
+		return x.Cap
+	}
+	return ""
+}
+
+func (x *PaymentStreamStatus) GetState() PaymentStreamStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return PaymentStreamStatus_running
+}
+
+func (x *PaymentStreamStatus) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *PaymentStreamStatus) GetTicks() uint64 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
+}
+
+func (x *PaymentStreamStatus) GetUpdates() uint64 {
+	if x != nil {
+		return x.Updates
+	}
+	return 0
+}
+
+func (x *PaymentStreamStatus) GetError() *MsgError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type StartPaymentStreamReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID         string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID              string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Payee             string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	Currency          string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	AmountPerInterval string `protobuf:"bytes,5,opt,name=amountPerInterval,proto3" json:"amountPerInterval,omitempty"`
+	IntervalMillis    uint64 `protobuf:"varint,6,opt,name=intervalMillis,proto3" json:"intervalMillis,omitempty"`
+	Cap               string `protobuf:"bytes,7,opt,name=cap,proto3" json:"cap,omitempty"`
+}
+
+func (x *StartPaymentStreamReq) Reset() {
+	*x = StartPaymentStreamReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPaymentStreamReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPaymentStreamReq) ProtoMessage() {}
+
+func (x *StartPaymentStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPaymentStreamReq.ProtoReflect.Descriptor instead.
+func (*StartPaymentStreamReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *StartPaymentStreamReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *StartPaymentStreamReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *StartPaymentStreamReq) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *StartPaymentStreamReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *StartPaymentStreamReq) GetAmountPerInterval() string {
+	if x != nil {
+		return x.AmountPerInterval
+	}
+	return ""
+}
+
+func (x *StartPaymentStreamReq) GetIntervalMillis() uint64 {
+	if x != nil {
+		return x.IntervalMillis
+	}
+	return 0
+}
+
+func (x *StartPaymentStreamReq) GetCap() string {
+	if x != nil {
+		return x.Cap
+	}
+	return ""
+}
+
+type StartPaymentStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*StartPaymentStreamResp_MsgSuccess_
+	//	*StartPaymentStreamResp_Error
+	Response isStartPaymentStreamResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *StartPaymentStreamResp) Reset() {
+	*x = StartPaymentStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPaymentStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPaymentStreamResp) ProtoMessage() {}
+
+func (x *StartPaymentStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPaymentStreamResp.ProtoReflect.Descriptor instead.
+func (*StartPaymentStreamResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (m *StartPaymentStreamResp) GetResponse() isStartPaymentStreamResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *StartPaymentStreamResp) GetMsgSuccess() *StartPaymentStreamResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*StartPaymentStreamResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *StartPaymentStreamResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*StartPaymentStreamResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStartPaymentStreamResp_Response interface {
+	isStartPaymentStreamResp_Response()
+}
+
+type StartPaymentStreamResp_MsgSuccess_ struct {
+	MsgSuccess *StartPaymentStreamResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type StartPaymentStreamResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StartPaymentStreamResp_MsgSuccess_) isStartPaymentStreamResp_Response() {}
+
+func (*StartPaymentStreamResp_Error) isStartPaymentStreamResp_Response() {}
+
+type StopPaymentStreamReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	StreamID  string `protobuf:"bytes,2,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (x *StopPaymentStreamReq) Reset() {
+	*x = StopPaymentStreamReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPaymentStreamReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPaymentStreamReq) ProtoMessage() {}
+
+func (x *StopPaymentStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPaymentStreamReq.ProtoReflect.Descriptor instead.
+func (*StopPaymentStreamReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StopPaymentStreamReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *StopPaymentStreamReq) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+type StopPaymentStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*StopPaymentStreamResp_MsgSuccess_
+	//	*StopPaymentStreamResp_Error
+	Response isStopPaymentStreamResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *StopPaymentStreamResp) Reset() {
+	*x = StopPaymentStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPaymentStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPaymentStreamResp) ProtoMessage() {}
+
+func (x *StopPaymentStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPaymentStreamResp.ProtoReflect.Descriptor instead.
+func (*StopPaymentStreamResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (m *StopPaymentStreamResp) GetResponse() isStopPaymentStreamResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *StopPaymentStreamResp) GetMsgSuccess() *StopPaymentStreamResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*StopPaymentStreamResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *StopPaymentStreamResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*StopPaymentStreamResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStopPaymentStreamResp_Response interface {
+	isStopPaymentStreamResp_Response()
+}
+
+type StopPaymentStreamResp_MsgSuccess_ struct {
+	MsgSuccess *StopPaymentStreamResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type StopPaymentStreamResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StopPaymentStreamResp_MsgSuccess_) isStopPaymentStreamResp_Response() {}
+
+func (*StopPaymentStreamResp_Error) isStopPaymentStreamResp_Response() {}
+
+type SubPaymentStreamStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	StreamID  string `protobuf:"bytes,2,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (x *SubPaymentStreamStatusReq) Reset() {
+	*x = SubPaymentStreamStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPaymentStreamStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPaymentStreamStatusReq) ProtoMessage() {}
+
+func (x *SubPaymentStreamStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPaymentStreamStatusReq.ProtoReflect.Descriptor instead.
+func (*SubPaymentStreamStatusReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SubPaymentStreamStatusReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SubPaymentStreamStatusReq) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+type SubPaymentStreamStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*SubPaymentStreamStatusResp_Notify_
+	//	*SubPaymentStreamStatusResp_Error
+	Response isSubPaymentStreamStatusResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *SubPaymentStreamStatusResp) Reset() {
+	*x = SubPaymentStreamStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPaymentStreamStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPaymentStreamStatusResp) ProtoMessage() {}
+
+func (x *SubPaymentStreamStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPaymentStreamStatusResp.ProtoReflect.Descriptor instead.
+func (*SubPaymentStreamStatusResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (m *SubPaymentStreamStatusResp) GetResponse() isSubPaymentStreamStatusResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SubPaymentStreamStatusResp) GetNotify() *SubPaymentStreamStatusResp_Notify {
+	if x, ok := x.GetResponse().(*SubPaymentStreamStatusResp_Notify_); ok {
+		return x.Notify
+	}
+	return nil
+}
+
+func (x *SubPaymentStreamStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPaymentStreamStatusResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSubPaymentStreamStatusResp_Response interface {
+	isSubPaymentStreamStatusResp_Response()
+}
+
+type SubPaymentStreamStatusResp_Notify_ struct {
+	Notify *SubPaymentStreamStatusResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+}
+
+type SubPaymentStreamStatusResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SubPaymentStreamStatusResp_Notify_) isSubPaymentStreamStatusResp_Response() {}
+
+func (*SubPaymentStreamStatusResp_Error) isSubPaymentStreamStatusResp_Response() {}
+
+type UnsubPaymentStreamStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	StreamID  string `protobuf:"bytes,2,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (x *UnsubPaymentStreamStatusReq) Reset() {
+	*x = UnsubPaymentStreamStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPaymentStreamStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPaymentStreamStatusReq) ProtoMessage() {}
+
+func (x *UnsubPaymentStreamStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPaymentStreamStatusReq.ProtoReflect.Descriptor instead.
+func (*UnsubPaymentStreamStatusReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UnsubPaymentStreamStatusReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UnsubPaymentStreamStatusReq) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+type UnsubPaymentStreamStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*UnsubPaymentStreamStatusResp_MsgSuccess_
+	//	*UnsubPaymentStreamStatusResp_Error
+	Response isUnsubPaymentStreamStatusResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *UnsubPaymentStreamStatusResp) Reset() {
+	*x = UnsubPaymentStreamStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPaymentStreamStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPaymentStreamStatusResp) ProtoMessage() {}
+
+func (x *UnsubPaymentStreamStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPaymentStreamStatusResp.ProtoReflect.Descriptor instead.
+func (*UnsubPaymentStreamStatusResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (m *UnsubPaymentStreamStatusResp) GetResponse() isUnsubPaymentStreamStatusResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UnsubPaymentStreamStatusResp) GetMsgSuccess() *UnsubPaymentStreamStatusResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPaymentStreamStatusResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *UnsubPaymentStreamStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPaymentStreamStatusResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUnsubPaymentStreamStatusResp_Response interface {
+	isUnsubPaymentStreamStatusResp_Response()
+}
+
+type UnsubPaymentStreamStatusResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPaymentStreamStatusResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type UnsubPaymentStreamStatusResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UnsubPaymentStreamStatusResp_MsgSuccess_) isUnsubPaymentStreamStatusResp_Response() {}
+
+func (*UnsubPaymentStreamStatusResp_Error) isUnsubPaymentStreamStatusResp_Response() {}
+
+// CreatePaymentScheduleReq carries the schedule to be created. Only the
+// fields describing the payments and nextAt are used; the others are set
+// by the node.
+type CreatePaymentScheduleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string           `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Schedule  *PaymentSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *CreatePaymentScheduleReq) Reset() {
+	*x = CreatePaymentScheduleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePaymentScheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentScheduleReq) ProtoMessage() {}
+
+func (x *CreatePaymentScheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentScheduleReq.ProtoReflect.Descriptor instead.
+func (*CreatePaymentScheduleReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreatePaymentScheduleReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CreatePaymentScheduleReq) GetSchedule() *PaymentSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type CreatePaymentScheduleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CreatePaymentScheduleResp_MsgSuccess_
+	//	*CreatePaymentScheduleResp_Error
+	Response isCreatePaymentScheduleResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *CreatePaymentScheduleResp) Reset() {
+	*x = CreatePaymentScheduleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePaymentScheduleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentScheduleResp) ProtoMessage() {}
+
+func (x *CreatePaymentScheduleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentScheduleResp.ProtoReflect.Descriptor instead.
+func (*CreatePaymentScheduleResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (m *CreatePaymentScheduleResp) GetResponse() isCreatePaymentScheduleResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CreatePaymentScheduleResp) GetMsgSuccess() *CreatePaymentScheduleResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CreatePaymentScheduleResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CreatePaymentScheduleResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CreatePaymentScheduleResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreatePaymentScheduleResp_Response interface {
+	isCreatePaymentScheduleResp_Response()
+}
+
+type CreatePaymentScheduleResp_MsgSuccess_ struct {
+	MsgSuccess *CreatePaymentScheduleResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CreatePaymentScheduleResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CreatePaymentScheduleResp_MsgSuccess_) isCreatePaymentScheduleResp_Response() {}
+
+func (*CreatePaymentScheduleResp_Error) isCreatePaymentScheduleResp_Response() {}
+
+type ListPaymentSchedulesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *ListPaymentSchedulesReq) Reset() {
+	*x = ListPaymentSchedulesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentSchedulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentSchedulesReq) ProtoMessage() {}
+
+func (x *ListPaymentSchedulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentSchedulesReq.ProtoReflect.Descriptor instead.
+func (*ListPaymentSchedulesReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListPaymentSchedulesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type ListPaymentSchedulesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ListPaymentSchedulesResp_MsgSuccess_
+	//	*ListPaymentSchedulesResp_Error
+	Response isListPaymentSchedulesResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListPaymentSchedulesResp) Reset() {
+	*x = ListPaymentSchedulesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentSchedulesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentSchedulesResp) ProtoMessage() {}
+
+func (x *ListPaymentSchedulesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentSchedulesResp.ProtoReflect.Descriptor instead.
+func (*ListPaymentSchedulesResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (m *ListPaymentSchedulesResp) GetResponse() isListPaymentSchedulesResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListPaymentSchedulesResp) GetMsgSuccess() *ListPaymentSchedulesResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ListPaymentSchedulesResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *ListPaymentSchedulesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ListPaymentSchedulesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListPaymentSchedulesResp_Response interface {
+	isListPaymentSchedulesResp_Response()
+}
+
+type ListPaymentSchedulesResp_MsgSuccess_ struct {
+	MsgSuccess *ListPaymentSchedulesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type ListPaymentSchedulesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListPaymentSchedulesResp_MsgSuccess_) isListPaymentSchedulesResp_Response() {}
+
+func (*ListPaymentSchedulesResp_Error) isListPaymentSchedulesResp_Response() {}
+
+type CancelPaymentScheduleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ScheduleID string `protobuf:"bytes,2,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+}
+
+func (x *CancelPaymentScheduleReq) Reset() {
+	*x = CancelPaymentScheduleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPaymentScheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentScheduleReq) ProtoMessage() {}
+
+func (x *CancelPaymentScheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentScheduleReq.ProtoReflect.Descriptor instead.
+func (*CancelPaymentScheduleReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CancelPaymentScheduleReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CancelPaymentScheduleReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+type CancelPaymentScheduleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CancelPaymentScheduleResp_MsgSuccess_
+	//	*CancelPaymentScheduleResp_Error
+	Response isCancelPaymentScheduleResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *CancelPaymentScheduleResp) Reset() {
+	*x = CancelPaymentScheduleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPaymentScheduleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentScheduleResp) ProtoMessage() {}
+
+func (x *CancelPaymentScheduleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentScheduleResp.ProtoReflect.Descriptor instead.
+func (*CancelPaymentScheduleResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (m *CancelPaymentScheduleResp) GetResponse() isCancelPaymentScheduleResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CancelPaymentScheduleResp) GetMsgSuccess() *CancelPaymentScheduleResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CancelPaymentScheduleResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CancelPaymentScheduleResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CancelPaymentScheduleResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCancelPaymentScheduleResp_Response interface {
+	isCancelPaymentScheduleResp_Response()
+}
+
+type CancelPaymentScheduleResp_MsgSuccess_ struct {
+	MsgSuccess *CancelPaymentScheduleResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CancelPaymentScheduleResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CancelPaymentScheduleResp_MsgSuccess_) isCancelPaymentScheduleResp_Response() {}
+
+func (*CancelPaymentScheduleResp_Error) isCancelPaymentScheduleResp_Response() {}
+
+// PayReq requests a payment to the peer with the target alias, through a
+// path of channels using the htlc app. It requires routing to be enabled in
+// the session config.
+type PayReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID   string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	TargetAlias string `protobuf:"bytes,2,opt,name=targetAlias,proto3" json:"targetAlias,omitempty"`
+	Currency    string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PayReq) Reset() {
+	*x = PayReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayReq) ProtoMessage() {}
+
+func (x *PayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayReq.ProtoReflect.Descriptor instead.
+func (*PayReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PayReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PayReq) GetTargetAlias() string {
+	if x != nil {
+		return x.TargetAlias
+	}
+	return ""
+}
+
+func (x *PayReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PayReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type PayResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PayResp_MsgSuccess_
+	//	*PayResp_Error
+	Response isPayResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *PayResp) Reset() {
+	*x = PayResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayResp) ProtoMessage() {}
+
+func (x *PayResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayResp.ProtoReflect.Descriptor instead.
+func (*PayResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (m *PayResp) GetResponse() isPayResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PayResp) GetMsgSuccess() *PayResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*PayResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *PayResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*PayResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isPayResp_Response interface {
+	isPayResp_Response()
+}
+
+type PayResp_MsgSuccess_ struct {
+	MsgSuccess *PayResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type PayResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*PayResp_MsgSuccess_) isPayResp_Response() {}
+
+func (*PayResp_Error) isPayResp_Response() {}
+
+// CreateInvoiceReq carries the parameters of the invoice to be created.
+// expirySecs is the duration for which the invoice can be paid; zero means
+// the invoice does not expire.
+type CreateInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Currency   string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo       string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpirySecs uint64 `protobuf:"varint,5,opt,name=expirySecs,proto3" json:"expirySecs,omitempty"`
+}
+
+func (x *CreateInvoiceReq) Reset() {
+	*x = CreateInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceReq) ProtoMessage() {}
+
+func (x *CreateInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceReq.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateInvoiceReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetExpirySecs() uint64 {
+	if x != nil {
+		return x.ExpirySecs
+	}
+	return 0
+}
+
+type CreateInvoiceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CreateInvoiceResp_MsgSuccess_
+	//	*CreateInvoiceResp_Error
+	Response isCreateInvoiceResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *CreateInvoiceResp) Reset() {
+	*x = CreateInvoiceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceResp) ProtoMessage() {}
+
+func (x *CreateInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceResp.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (m *CreateInvoiceResp) GetResponse() isCreateInvoiceResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CreateInvoiceResp) GetMsgSuccess() *CreateInvoiceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CreateInvoiceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CreateInvoiceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CreateInvoiceResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateInvoiceResp_Response interface {
+	isCreateInvoiceResp_Response()
+}
+
+type CreateInvoiceResp_MsgSuccess_ struct {
+	MsgSuccess *CreateInvoiceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CreateInvoiceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateInvoiceResp_MsgSuccess_) isCreateInvoiceResp_Response() {}
+
+func (*CreateInvoiceResp_Error) isCreateInvoiceResp_Response() {}
+
+type GetInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	InvoiceID string `protobuf:"bytes,2,opt,name=invoiceID,proto3" json:"invoiceID,omitempty"`
+}
+
+func (x *GetInvoiceReq) Reset() {
+	*x = GetInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceReq) ProtoMessage() {}
+
+func (x *GetInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceReq.ProtoReflect.Descriptor instead.
+func (*GetInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetInvoiceReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *GetInvoiceReq) GetInvoiceID() string {
+	if x != nil {
+		return x.InvoiceID
+	}
+	return ""
+}
+
+type GetInvoiceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetInvoiceResp_MsgSuccess_
+	//	*GetInvoiceResp_Error
+	Response isGetInvoiceResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetInvoiceResp) Reset() {
+	*x = GetInvoiceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoiceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResp) ProtoMessage() {}
+
+func (x *GetInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResp.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (m *GetInvoiceResp) GetResponse() isGetInvoiceResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetInvoiceResp) GetMsgSuccess() *GetInvoiceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetInvoiceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetInvoiceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetInvoiceResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetInvoiceResp_Response interface {
+	isGetInvoiceResp_Response()
+}
+
+type GetInvoiceResp_MsgSuccess_ struct {
+	MsgSuccess *GetInvoiceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetInvoiceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetInvoiceResp_MsgSuccess_) isGetInvoiceResp_Response() {}
+
+func (*GetInvoiceResp_Error) isGetInvoiceResp_Response() {}
+
+type ListInvoicesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *ListInvoicesReq) Reset() {
+	*x = ListInvoicesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvoicesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesReq) ProtoMessage() {}
+
+func (x *ListInvoicesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesReq.ProtoReflect.Descriptor instead.
+func (*ListInvoicesReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListInvoicesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type ListInvoicesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ListInvoicesResp_MsgSuccess_
+	//	*ListInvoicesResp_Error
+	Response isListInvoicesResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListInvoicesResp) Reset() {
+	*x = ListInvoicesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvoicesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResp) ProtoMessage() {}
+
+func (x *ListInvoicesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResp.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (m *ListInvoicesResp) GetResponse() isListInvoicesResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListInvoicesResp) GetMsgSuccess() *ListInvoicesResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ListInvoicesResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *ListInvoicesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ListInvoicesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListInvoicesResp_Response interface {
+	isListInvoicesResp_Response()
+}
+
+type ListInvoicesResp_MsgSuccess_ struct {
+	MsgSuccess *ListInvoicesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type ListInvoicesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListInvoicesResp_MsgSuccess_) isListInvoicesResp_Response() {}
+
+func (*ListInvoicesResp_Error) isListInvoicesResp_Response() {}
+
+type PayInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID      string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	EncodedInvoice string `protobuf:"bytes,2,opt,name=encodedInvoice,proto3" json:"encodedInvoice,omitempty"`
+}
+
+func (x *PayInvoiceReq) Reset() {
+	*x = PayInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceReq) ProtoMessage() {}
+
+func (x *PayInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceReq.ProtoReflect.Descriptor instead.
+func (*PayInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PayInvoiceReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PayInvoiceReq) GetEncodedInvoice() string {
+	if x != nil {
+		return x.EncodedInvoice
+	}
+	return ""
+}
+
+type PayInvoiceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PayInvoiceResp_MsgSuccess_
+	//	*PayInvoiceResp_Error
+	Response isPayInvoiceResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *PayInvoiceResp) Reset() {
+	*x = PayInvoiceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayInvoiceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceResp) ProtoMessage() {}
+
+func (x *PayInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceResp.ProtoReflect.Descriptor instead.
+func (*PayInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (m *PayInvoiceResp) GetResponse() isPayInvoiceResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PayInvoiceResp) GetMsgSuccess() *PayInvoiceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*PayInvoiceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *PayInvoiceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*PayInvoiceResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isPayInvoiceResp_Response interface {
+	isPayInvoiceResp_Response()
+}
+
+type PayInvoiceResp_MsgSuccess_ struct {
+	MsgSuccess *PayInvoiceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type PayInvoiceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*PayInvoiceResp_MsgSuccess_) isPayInvoiceResp_Response() {}
+
+func (*PayInvoiceResp_Error) isPayInvoiceResp_Response() {}
+
+type OpenSessionResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID   string       `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	RestoredChs []*PayChInfo `protobuf:"bytes,2,rep,name=restoredChs,proto3" json:"restoredChs,omitempty"`
+}
+
+func (x *OpenSessionResp_MsgSuccess) Reset() {
+	*x = OpenSessionResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenSessionResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenSessionResp_MsgSuccess) ProtoMessage() {}
+
+func (x *OpenSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*OpenSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *OpenSessionResp_MsgSuccess) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *OpenSessionResp_MsgSuccess) GetRestoredChs() []*PayChInfo {
+	if x != nil {
+		return x.RestoredChs
+	}
+	return nil
+}
+
+type RegisterCurrencyResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *RegisterCurrencyResp_MsgSuccess) Reset() {
+	*x = RegisterCurrencyResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterCurrencyResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCurrencyResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RegisterCurrencyResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCurrencyResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RegisterCurrencyResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *RegisterCurrencyResp_MsgSuccess) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type AddPeerIDResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *AddPeerIDResp_MsgSuccess) Reset() {
+	*x = AddPeerIDResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPeerIDResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPeerIDResp_MsgSuccess) ProtoMessage() {}
+
+func (x *AddPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*AddPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *AddPeerIDResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetPeerIDResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerID *PeerID `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+}
+
+func (x *GetPeerIDResp_MsgSuccess) Reset() {
+	*x = GetPeerIDResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerIDResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerIDResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetPeerIDResp_MsgSuccess) GetPeerID() *PeerID {
+	if x != nil {
+		return x.PeerID
+	}
+	return nil
+}
+
+type ListPeerIDsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerIDs []*PeerID `protobuf:"bytes,1,rep,name=peerIDs,proto3" json:"peerIDs,omitempty"`
+}
+
+func (x *ListPeerIDsResp_MsgSuccess) Reset() {
+	*x = ListPeerIDsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPeerIDsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeerIDsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ListPeerIDsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeerIDsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ListPeerIDsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ListPeerIDsResp_MsgSuccess) GetPeerIDs() []*PeerID {
+	if x != nil {
+		return x.PeerIDs
+	}
+	return nil
+}
+
+type UpdatePeerIDResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UpdatePeerIDResp_MsgSuccess) Reset() {
+	*x = UpdatePeerIDResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePeerIDResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePeerIDResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UpdatePeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UpdatePeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *UpdatePeerIDResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeletePeerIDResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeletePeerIDResp_MsgSuccess) Reset() {
+	*x = DeletePeerIDResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePeerIDResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePeerIDResp_MsgSuccess) ProtoMessage() {}
+
+func (x *DeletePeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*DeletePeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *DeletePeerIDResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetPeerStatusResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerStatus *PeerStatus `protobuf:"bytes,1,opt,name=peerStatus,proto3" json:"peerStatus,omitempty"`
+}
+
+func (x *GetPeerStatusResp_MsgSuccess) Reset() {
+	*x = GetPeerStatusResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerStatusResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerStatusResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPeerStatusResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerStatusResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPeerStatusResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *GetPeerStatusResp_MsgSuccess) GetPeerStatus() *PeerStatus {
+	if x != nil {
+		return x.PeerStatus
+	}
+	return nil
+}
+
+type SubPeerStatusResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerStatus *PeerStatus `protobuf:"bytes,1,opt,name=peerStatus,proto3" json:"peerStatus,omitempty"`
+}
+
+func (x *SubPeerStatusResp_Notify) Reset() {
+	*x = SubPeerStatusResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPeerStatusResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPeerStatusResp_Notify) ProtoMessage() {}
+
+func (x *SubPeerStatusResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPeerStatusResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPeerStatusResp_Notify) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *SubPeerStatusResp_Notify) GetPeerStatus() *PeerStatus {
+	if x != nil {
+		return x.PeerStatus
+	}
+	return nil
+}
+
+type UnsubPeerStatusResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) Reset() {
+	*x = UnsubPeerStatusResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPeerStatusResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPeerStatusResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPeerStatusResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type OpenPayChResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+}
+
+func (x *OpenPayChResp_MsgSuccess) Reset() {
+	*x = OpenPayChResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenPayChResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPayChResp_MsgSuccess) ProtoMessage() {}
+
+func (x *OpenPayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*OpenPayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *OpenPayChResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.OpenedPayChInfo
+	}
+	return nil
+}
+
+type OpenVirtualPayChResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+}
+
+func (x *OpenVirtualPayChResp_MsgSuccess) Reset() {
+	*x = OpenVirtualPayChResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenVirtualPayChResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenVirtualPayChResp_MsgSuccess) ProtoMessage() {}
+
+func (x *OpenVirtualPayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenVirtualPayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*OpenVirtualPayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *OpenVirtualPayChResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.OpenedPayChInfo
+	}
+	return nil
+}
+
+type GetPayChsInfoResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+}
+
+func (x *GetPayChsInfoResp_MsgSuccess) Reset() {
+	*x = GetPayChsInfoResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChsInfoResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChsInfoResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPayChsInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChsInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPayChsInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *GetPayChsInfoResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
+	if x != nil {
+		return x.OpenPayChsInfo
+	}
+	return nil
+}
+
+type SubPayChProposalsResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalID       string   `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	OpeningBalInfo   *BalInfo `protobuf:"bytes,4,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
+	ChallengeDurSecs uint64   `protobuf:"varint,5,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
+	Expiry           int64    `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Set only for virtual channel proposals, which should be responded
+	// to using RespondVirtualPayChProposal.
+	ParentChID string `protobuf:"bytes,7,opt,name=parentChID,proto3" json:"parentChID,omitempty"`
+	// Set only for proposals responded to as per the session policy, no
+	// response is expected for these.
+	AutoResponse SubPayChProposalsResp_Notify_AutoResponse `protobuf:"varint,8,opt,name=autoResponse,proto3,enum=pb.SubPayChProposalsResp_Notify_AutoResponse" json:"autoResponse,omitempty"`
+}
+
+func (x *SubPayChProposalsResp_Notify) Reset() {
+	*x = SubPayChProposalsResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayChProposalsResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayChProposalsResp_Notify) ProtoMessage() {}
+
+func (x *SubPayChProposalsResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayChProposalsResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPayChProposalsResp_Notify) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *SubPayChProposalsResp_Notify) GetProposalID() string {
+	if x != nil {
+		return x.ProposalID
+	}
+	return ""
+}
+
+func (x *SubPayChProposalsResp_Notify) GetOpeningBalInfo() *BalInfo {
+	if x != nil {
+		return x.OpeningBalInfo
+	}
+	return nil
+}
+
+func (x *SubPayChProposalsResp_Notify) GetChallengeDurSecs() uint64 {
+	if x != nil {
+		return x.ChallengeDurSecs
+	}
+	return 0
+}
+
+func (x *SubPayChProposalsResp_Notify) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *SubPayChProposalsResp_Notify) GetParentChID() string {
+	if x != nil {
+		return x.ParentChID
+	}
+	return ""
+}
+
+func (x *SubPayChProposalsResp_Notify) GetAutoResponse() SubPayChProposalsResp_Notify_AutoResponse {
+	if x != nil {
+		return x.AutoResponse
+	}
+	return SubPayChProposalsResp_Notify_none
+}
+
+type UnsubPayChProposalsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) Reset() {
+	*x = UnsubPayChProposalsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayChProposalsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayChProposalsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPayChProposalsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RespondPayChProposalResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedPayChInfo *PayChInfo `protobuf:"bytes,2,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+}
+
+func (x *RespondPayChProposalResp_MsgSuccess) Reset() {
+	*x = RespondPayChProposalResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondPayChProposalResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPayChProposalResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RespondPayChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPayChProposalResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RespondPayChProposalResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *RespondPayChProposalResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.OpenedPayChInfo
+	}
+	return nil
+}
+
+type RespondVirtualPayChProposalResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedPayChInfo *PayChInfo `protobuf:"bytes,2,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+}
+
+func (x *RespondVirtualPayChProposalResp_MsgSuccess) Reset() {
+	*x = RespondVirtualPayChProposalResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondVirtualPayChProposalResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondVirtualPayChProposalResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RespondVirtualPayChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondVirtualPayChProposalResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RespondVirtualPayChProposalResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *RespondVirtualPayChProposalResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.OpenedPayChInfo
+	}
+	return nil
+}
+
+type CloseSessionResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+}
+
+func (x *CloseSessionResp_MsgSuccess) Reset() {
+	*x = CloseSessionResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseSessionResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSessionResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CloseSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CloseSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *CloseSessionResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
+	if x != nil {
+		return x.OpenPayChsInfo
+	}
+	return nil
+}
+
+type DeployAssetERC20Resp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetAddr string `protobuf:"bytes,2,opt,name=AssetAddr,proto3" json:"AssetAddr,omitempty"`
+}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) Reset() {
+	*x = DeployAssetERC20Resp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployAssetERC20Resp_MsgSuccess) ProtoMessage() {}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployAssetERC20Resp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*DeployAssetERC20Resp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) GetAssetAddr() string {
+	if x != nil {
+		return x.AssetAddr
+	}
+	return ""
+}
+
+type SendPayChUpdateResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+}
+
+func (x *SendPayChUpdateResp_MsgSuccess) Reset() {
+	*x = SendPayChUpdateResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendPayChUpdateResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPayChUpdateResp_MsgSuccess) ProtoMessage() {}
+
+func (x *SendPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*SendPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *SendPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.UpdatedPayChInfo
+	}
+	return nil
+}
+
+type SubPayChUpdatesResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdateID          string                                  `protobuf:"bytes,1,opt,name=updateID,proto3" json:"updateID,omitempty"`
+	ProposedPayChInfo *PayChInfo                              `protobuf:"bytes,2,opt,name=proposedPayChInfo,proto3" json:"proposedPayChInfo,omitempty"`
+	Type              SubPayChUpdatesResp_Notify_ChUpdateType `protobuf:"varint,3,opt,name=Type,proto3,enum=pb.SubPayChUpdatesResp_Notify_ChUpdateType" json:"Type,omitempty"`
+	Expiry            int64                                   `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Error             *MsgError                               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Set only for updates responded to as per the session policy, no
+	// response is expected for these.
+	AutoResponse SubPayChUpdatesResp_Notify_AutoResponse `protobuf:"varint,6,opt,name=autoResponse,proto3,enum=pb.SubPayChUpdatesResp_Notify_AutoResponse" json:"autoResponse,omitempty"`
+}
+
+func (x *SubPayChUpdatesResp_Notify) Reset() {
+	*x = SubPayChUpdatesResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayChUpdatesResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayChUpdatesResp_Notify) ProtoMessage() {}
+
+func (x *SubPayChUpdatesResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayChUpdatesResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPayChUpdatesResp_Notify) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetUpdateID() string {
+	if x != nil {
+		return x.UpdateID
+	}
+	return ""
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetProposedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.ProposedPayChInfo
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetType() SubPayChUpdatesResp_Notify_ChUpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return SubPayChUpdatesResp_Notify_open
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetError() *MsgError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetAutoResponse() SubPayChUpdatesResp_Notify_AutoResponse {
+	if x != nil {
+		return x.AutoResponse
+	}
+	return SubPayChUpdatesResp_Notify_none
+}
+
+type UnsubPayChUpdatesResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) Reset() {
+	*x = UnsubPayChUpdatesResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayChUpdatesResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayChUpdatesResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPayChUpdatesResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RespondPayChUpdateResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) Reset() {
+	*x = RespondPayChUpdateResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPayChUpdateResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RespondPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.UpdatedPayChInfo
+	}
+	return nil
+}
+
+type GetPayChInfoResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=payChInfo,proto3" json:"payChInfo,omitempty"`
+}
+
+func (x *GetPayChInfoResp_MsgSuccess) Reset() {
+	*x = GetPayChInfoResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChInfoResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChInfoResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPayChInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPayChInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *GetPayChInfoResp_MsgSuccess) GetPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.PayChInfo
+	}
+	return nil
+}
+
+type GetPayChHistoryResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ChHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetPayChHistoryResp_MsgSuccess) Reset() {
+	*x = GetPayChHistoryResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChHistoryResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChHistoryResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPayChHistoryResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChHistoryResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPayChHistoryResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *GetPayChHistoryResp_MsgSuccess) GetEntries() []*ChHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ClosePayChResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClosedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=closedPayChInfo,proto3" json:"closedPayChInfo,omitempty"`
+}
+
+func (x *ClosePayChResp_MsgSuccess) Reset() {
+	*x = ClosePayChResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePayChResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePayChResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ClosePayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ClosePayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{57, 0}
+}
+
+func (x *ClosePayChResp_MsgSuccess) GetClosedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.ClosedPayChInfo
+	}
+	return nil
+}
+
+type StartPaymentStreamResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PaymentStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StartPaymentStreamResp_MsgSuccess) Reset() {
+	*x = StartPaymentStreamResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPaymentStreamResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPaymentStreamResp_MsgSuccess) ProtoMessage() {}
+
+func (x *StartPaymentStreamResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPaymentStreamResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*StartPaymentStreamResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *StartPaymentStreamResp_MsgSuccess) GetStatus() *PaymentStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type StopPaymentStreamResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PaymentStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StopPaymentStreamResp_MsgSuccess) Reset() {
+	*x = StopPaymentStreamResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPaymentStreamResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPaymentStreamResp_MsgSuccess) ProtoMessage() {}
+
+func (x *StopPaymentStreamResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPaymentStreamResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*StopPaymentStreamResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *StopPaymentStreamResp_MsgSuccess) GetStatus() *PaymentStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type SubPaymentStreamStatusResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PaymentStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SubPaymentStreamStatusResp_Notify) Reset() {
+	*x = SubPaymentStreamStatusResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPaymentStreamStatusResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPaymentStreamStatusResp_Notify) ProtoMessage() {}
+
+func (x *SubPaymentStreamStatusResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPaymentStreamStatusResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPaymentStreamStatusResp_Notify) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *SubPaymentStreamStatusResp_Notify) GetStatus() *PaymentStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UnsubPaymentStreamStatusResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPaymentStreamStatusResp_MsgSuccess) Reset() {
+	*x = UnsubPaymentStreamStatusResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPaymentStreamStatusResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPaymentStreamStatusResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPaymentStreamStatusResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPaymentStreamStatusResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPaymentStreamStatusResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *UnsubPaymentStreamStatusResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreatePaymentScheduleResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *PaymentSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *CreatePaymentScheduleResp_MsgSuccess) Reset() {
+	*x = CreatePaymentScheduleResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePaymentScheduleResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentScheduleResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CreatePaymentScheduleResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentScheduleResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CreatePaymentScheduleResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *CreatePaymentScheduleResp_MsgSuccess) GetSchedule() *PaymentSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListPaymentSchedulesResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*PaymentSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *ListPaymentSchedulesResp_MsgSuccess) Reset() {
+	*x = ListPaymentSchedulesResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentSchedulesResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentSchedulesResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ListPaymentSchedulesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentSchedulesResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ListPaymentSchedulesResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *ListPaymentSchedulesResp_MsgSuccess) GetSchedules() []*PaymentSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type CancelPaymentScheduleResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *PaymentSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *CancelPaymentScheduleResp_MsgSuccess) Reset() {
+	*x = CancelPaymentScheduleResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPaymentScheduleResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentScheduleResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CancelPaymentScheduleResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentScheduleResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CancelPaymentScheduleResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *CancelPaymentScheduleResp_MsgSuccess) GetSchedule() *PaymentSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type PayResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *RoutedPayment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *PayResp_MsgSuccess) Reset() {
+	*x = PayResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayResp_MsgSuccess) ProtoMessage() {}
+
+func (x *PayResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*PayResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *PayResp_MsgSuccess) GetPayment() *RoutedPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type CreateInvoiceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *CreateInvoiceResp_MsgSuccess) Reset() {
+	*x = CreateInvoiceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CreateInvoiceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{76, 0}
+}
+
+func (x *CreateInvoiceResp_MsgSuccess) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type GetInvoiceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *GetInvoiceResp_MsgSuccess) Reset() {
+	*x = GetInvoiceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoiceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetInvoiceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{78, 0}
+}
+
+func (x *GetInvoiceResp_MsgSuccess) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type ListInvoicesResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+}
+
+func (x *ListInvoicesResp_MsgSuccess) Reset() {
+	*x = ListInvoicesResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvoicesResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ListInvoicesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{80, 0}
+}
+
+func (x *ListInvoicesResp_MsgSuccess) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type PayInvoiceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *PayInvoiceResp_MsgSuccess) Reset() {
+	*x = PayInvoiceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_service_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayInvoiceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *PayInvoiceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*PayInvoiceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{82, 0}
+}
+
+func (x *PayInvoiceResp_MsgSuccess) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+var File_payment_service_proto protoreflect.FileDescriptor
+
+var file_payment_service_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0f, 0x6e, 0x6f, 0x64,
+	0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x54, 0x48, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x54, 0x48, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x69, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f,
+	0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0xe2, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x5b, 0x0a, 0x0a, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x43, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x68, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x22,
+	0x1e, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x51, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x22, 0xb5, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x24, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x48, 0x65,
+	0x6c, 0x70, 0x52, 0x65, 0x71, 0x22, 0x1e, 0x0a, 0x08, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x70, 0x69, 0x73, 0x22, 0x50, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x30, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x44, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xb9, 0x01,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x40, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x32, 0x0a, 0x0a, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0xaf,
+	0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x45, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x22, 0xc7, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x3c, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x2e, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x53,
+	0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x06, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x38, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a,
+	0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x8d, 0x01, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x33,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x22,
+	0xc8, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f,
+	0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x13, 0x4f,
+	0x70, 0x65, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x49, 0x44,
+	0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x65, 0x72, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x70, 0x65, 0x65, 0x72, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x78, 0x12, 0x33,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x22,
+	0xd6, 0x01, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x53,
+	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0xd2, 0x03, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52,
+	0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xca, 0x02,
+	0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a,
+	0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x49, 0x44, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x49,
+	0x44, 0x12, 0x51, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x02, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x16, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xbd,
+	0x01, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f,
+	0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22,
+	0xde, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a,
+	0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f,
+	0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x76, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xcc,
+	0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70,
+	0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a,
+	0x13, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32,
+	0x30, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x22, 0xbb, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52,
+	0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x2a, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f,
+	0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0xd6, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x70,
+	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44,
+	0x22, 0x9a, 0x04, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x96, 0x03, 0x0a, 0x06, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12,
+	0x3b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x0c, 0x61, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x61, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x0c, 0x43, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6f, 0x70,
+	0x65, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x0c, 0x41,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6e,
+	0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10,
+	0x02, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a,
+	0x14, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x46, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x68, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x3a, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xba, 0x03, 0x0a, 0x13, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x79, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x61, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x73,
+	0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0x03, 0x22, 0xe3, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x61, 0x70, 0x22, 0xd2, 0x01, 0x0a, 0x16, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3d, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x50, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x22, 0xd0, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3d, 0x0a, 0x0a, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0xca, 0x01, 0x0a, 0x1a,
+	0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x06, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x39, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x1b, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x22, 0xc7, 0x01, 0x0a, 0x1c, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3d, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3f, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22,
+	0xd8, 0x01, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x3d, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x06, 0x50, 0x61,
+	0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x53, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x53, 0x65, 0x63, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x33, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x49, 0x44, 0x22, 0xb8, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x33,
+	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x07,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x22, 0xbe, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x35,
+	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x08,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x55, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x33, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xe2, 0x15, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x41, 0x50, 0x49, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x23, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x23, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x70, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x6c,
+	0x70, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
+	0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x44, 0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x10,
+	0x4f, 0x70, 0x65, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x1b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45,
+	0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x53, 0x65,
+	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x70, 0x61, 0x79, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x1a,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x11,
+	0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x20, 0x0a, 0x03, 0x50,
+	0x61, 0x79, 0x12, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x35, 0x0a, 0x0a, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_payment_service_proto_rawDescOnce sync.Once
+	file_payment_service_proto_rawDescData = file_payment_service_proto_rawDesc
+)
+
+func file_payment_service_proto_rawDescGZIP() []byte {
+	file_payment_service_proto_rawDescOnce.Do(func() {
+		file_payment_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_payment_service_proto_rawDescData)
+	})
+	return file_payment_service_proto_rawDescData
+}
+
+var file_payment_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 121)
+var file_payment_service_proto_goTypes = []interface{}{
+	(SubPayChProposalsResp_Notify_AutoResponse)(0),     // 0: pb.SubPayChProposalsResp.Notify.AutoResponse
+	(SubPayChUpdatesResp_Notify_ChUpdateType)(0),       // 1: pb.SubPayChUpdatesResp.Notify.ChUpdateType
+	(SubPayChUpdatesResp_Notify_AutoResponse)(0),       // 2: pb.SubPayChUpdatesResp.Notify.AutoResponse
+	(PaymentStreamStatus_State)(0),                     // 3: pb.PaymentStreamStatus.State
+	(*GetConfigReq)(nil),                               // 4: pb.GetConfigReq
+	(*GetConfigResp)(nil),                              // 5: pb.GetConfigResp
+	(*OpenSessionReq)(nil),                             // 6: pb.OpenSessionReq
+	(*OpenSessionResp)(nil),                            // 7: pb.OpenSessionResp
+	(*TimeReq)(nil),                                    // 8: pb.TimeReq
+	(*TimeResp)(nil),                                   // 9: pb.TimeResp
+	(*RegisterCurrencyReq)(nil),                        // 10: pb.RegisterCurrencyReq
+	(*RegisterCurrencyResp)(nil),                       // 11: pb.RegisterCurrencyResp
+	(*HelpReq)(nil),                                    // 12: pb.HelpReq
+	(*HelpResp)(nil),                                   // 13: pb.HelpResp
+	(*AddPeerIDReq)(nil),                               // 14: pb.AddPeerIDReq
+	(*AddPeerIDResp)(nil),                              // 15: pb.AddPeerIDResp
+	(*GetPeerIDReq)(nil),                               // 16: pb.GetPeerIDReq
+	(*GetPeerIDResp)(nil),                              // 17: pb.GetPeerIDResp
+	(*ListPeerIDsReq)(nil),                             // 18: pb.ListPeerIDsReq
+	(*ListPeerIDsResp)(nil),                            // 19: pb.ListPeerIDsResp
+	(*UpdatePeerIDReq)(nil),                            // 20: pb.UpdatePeerIDReq
+	(*UpdatePeerIDResp)(nil),                           // 21: pb.UpdatePeerIDResp
+	(*DeletePeerIDReq)(nil),                            // 22: pb.DeletePeerIDReq
+	(*DeletePeerIDResp)(nil),                           // 23: pb.DeletePeerIDResp
+	(*GetPeerStatusReq)(nil),                           // 24: pb.GetPeerStatusReq
+	(*GetPeerStatusResp)(nil),                          // 25: pb.GetPeerStatusResp
+	(*SubPeerStatusReq)(nil),                           // 26: pb.SubPeerStatusReq
+	(*SubPeerStatusResp)(nil),                          // 27: pb.SubPeerStatusResp
+	(*UnsubPeerStatusReq)(nil),                         // 28: pb.UnsubPeerStatusReq
+	(*UnsubPeerStatusResp)(nil),                        // 29: pb.UnsubPeerStatusResp
+	(*OpenPayChReq)(nil),                               // 30: pb.OpenPayChReq
+	(*OpenPayChResp)(nil),                              // 31: pb.OpenPayChResp
+	(*OpenVirtualPayChReq)(nil),                        // 32: pb.OpenVirtualPayChReq
+	(*OpenVirtualPayChResp)(nil),                       // 33: pb.OpenVirtualPayChResp
+	(*GetPayChsInfoReq)(nil),                           // 34: pb.GetPayChsInfoReq
+	(*GetPayChsInfoResp)(nil),                          // 35: pb.GetPayChsInfoResp
+	(*SubPayChProposalsReq)(nil),                       // 36: pb.SubPayChProposalsReq
+	(*SubPayChProposalsResp)(nil),                      // 37: pb.SubPayChProposalsResp
+	(*UnsubPayChProposalsReq)(nil),                     // 38: pb.UnsubPayChProposalsReq
+	(*UnsubPayChProposalsResp)(nil),                    // 39: pb.UnsubPayChProposalsResp
+	(*RespondPayChProposalReq)(nil),                    // 40: pb.RespondPayChProposalReq
+	(*RespondPayChProposalResp)(nil),                   // 41: pb.RespondPayChProposalResp
+	(*RespondVirtualPayChProposalReq)(nil),             // 42: pb.RespondVirtualPayChProposalReq
+	(*RespondVirtualPayChProposalResp)(nil),            // 43: pb.RespondVirtualPayChProposalResp
+	(*CloseSessionReq)(nil),                            // 44: pb.CloseSessionReq
+	(*CloseSessionResp)(nil),                           // 45: pb.CloseSessionResp
+	(*DeployAssetERC20Req)(nil),                        // 46: pb.DeployAssetERC20Req
+	(*DeployAssetERC20Resp)(nil),                       // 47: pb.DeployAssetERC20Resp
+	(*SendPayChUpdateReq)(nil),                         // 48: pb.SendPayChUpdateReq
+	(*SendPayChUpdateResp)(nil),                        // 49: pb.SendPayChUpdateResp
+	(*SubpayChUpdatesReq)(nil),                         // 50: pb.SubpayChUpdatesReq
+	(*SubPayChUpdatesResp)(nil),                        // 51: pb.SubPayChUpdatesResp
+	(*UnsubPayChUpdatesReq)(nil),                       // 52: pb.UnsubPayChUpdatesReq
+	(*UnsubPayChUpdatesResp)(nil),                      // 53: pb.UnsubPayChUpdatesResp
+	(*RespondPayChUpdateReq)(nil),                      // 54: pb.RespondPayChUpdateReq
+	(*RespondPayChUpdateResp)(nil),                     // 55: pb.RespondPayChUpdateResp
+	(*GetPayChInfoReq)(nil),                            // 56: pb.GetPayChInfoReq
+	(*GetPayChInfoResp)(nil),                           // 57: pb.GetPayChInfoResp
+	(*GetPayChHistoryReq)(nil),                         // 58: pb.GetPayChHistoryReq
+	(*GetPayChHistoryResp)(nil),                        // 59: pb.GetPayChHistoryResp
+	(*ClosePayChReq)(nil),                              // 60: pb.ClosePayChReq
+	(*ClosePayChResp)(nil),                             // 61: pb.ClosePayChResp
+	(*PaymentStreamStatus)(nil),                        // 62: pb.PaymentStreamStatus
+	(*StartPaymentStreamReq)(nil),                      // 63: pb.StartPaymentStreamReq
+	(*StartPaymentStreamResp)(nil),                     // 64: pb.StartPaymentStreamResp
+	(*StopPaymentStreamReq)(nil),                       // 65: pb.StopPaymentStreamReq
+	(*StopPaymentStreamResp)(nil),                      // 66: pb.StopPaymentStreamResp
+	(*SubPaymentStreamStatusReq)(nil),                  // 67: pb.SubPaymentStreamStatusReq
+	(*SubPaymentStreamStatusResp)(nil),                 // 68: pb.SubPaymentStreamStatusResp
+	(*UnsubPaymentStreamStatusReq)(nil),                // 69: pb.UnsubPaymentStreamStatusReq
+	(*UnsubPaymentStreamStatusResp)(nil),               // 70: pb.UnsubPaymentStreamStatusResp
+	(*CreatePaymentScheduleReq)(nil),                   // 71: pb.CreatePaymentScheduleReq
+	(*CreatePaymentScheduleResp)(nil),                  // 72: pb.CreatePaymentScheduleResp
+	(*ListPaymentSchedulesReq)(nil),                    // 73: pb.ListPaymentSchedulesReq
+	(*ListPaymentSchedulesResp)(nil),                   // 74: pb.ListPaymentSchedulesResp
+	(*CancelPaymentScheduleReq)(nil),                   // 75: pb.CancelPaymentScheduleReq
+	(*CancelPaymentScheduleResp)(nil),                  // 76: pb.CancelPaymentScheduleResp
+	(*PayReq)(nil),                                     // 77: pb.PayReq
+	(*PayResp)(nil),                                    // 78: pb.PayResp
+	(*CreateInvoiceReq)(nil),                           // 79: pb.CreateInvoiceReq
+	(*CreateInvoiceResp)(nil),                          // 80: pb.CreateInvoiceResp
+	(*GetInvoiceReq)(nil),                              // 81: pb.GetInvoiceReq
+	(*GetInvoiceResp)(nil),                             // 82: pb.GetInvoiceResp
+	(*ListInvoicesReq)(nil),                            // 83: pb.ListInvoicesReq
+	(*ListInvoicesResp)(nil),                           // 84: pb.ListInvoicesResp
+	(*PayInvoiceReq)(nil),                              // 85: pb.PayInvoiceReq
+	(*PayInvoiceResp)(nil),                             // 86: pb.PayInvoiceResp
+	(*OpenSessionResp_MsgSuccess)(nil),                 // 87: pb.OpenSessionResp.MsgSuccess
+	(*RegisterCurrencyResp_MsgSuccess)(nil),            // 88: pb.RegisterCurrencyResp.MsgSuccess
+	(*AddPeerIDResp_MsgSuccess)(nil),                   // 89: pb.AddPeerIDResp.MsgSuccess
+	(*GetPeerIDResp_MsgSuccess)(nil),                   // 90: pb.GetPeerIDResp.MsgSuccess
+	(*ListPeerIDsResp_MsgSuccess)(nil),                 // 91: pb.ListPeerIDsResp.MsgSuccess
+	(*UpdatePeerIDResp_MsgSuccess)(nil),                // 92: pb.UpdatePeerIDResp.MsgSuccess
+	(*DeletePeerIDResp_MsgSuccess)(nil),                // 93: pb.DeletePeerIDResp.MsgSuccess
+	(*GetPeerStatusResp_MsgSuccess)(nil),               // 94: pb.GetPeerStatusResp.MsgSuccess
+	(*SubPeerStatusResp_Notify)(nil),                   // 95: pb.SubPeerStatusResp.Notify
+	(*UnsubPeerStatusResp_MsgSuccess)(nil),             // 96: pb.UnsubPeerStatusResp.MsgSuccess
+	(*OpenPayChResp_MsgSuccess)(nil),                   // 97: pb.OpenPayChResp.MsgSuccess
+	(*OpenVirtualPayChResp_MsgSuccess)(nil),            // 98: pb.OpenVirtualPayChResp.MsgSuccess
+	(*GetPayChsInfoResp_MsgSuccess)(nil),               // 99: pb.GetPayChsInfoResp.MsgSuccess
+	(*SubPayChProposalsResp_Notify)(nil),               // 100: pb.SubPayChProposalsResp.Notify
+	(*UnsubPayChProposalsResp_MsgSuccess)(nil),         // 101: pb.UnsubPayChProposalsResp.MsgSuccess
+	(*RespondPayChProposalResp_MsgSuccess)(nil),        // 102: pb.RespondPayChProposalResp.MsgSuccess
+	(*RespondVirtualPayChProposalResp_MsgSuccess)(nil), // 103: pb.RespondVirtualPayChProposalResp.MsgSuccess
+	(*CloseSessionResp_MsgSuccess)(nil),                // 104: pb.CloseSessionResp.MsgSuccess
+	(*DeployAssetERC20Resp_MsgSuccess)(nil),            // 105: pb.DeployAssetERC20Resp.MsgSuccess
+	(*SendPayChUpdateResp_MsgSuccess)(nil),             // 106: pb.SendPayChUpdateResp.MsgSuccess
+	(*SubPayChUpdatesResp_Notify)(nil),                 // 107: pb.SubPayChUpdatesResp.Notify
+	(*UnsubPayChUpdatesResp_MsgSuccess)(nil),           // 108: pb.UnsubPayChUpdatesResp.MsgSuccess
+	(*RespondPayChUpdateResp_MsgSuccess)(nil),          // 109: pb.RespondPayChUpdateResp.MsgSuccess
+	(*GetPayChInfoResp_MsgSuccess)(nil),                // 110: pb.GetPayChInfoResp.MsgSuccess
+	(*GetPayChHistoryResp_MsgSuccess)(nil),             // 111: pb.GetPayChHistoryResp.MsgSuccess
+	(*ClosePayChResp_MsgSuccess)(nil),                  // 112: pb.ClosePayChResp.MsgSuccess
+	(*StartPaymentStreamResp_MsgSuccess)(nil),          // 113: pb.StartPaymentStreamResp.MsgSuccess
+	(*StopPaymentStreamResp_MsgSuccess)(nil),           // 114: pb.StopPaymentStreamResp.MsgSuccess
+	(*SubPaymentStreamStatusResp_Notify)(nil),          // 115: pb.SubPaymentStreamStatusResp.Notify
+	(*UnsubPaymentStreamStatusResp_MsgSuccess)(nil),    // 116: pb.UnsubPaymentStreamStatusResp.MsgSuccess
+	(*CreatePaymentScheduleResp_MsgSuccess)(nil),       // 117: pb.CreatePaymentScheduleResp.MsgSuccess
+	(*ListPaymentSchedulesResp_MsgSuccess)(nil),        // 118: pb.ListPaymentSchedulesResp.MsgSuccess
+	(*CancelPaymentScheduleResp_MsgSuccess)(nil),       // 119: pb.CancelPaymentScheduleResp.MsgSuccess
+	(*PayResp_MsgSuccess)(nil),                         // 120: pb.PayResp.MsgSuccess
+	(*CreateInvoiceResp_MsgSuccess)(nil),               // 121: pb.CreateInvoiceResp.MsgSuccess
+	(*GetInvoiceResp_MsgSuccess)(nil),                  // 122: pb.GetInvoiceResp.MsgSuccess
+	(*ListInvoicesResp_MsgSuccess)(nil),                // 123: pb.ListInvoicesResp.MsgSuccess
+	(*PayInvoiceResp_MsgSuccess)(nil),                  // 124: pb.PayInvoiceResp.MsgSuccess
+	(*MsgError)(nil),                                   // 125: pb.MsgError
+	(*PeerID)(nil),                                     // 126: pb.PeerID
+	(*BalInfo)(nil),                                    // 127: pb.BalInfo
+	(*Payment)(nil),                                    // 128: pb.Payment
+	(*PaymentSchedule)(nil),                            // 129: pb.PaymentSchedule
+	(*PayChInfo)(nil),                                  // 130: pb.PayChInfo
+	(*PeerStatus)(nil),                                 // 131: pb.PeerStatus
+	(*ChHistoryEntry)(nil),                             // 132: pb.ChHistoryEntry
+	(*RoutedPayment)(nil),                              // 133: pb.RoutedPayment
+	(*Invoice)(nil),                                    // 134: pb.Invoice
+}
+var file_payment_service_proto_depIdxs = []int32{
+	87,  // 0: pb.OpenSessionResp.msgSuccess:type_name -> pb.OpenSessionResp.MsgSuccess
+	125, // 1: pb.OpenSessionResp.error:type_name -> pb.MsgError
+	88,  // 2: pb.RegisterCurrencyResp.msgSuccess:type_name -> pb.RegisterCurrencyResp.MsgSuccess
+	125, // 3: pb.RegisterCurrencyResp.error:type_name -> pb.MsgError
+	126, // 4: pb.AddPeerIDReq.peerID:type_name -> pb.PeerID
+	89,  // 5: pb.AddPeerIDResp.msgSuccess:type_name -> pb.AddPeerIDResp.MsgSuccess
+	125, // 6: pb.AddPeerIDResp.error:type_name -> pb.MsgError
+	90,  // 7: pb.GetPeerIDResp.msgSuccess:type_name -> pb.GetPeerIDResp.MsgSuccess
+	125, // 8: pb.GetPeerIDResp.error:type_name -> pb.MsgError
+	91,  // 9: pb.ListPeerIDsResp.msgSuccess:type_name -> pb.ListPeerIDsResp.MsgSuccess
+	125, // 10: pb.ListPeerIDsResp.error:type_name -> pb.MsgError
+	126, // 11: pb.UpdatePeerIDReq.peerID:type_name -> pb.PeerID
+	92,  // 12: pb.UpdatePeerIDResp.msgSuccess:type_name -> pb.UpdatePeerIDResp.MsgSuccess
+	125, // 13: pb.UpdatePeerIDResp.error:type_name -> pb.MsgError
+	93,  // 14: pb.DeletePeerIDResp.msgSuccess:type_name -> pb.DeletePeerIDResp.MsgSuccess
+	125, // 15: pb.DeletePeerIDResp.error:type_name -> pb.MsgError
+	94,  // 16: pb.GetPeerStatusResp.msgSuccess:type_name -> pb.GetPeerStatusResp.MsgSuccess
+	125, // 17: pb.GetPeerStatusResp.error:type_name -> pb.MsgError
+	95,  // 18: pb.SubPeerStatusResp.notify:type_name -> pb.SubPeerStatusResp.Notify
+	125, // 19: pb.SubPeerStatusResp.error:type_name -> pb.MsgError
+	96,  // 20: pb.UnsubPeerStatusResp.msgSuccess:type_name -> pb.UnsubPeerStatusResp.MsgSuccess
+	125, // 21: pb.UnsubPeerStatusResp.error:type_name -> pb.MsgError
+	127, // 22: pb.OpenPayChReq.openingBalInfo:type_name -> pb.BalInfo
+	97,  // 23: pb.OpenPayChResp.msgSuccess:type_name -> pb.OpenPayChResp.MsgSuccess
+	125, // 24: pb.OpenPayChResp.error:type_name -> pb.MsgError
+	127, // 25: pb.OpenVirtualPayChReq.openingBalInfo:type_name -> pb.BalInfo
+	98,  // 26: pb.OpenVirtualPayChResp.msgSuccess:type_name -> pb.OpenVirtualPayChResp.MsgSuccess
+	125, // 27: pb.OpenVirtualPayChResp.error:type_name -> pb.MsgError
+	99,  // 28: pb.GetPayChsInfoResp.msgSuccess:type_name -> pb.GetPayChsInfoResp.MsgSuccess
+	125, // 29: pb.GetPayChsInfoResp.error:type_name -> pb.MsgError
+	100, // 30: pb.SubPayChProposalsResp.notify:type_name -> pb.SubPayChProposalsResp.Notify
+	125, // 31: pb.SubPayChProposalsResp.error:type_name -> pb.MsgError
+	101, // 32: pb.UnsubPayChProposalsResp.msgSuccess:type_name -> pb.UnsubPayChProposalsResp.MsgSuccess
+	125, // 33: pb.UnsubPayChProposalsResp.error:type_name -> pb.MsgError
+	102, // 34: pb.RespondPayChProposalResp.msgSuccess:type_name -> pb.RespondPayChProposalResp.MsgSuccess
+	125, // 35: pb.RespondPayChProposalResp.error:type_name -> pb.MsgError
+	103, // 36: pb.RespondVirtualPayChProposalResp.msgSuccess:type_name -> pb.RespondVirtualPayChProposalResp.MsgSuccess
+	125, // 37: pb.RespondVirtualPayChProposalResp.error:type_name -> pb.MsgError
+	104, // 38: pb.CloseSessionResp.msgSuccess:type_name -> pb.CloseSessionResp.MsgSuccess
+	125, // 39: pb.CloseSessionResp.error:type_name -> pb.MsgError
+	105, // 40: pb.DeployAssetERC20Resp.msgSuccess:type_name -> pb.DeployAssetERC20Resp.MsgSuccess
+	125, // 41: pb.DeployAssetERC20Resp.error:type_name -> pb.MsgError
+	128, // 42: pb.SendPayChUpdateReq.payments:type_name -> pb.Payment
+	106, // 43: pb.SendPayChUpdateResp.msgSuccess:type_name -> pb.SendPayChUpdateResp.MsgSuccess
+	125, // 44: pb.SendPayChUpdateResp.error:type_name -> pb.MsgError
+	107, // 45: pb.SubPayChUpdatesResp.notify:type_name -> pb.SubPayChUpdatesResp.Notify
+	125, // 46: pb.SubPayChUpdatesResp.error:type_name -> pb.MsgError
+	108, // 47: pb.UnsubPayChUpdatesResp.msgSuccess:type_name -> pb.UnsubPayChUpdatesResp.MsgSuccess
+	125, // 48: pb.UnsubPayChUpdatesResp.error:type_name -> pb.MsgError
+	109, // 49: pb.RespondPayChUpdateResp.msgSuccess:type_name -> pb.RespondPayChUpdateResp.MsgSuccess
+	125, // 50: pb.RespondPayChUpdateResp.error:type_name -> pb.MsgError
+	110, // 51: pb.GetPayChInfoResp.msgSuccess:type_name -> pb.GetPayChInfoResp.MsgSuccess
+	125, // 52: pb.GetPayChInfoResp.error:type_name -> pb.MsgError
+	111, // 53: pb.GetPayChHistoryResp.msgSuccess:type_name -> pb.GetPayChHistoryResp.MsgSuccess
+	125, // 54: pb.GetPayChHistoryResp.error:type_name -> pb.MsgError
+	112, // 55: pb.ClosePayChResp.msgSuccess:type_name -> pb.ClosePayChResp.MsgSuccess
+	125, // 56: pb.ClosePayChResp.error:type_name -> pb.MsgError
+	3,   // 57: pb.PaymentStreamStatus.state:type_name -> pb.PaymentStreamStatus.State
+	125, // 58: pb.PaymentStreamStatus.error:type_name -> pb.MsgError
+	113, // 59: pb.StartPaymentStreamResp.msgSuccess:type_name -> pb.StartPaymentStreamResp.MsgSuccess
+	125, // 60: pb.StartPaymentStreamResp.error:type_name -> pb.MsgError
+	114, // 61: pb.StopPaymentStreamResp.msgSuccess:type_name -> pb.StopPaymentStreamResp.MsgSuccess
+	125, // 62: pb.StopPaymentStreamResp.error:type_name -> pb.MsgError
+	115, // 63: pb.SubPaymentStreamStatusResp.notify:type_name -> pb.SubPaymentStreamStatusResp.Notify
+	125, // 64: pb.SubPaymentStreamStatusResp.error:type_name -> pb.MsgError
+	116, // 65: pb.UnsubPaymentStreamStatusResp.msgSuccess:type_name -> pb.UnsubPaymentStreamStatusResp.MsgSuccess
+	125, // 66: pb.UnsubPaymentStreamStatusResp.error:type_name -> pb.MsgError
+	129, // 67: pb.CreatePaymentScheduleReq.schedule:type_name -> pb.PaymentSchedule
+	117, // 68: pb.CreatePaymentScheduleResp.msgSuccess:type_name -> pb.CreatePaymentScheduleResp.MsgSuccess
+	125, // 69: pb.CreatePaymentScheduleResp.error:type_name -> pb.MsgError
+	118, // 70: pb.ListPaymentSchedulesResp.msgSuccess:type_name -> pb.ListPaymentSchedulesResp.MsgSuccess
+	125, // 71: pb.ListPaymentSchedulesResp.error:type_name -> pb.MsgError
+	119, // 72: pb.CancelPaymentScheduleResp.msgSuccess:type_name -> pb.CancelPaymentScheduleResp.MsgSuccess
+	125, // 73: pb.CancelPaymentScheduleResp.error:type_name -> pb.MsgError
+	120, // 74: pb.PayResp.msgSuccess:type_name -> pb.PayResp.MsgSuccess
+	125, // 75: pb.PayResp.error:type_name -> pb.MsgError
+	121, // 76: pb.CreateInvoiceResp.msgSuccess:type_name -> pb.CreateInvoiceResp.MsgSuccess
+	125, // 77: pb.CreateInvoiceResp.error:type_name -> pb.MsgError
+	122, // 78: pb.GetInvoiceResp.msgSuccess:type_name -> pb.GetInvoiceResp.MsgSuccess
+	125, // 79: pb.GetInvoiceResp.error:type_name -> pb.MsgError
+	123, // 80: pb.ListInvoicesResp.msgSuccess:type_name -> pb.ListInvoicesResp.MsgSuccess
+	125, // 81: pb.ListInvoicesResp.error:type_name -> pb.MsgError
+	124, // 82: pb.PayInvoiceResp.msgSuccess:type_name -> pb.PayInvoiceResp.MsgSuccess
+	125, // 83: pb.PayInvoiceResp.error:type_name -> pb.MsgError
+	130, // 84: pb.OpenSessionResp.MsgSuccess.restoredChs:type_name -> pb.PayChInfo
+	126, // 85: pb.GetPeerIDResp.MsgSuccess.peerID:type_name -> pb.PeerID
+	126, // 86: pb.ListPeerIDsResp.MsgSuccess.peerIDs:type_name -> pb.PeerID
+	131, // 87: pb.GetPeerStatusResp.MsgSuccess.peerStatus:type_name -> pb.PeerStatus
+	131, // 88: pb.SubPeerStatusResp.Notify.peerStatus:type_name -> pb.PeerStatus
+	130, // 89: pb.OpenPayChResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
+	130, // 90: pb.OpenVirtualPayChResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
+	130, // 91: pb.GetPayChsInfoResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
+	127, // 92: pb.SubPayChProposalsResp.Notify.openingBalInfo:type_name -> pb.BalInfo
+	0,   // 93: pb.SubPayChProposalsResp.Notify.autoResponse:type_name -> pb.SubPayChProposalsResp.Notify.AutoResponse
+	130, // 94: pb.RespondPayChProposalResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
+	130, // 95: pb.RespondVirtualPayChProposalResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
+	130, // 96: pb.CloseSessionResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
+	130, // 97: pb.SendPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
+	130, // 98: pb.SubPayChUpdatesResp.Notify.proposedPayChInfo:type_name -> pb.PayChInfo
+	1,   // 99: pb.SubPayChUpdatesResp.Notify.Type:type_name -> pb.SubPayChUpdatesResp.Notify.ChUpdateType
+	125, // 100: pb.SubPayChUpdatesResp.Notify.error:type_name -> pb.MsgError
+	2,   // 101: pb.SubPayChUpdatesResp.Notify.autoResponse:type_name -> pb.SubPayChUpdatesResp.Notify.AutoResponse
+	130, // 102: pb.RespondPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
+	130, // 103: pb.GetPayChInfoResp.MsgSuccess.payChInfo:type_name -> pb.PayChInfo
+	132, // 104: pb.GetPayChHistoryResp.MsgSuccess.entries:type_name -> pb.ChHistoryEntry
+	130, // 105: pb.ClosePayChResp.MsgSuccess.closedPayChInfo:type_name -> pb.PayChInfo
+	62,  // 106: pb.StartPaymentStreamResp.MsgSuccess.status:type_name -> pb.PaymentStreamStatus
+	62,  // 107: pb.StopPaymentStreamResp.MsgSuccess.status:type_name -> pb.PaymentStreamStatus
+	62,  // 108: pb.SubPaymentStreamStatusResp.Notify.status:type_name -> pb.PaymentStreamStatus
+	129, // 109: pb.CreatePaymentScheduleResp.MsgSuccess.schedule:type_name -> pb.PaymentSchedule
+	129, // 110: pb.ListPaymentSchedulesResp.MsgSuccess.schedules:type_name -> pb.PaymentSchedule
+	129, // 111: pb.CancelPaymentScheduleResp.MsgSuccess.schedule:type_name -> pb.PaymentSchedule
+	133, // 112: pb.PayResp.MsgSuccess.payment:type_name -> pb.RoutedPayment
+	134, // 113: pb.CreateInvoiceResp.MsgSuccess.invoice:type_name -> pb.Invoice
+	134, // 114: pb.GetInvoiceResp.MsgSuccess.invoice:type_name -> pb.Invoice
+	134, // 115: pb.ListInvoicesResp.MsgSuccess.invoices:type_name -> pb.Invoice
+	134, // 116: pb.PayInvoiceResp.MsgSuccess.invoice:type_name -> pb.Invoice
+	4,   // 117: pb.Payment_API.GetConfig:input_type -> pb.GetConfigReq
+	6,   // 118: pb.Payment_API.OpenSession:input_type -> pb.OpenSessionReq
+	8,   // 119: pb.Payment_API.Time:input_type -> pb.TimeReq
+	10,  // 120: pb.Payment_API.RegisterCurrency:input_type -> pb.RegisterCurrencyReq
+	12,  // 121: pb.Payment_API.Help:input_type -> pb.HelpReq
+	14,  // 122: pb.Payment_API.AddPeerID:input_type -> pb.AddPeerIDReq
+	16,  // 123: pb.Payment_API.GetPeerID:input_type -> pb.GetPeerIDReq
+	18,  // 124: pb.Payment_API.ListPeerIDs:input_type -> pb.ListPeerIDsReq
+	20,  // 125: pb.Payment_API.UpdatePeerID:input_type -> pb.UpdatePeerIDReq
+	22,  // 126: pb.Payment_API.DeletePeerID:input_type -> pb.DeletePeerIDReq
+	24,  // 127: pb.Payment_API.GetPeerStatus:input_type -> pb.GetPeerStatusReq
+	26,  // 128: pb.Payment_API.SubPeerStatus:input_type -> pb.SubPeerStatusReq
+	28,  // 129: pb.Payment_API.UnsubPeerStatus:input_type -> pb.UnsubPeerStatusReq
+	30,  // 130: pb.Payment_API.OpenPayCh:input_type -> pb.OpenPayChReq
+	32,  // 131: pb.Payment_API.OpenVirtualPayCh:input_type -> pb.OpenVirtualPayChReq
+	34,  // 132: pb.Payment_API.GetPayChsInfo:input_type -> pb.GetPayChsInfoReq
+	36,  // 133: pb.Payment_API.SubPayChProposals:input_type -> pb.SubPayChProposalsReq
+	38,  // 134: pb.Payment_API.UnsubPayChProposals:input_type -> pb.UnsubPayChProposalsReq
+	40,  // 135: pb.Payment_API.RespondPayChProposal:input_type -> pb.RespondPayChProposalReq
+	42,  // 136: pb.Payment_API.RespondVirtualPayChProposal:input_type -> pb.RespondVirtualPayChProposalReq
+	44,  // 137: pb.Payment_API.CloseSession:input_type -> pb.CloseSessionReq
+	46,  // 138: pb.Payment_API.DeployAssetERC20:input_type -> pb.DeployAssetERC20Req
+	48,  // 139: pb.Payment_API.SendPayChUpdate:input_type -> pb.SendPayChUpdateReq
+	50,  // 140: pb.Payment_API.SubPayChUpdates:input_type -> pb.SubpayChUpdatesReq
+	52,  // 141: pb.Payment_API.UnsubPayChUpdates:input_type -> pb.UnsubPayChUpdatesReq
+	54,  // 142: pb.Payment_API.RespondPayChUpdate:input_type -> pb.RespondPayChUpdateReq
+	56,  // 143: pb.Payment_API.GetPayChInfo:input_type -> pb.GetPayChInfoReq
+	58,  // 144: pb.Payment_API.GetPayChHistory:input_type -> pb.GetPayChHistoryReq
+	60,  // 145: pb.Payment_API.ClosePayCh:input_type -> pb.ClosePayChReq
+	63,  // 146: pb.Payment_API.StartPaymentStream:input_type -> pb.StartPaymentStreamReq
+	65,  // 147: pb.Payment_API.StopPaymentStream:input_type -> pb.StopPaymentStreamReq
+	67,  // 148: pb.Payment_API.SubPaymentStreamStatus:input_type -> pb.SubPaymentStreamStatusReq
+	69,  // 149: pb.Payment_API.UnsubPaymentStreamStatus:input_type -> pb.UnsubPaymentStreamStatusReq
+	71,  // 150: pb.Payment_API.CreatePaymentSchedule:input_type -> pb.CreatePaymentScheduleReq
+	73,  // 151: pb.Payment_API.ListPaymentSchedules:input_type -> pb.ListPaymentSchedulesReq
+	75,  // 152: pb.Payment_API.CancelPaymentSchedule:input_type -> pb.CancelPaymentScheduleReq
+	77,  // 153: pb.Payment_API.Pay:input_type -> pb.PayReq
+	79,  // 154: pb.Payment_API.CreateInvoice:input_type -> pb.CreateInvoiceReq
+	81,  // 155: pb.Payment_API.GetInvoice:input_type -> pb.GetInvoiceReq
+	83,  // 156: pb.Payment_API.ListInvoices:input_type -> pb.ListInvoicesReq
+	85,  // 157: pb.Payment_API.PayInvoice:input_type -> pb.PayInvoiceReq
+	5,   // 158: pb.Payment_API.GetConfig:output_type -> pb.GetConfigResp
+	7,   // 159: pb.Payment_API.OpenSession:output_type -> pb.OpenSessionResp
+	9,   // 160: pb.Payment_API.Time:output_type -> pb.TimeResp
+	11,  // 161: pb.Payment_API.RegisterCurrency:output_type -> pb.RegisterCurrencyResp
+	13,  // 162: pb.Payment_API.Help:output_type -> pb.HelpResp
+	15,  // 163: pb.Payment_API.AddPeerID:output_type -> pb.AddPeerIDResp
+	17,  // 164: pb.Payment_API.GetPeerID:output_type -> pb.GetPeerIDResp
+	19,  // 165: pb.Payment_API.ListPeerIDs:output_type -> pb.ListPeerIDsResp
+	21,  // 166: pb.Payment_API.UpdatePeerID:output_type -> pb.UpdatePeerIDResp
+	23,  // 167: pb.Payment_API.DeletePeerID:output_type -> pb.DeletePeerIDResp
+	25,  // 168: pb.Payment_API.GetPeerStatus:output_type -> pb.GetPeerStatusResp
+	27,  // 169: pb.Payment_API.SubPeerStatus:output_type -> pb.SubPeerStatusResp
+	29,  // 170: pb.Payment_API.UnsubPeerStatus:output_type -> pb.UnsubPeerStatusResp
+	31,  // 171: pb.Payment_API.OpenPayCh:output_type -> pb.OpenPayChResp
+	33,  // 172: pb.Payment_API.OpenVirtualPayCh:output_type -> pb.OpenVirtualPayChResp
+	35,  // 173: pb.Payment_API.GetPayChsInfo:output_type -> pb.GetPayChsInfoResp
+	37,  // 174: pb.Payment_API.SubPayChProposals:output_type -> pb.SubPayChProposalsResp
+	39,  // 175: pb.Payment_API.UnsubPayChProposals:output_type -> pb.UnsubPayChProposalsResp
+	41,  // 176: pb.Payment_API.RespondPayChProposal:output_type -> pb.RespondPayChProposalResp
+	43,  // 177: pb.Payment_API.RespondVirtualPayChProposal:output_type -> pb.RespondVirtualPayChProposalResp
+	45,  // 178: pb.Payment_API.CloseSession:output_type -> pb.CloseSessionResp
+	47,  // 179: pb.Payment_API.DeployAssetERC20:output_type -> pb.DeployAssetERC20Resp
+	49,  // 180: pb.Payment_API.SendPayChUpdate:output_type -> pb.SendPayChUpdateResp
+	51,  // 181: pb.Payment_API.SubPayChUpdates:output_type -> pb.SubPayChUpdatesResp
+	53,  // 182: pb.Payment_API.UnsubPayChUpdates:output_type -> pb.UnsubPayChUpdatesResp
+	55,  // 183: pb.Payment_API.RespondPayChUpdate:output_type -> pb.RespondPayChUpdateResp
+	57,  // 184: pb.Payment_API.GetPayChInfo:output_type -> pb.GetPayChInfoResp
+	59,  // 185: pb.Payment_API.GetPayChHistory:output_type -> pb.GetPayChHistoryResp
+	61,  // 186: pb.Payment_API.ClosePayCh:output_type -> pb.ClosePayChResp
+	64,  // 187: pb.Payment_API.StartPaymentStream:output_type -> pb.StartPaymentStreamResp
+	66,  // 188: pb.Payment_API.StopPaymentStream:output_type -> pb.StopPaymentStreamResp
+	68,  // 189: pb.Payment_API.SubPaymentStreamStatus:output_type -> pb.SubPaymentStreamStatusResp
+	70,  // 190: pb.Payment_API.UnsubPaymentStreamStatus:output_type -> pb.UnsubPaymentStreamStatusResp
+	72,  // 191: pb.Payment_API.CreatePaymentSchedule:output_type -> pb.CreatePaymentScheduleResp
+	74,  // 192: pb.Payment_API.ListPaymentSchedules:output_type -> pb.ListPaymentSchedulesResp
+	76,  // 193: pb.Payment_API.CancelPaymentSchedule:output_type -> pb.CancelPaymentScheduleResp
+	78,  // 194: pb.Payment_API.Pay:output_type -> pb.PayResp
+	80,  // 195: pb.Payment_API.CreateInvoice:output_type -> pb.CreateInvoiceResp
+	82,  // 196: pb.Payment_API.GetInvoice:output_type -> pb.GetInvoiceResp
+	84,  // 197: pb.Payment_API.ListInvoices:output_type -> pb.ListInvoicesResp
+	86,  // 198: pb.Payment_API.PayInvoice:output_type -> pb.PayInvoiceResp
+	158, // [158:199] is the sub-list for method output_type
+	117, // [117:158] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
+}
+
+func init() { file_payment_service_proto_init() }
+func file_payment_service_proto_init() {
+	if File_payment_service_proto != nil {
+		return
+	}
+	file_nodetypes_proto_init()
+	file_errors_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_payment_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCurrencyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCurrencyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelpReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelpResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerIDResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerIDResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeerIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeerIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePeerIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePeerIDResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePeerIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePeerIDResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPeerStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPeerStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPeerStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPeerStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPayChReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPayChResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenVirtualPayChReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenVirtualPayChResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChsInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChsInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChProposalsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChProposalsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChProposalsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChProposalsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChProposalReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChProposalResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondVirtualPayChProposalReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondVirtualPayChProposalResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployAssetERC20Req); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployAssetERC20Resp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPayChUpdateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPayChUpdateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubpayChUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChUpdatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChUpdatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChUpdateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChUpdateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePayChReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4184,8 +9883,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigResp); i {
+		file_payment_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePayChResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4196,8 +9895,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenSessionReq); i {
+		file_payment_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentStreamStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4208,8 +9907,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenSessionResp); i {
+		file_payment_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPaymentStreamReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4220,8 +9919,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeReq); i {
+		file_payment_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPaymentStreamResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4232,8 +9931,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeResp); i {
+		file_payment_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPaymentStreamReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4244,8 +9943,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterCurrencyReq); i {
+		file_payment_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPaymentStreamResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4256,8 +9955,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterCurrencyResp); i {
+		file_payment_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPaymentStreamStatusReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4268,8 +9967,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelpReq); i {
+		file_payment_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPaymentStreamStatusResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4280,8 +9979,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelpResp); i {
+		file_payment_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPaymentStreamStatusReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4292,8 +9991,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerIDReq); i {
+		file_payment_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPaymentStreamStatusResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4304,8 +10003,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerIDResp); i {
+		file_payment_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePaymentScheduleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4316,8 +10015,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerIDReq); i {
+		file_payment_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePaymentScheduleResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4328,8 +10027,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerIDResp); i {
+		file_payment_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentSchedulesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4340,8 +10039,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPayChReq); i {
+		file_payment_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentSchedulesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4352,8 +10051,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPayChResp); i {
+		file_payment_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPaymentScheduleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4364,8 +10063,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChsInfoReq); i {
+		file_payment_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPaymentScheduleResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4376,8 +10075,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChsInfoResp); i {
+		file_payment_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4388,8 +10087,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChProposalsReq); i {
+		file_payment_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4400,8 +10099,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChProposalsResp); i {
+		file_payment_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvoiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4412,8 +10111,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChProposalsReq); i {
+		file_payment_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvoiceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4424,8 +10123,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChProposalsResp); i {
+		file_payment_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4436,8 +10135,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChProposalReq); i {
+		file_payment_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoiceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4448,8 +10147,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChProposalResp); i {
+		file_payment_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvoicesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4460,8 +10159,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionReq); i {
+		file_payment_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvoicesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4472,8 +10171,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionResp); i {
+		file_payment_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayInvoiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4484,8 +10183,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployAssetERC20Req); i {
+		file_payment_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayInvoiceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4496,8 +10195,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployAssetERC20Resp); i {
+		file_payment_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4508,8 +10207,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendPayChUpdateReq); i {
+		file_payment_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCurrencyResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4520,8 +10219,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendPayChUpdateResp); i {
+		file_payment_service_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerIDResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4532,8 +10231,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubpayChUpdatesReq); i {
+		file_payment_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerIDResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4544,8 +10243,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChUpdatesResp); i {
+		file_payment_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeerIDsResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4556,8 +10255,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChUpdatesReq); i {
+		file_payment_service_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePeerIDResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4568,8 +10267,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChUpdatesResp); i {
+		file_payment_service_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePeerIDResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4580,8 +10279,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChUpdateReq); i {
+		file_payment_service_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerStatusResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4592,8 +10291,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChUpdateResp); i {
+		file_payment_service_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPeerStatusResp_Notify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4604,8 +10303,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChInfoReq); i {
+		file_payment_service_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPeerStatusResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4616,8 +10315,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChInfoResp); i {
+		file_payment_service_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPayChResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4628,8 +10327,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePayChReq); i {
+		file_payment_service_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenVirtualPayChResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4640,8 +10339,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePayChResp); i {
+		file_payment_service_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChsInfoResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4652,8 +10351,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenSessionResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChProposalsResp_Notify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4664,8 +10363,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterCurrencyResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChProposalsResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4676,8 +10375,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerIDResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChProposalResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4688,8 +10387,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerIDResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondVirtualPayChProposalResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4700,8 +10399,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPayChResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4712,8 +10411,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChsInfoResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployAssetERC20Resp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4724,8 +10423,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChProposalsResp_Notify); i {
+		file_payment_service_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPayChUpdateResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4736,8 +10435,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChProposalsResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChUpdatesResp_Notify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4748,8 +10447,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChProposalResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChUpdatesResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4760,8 +10459,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChUpdateResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4772,8 +10471,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployAssetERC20Resp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChInfoResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4784,8 +10483,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendPayChUpdateResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChHistoryResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4796,8 +10495,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChUpdatesResp_Notify); i {
+		file_payment_service_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePayChResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4808,8 +10507,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChUpdatesResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPaymentStreamResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4820,8 +10519,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChUpdateResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPaymentStreamResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4832,8 +10531,8 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChInfoResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPaymentStreamStatusResp_Notify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4844,8 +10543,104 @@ func file_payment_service_proto_init() {
 				return nil
 			}
 		}
-		file_payment_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePayChResp_MsgSuccess); i {
+		file_payment_service_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPaymentStreamStatusResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePaymentScheduleResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentSchedulesResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPaymentScheduleResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvoiceResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoiceResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvoicesResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_service_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayInvoiceResp_MsgSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4874,64 +10669,148 @@ func file_payment_service_proto_init() {
 		(*GetPeerIDResp_Error)(nil),
 	}
 	file_payment_service_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*ListPeerIDsResp_MsgSuccess_)(nil),
+		(*ListPeerIDsResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*UpdatePeerIDResp_MsgSuccess_)(nil),
+		(*UpdatePeerIDResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*DeletePeerIDResp_MsgSuccess_)(nil),
+		(*DeletePeerIDResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*GetPeerStatusResp_MsgSuccess_)(nil),
+		(*GetPeerStatusResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*SubPeerStatusResp_Notify_)(nil),
+		(*SubPeerStatusResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*UnsubPeerStatusResp_MsgSuccess_)(nil),
+		(*UnsubPeerStatusResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*OpenPayChResp_MsgSuccess_)(nil),
 		(*OpenPayChResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*OpenVirtualPayChResp_MsgSuccess_)(nil),
+		(*OpenVirtualPayChResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[31].OneofWrappers = []interface{}{
 		(*GetPayChsInfoResp_MsgSuccess_)(nil),
 		(*GetPayChsInfoResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*SubPayChProposalsResp_Notify_)(nil),
 		(*SubPayChProposalsResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[21].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*UnsubPayChProposalsResp_MsgSuccess_)(nil),
 		(*UnsubPayChProposalsResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*RespondPayChProposalResp_MsgSuccess_)(nil),
 		(*RespondPayChProposalResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*RespondVirtualPayChProposalResp_MsgSuccess_)(nil),
+		(*RespondVirtualPayChProposalResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*CloseSessionResp_MsgSuccess_)(nil),
 		(*CloseSessionResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[27].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[43].OneofWrappers = []interface{}{
 		(*DeployAssetERC20Resp_MsgSuccess_)(nil),
 		(*DeployAssetERC20Resp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[29].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[45].OneofWrappers = []interface{}{
 		(*SendPayChUpdateResp_MsgSuccess_)(nil),
 		(*SendPayChUpdateResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[31].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[47].OneofWrappers = []interface{}{
 		(*SubPayChUpdatesResp_Notify_)(nil),
 		(*SubPayChUpdatesResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[33].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[49].OneofWrappers = []interface{}{
 		(*UnsubPayChUpdatesResp_MsgSuccess_)(nil),
 		(*UnsubPayChUpdatesResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[51].OneofWrappers = []interface{}{
 		(*RespondPayChUpdateResp_MsgSuccess_)(nil),
 		(*RespondPayChUpdateResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[37].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*GetPayChInfoResp_MsgSuccess_)(nil),
 		(*GetPayChInfoResp_Error)(nil),
 	}
-	file_payment_service_proto_msgTypes[39].OneofWrappers = []interface{}{
+	file_payment_service_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*GetPayChHistoryResp_MsgSuccess_)(nil),
+		(*GetPayChHistoryResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[57].OneofWrappers = []interface{}{
 		(*ClosePayChResp_MsgSuccess_)(nil),
 		(*ClosePayChResp_Error)(nil),
 	}
+	file_payment_service_proto_msgTypes[60].OneofWrappers = []interface{}{
+		(*StartPaymentStreamResp_MsgSuccess_)(nil),
+		(*StartPaymentStreamResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[62].OneofWrappers = []interface{}{
+		(*StopPaymentStreamResp_MsgSuccess_)(nil),
+		(*StopPaymentStreamResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[64].OneofWrappers = []interface{}{
+		(*SubPaymentStreamStatusResp_Notify_)(nil),
+		(*SubPaymentStreamStatusResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[66].OneofWrappers = []interface{}{
+		(*UnsubPaymentStreamStatusResp_MsgSuccess_)(nil),
+		(*UnsubPaymentStreamStatusResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[68].OneofWrappers = []interface{}{
+		(*CreatePaymentScheduleResp_MsgSuccess_)(nil),
+		(*CreatePaymentScheduleResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[70].OneofWrappers = []interface{}{
+		(*ListPaymentSchedulesResp_MsgSuccess_)(nil),
+		(*ListPaymentSchedulesResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[72].OneofWrappers = []interface{}{
+		(*CancelPaymentScheduleResp_MsgSuccess_)(nil),
+		(*CancelPaymentScheduleResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[74].OneofWrappers = []interface{}{
+		(*PayResp_MsgSuccess_)(nil),
+		(*PayResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[76].OneofWrappers = []interface{}{
+		(*CreateInvoiceResp_MsgSuccess_)(nil),
+		(*CreateInvoiceResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[78].OneofWrappers = []interface{}{
+		(*GetInvoiceResp_MsgSuccess_)(nil),
+		(*GetInvoiceResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[80].OneofWrappers = []interface{}{
+		(*ListInvoicesResp_MsgSuccess_)(nil),
+		(*ListInvoicesResp_Error)(nil),
+	}
+	file_payment_service_proto_msgTypes[82].OneofWrappers = []interface{}{
+		(*PayInvoiceResp_MsgSuccess_)(nil),
+		(*PayInvoiceResp_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_payment_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   57,
+			NumEnums:      4,
+			NumMessages:   121,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
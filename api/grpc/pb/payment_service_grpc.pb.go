@@ -38,26 +38,47 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Payment_API_GetConfig_FullMethodName            = "/pb.Payment_API/GetConfig"
-	Payment_API_OpenSession_FullMethodName          = "/pb.Payment_API/OpenSession"
-	Payment_API_Time_FullMethodName                 = "/pb.Payment_API/Time"
-	Payment_API_RegisterCurrency_FullMethodName     = "/pb.Payment_API/RegisterCurrency"
-	Payment_API_Help_FullMethodName                 = "/pb.Payment_API/Help"
-	Payment_API_AddPeerID_FullMethodName            = "/pb.Payment_API/AddPeerID"
-	Payment_API_GetPeerID_FullMethodName            = "/pb.Payment_API/GetPeerID"
-	Payment_API_OpenPayCh_FullMethodName            = "/pb.Payment_API/OpenPayCh"
-	Payment_API_GetPayChsInfo_FullMethodName        = "/pb.Payment_API/GetPayChsInfo"
-	Payment_API_SubPayChProposals_FullMethodName    = "/pb.Payment_API/SubPayChProposals"
-	Payment_API_UnsubPayChProposals_FullMethodName  = "/pb.Payment_API/UnsubPayChProposals"
-	Payment_API_RespondPayChProposal_FullMethodName = "/pb.Payment_API/RespondPayChProposal"
-	Payment_API_CloseSession_FullMethodName         = "/pb.Payment_API/CloseSession"
-	Payment_API_DeployAssetERC20_FullMethodName     = "/pb.Payment_API/DeployAssetERC20"
-	Payment_API_SendPayChUpdate_FullMethodName      = "/pb.Payment_API/SendPayChUpdate"
-	Payment_API_SubPayChUpdates_FullMethodName      = "/pb.Payment_API/SubPayChUpdates"
-	Payment_API_UnsubPayChUpdates_FullMethodName    = "/pb.Payment_API/UnsubPayChUpdates"
-	Payment_API_RespondPayChUpdate_FullMethodName   = "/pb.Payment_API/RespondPayChUpdate"
-	Payment_API_GetPayChInfo_FullMethodName         = "/pb.Payment_API/GetPayChInfo"
-	Payment_API_ClosePayCh_FullMethodName           = "/pb.Payment_API/ClosePayCh"
+	Payment_API_GetConfig_FullMethodName                   = "/pb.Payment_API/GetConfig"
+	Payment_API_OpenSession_FullMethodName                 = "/pb.Payment_API/OpenSession"
+	Payment_API_Time_FullMethodName                        = "/pb.Payment_API/Time"
+	Payment_API_RegisterCurrency_FullMethodName            = "/pb.Payment_API/RegisterCurrency"
+	Payment_API_Help_FullMethodName                        = "/pb.Payment_API/Help"
+	Payment_API_AddPeerID_FullMethodName                   = "/pb.Payment_API/AddPeerID"
+	Payment_API_GetPeerID_FullMethodName                   = "/pb.Payment_API/GetPeerID"
+	Payment_API_ListPeerIDs_FullMethodName                 = "/pb.Payment_API/ListPeerIDs"
+	Payment_API_UpdatePeerID_FullMethodName                = "/pb.Payment_API/UpdatePeerID"
+	Payment_API_DeletePeerID_FullMethodName                = "/pb.Payment_API/DeletePeerID"
+	Payment_API_GetPeerStatus_FullMethodName               = "/pb.Payment_API/GetPeerStatus"
+	Payment_API_SubPeerStatus_FullMethodName               = "/pb.Payment_API/SubPeerStatus"
+	Payment_API_UnsubPeerStatus_FullMethodName             = "/pb.Payment_API/UnsubPeerStatus"
+	Payment_API_OpenPayCh_FullMethodName                   = "/pb.Payment_API/OpenPayCh"
+	Payment_API_OpenVirtualPayCh_FullMethodName            = "/pb.Payment_API/OpenVirtualPayCh"
+	Payment_API_GetPayChsInfo_FullMethodName               = "/pb.Payment_API/GetPayChsInfo"
+	Payment_API_SubPayChProposals_FullMethodName           = "/pb.Payment_API/SubPayChProposals"
+	Payment_API_UnsubPayChProposals_FullMethodName         = "/pb.Payment_API/UnsubPayChProposals"
+	Payment_API_RespondPayChProposal_FullMethodName        = "/pb.Payment_API/RespondPayChProposal"
+	Payment_API_RespondVirtualPayChProposal_FullMethodName = "/pb.Payment_API/RespondVirtualPayChProposal"
+	Payment_API_CloseSession_FullMethodName                = "/pb.Payment_API/CloseSession"
+	Payment_API_DeployAssetERC20_FullMethodName            = "/pb.Payment_API/DeployAssetERC20"
+	Payment_API_SendPayChUpdate_FullMethodName             = "/pb.Payment_API/SendPayChUpdate"
+	Payment_API_SubPayChUpdates_FullMethodName             = "/pb.Payment_API/SubPayChUpdates"
+	Payment_API_UnsubPayChUpdates_FullMethodName           = "/pb.Payment_API/UnsubPayChUpdates"
+	Payment_API_RespondPayChUpdate_FullMethodName          = "/pb.Payment_API/RespondPayChUpdate"
+	Payment_API_GetPayChInfo_FullMethodName                = "/pb.Payment_API/GetPayChInfo"
+	Payment_API_GetPayChHistory_FullMethodName             = "/pb.Payment_API/GetPayChHistory"
+	Payment_API_ClosePayCh_FullMethodName                  = "/pb.Payment_API/ClosePayCh"
+	Payment_API_StartPaymentStream_FullMethodName          = "/pb.Payment_API/StartPaymentStream"
+	Payment_API_StopPaymentStream_FullMethodName           = "/pb.Payment_API/StopPaymentStream"
+	Payment_API_SubPaymentStreamStatus_FullMethodName      = "/pb.Payment_API/SubPaymentStreamStatus"
+	Payment_API_UnsubPaymentStreamStatus_FullMethodName    = "/pb.Payment_API/UnsubPaymentStreamStatus"
+	Payment_API_CreatePaymentSchedule_FullMethodName       = "/pb.Payment_API/CreatePaymentSchedule"
+	Payment_API_ListPaymentSchedules_FullMethodName        = "/pb.Payment_API/ListPaymentSchedules"
+	Payment_API_CancelPaymentSchedule_FullMethodName       = "/pb.Payment_API/CancelPaymentSchedule"
+	Payment_API_Pay_FullMethodName                         = "/pb.Payment_API/Pay"
+	Payment_API_CreateInvoice_FullMethodName               = "/pb.Payment_API/CreateInvoice"
+	Payment_API_GetInvoice_FullMethodName                  = "/pb.Payment_API/GetInvoice"
+	Payment_API_ListInvoices_FullMethodName                = "/pb.Payment_API/ListInvoices"
+	Payment_API_PayInvoice_FullMethodName                  = "/pb.Payment_API/PayInvoice"
 )
 
 // Payment_APIClient is the client API for Payment_API service.
@@ -71,11 +92,19 @@ type Payment_APIClient interface {
 	Help(ctx context.Context, in *HelpReq, opts ...grpc.CallOption) (*HelpResp, error)
 	AddPeerID(ctx context.Context, in *AddPeerIDReq, opts ...grpc.CallOption) (*AddPeerIDResp, error)
 	GetPeerID(ctx context.Context, in *GetPeerIDReq, opts ...grpc.CallOption) (*GetPeerIDResp, error)
+	ListPeerIDs(ctx context.Context, in *ListPeerIDsReq, opts ...grpc.CallOption) (*ListPeerIDsResp, error)
+	UpdatePeerID(ctx context.Context, in *UpdatePeerIDReq, opts ...grpc.CallOption) (*UpdatePeerIDResp, error)
+	DeletePeerID(ctx context.Context, in *DeletePeerIDReq, opts ...grpc.CallOption) (*DeletePeerIDResp, error)
+	GetPeerStatus(ctx context.Context, in *GetPeerStatusReq, opts ...grpc.CallOption) (*GetPeerStatusResp, error)
+	SubPeerStatus(ctx context.Context, in *SubPeerStatusReq, opts ...grpc.CallOption) (Payment_API_SubPeerStatusClient, error)
+	UnsubPeerStatus(ctx context.Context, in *UnsubPeerStatusReq, opts ...grpc.CallOption) (*UnsubPeerStatusResp, error)
 	OpenPayCh(ctx context.Context, in *OpenPayChReq, opts ...grpc.CallOption) (*OpenPayChResp, error)
+	OpenVirtualPayCh(ctx context.Context, in *OpenVirtualPayChReq, opts ...grpc.CallOption) (*OpenVirtualPayChResp, error)
 	GetPayChsInfo(ctx context.Context, in *GetPayChsInfoReq, opts ...grpc.CallOption) (*GetPayChsInfoResp, error)
 	SubPayChProposals(ctx context.Context, in *SubPayChProposalsReq, opts ...grpc.CallOption) (Payment_API_SubPayChProposalsClient, error)
 	UnsubPayChProposals(ctx context.Context, in *UnsubPayChProposalsReq, opts ...grpc.CallOption) (*UnsubPayChProposalsResp, error)
 	RespondPayChProposal(ctx context.Context, in *RespondPayChProposalReq, opts ...grpc.CallOption) (*RespondPayChProposalResp, error)
+	RespondVirtualPayChProposal(ctx context.Context, in *RespondVirtualPayChProposalReq, opts ...grpc.CallOption) (*RespondVirtualPayChProposalResp, error)
 	CloseSession(ctx context.Context, in *CloseSessionReq, opts ...grpc.CallOption) (*CloseSessionResp, error)
 	DeployAssetERC20(ctx context.Context, in *DeployAssetERC20Req, opts ...grpc.CallOption) (*DeployAssetERC20Resp, error)
 	SendPayChUpdate(ctx context.Context, in *SendPayChUpdateReq, opts ...grpc.CallOption) (*SendPayChUpdateResp, error)
@@ -83,7 +112,20 @@ type Payment_APIClient interface {
 	UnsubPayChUpdates(ctx context.Context, in *UnsubPayChUpdatesReq, opts ...grpc.CallOption) (*UnsubPayChUpdatesResp, error)
 	RespondPayChUpdate(ctx context.Context, in *RespondPayChUpdateReq, opts ...grpc.CallOption) (*RespondPayChUpdateResp, error)
 	GetPayChInfo(ctx context.Context, in *GetPayChInfoReq, opts ...grpc.CallOption) (*GetPayChInfoResp, error)
+	GetPayChHistory(ctx context.Context, in *GetPayChHistoryReq, opts ...grpc.CallOption) (*GetPayChHistoryResp, error)
 	ClosePayCh(ctx context.Context, in *ClosePayChReq, opts ...grpc.CallOption) (*ClosePayChResp, error)
+	StartPaymentStream(ctx context.Context, in *StartPaymentStreamReq, opts ...grpc.CallOption) (*StartPaymentStreamResp, error)
+	StopPaymentStream(ctx context.Context, in *StopPaymentStreamReq, opts ...grpc.CallOption) (*StopPaymentStreamResp, error)
+	SubPaymentStreamStatus(ctx context.Context, in *SubPaymentStreamStatusReq, opts ...grpc.CallOption) (Payment_API_SubPaymentStreamStatusClient, error)
+	UnsubPaymentStreamStatus(ctx context.Context, in *UnsubPaymentStreamStatusReq, opts ...grpc.CallOption) (*UnsubPaymentStreamStatusResp, error)
+	CreatePaymentSchedule(ctx context.Context, in *CreatePaymentScheduleReq, opts ...grpc.CallOption) (*CreatePaymentScheduleResp, error)
+	ListPaymentSchedules(ctx context.Context, in *ListPaymentSchedulesReq, opts ...grpc.CallOption) (*ListPaymentSchedulesResp, error)
+	CancelPaymentSchedule(ctx context.Context, in *CancelPaymentScheduleReq, opts ...grpc.CallOption) (*CancelPaymentScheduleResp, error)
+	Pay(ctx context.Context, in *PayReq, opts ...grpc.CallOption) (*PayResp, error)
+	CreateInvoice(ctx context.Context, in *CreateInvoiceReq, opts ...grpc.CallOption) (*CreateInvoiceResp, error)
+	GetInvoice(ctx context.Context, in *GetInvoiceReq, opts ...grpc.CallOption) (*GetInvoiceResp, error)
+	ListInvoices(ctx context.Context, in *ListInvoicesReq, opts ...grpc.CallOption) (*ListInvoicesResp, error)
+	PayInvoice(ctx context.Context, in *PayInvoiceReq, opts ...grpc.CallOption) (*PayInvoiceResp, error)
 }
 
 type payment_APIClient struct {
@@ -157,6 +199,83 @@ func (c *payment_APIClient) GetPeerID(ctx context.Context, in *GetPeerIDReq, opt
 	return out, nil
 }
 
+func (c *payment_APIClient) ListPeerIDs(ctx context.Context, in *ListPeerIDsReq, opts ...grpc.CallOption) (*ListPeerIDsResp, error) {
+	out := new(ListPeerIDsResp)
+	err := c.cc.Invoke(ctx, Payment_API_ListPeerIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) UpdatePeerID(ctx context.Context, in *UpdatePeerIDReq, opts ...grpc.CallOption) (*UpdatePeerIDResp, error) {
+	out := new(UpdatePeerIDResp)
+	err := c.cc.Invoke(ctx, Payment_API_UpdatePeerID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) DeletePeerID(ctx context.Context, in *DeletePeerIDReq, opts ...grpc.CallOption) (*DeletePeerIDResp, error) {
+	out := new(DeletePeerIDResp)
+	err := c.cc.Invoke(ctx, Payment_API_DeletePeerID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetPeerStatus(ctx context.Context, in *GetPeerStatusReq, opts ...grpc.CallOption) (*GetPeerStatusResp, error) {
+	out := new(GetPeerStatusResp)
+	err := c.cc.Invoke(ctx, Payment_API_GetPeerStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SubPeerStatus(ctx context.Context, in *SubPeerStatusReq, opts ...grpc.CallOption) (Payment_API_SubPeerStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Payment_API_ServiceDesc.Streams[0], Payment_API_SubPeerStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &payment_APISubPeerStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Payment_API_SubPeerStatusClient interface {
+	Recv() (*SubPeerStatusResp, error)
+	grpc.ClientStream
+}
+
+type payment_APISubPeerStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *payment_APISubPeerStatusClient) Recv() (*SubPeerStatusResp, error) {
+	m := new(SubPeerStatusResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *payment_APIClient) UnsubPeerStatus(ctx context.Context, in *UnsubPeerStatusReq, opts ...grpc.CallOption) (*UnsubPeerStatusResp, error) {
+	out := new(UnsubPeerStatusResp)
+	err := c.cc.Invoke(ctx, Payment_API_UnsubPeerStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *payment_APIClient) OpenPayCh(ctx context.Context, in *OpenPayChReq, opts ...grpc.CallOption) (*OpenPayChResp, error) {
 	out := new(OpenPayChResp)
 	err := c.cc.Invoke(ctx, Payment_API_OpenPayCh_FullMethodName, in, out, opts...)
@@ -166,6 +285,15 @@ func (c *payment_APIClient) OpenPayCh(ctx context.Context, in *OpenPayChReq, opt
 	return out, nil
 }
 
+func (c *payment_APIClient) OpenVirtualPayCh(ctx context.Context, in *OpenVirtualPayChReq, opts ...grpc.CallOption) (*OpenVirtualPayChResp, error) {
+	out := new(OpenVirtualPayChResp)
+	err := c.cc.Invoke(ctx, Payment_API_OpenVirtualPayCh_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *payment_APIClient) GetPayChsInfo(ctx context.Context, in *GetPayChsInfoReq, opts ...grpc.CallOption) (*GetPayChsInfoResp, error) {
 	out := new(GetPayChsInfoResp)
 	err := c.cc.Invoke(ctx, Payment_API_GetPayChsInfo_FullMethodName, in, out, opts...)
@@ -176,7 +304,7 @@ func (c *payment_APIClient) GetPayChsInfo(ctx context.Context, in *GetPayChsInfo
 }
 
 func (c *payment_APIClient) SubPayChProposals(ctx context.Context, in *SubPayChProposalsReq, opts ...grpc.CallOption) (Payment_API_SubPayChProposalsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Payment_API_ServiceDesc.Streams[0], Payment_API_SubPayChProposals_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Payment_API_ServiceDesc.Streams[1], Payment_API_SubPayChProposals_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +353,15 @@ func (c *payment_APIClient) RespondPayChProposal(ctx context.Context, in *Respon
 	return out, nil
 }
 
+func (c *payment_APIClient) RespondVirtualPayChProposal(ctx context.Context, in *RespondVirtualPayChProposalReq, opts ...grpc.CallOption) (*RespondVirtualPayChProposalResp, error) {
+	out := new(RespondVirtualPayChProposalResp)
+	err := c.cc.Invoke(ctx, Payment_API_RespondVirtualPayChProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *payment_APIClient) CloseSession(ctx context.Context, in *CloseSessionReq, opts ...grpc.CallOption) (*CloseSessionResp, error) {
 	out := new(CloseSessionResp)
 	err := c.cc.Invoke(ctx, Payment_API_CloseSession_FullMethodName, in, out, opts...)
@@ -253,7 +390,7 @@ func (c *payment_APIClient) SendPayChUpdate(ctx context.Context, in *SendPayChUp
 }
 
 func (c *payment_APIClient) SubPayChUpdates(ctx context.Context, in *SubpayChUpdatesReq, opts ...grpc.CallOption) (Payment_API_SubPayChUpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Payment_API_ServiceDesc.Streams[1], Payment_API_SubPayChUpdates_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Payment_API_ServiceDesc.Streams[2], Payment_API_SubPayChUpdates_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -311,6 +448,15 @@ func (c *payment_APIClient) GetPayChInfo(ctx context.Context, in *GetPayChInfoRe
 	return out, nil
 }
 
+func (c *payment_APIClient) GetPayChHistory(ctx context.Context, in *GetPayChHistoryReq, opts ...grpc.CallOption) (*GetPayChHistoryResp, error) {
+	out := new(GetPayChHistoryResp)
+	err := c.cc.Invoke(ctx, Payment_API_GetPayChHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *payment_APIClient) ClosePayCh(ctx context.Context, in *ClosePayChReq, opts ...grpc.CallOption) (*ClosePayChResp, error) {
 	out := new(ClosePayChResp)
 	err := c.cc.Invoke(ctx, Payment_API_ClosePayCh_FullMethodName, in, out, opts...)
@@ -320,6 +466,137 @@ func (c *payment_APIClient) ClosePayCh(ctx context.Context, in *ClosePayChReq, o
 	return out, nil
 }
 
+func (c *payment_APIClient) StartPaymentStream(ctx context.Context, in *StartPaymentStreamReq, opts ...grpc.CallOption) (*StartPaymentStreamResp, error) {
+	out := new(StartPaymentStreamResp)
+	err := c.cc.Invoke(ctx, Payment_API_StartPaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) StopPaymentStream(ctx context.Context, in *StopPaymentStreamReq, opts ...grpc.CallOption) (*StopPaymentStreamResp, error) {
+	out := new(StopPaymentStreamResp)
+	err := c.cc.Invoke(ctx, Payment_API_StopPaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SubPaymentStreamStatus(ctx context.Context, in *SubPaymentStreamStatusReq, opts ...grpc.CallOption) (Payment_API_SubPaymentStreamStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Payment_API_ServiceDesc.Streams[3], Payment_API_SubPaymentStreamStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &payment_APISubPaymentStreamStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Payment_API_SubPaymentStreamStatusClient interface {
+	Recv() (*SubPaymentStreamStatusResp, error)
+	grpc.ClientStream
+}
+
+type payment_APISubPaymentStreamStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *payment_APISubPaymentStreamStatusClient) Recv() (*SubPaymentStreamStatusResp, error) {
+	m := new(SubPaymentStreamStatusResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *payment_APIClient) UnsubPaymentStreamStatus(ctx context.Context, in *UnsubPaymentStreamStatusReq, opts ...grpc.CallOption) (*UnsubPaymentStreamStatusResp, error) {
+	out := new(UnsubPaymentStreamStatusResp)
+	err := c.cc.Invoke(ctx, Payment_API_UnsubPaymentStreamStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) CreatePaymentSchedule(ctx context.Context, in *CreatePaymentScheduleReq, opts ...grpc.CallOption) (*CreatePaymentScheduleResp, error) {
+	out := new(CreatePaymentScheduleResp)
+	err := c.cc.Invoke(ctx, Payment_API_CreatePaymentSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) ListPaymentSchedules(ctx context.Context, in *ListPaymentSchedulesReq, opts ...grpc.CallOption) (*ListPaymentSchedulesResp, error) {
+	out := new(ListPaymentSchedulesResp)
+	err := c.cc.Invoke(ctx, Payment_API_ListPaymentSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) CancelPaymentSchedule(ctx context.Context, in *CancelPaymentScheduleReq, opts ...grpc.CallOption) (*CancelPaymentScheduleResp, error) {
+	out := new(CancelPaymentScheduleResp)
+	err := c.cc.Invoke(ctx, Payment_API_CancelPaymentSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) Pay(ctx context.Context, in *PayReq, opts ...grpc.CallOption) (*PayResp, error) {
+	out := new(PayResp)
+	err := c.cc.Invoke(ctx, Payment_API_Pay_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) CreateInvoice(ctx context.Context, in *CreateInvoiceReq, opts ...grpc.CallOption) (*CreateInvoiceResp, error) {
+	out := new(CreateInvoiceResp)
+	err := c.cc.Invoke(ctx, Payment_API_CreateInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetInvoice(ctx context.Context, in *GetInvoiceReq, opts ...grpc.CallOption) (*GetInvoiceResp, error) {
+	out := new(GetInvoiceResp)
+	err := c.cc.Invoke(ctx, Payment_API_GetInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) ListInvoices(ctx context.Context, in *ListInvoicesReq, opts ...grpc.CallOption) (*ListInvoicesResp, error) {
+	out := new(ListInvoicesResp)
+	err := c.cc.Invoke(ctx, Payment_API_ListInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) PayInvoice(ctx context.Context, in *PayInvoiceReq, opts ...grpc.CallOption) (*PayInvoiceResp, error) {
+	out := new(PayInvoiceResp)
+	err := c.cc.Invoke(ctx, Payment_API_PayInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Payment_APIServer is the server API for Payment_API service.
 // All implementations must embed UnimplementedPayment_APIServer
 // for forward compatibility
@@ -331,11 +608,19 @@ type Payment_APIServer interface {
 	Help(context.Context, *HelpReq) (*HelpResp, error)
 	AddPeerID(context.Context, *AddPeerIDReq) (*AddPeerIDResp, error)
 	GetPeerID(context.Context, *GetPeerIDReq) (*GetPeerIDResp, error)
+	ListPeerIDs(context.Context, *ListPeerIDsReq) (*ListPeerIDsResp, error)
+	UpdatePeerID(context.Context, *UpdatePeerIDReq) (*UpdatePeerIDResp, error)
+	DeletePeerID(context.Context, *DeletePeerIDReq) (*DeletePeerIDResp, error)
+	GetPeerStatus(context.Context, *GetPeerStatusReq) (*GetPeerStatusResp, error)
+	SubPeerStatus(*SubPeerStatusReq, Payment_API_SubPeerStatusServer) error
+	UnsubPeerStatus(context.Context, *UnsubPeerStatusReq) (*UnsubPeerStatusResp, error)
 	OpenPayCh(context.Context, *OpenPayChReq) (*OpenPayChResp, error)
+	OpenVirtualPayCh(context.Context, *OpenVirtualPayChReq) (*OpenVirtualPayChResp, error)
 	GetPayChsInfo(context.Context, *GetPayChsInfoReq) (*GetPayChsInfoResp, error)
 	SubPayChProposals(*SubPayChProposalsReq, Payment_API_SubPayChProposalsServer) error
 	UnsubPayChProposals(context.Context, *UnsubPayChProposalsReq) (*UnsubPayChProposalsResp, error)
 	RespondPayChProposal(context.Context, *RespondPayChProposalReq) (*RespondPayChProposalResp, error)
+	RespondVirtualPayChProposal(context.Context, *RespondVirtualPayChProposalReq) (*RespondVirtualPayChProposalResp, error)
 	CloseSession(context.Context, *CloseSessionReq) (*CloseSessionResp, error)
 	DeployAssetERC20(context.Context, *DeployAssetERC20Req) (*DeployAssetERC20Resp, error)
 	SendPayChUpdate(context.Context, *SendPayChUpdateReq) (*SendPayChUpdateResp, error)
@@ -343,7 +628,20 @@ type Payment_APIServer interface {
 	UnsubPayChUpdates(context.Context, *UnsubPayChUpdatesReq) (*UnsubPayChUpdatesResp, error)
 	RespondPayChUpdate(context.Context, *RespondPayChUpdateReq) (*RespondPayChUpdateResp, error)
 	GetPayChInfo(context.Context, *GetPayChInfoReq) (*GetPayChInfoResp, error)
+	GetPayChHistory(context.Context, *GetPayChHistoryReq) (*GetPayChHistoryResp, error)
 	ClosePayCh(context.Context, *ClosePayChReq) (*ClosePayChResp, error)
+	StartPaymentStream(context.Context, *StartPaymentStreamReq) (*StartPaymentStreamResp, error)
+	StopPaymentStream(context.Context, *StopPaymentStreamReq) (*StopPaymentStreamResp, error)
+	SubPaymentStreamStatus(*SubPaymentStreamStatusReq, Payment_API_SubPaymentStreamStatusServer) error
+	UnsubPaymentStreamStatus(context.Context, *UnsubPaymentStreamStatusReq) (*UnsubPaymentStreamStatusResp, error)
+	CreatePaymentSchedule(context.Context, *CreatePaymentScheduleReq) (*CreatePaymentScheduleResp, error)
+	ListPaymentSchedules(context.Context, *ListPaymentSchedulesReq) (*ListPaymentSchedulesResp, error)
+	CancelPaymentSchedule(context.Context, *CancelPaymentScheduleReq) (*CancelPaymentScheduleResp, error)
+	Pay(context.Context, *PayReq) (*PayResp, error)
+	CreateInvoice(context.Context, *CreateInvoiceReq) (*CreateInvoiceResp, error)
+	GetInvoice(context.Context, *GetInvoiceReq) (*GetInvoiceResp, error)
+	ListInvoices(context.Context, *ListInvoicesReq) (*ListInvoicesResp, error)
+	PayInvoice(context.Context, *PayInvoiceReq) (*PayInvoiceResp, error)
 	mustEmbedUnimplementedPayment_APIServer()
 }
 
@@ -372,9 +670,30 @@ func (UnimplementedPayment_APIServer) AddPeerID(context.Context, *AddPeerIDReq)
 func (UnimplementedPayment_APIServer) GetPeerID(context.Context, *GetPeerIDReq) (*GetPeerIDResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeerID not implemented")
 }
+func (UnimplementedPayment_APIServer) ListPeerIDs(context.Context, *ListPeerIDsReq) (*ListPeerIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerIDs not implemented")
+}
+func (UnimplementedPayment_APIServer) UpdatePeerID(context.Context, *UpdatePeerIDReq) (*UpdatePeerIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePeerID not implemented")
+}
+func (UnimplementedPayment_APIServer) DeletePeerID(context.Context, *DeletePeerIDReq) (*DeletePeerIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePeerID not implemented")
+}
+func (UnimplementedPayment_APIServer) GetPeerStatus(context.Context, *GetPeerStatusReq) (*GetPeerStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerStatus not implemented")
+}
+func (UnimplementedPayment_APIServer) SubPeerStatus(*SubPeerStatusReq, Payment_API_SubPeerStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubPeerStatus not implemented")
+}
+func (UnimplementedPayment_APIServer) UnsubPeerStatus(context.Context, *UnsubPeerStatusReq) (*UnsubPeerStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubPeerStatus not implemented")
+}
 func (UnimplementedPayment_APIServer) OpenPayCh(context.Context, *OpenPayChReq) (*OpenPayChResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenPayCh not implemented")
 }
+func (UnimplementedPayment_APIServer) OpenVirtualPayCh(context.Context, *OpenVirtualPayChReq) (*OpenVirtualPayChResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenVirtualPayCh not implemented")
+}
 func (UnimplementedPayment_APIServer) GetPayChsInfo(context.Context, *GetPayChsInfoReq) (*GetPayChsInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPayChsInfo not implemented")
 }
@@ -387,6 +706,9 @@ func (UnimplementedPayment_APIServer) UnsubPayChProposals(context.Context, *Unsu
 func (UnimplementedPayment_APIServer) RespondPayChProposal(context.Context, *RespondPayChProposalReq) (*RespondPayChProposalResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RespondPayChProposal not implemented")
 }
+func (UnimplementedPayment_APIServer) RespondVirtualPayChProposal(context.Context, *RespondVirtualPayChProposalReq) (*RespondVirtualPayChProposalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondVirtualPayChProposal not implemented")
+}
 func (UnimplementedPayment_APIServer) CloseSession(context.Context, *CloseSessionReq) (*CloseSessionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
 }
@@ -408,9 +730,48 @@ func (UnimplementedPayment_APIServer) RespondPayChUpdate(context.Context, *Respo
 func (UnimplementedPayment_APIServer) GetPayChInfo(context.Context, *GetPayChInfoReq) (*GetPayChInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPayChInfo not implemented")
 }
+func (UnimplementedPayment_APIServer) GetPayChHistory(context.Context, *GetPayChHistoryReq) (*GetPayChHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayChHistory not implemented")
+}
 func (UnimplementedPayment_APIServer) ClosePayCh(context.Context, *ClosePayChReq) (*ClosePayChResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClosePayCh not implemented")
 }
+func (UnimplementedPayment_APIServer) StartPaymentStream(context.Context, *StartPaymentStreamReq) (*StartPaymentStreamResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPaymentStream not implemented")
+}
+func (UnimplementedPayment_APIServer) StopPaymentStream(context.Context, *StopPaymentStreamReq) (*StopPaymentStreamResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopPaymentStream not implemented")
+}
+func (UnimplementedPayment_APIServer) SubPaymentStreamStatus(*SubPaymentStreamStatusReq, Payment_API_SubPaymentStreamStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubPaymentStreamStatus not implemented")
+}
+func (UnimplementedPayment_APIServer) UnsubPaymentStreamStatus(context.Context, *UnsubPaymentStreamStatusReq) (*UnsubPaymentStreamStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubPaymentStreamStatus not implemented")
+}
+func (UnimplementedPayment_APIServer) CreatePaymentSchedule(context.Context, *CreatePaymentScheduleReq) (*CreatePaymentScheduleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentSchedule not implemented")
+}
+func (UnimplementedPayment_APIServer) ListPaymentSchedules(context.Context, *ListPaymentSchedulesReq) (*ListPaymentSchedulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentSchedules not implemented")
+}
+func (UnimplementedPayment_APIServer) CancelPaymentSchedule(context.Context, *CancelPaymentScheduleReq) (*CancelPaymentScheduleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentSchedule not implemented")
+}
+func (UnimplementedPayment_APIServer) Pay(context.Context, *PayReq) (*PayResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pay not implemented")
+}
+func (UnimplementedPayment_APIServer) CreateInvoice(context.Context, *CreateInvoiceReq) (*CreateInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
+}
+func (UnimplementedPayment_APIServer) GetInvoice(context.Context, *GetInvoiceReq) (*GetInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedPayment_APIServer) ListInvoices(context.Context, *ListInvoicesReq) (*ListInvoicesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
+}
+func (UnimplementedPayment_APIServer) PayInvoice(context.Context, *PayInvoiceReq) (*PayInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayInvoice not implemented")
+}
 func (UnimplementedPayment_APIServer) mustEmbedUnimplementedPayment_APIServer() {}
 
 // UnsafePayment_APIServer may be embedded to opt out of forward compatibility for this service.
@@ -550,6 +911,117 @@ func _Payment_API_GetPeerID_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_API_ListPeerIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeerIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).ListPeerIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_ListPeerIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).ListPeerIDs(ctx, req.(*ListPeerIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_UpdatePeerID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePeerIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).UpdatePeerID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_UpdatePeerID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).UpdatePeerID(ctx, req.(*UpdatePeerIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_DeletePeerID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePeerIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).DeletePeerID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_DeletePeerID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).DeletePeerID(ctx, req.(*DeletePeerIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_GetPeerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetPeerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_GetPeerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetPeerStatus(ctx, req.(*GetPeerStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_SubPeerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubPeerStatusReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Payment_APIServer).SubPeerStatus(m, &payment_APISubPeerStatusServer{stream})
+}
+
+type Payment_API_SubPeerStatusServer interface {
+	Send(*SubPeerStatusResp) error
+	grpc.ServerStream
+}
+
+type payment_APISubPeerStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *payment_APISubPeerStatusServer) Send(m *SubPeerStatusResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Payment_API_UnsubPeerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubPeerStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).UnsubPeerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_UnsubPeerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).UnsubPeerStatus(ctx, req.(*UnsubPeerStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payment_API_OpenPayCh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenPayChReq)
 	if err := dec(in); err != nil {
@@ -568,6 +1040,24 @@ func _Payment_API_OpenPayCh_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_API_OpenVirtualPayCh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenVirtualPayChReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).OpenVirtualPayCh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_OpenVirtualPayCh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).OpenVirtualPayCh(ctx, req.(*OpenVirtualPayChReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payment_API_GetPayChsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPayChsInfoReq)
 	if err := dec(in); err != nil {
@@ -643,6 +1133,24 @@ func _Payment_API_RespondPayChProposal_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_API_RespondVirtualPayChProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondVirtualPayChProposalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).RespondVirtualPayChProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_RespondVirtualPayChProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).RespondVirtualPayChProposal(ctx, req.(*RespondVirtualPayChProposalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payment_API_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CloseSessionReq)
 	if err := dec(in); err != nil {
@@ -772,6 +1280,24 @@ func _Payment_API_GetPayChInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_API_GetPayChHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayChHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetPayChHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_GetPayChHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetPayChHistory(ctx, req.(*GetPayChHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payment_API_ClosePayCh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClosePayChReq)
 	if err := dec(in); err != nil {
@@ -790,6 +1316,225 @@ func _Payment_API_ClosePayCh_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_API_StartPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPaymentStreamReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).StartPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_StartPaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).StartPaymentStream(ctx, req.(*StartPaymentStreamReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_StopPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPaymentStreamReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).StopPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_StopPaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).StopPaymentStream(ctx, req.(*StopPaymentStreamReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_SubPaymentStreamStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubPaymentStreamStatusReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Payment_APIServer).SubPaymentStreamStatus(m, &payment_APISubPaymentStreamStatusServer{stream})
+}
+
+type Payment_API_SubPaymentStreamStatusServer interface {
+	Send(*SubPaymentStreamStatusResp) error
+	grpc.ServerStream
+}
+
+type payment_APISubPaymentStreamStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *payment_APISubPaymentStreamStatusServer) Send(m *SubPaymentStreamStatusResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Payment_API_UnsubPaymentStreamStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubPaymentStreamStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).UnsubPaymentStreamStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_UnsubPaymentStreamStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).UnsubPaymentStreamStatus(ctx, req.(*UnsubPaymentStreamStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_CreatePaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentScheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).CreatePaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_CreatePaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).CreatePaymentSchedule(ctx, req.(*CreatePaymentScheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_ListPaymentSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentSchedulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).ListPaymentSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_ListPaymentSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).ListPaymentSchedules(ctx, req.(*ListPaymentSchedulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_CancelPaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentScheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).CancelPaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_CancelPaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).CancelPaymentSchedule(ctx, req.(*CancelPaymentScheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_Pay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).Pay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_Pay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).Pay(ctx, req.(*PayReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvoiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).CreateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_CreateInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).CreateInvoice(ctx, req.(*CreateInvoiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetInvoice(ctx, req.(*GetInvoiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoicesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_ListInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).ListInvoices(ctx, req.(*ListInvoicesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_API_PayInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayInvoiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).PayInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_API_PayInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).PayInvoice(ctx, req.(*PayInvoiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Payment_API_ServiceDesc is the grpc.ServiceDesc for Payment_API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -825,10 +1570,34 @@ var Payment_API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeerID",
 			Handler:    _Payment_API_GetPeerID_Handler,
 		},
+		{
+			MethodName: "ListPeerIDs",
+			Handler:    _Payment_API_ListPeerIDs_Handler,
+		},
+		{
+			MethodName: "UpdatePeerID",
+			Handler:    _Payment_API_UpdatePeerID_Handler,
+		},
+		{
+			MethodName: "DeletePeerID",
+			Handler:    _Payment_API_DeletePeerID_Handler,
+		},
+		{
+			MethodName: "GetPeerStatus",
+			Handler:    _Payment_API_GetPeerStatus_Handler,
+		},
+		{
+			MethodName: "UnsubPeerStatus",
+			Handler:    _Payment_API_UnsubPeerStatus_Handler,
+		},
 		{
 			MethodName: "OpenPayCh",
 			Handler:    _Payment_API_OpenPayCh_Handler,
 		},
+		{
+			MethodName: "OpenVirtualPayCh",
+			Handler:    _Payment_API_OpenVirtualPayCh_Handler,
+		},
 		{
 			MethodName: "GetPayChsInfo",
 			Handler:    _Payment_API_GetPayChsInfo_Handler,
@@ -841,6 +1610,10 @@ var Payment_API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RespondPayChProposal",
 			Handler:    _Payment_API_RespondPayChProposal_Handler,
 		},
+		{
+			MethodName: "RespondVirtualPayChProposal",
+			Handler:    _Payment_API_RespondVirtualPayChProposal_Handler,
+		},
 		{
 			MethodName: "CloseSession",
 			Handler:    _Payment_API_CloseSession_Handler,
@@ -865,12 +1638,65 @@ var Payment_API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPayChInfo",
 			Handler:    _Payment_API_GetPayChInfo_Handler,
 		},
+		{
+			MethodName: "GetPayChHistory",
+			Handler:    _Payment_API_GetPayChHistory_Handler,
+		},
 		{
 			MethodName: "ClosePayCh",
 			Handler:    _Payment_API_ClosePayCh_Handler,
 		},
+		{
+			MethodName: "StartPaymentStream",
+			Handler:    _Payment_API_StartPaymentStream_Handler,
+		},
+		{
+			MethodName: "StopPaymentStream",
+			Handler:    _Payment_API_StopPaymentStream_Handler,
+		},
+		{
+			MethodName: "UnsubPaymentStreamStatus",
+			Handler:    _Payment_API_UnsubPaymentStreamStatus_Handler,
+		},
+		{
+			MethodName: "CreatePaymentSchedule",
+			Handler:    _Payment_API_CreatePaymentSchedule_Handler,
+		},
+		{
+			MethodName: "ListPaymentSchedules",
+			Handler:    _Payment_API_ListPaymentSchedules_Handler,
+		},
+		{
+			MethodName: "CancelPaymentSchedule",
+			Handler:    _Payment_API_CancelPaymentSchedule_Handler,
+		},
+		{
+			MethodName: "Pay",
+			Handler:    _Payment_API_Pay_Handler,
+		},
+		{
+			MethodName: "CreateInvoice",
+			Handler:    _Payment_API_CreateInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _Payment_API_GetInvoice_Handler,
+		},
+		{
+			MethodName: "ListInvoices",
+			Handler:    _Payment_API_ListInvoices_Handler,
+		},
+		{
+			MethodName: "PayInvoice",
+			Handler:    _Payment_API_PayInvoice_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubPeerStatus",
+			Handler:       _Payment_API_SubPeerStatus_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubPayChProposals",
 			Handler:       _Payment_API_SubPayChProposals_Handler,
@@ -881,6 +1707,11 @@ var Payment_API_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Payment_API_SubPayChUpdates_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubPaymentStreamStatus",
+			Handler:       _Payment_API_SubPaymentStreamStatus_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "payment_service.proto",
 }
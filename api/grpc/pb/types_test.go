@@ -18,11 +18,14 @@ package pb_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/app/payment"
 )
 
 func Test_ChUpdateType(t *testing.T) {
@@ -30,3 +33,120 @@ func Test_ChUpdateType(t *testing.T) {
 	assert.EqualValues(t, perun.ChUpdateTypeFinal, pb.SubPayChUpdatesResp_Notify_final)
 	assert.EqualValues(t, perun.ChUpdateTypeClosed, pb.SubPayChUpdatesResp_Notify_closed)
 }
+
+func Test_AppChUpdateType(t *testing.T) {
+	assert.EqualValues(t, perun.ChUpdateTypeOpen, pb.SubAppChUpdatesResp_Notify_open)
+	assert.EqualValues(t, perun.ChUpdateTypeFinal, pb.SubAppChUpdatesResp_Notify_final)
+	assert.EqualValues(t, perun.ChUpdateTypeClosed, pb.SubAppChUpdatesResp_Notify_closed)
+}
+
+func Test_AutoResponse(t *testing.T) {
+	assert.EqualValues(t, perun.AutoResponseNone, pb.SubPayChProposalsResp_Notify_none)
+	assert.EqualValues(t, perun.AutoResponseAccepted, pb.SubPayChProposalsResp_Notify_accepted)
+	assert.EqualValues(t, perun.AutoResponseRejected, pb.SubPayChProposalsResp_Notify_rejected)
+	assert.EqualValues(t, perun.AutoResponseNone, pb.SubPayChUpdatesResp_Notify_none)
+	assert.EqualValues(t, perun.AutoResponseAccepted, pb.SubPayChUpdatesResp_Notify_accepted)
+	assert.EqualValues(t, perun.AutoResponseRejected, pb.SubPayChUpdatesResp_Notify_rejected)
+}
+
+func Test_PaymentDirection(t *testing.T) {
+	assert.EqualValues(t, perun.PaymentDirectionNone, pb.ChHistoryEntry_none)
+	assert.EqualValues(t, perun.PaymentDirectionIncoming, pb.ChHistoryEntry_incoming)
+	assert.EqualValues(t, perun.PaymentDirectionOutgoing, pb.ChHistoryEntry_outgoing)
+	assert.EqualValues(t, perun.PaymentDirectionMixed, pb.ChHistoryEntry_mixed)
+}
+
+func Test_FromPaymentStreamStatus(t *testing.T) {
+	for state, want := range map[payment.StreamState]pb.PaymentStreamStatus_State{
+		payment.StreamRunning:   pb.PaymentStreamStatus_running,
+		payment.StreamCompleted: pb.PaymentStreamStatus_completed,
+		payment.StreamStopped:   pb.PaymentStreamStatus_stopped,
+		payment.StreamFailed:    pb.PaymentStreamStatus_failed,
+	} {
+		got := pb.FromPaymentStreamStatus(payment.StreamStatus{State: state, Interval: 1500 * time.Millisecond})
+		assert.Equal(t, want, got.State)
+		assert.Equal(t, uint64(1500), got.IntervalMillis)
+		assert.Nil(t, got.Error)
+	}
+
+	apiErr := perun.NewAPIErrPeerRejected(errors.New("rejected"), "peer", "rejected")
+	got := pb.FromPaymentStreamStatus(payment.StreamStatus{State: payment.StreamFailed, Error: apiErr})
+	assert.Equal(t, pb.FromError(apiErr), got.Error)
+}
+
+func Test_ScheduleUnit(t *testing.T) {
+	assert.EqualValues(t, perun.ScheduleUnitMinute, pb.PaymentSchedule_minute)
+	assert.EqualValues(t, perun.ScheduleUnitHour, pb.PaymentSchedule_hour)
+	assert.EqualValues(t, perun.ScheduleUnitDay, pb.PaymentSchedule_day)
+	assert.EqualValues(t, perun.ScheduleUnitWeek, pb.PaymentSchedule_week)
+	assert.EqualValues(t, perun.ScheduleUnitMonth, pb.PaymentSchedule_month)
+}
+
+func Test_PaymentScheduleStatus(t *testing.T) {
+	assert.EqualValues(t, perun.PaymentScheduleActive, pb.PaymentSchedule_active)
+	assert.EqualValues(t, perun.PaymentScheduleCancelled, pb.PaymentSchedule_cancelled)
+	assert.EqualValues(t, perun.PaymentScheduleFailed, pb.PaymentSchedule_failed)
+}
+
+func Test_PaymentSchedule(t *testing.T) {
+	sch := perun.PaymentSchedule{
+		ID:         "a1b2",
+		ChID:       "ch-id",
+		Payee:      "bob",
+		Currency:   "ETH",
+		Amount:     "0.5",
+		Every:      2,
+		Unit:       perun.ScheduleUnitWeek,
+		NextAt:     1700000000,
+		Payments:   3,
+		LastPaidAt: 1690000000,
+		Status:     perun.PaymentScheduleFailed,
+		LastError:  "peer rejected",
+	}
+	assert.Equal(t, sch, pb.ToPaymentSchedule(pb.FromPaymentSchedule(sch)))
+	assert.Len(t, pb.FromPaymentSchedules([]perun.PaymentSchedule{sch, sch}), 2)
+}
+
+func Test_FromRoutedPayment(t *testing.T) {
+	p := perun.RoutedPayment{
+		Hash:     "6e34",
+		Preimage: "00",
+		Route:    []string{"0xbbbb", "0xcccc"},
+		Amount:   "0.11",
+		Fee:      "0.01",
+	}
+	got := pb.FromRoutedPayment(p)
+	assert.Equal(t, p.Hash, got.Hash)
+	assert.Equal(t, p.Preimage, got.Preimage)
+	assert.Equal(t, p.Route, got.Route)
+	assert.Equal(t, p.Amount, got.Amount)
+	assert.Equal(t, p.Fee, got.Fee)
+}
+
+func Test_InvoiceStatus(t *testing.T) {
+	assert.EqualValues(t, perun.InvoiceOpen, pb.Invoice_open)
+	assert.EqualValues(t, perun.InvoicePaid, pb.Invoice_paid)
+	assert.EqualValues(t, perun.InvoiceExpired, pb.Invoice_expired)
+}
+
+func Test_FromInvoice(t *testing.T) {
+	inv := perun.Invoice{
+		ID:        "a1b2",
+		Payee:     "0xbbbb",
+		Currency:  "ETH",
+		Amount:    "0.5",
+		Memo:      "memo",
+		CreatedAt: 1690000000,
+		Expiry:    1700000000,
+		Status:    perun.InvoicePaid,
+		ChID:      "ch-id",
+		Version:   3,
+		PaidAt:    1695000000,
+	}
+	got := pb.FromInvoice(inv)
+	assert.Equal(t, inv.ID, got.Id)
+	assert.Equal(t, pb.Invoice_paid, got.Status)
+	assert.Equal(t, inv.Version, got.Version)
+	assert.Equal(t, inv.PaidAt, got.PaidAt)
+	assert.Len(t, pb.FromInvoices([]perun.Invoice{inv, inv}), 2)
+}
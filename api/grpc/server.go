@@ -25,16 +25,18 @@ import (
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/app/payment/invoice"
 )
 
 // ServePaymentAPI starts a payment channel API server that listens for incoming grpc
 // requests at the specified address and serves those requests using the node API instance.
+//
+// The node administration API and the app channel API are also served on the
+// same server.
 func ServePaymentAPI(n perun.NodeAPI, grpcPort string) error {
-	paymentChServer := &payChAPIServer{
-		n:                n,
-		chProposalsNotif: make(map[string]chan bool),
-		chUpdatesNotif:   make(map[string]map[string]chan bool),
-	}
+	paymentChServer := newPayChAPIServer(n)
+	adminServer := &adminServer{n: n}
 
 	listener, err := net.Listen("tcp", grpcPort)
 	if err != nil {
@@ -42,6 +44,8 @@ func ServePaymentAPI(n perun.NodeAPI, grpcPort string) error {
 	}
 	grpcServer := grpclib.NewServer()
 	pb.RegisterPayment_APIServer(grpcServer, paymentChServer)
+	pb.RegisterAdmin_APIServer(grpcServer, adminServer)
+	pb.RegisterApp_APIServer(grpcServer, newAppChAPIServer(n))
 
 	return grpcServer.Serve(listener)
 }
@@ -49,11 +53,7 @@ func ServePaymentAPI(n perun.NodeAPI, grpcPort string) error {
 // ServeFundingWatchingAPI starts a payment channel API server that listens for incoming grpc
 // requests at the specified address and serves those requests using the node API instance.
 func ServeFundingWatchingAPI(n perun.NodeAPI, grpcPort string) error {
-	paymentChServer := &payChAPIServer{
-		n:                n,
-		chProposalsNotif: make(map[string]chan bool),
-		chUpdatesNotif:   make(map[string]map[string]chan bool),
-	}
+	paymentChServer := newPayChAPIServer(n)
 	fundingServer := &fundingServer{
 		n:          n,
 		subscribes: make(map[string]map[pchannel.ID]pchannel.AdjudicatorSubscription),
@@ -74,3 +74,25 @@ func ServeFundingWatchingAPI(n perun.NodeAPI, grpcPort string) error {
 
 	return grpcServer.Serve(listener)
 }
+
+// newPayChAPIServer returns a payment channel API server that serves the
+// requests using the node API instance.
+//
+// The invoice managers of the sessions that are already open on the node are
+// initialized right away, so that the payments for their invoices are received
+// even before the invoice APIs are used.
+func newPayChAPIServer(n perun.NodeAPI) *payChAPIServer {
+	a := &payChAPIServer{
+		n:                n,
+		chProposalsNotif: make(map[string]chan bool),
+		chUpdatesNotif:   make(map[string]map[string]chan bool),
+		peerStatusNotif:  make(map[string]map[string]chan bool),
+		payStreams:       make(map[string]*payment.Streams),
+		payStreamsNotif:  make(map[string]map[string]chan bool),
+		invoices:         make(map[string]*invoice.Manager),
+	}
+	for _, sessInfo := range n.ListSessions() {
+		a.initInvoices(sessInfo.ID)
+	}
+	return a
+}
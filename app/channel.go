@@ -0,0 +1,205 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Error type is used to define error constants for this package.
+type Error string
+
+// Error implements error interface.
+func (e Error) Error() string {
+	return string(e)
+}
+
+// Definition of error constants for this package.
+const (
+	ErrInvalidInitParams Error = "invalid init params"
+	ErrInvalidAction     Error = "invalid action"
+	ErrNotAppCh          Error = "channel does not use a registered app"
+)
+
+type (
+	// ChInfo represents the interpretation of channel info for app
+	// channels. App is the name of the app and Data is the binary encoding of
+	// the app data. Both are empty for channels without an app.
+	ChInfo struct {
+		ChID    string
+		App     string
+		Data    []byte
+		BalInfo perun.BalInfo
+		Version string
+	}
+
+	// ChUpdateNotifier represents the interpretation of channel update
+	// notifier for app channels.
+	ChUpdateNotifier func(ChUpdateNotif)
+
+	// ChUpdateNotif represents the interpretation of channel update
+	// notification for app channels. ProposedChInfo (of ChUpdateNotif) is
+	// sent in the ProposedAppChInfo field for regular updates and CurrChInfo
+	// (of ChUpdateNotif) for channel close update.
+	// See perun.ChUpdateNotif for documentation on the other struct fields.
+	ChUpdateNotif struct {
+		UpdateID          string
+		ProposedAppChInfo ChInfo
+		Type              perun.ChUpdateType
+		Expiry            int64
+		Error             perun.APIError
+	}
+)
+
+// SendAppAction sends an update on the channel, that applies the app
+// specific action taken by the user to the channel state.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when the channel does not use a registered app.
+// - ErrInvalidArgument with Name:"action" when the action cannot be applied to the current state.
+// or any of the errors returned by the session.SendChUpdate API.
+func SendAppAction(pctx context.Context, ch perun.ChAPI, action []byte) (ChInfo, perun.APIError) {
+	app, ok := ch.GetChInfo().App.Def.(perun.AppDef)
+	if !ok {
+		return ChInfo{}, perun.NewAPIErrFailedPreCondition(ErrNotAppCh)
+	}
+	actor, found := partIdx(ch.Parts(), perun.OwnAlias)
+	if !found {
+		return ChInfo{}, perun.NewAPIErrUnknownInternal(errors.New("user is not a participant of the channel"))
+	}
+
+	var actionErr error
+	chInfo, apiErr := ch.SendChUpdate(pctx, func(state *pchannel.State) {
+		if actionErr = app.ApplyAction(state, pchannel.Index(actor), action); actionErr != nil {
+			// State updater cannot return an error. So, the version is
+			// changed, which is not allowed for an updater. This makes the
+			// update fail validation locally, before it is sent to the peers.
+			state.Version++
+		}
+	})
+	if actionErr != nil {
+		actionErr = errors.WithMessage(actionErr, ErrInvalidAction.Error())
+		return ChInfo{}, perun.NewAPIErrInvalidArgument(actionErr, perun.ArgNameAction, hex.EncodeToString(action))
+	}
+	return toAppChInfo(chInfo), apiErr
+}
+
+// partIdx returns the index of the alias in the list of channel participants.
+func partIdx(parts []string, alias string) (int, bool) {
+	for i := range parts {
+		if parts[i] == alias {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// GetAppChInfo fetches the channel info for this channel and interprets it as
+// app channel info.
+func GetAppChInfo(ch perun.ChAPI) ChInfo {
+	return toAppChInfo(ch.GetChInfo())
+}
+
+// SubAppChUpdates sets up a subscription for incoming channel updates and
+// interprets the notifications as app channel update notifications.
+//
+// See session.SubChUpdates for the list of errors returned by this API.
+func SubAppChUpdates(ch perun.ChAPI, notifier ChUpdateNotifier) perun.APIError {
+	return ch.SubChUpdates(func(notif perun.ChUpdateNotif) {
+		var proposedAppChInfo ChInfo
+		if notif.Type == perun.ChUpdateTypeClosed {
+			proposedAppChInfo = toAppChInfo(notif.CurrChInfo)
+		} else {
+			proposedAppChInfo = toAppChInfo(notif.ProposedChInfo)
+		}
+		notifier(ChUpdateNotif{
+			UpdateID:          notif.UpdateID,
+			ProposedAppChInfo: proposedAppChInfo,
+			Type:              notif.Type,
+			Expiry:            notif.Expiry,
+			Error:             notif.Error,
+		})
+	})
+}
+
+// UnsubAppChUpdates deletes the existing subscription for updates on this channel.
+//
+// See session.UnsubChUpdates for the list of errors returned by this API.
+func UnsubAppChUpdates(ch perun.ChAPI) perun.APIError {
+	return ch.UnsubChUpdates()
+}
+
+// RespondAppChUpdate sends a response for a channel update notification and
+// interprets the updated channel info as app channel info.
+//
+// See session.RespondChUpdate for the list of errors returned by this API.
+func RespondAppChUpdate(pctx context.Context, ch perun.ChAPI, updateID string, accept bool) (
+	ChInfo, perun.APIError,
+) {
+	chInfo, err := ch.RespondChUpdate(pctx, updateID, accept)
+	return toAppChInfo(chInfo), err
+}
+
+// CloseAppCh closes the channel and interprets the closing channel info as
+// app channel info.
+//
+// See session.CloseCh for the list of errors returned by this API.
+func CloseAppCh(pctx context.Context, ch perun.ChAPI) (ChInfo, perun.APIError) {
+	chInfo, err := ch.Close(pctx)
+	return toAppChInfo(chInfo), err
+}
+
+// toAppChsInfo converts ChInfo to ChInfo.
+func toAppChsInfo(chsInfo []perun.ChInfo) []ChInfo {
+	appChsInfo := make([]ChInfo, len(chsInfo))
+	for i := range chsInfo {
+		appChsInfo[i] = toAppChInfo(chsInfo[i])
+	}
+	return appChsInfo
+}
+
+// toAppChInfo converts ChInfo to ChInfo.
+func toAppChInfo(chInfo perun.ChInfo) ChInfo {
+	name, data := toAppNameAndData(chInfo.App)
+	return ChInfo{
+		ChID:    chInfo.ChID,
+		App:     name,
+		Data:    data,
+		BalInfo: chInfo.BalInfo,
+		Version: chInfo.Version,
+	}
+}
+
+// toAppNameAndData returns the name of the app and the binary encoding of the
+// app data. Both are empty if the app is not a registered app.
+func toAppNameAndData(app perun.App) (string, []byte) {
+	appDef, ok := app.Def.(perun.AppDef)
+	if !ok || app.Data == nil {
+		return "", nil
+	}
+	data, err := app.Data.MarshalBinary()
+	if err != nil {
+		return appDef.Name(), nil
+	}
+	return appDef.Name(), data
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app"
+	"github.com/hyperledger-labs/perun-node/app/tictactoe"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+)
+
+var (
+	parts   = []string{perun.OwnAlias, "peer"}
+	balInfo = perun.BalInfo{
+		Currencies: []string{"ETH"},
+		Parts:      parts,
+		Bals:       [][]string{{"1", "2"}},
+	}
+)
+
+func newGame(t *testing.T) *tictactoe.App {
+	t.Helper()
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	return tictactoe.New(ethereumtest.NewRandomAddress(prng))
+}
+
+func newGameState(game *tictactoe.App) *pchannel.State {
+	return &pchannel.State{
+		App: game,
+		Allocation: pchannel.Allocation{
+			Balances: pchannel.Balances{{big.NewInt(1), big.NewInt(2)}},
+		},
+		Data: &tictactoe.Data{},
+	}
+}
+
+func Test_SendAppAction(t *testing.T) {
+	game := newGame(t)
+	currChInfo := perun.ChInfo{
+		ChID:    "ch-id",
+		BalInfo: balInfo,
+		App:     perun.App{Def: game, Data: &tictactoe.Data{}},
+		Version: "0",
+	}
+	updatedData := &tictactoe.Data{NextActor: 1, Grid: [9]uint8{0, 0, 0, 0, 1}}
+	updatedChInfo := perun.ChInfo{
+		ChID:    "ch-id",
+		BalInfo: balInfo,
+		App:     perun.App{Def: game, Data: updatedData},
+		Version: "1",
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		var updater perun.StateUpdater
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("GetChInfo").Return(currChInfo)
+		chAPI.On("Parts").Return(parts)
+		chAPI.On("SendChUpdate", context.Background(), mock.MatchedBy(func(gotUpdater perun.StateUpdater) bool {
+			updater = gotUpdater
+			return true
+		})).Return(updatedChInfo, nil)
+
+		gotChInfo, gotErr := app.SendAppAction(context.Background(), chAPI, []byte{4})
+		require.NoError(t, gotErr)
+		wantData, err := updatedData.MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, app.ChInfo{
+			ChID:    "ch-id",
+			App:     tictactoe.Name,
+			Data:    wantData,
+			BalInfo: balInfo,
+			Version: "1",
+		}, gotChInfo)
+
+		state := newGameState(game)
+		updater(state)
+		assert.Equal(t, updatedData, state.Data)
+		assert.Equal(t, uint64(0), state.Version)
+	})
+
+	t.Run("error_InvalidAction", func(t *testing.T) {
+		var state *pchannel.State
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("GetChInfo").Return(currChInfo)
+		chAPI.On("Parts").Return(parts)
+		chAPI.On("SendChUpdate", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+			state = newGameState(game)
+			args.Get(1).(perun.StateUpdater)(state)
+		}).Return(perun.ChInfo{}, perun.NewAPIErrUnknownInternal(assert.AnError))
+
+		invalidAction := []byte{9}
+		_, gotErr := app.SendAppAction(context.Background(), chAPI, invalidAction)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument, app.ErrInvalidAction.Error())
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), perun.ArgNameAction, hex.EncodeToString(invalidAction))
+		require.NotNil(t, state)
+		assert.Equal(t, uint64(1), state.Version, "version should be changed to fail the update")
+	})
+
+	t.Run("error_NotAppCh", func(t *testing.T) {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("GetChInfo").Return(perun.ChInfo{App: perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}})
+
+		_, gotErr := app.SendAppAction(context.Background(), chAPI, []byte{4})
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrFailedPreCondition, app.ErrNotAppCh.Error())
+	})
+}
+
+func Test_OpenAppCh(t *testing.T) {
+	game := newGame(t)
+	openedChInfo := perun.ChInfo{
+		ChID:    "ch-id",
+		BalInfo: balInfo,
+		App:     perun.App{Def: game, Data: &tictactoe.Data{NextActor: 1}},
+		Version: "0",
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		wantApp := perun.App{Def: game, Data: &tictactoe.Data{NextActor: 1}}
+		sessionAPI.On("OpenCh", context.Background(), balInfo, wantApp, uint64(10)).Return(openedChInfo, nil)
+
+		gotChInfo, gotErr := app.OpenAppCh(context.Background(), sessionAPI, game, []byte{1}, balInfo, 10)
+		require.NoError(t, gotErr)
+		assert.Equal(t, tictactoe.Name, gotChInfo.App)
+		assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0}, gotChInfo.Data)
+	})
+
+	t.Run("error_InvalidInitParams", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+
+		invalidParams := []byte{5}
+		_, gotErr := app.OpenAppCh(context.Background(), sessionAPI, game, invalidParams, balInfo, 10)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument, app.ErrInvalidInitParams.Error())
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), perun.ArgNameInitParams,
+			hex.EncodeToString(invalidParams))
+	})
+}
+
+func Test_GetAppChInfo_PaymentCh(t *testing.T) {
+	chAPI := &mocks.ChAPI{}
+	chAPI.On("GetChInfo").Return(perun.ChInfo{
+		ChID:    "ch-id",
+		BalInfo: balInfo,
+		App:     perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()},
+		Version: "0",
+	})
+
+	gotChInfo := app.GetAppChInfo(chAPI)
+	assert.Equal(t, app.ChInfo{ChID: "ch-id", BalInfo: balInfo, Version: "0"}, gotChInfo)
+}
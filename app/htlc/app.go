@@ -0,0 +1,353 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htlc
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Name is the name of the htlc app.
+const Name = "htlc"
+
+// Types of actions and their lengths, including the type.
+const (
+	actionLock byte = iota
+	actionClaim
+	actionExpire
+
+	lockActionLen   = 1 + hashLen + indexLen + amountLen + deadlineLen
+	claimActionLen  = 1 + preimageLen
+	expireActionLen = 1 + hashLen
+
+	numParts = 2
+)
+
+// App implements hash time locked transfers as a channel app.
+type App struct {
+	def pwallet.Address
+}
+
+var _ perun.AppDef = &App{}
+
+// New returns an htlc app with the given app definition address.
+func New(def pwallet.Address) *App {
+	return &App{def: def}
+}
+
+// Name returns the name of the app.
+func (a *App) Name() string {
+	return Name
+}
+
+// Def returns the app definition address.
+func (a *App) Def() pwallet.Address {
+	return a.def
+}
+
+// NewData returns a new instance of the app data.
+func (a *App) NewData() pchannel.Data {
+	return &Data{}
+}
+
+// InitData returns the initial app data, without any locks. The params
+// should be empty.
+func (a *App) InitData(params []byte) (pchannel.Data, error) {
+	if len(params) != 0 {
+		return nil, errors.New("init params should be empty")
+	}
+	return &Data{}, nil
+}
+
+// LockAction returns the action for locking the amount of the asset on the
+// hash, until the deadline.
+func LockAction(hash [32]byte, asset uint16, amount *big.Int, deadline time.Time) ([]byte, error) {
+	if amount.Sign() <= 0 || amount.BitLen() > 8*amountLen {
+		return nil, errors.New("amount out of range")
+	}
+	action := make([]byte, lockActionLen)
+	action[0] = actionLock
+	encodeTerms(action[1:], hash, asset, amount, uint64(deadline.Unix()))
+	return action, nil
+}
+
+// ClaimAction returns the action for claiming the lock on the hash of the
+// preimage.
+func ClaimAction(preimage [32]byte) []byte {
+	return append([]byte{actionClaim}, preimage[:]...)
+}
+
+// ExpireAction returns the action for removing the expired lock on the hash.
+func ExpireAction(hash [32]byte) []byte {
+	return append([]byte{actionExpire}, hash[:]...)
+}
+
+// ApplyAction applies the lock, claim or expire action taken by the actor.
+func (a *App) ApplyAction(state *pchannel.State, actor pchannel.Index, action []byte) error {
+	data, ok := state.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", state.Data)
+	}
+	if len(action) == 0 {
+		return errors.New("action should not be empty")
+	}
+	switch {
+	case action[0] == actionLock && len(action) == lockActionLen:
+		return applyLock(state, data, actor, action[1:])
+	case action[0] == actionClaim && len(action) == claimActionLen:
+		return applyClaim(state, data, actor, action[1:])
+	case action[0] == actionExpire && len(action) == expireActionLen:
+		return applyExpire(data, actor, action[1:])
+	default:
+		return errors.New("action should be a lock, claim or expire action")
+	}
+}
+
+func applyLock(state *pchannel.State, data *Data, actor pchannel.Index, terms []byte) error {
+	l := Lock{Sender: actor}
+	l.Hash, l.Asset, l.Amount, l.Deadline = decodeTerms(terms)
+	if err := validLock(state, data, l); err != nil {
+		return err
+	}
+	data.Locks = append(data.Locks, l)
+	return checkLocked(state.Balances, data)
+}
+
+func applyClaim(state *pchannel.State, data *Data, actor pchannel.Index, preimage []byte) error {
+	l, ok := data.Lock(sha256.Sum256(preimage))
+	if !ok || l.Sender == actor {
+		return errors.New("no lock from the other participant on the hash of the preimage")
+	}
+	if !beforeDeadline(l) {
+		return errors.New("lock has expired")
+	}
+	removeLock(data, l.Hash)
+	copy(data.Revealed[:], preimage)
+	bal := state.Balances[l.Asset]
+	bal[l.Sender].Sub(bal[l.Sender], l.Amount)
+	bal[actor].Add(bal[actor], l.Amount)
+	return nil
+}
+
+func applyExpire(data *Data, actor pchannel.Index, hash []byte) error {
+	var h [hashLen]byte
+	copy(h[:], hash)
+	l, ok := data.Lock(h)
+	if !ok || l.Sender != actor {
+		return errors.New("no lock from the actor on the hash")
+	}
+	if beforeDeadline(l) {
+		return errors.New("lock has not expired")
+	}
+	removeLock(data, l.Hash)
+	return nil
+}
+
+// ValidInit checks that the channel has two participants and the initial
+// state has no locks.
+func (a *App) ValidInit(params *pchannel.Params, state *pchannel.State) error {
+	if len(params.Parts) != numParts {
+		return errors.Errorf("expected %d participants, got %d", numParts, len(params.Parts))
+	}
+	data, ok := state.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", state.Data)
+	}
+	if len(data.Locks) != 0 {
+		return errors.New("initial state should not have locks")
+	}
+	if state.IsFinal {
+		return errors.New("initial state should not be final")
+	}
+	return nil
+}
+
+// ValidTransition checks that the transition either adds a lock from the
+// actor, claims a lock from the other participant, removes an expired lock
+// from the actor, or transfers an amount from the actor to the other
+// participant. In all the cases, the locked amounts should not exceed the
+// balances of the senders.
+//
+// A transition that only finalizes the state without changing the data and
+// the balances is also valid for either actor, if there are no locks. This
+// allows the participants to close the channel.
+func (a *App) ValidTransition(params *pchannel.Params, from, to *pchannel.State, actor pchannel.Index) error {
+	fromData, ok := from.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", from.Data)
+	}
+	toData, ok := to.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", to.Data)
+	}
+	if err := checkLocked(to.Balances, toData); err != nil {
+		return pchannel.NewStateTransitionError(params.ID(), err.Error())
+	}
+
+	var err error
+	switch {
+	case to.IsFinal:
+		err = validFinal(from, to, fromData, toData)
+	case len(toData.Locks) == len(fromData.Locks)+1:
+		err = validLockTransition(from, to, fromData, toData, actor)
+	case len(toData.Locks)+1 == len(fromData.Locks):
+		err = validUnlockTransition(from, to, fromData, toData, actor)
+	case len(toData.Locks) == len(fromData.Locks):
+		err = validTransfer(from, to, fromData, toData, actor)
+	default:
+		err = errors.New("only one lock can be added or removed in a transition")
+	}
+	if err != nil {
+		return pchannel.NewStateTransitionError(params.ID(), err.Error())
+	}
+	return nil
+}
+
+func validFinal(from, to *pchannel.State, fromData, toData *Data) error {
+	if len(fromData.Locks) != 0 {
+		return errors.New("state with locks should not be finalized")
+	}
+	if fromData.Revealed != toData.Revealed || len(toData.Locks) != 0 || !from.Balances.Equal(to.Balances) {
+		return errors.New("data and balances should not change when finalizing")
+	}
+	return nil
+}
+
+func validLockTransition(from, to *pchannel.State, fromData, toData *Data, actor pchannel.Index) error {
+	l := toData.Locks[len(toData.Locks)-1]
+	if !locksEqual(fromData.Locks, toData.Locks[:len(fromData.Locks)]) {
+		return errors.New("existing locks should not change when adding a lock")
+	}
+	if l.Sender != actor {
+		return errors.New("lock should be added by its sender")
+	}
+	if fromData.Revealed != toData.Revealed || !from.Balances.Equal(to.Balances) {
+		return errors.New("revealed preimage and balances should not change when adding a lock")
+	}
+	return validLock(to, fromData, l)
+}
+
+func validUnlockTransition(from, to *pchannel.State, fromData, toData *Data, actor pchannel.Index) error {
+	var l Lock
+	for i := range fromData.Locks {
+		if locksEqual(withoutLock(fromData.Locks, i), toData.Locks) {
+			l = fromData.Locks[i]
+			break
+		}
+	}
+	if l.Amount == nil {
+		return errors.New("existing locks should not change when removing a lock")
+	}
+
+	if l.Sender == actor { // Expire.
+		if beforeDeadline(l) {
+			return errors.New("lock should be removed by its sender only after the deadline")
+		}
+		if fromData.Revealed != toData.Revealed || !from.Balances.Equal(to.Balances) {
+			return errors.New("revealed preimage and balances should not change when removing an expired lock")
+		}
+		return nil
+	}
+
+	// Claim.
+	if !beforeDeadline(l) {
+		return errors.New("lock should be claimed before the deadline")
+	}
+	if sha256.Sum256(toData.Revealed[:]) != l.Hash {
+		return errors.New("revealed preimage does not match the hash of the claimed lock")
+	}
+	expectedBals := from.Balances.Clone()
+	expectedBals[l.Asset][l.Sender].Sub(expectedBals[l.Asset][l.Sender], l.Amount)
+	expectedBals[l.Asset][actor].Add(expectedBals[l.Asset][actor], l.Amount)
+	if !to.Balances.Equal(expectedBals) {
+		return errors.New("balances not updated as per the amount of the claimed lock")
+	}
+	return nil
+}
+
+func validTransfer(from, to *pchannel.State, fromData, toData *Data, actor pchannel.Index) error {
+	if !locksEqual(fromData.Locks, toData.Locks) || fromData.Revealed != toData.Revealed {
+		return errors.New("data should not change when transferring an amount")
+	}
+	for asset := range to.Balances {
+		for part := range to.Balances[asset] {
+			if pchannel.Index(part) != actor && to.Balances[asset][part].Cmp(from.Balances[asset][part]) < 0 {
+				return errors.New("only the actor can transfer its balance")
+			}
+		}
+	}
+	return nil
+}
+
+// validLock checks the terms of a new lock to be added to the data.
+func validLock(state *pchannel.State, data *Data, l Lock) error {
+	if int(l.Asset) >= len(state.Balances) {
+		return errors.New("invalid asset index")
+	}
+	if l.Amount.Sign() <= 0 {
+		return errors.New("amount should be positive")
+	}
+	if !beforeDeadline(l) {
+		return errors.New("deadline should be in the future")
+	}
+	if _, ok := data.Lock(l.Hash); ok {
+		return errors.New("a lock already exists on the hash")
+	}
+	return nil
+}
+
+// checkLocked checks that the locked amounts do not exceed the balances of
+// the senders.
+func checkLocked(bals pchannel.Balances, data *Data) error {
+	for i := range data.Locks {
+		l := data.Locks[i]
+		if int(l.Asset) >= len(bals) || int(l.Sender) >= len(bals[l.Asset]) {
+			return errors.New("invalid lock")
+		}
+		if bals[l.Asset][l.Sender].Cmp(data.Locked(l.Sender, l.Asset)) < 0 {
+			return errors.New("locked amount exceeds the balance")
+		}
+	}
+	return nil
+}
+
+func beforeDeadline(l Lock) bool {
+	return time.Now().Before(time.Unix(int64(l.Deadline), 0))
+}
+
+func removeLock(data *Data, hash [hashLen]byte) {
+	for i := range data.Locks {
+		if data.Locks[i].Hash == hash {
+			data.Locks = withoutLock(data.Locks, i)
+			return
+		}
+	}
+}
+
+// withoutLock returns a copy of the locks, without the one at index i.
+func withoutLock(locks []Lock, i int) []Lock {
+	remaining := make([]Lock, 0, len(locks)-1)
+	remaining = append(remaining, locks[:i]...)
+	return append(remaining, locks[i+1:]...)
+}
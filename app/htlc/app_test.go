@@ -0,0 +1,207 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htlc_test
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node/app/htlc"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+)
+
+var preimage = [32]byte{1, 2, 3}
+
+func newSetup(t *testing.T) (*htlc.App, *pchannel.Params, *pchannel.State) {
+	t.Helper()
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	app := htlc.New(ethereumtest.NewRandomAddress(prng))
+	parts := []pwallet.Address{ethereumtest.NewRandomAddress(prng), ethereumtest.NewRandomAddress(prng)}
+	params := pchannel.NewParamsUnsafe(10, parts, app, big.NewInt(1), true, false)
+
+	initData, err := app.InitData(nil)
+	require.NoError(t, err)
+	state := &pchannel.State{
+		ID:  params.ID(),
+		App: app,
+		Allocation: pchannel.Allocation{
+			Balances: pchannel.Balances{
+				{big.NewInt(100), big.NewInt(0)},
+				{big.NewInt(5), big.NewInt(5)},
+			},
+		},
+		Data: initData,
+	}
+	require.NoError(t, app.ValidInit(params, state))
+	return app, params, state
+}
+
+func lockAction(t *testing.T, amount int64, deadline time.Time) []byte {
+	t.Helper()
+	action, err := htlc.LockAction(sha256.Sum256(preimage[:]), 0, big.NewInt(amount), deadline)
+	require.NoError(t, err)
+	return action
+}
+
+func Test_App_ApplyAction(t *testing.T) {
+	app, params, from := newSetup(t)
+	hash := sha256.Sum256(preimage[:])
+
+	t.Run("happy_lock_claim", func(t *testing.T) {
+		locked := from.Clone()
+		require.NoError(t, app.ApplyAction(locked, 0, lockAction(t, 30, time.Now().Add(time.Minute))))
+		require.NoError(t, app.ValidTransition(params, from, locked, 0))
+		assert.Equal(t, from.Balances, locked.Balances)
+		assert.Equal(t, int64(30), locked.Data.(*htlc.Data).Locked(0, 0).Int64())
+
+		claimed := locked.Clone()
+		require.NoError(t, app.ApplyAction(claimed, 1, htlc.ClaimAction(preimage)))
+		require.NoError(t, app.ValidTransition(params, locked, claimed, 1))
+		assert.Empty(t, claimed.Data.(*htlc.Data).Locks)
+		assert.Equal(t, preimage, claimed.Data.(*htlc.Data).Revealed)
+		assert.Equal(t, int64(70), claimed.Balances[0][0].Int64())
+		assert.Equal(t, int64(30), claimed.Balances[0][1].Int64())
+		assert.Equal(t, from.Balances[1], claimed.Balances[1])
+	})
+
+	t.Run("happy_expire", func(t *testing.T) {
+		// Lock that has expired is added directly, as it cannot be added by an action.
+		locked := from.Clone()
+		locked.Data.(*htlc.Data).Locks = []htlc.Lock{{
+			Sender: 0, Hash: hash, Amount: big.NewInt(30), Deadline: uint64(time.Now().Add(-time.Second).Unix()),
+		}}
+
+		expired := locked.Clone()
+		require.NoError(t, app.ApplyAction(expired, 0, htlc.ExpireAction(hash)))
+		require.NoError(t, app.ValidTransition(params, locked, expired, 0))
+		assert.Empty(t, expired.Data.(*htlc.Data).Locks)
+		assert.Equal(t, from.Balances, expired.Balances)
+
+		assert.Error(t, app.ApplyAction(locked.Clone(), 1, htlc.ClaimAction(preimage)), "claim after deadline")
+	})
+
+	locked := from.Clone()
+	require.NoError(t, app.ApplyAction(locked, 0, lockAction(t, 30, time.Now().Add(time.Minute))))
+	tests := []struct {
+		name   string
+		state  *pchannel.State
+		actor  pchannel.Index
+		action []byte
+	}{
+		{"error_lock_insufficient_balance", from, 0, lockAction(t, 101, time.Now().Add(time.Minute))},
+		{"error_lock_past_deadline", from, 0, lockAction(t, 1, time.Now().Add(-time.Second))},
+		{"error_lock_duplicate_hash", locked, 0, lockAction(t, 1, time.Now().Add(time.Minute))},
+		{"error_claim_by_sender", locked, 0, htlc.ClaimAction(preimage)},
+		{"error_claim_invalid_preimage", locked, 1, htlc.ClaimAction([32]byte{})},
+		{"error_expire_before_deadline", locked, 0, htlc.ExpireAction(hash)},
+		{"error_expire_by_receiver", locked, 1, htlc.ExpireAction(hash)},
+		{"error_invalid_action", locked, 0, []byte{3}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Error(t, app.ApplyAction(tc.state.Clone(), tc.actor, tc.action))
+		})
+	}
+}
+
+func Test_App_ValidTransition(t *testing.T) {
+	app, params, from := newSetup(t)
+	locked := from.Clone()
+	require.NoError(t, app.ApplyAction(locked, 0, lockAction(t, 30, time.Now().Add(time.Minute))))
+
+	t.Run("error_lock_by_other", func(t *testing.T) {
+		assert.Error(t, app.ValidTransition(params, from, locked, 1))
+	})
+	t.Run("error_lock_with_transfer", func(t *testing.T) {
+		to := locked.Clone()
+		to.Balances[0][0].SetInt64(90)
+		to.Balances[0][1].SetInt64(10)
+		assert.Error(t, app.ValidTransition(params, from, to, 0))
+	})
+	t.Run("error_claim_wrong_preimage", func(t *testing.T) {
+		to := locked.Clone()
+		to.Data.(*htlc.Data).Locks = nil
+		to.Balances[0][0].SetInt64(70)
+		to.Balances[0][1].SetInt64(30)
+		assert.Error(t, app.ValidTransition(params, locked, to, 1))
+	})
+	t.Run("error_remove_without_claim", func(t *testing.T) {
+		to := locked.Clone()
+		to.Data.(*htlc.Data).Locks = nil
+		assert.Error(t, app.ValidTransition(params, locked, to, 1))
+	})
+	t.Run("happy_transfer_unlocked", func(t *testing.T) {
+		to := locked.Clone()
+		to.Balances[0][0].SetInt64(30)
+		to.Balances[0][1].SetInt64(70)
+		assert.NoError(t, app.ValidTransition(params, locked, to, 0))
+	})
+	t.Run("error_transfer_locked", func(t *testing.T) {
+		to := locked.Clone()
+		to.Balances[0][0].SetInt64(29)
+		to.Balances[0][1].SetInt64(71)
+		assert.Error(t, app.ValidTransition(params, locked, to, 0))
+	})
+	t.Run("error_transfer_by_other", func(t *testing.T) {
+		to := locked.Clone()
+		to.Balances[0][0].SetInt64(90)
+		to.Balances[0][1].SetInt64(10)
+		assert.Error(t, app.ValidTransition(params, locked, to, 1))
+	})
+	t.Run("happy_finalize", func(t *testing.T) {
+		to := from.Clone()
+		to.IsFinal = true
+		assert.NoError(t, app.ValidTransition(params, from, to, 1))
+	})
+	t.Run("error_finalize_with_locks", func(t *testing.T) {
+		to := locked.Clone()
+		to.IsFinal = true
+		assert.Error(t, app.ValidTransition(params, locked, to, 0))
+	})
+}
+
+func Test_App_InitData(t *testing.T) {
+	_, err := htlc.New(nil).InitData([]byte{0})
+	assert.Error(t, err)
+}
+
+func Test_Data_Marshal(t *testing.T) {
+	data := &htlc.Data{
+		Revealed: preimage,
+		Locks: []htlc.Lock{
+			{Sender: 1, Hash: sha256.Sum256(preimage[:]), Asset: 2, Amount: big.NewInt(1000), Deadline: 42},
+			{Sender: 0, Hash: [32]byte{4}, Amount: big.NewInt(1), Deadline: 43},
+		},
+	}
+	b, err := data.MarshalBinary()
+	require.NoError(t, err)
+	assert.Len(t, b, 34+2*76)
+
+	got := htlc.New(nil).NewData()
+	require.NoError(t, got.UnmarshalBinary(b))
+	assert.Equal(t, data, got)
+	assert.Equal(t, data, got.Clone())
+	assert.Error(t, got.UnmarshalBinary(b[1:]))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htlc
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+)
+
+const (
+	hashLen     = 32
+	preimageLen = 32
+	indexLen    = 2
+	amountLen   = 32
+	deadlineLen = 8
+	lockLen     = indexLen + hashLen + indexLen + amountLen + deadlineLen
+	headerLen   = preimageLen + indexLen
+
+	maxLocks = 1<<(8*indexLen) - 1
+)
+
+type (
+	// Data is the app data of the htlc app.
+	Data struct {
+		// Preimage revealed by the last claim. It is zero until a lock is claimed.
+		Revealed [preimageLen]byte
+		Locks    []Lock
+	}
+
+	// Lock is an amount locked by the sender on a hash, until the deadline.
+	Lock struct {
+		Sender   pchannel.Index
+		Hash     [hashLen]byte
+		Asset    uint16   // Index of the asset in the balances.
+		Amount   *big.Int // Amount transferred to the other participant, when claimed.
+		Deadline uint64   // Time (in unix seconds) before which the lock can be claimed.
+	}
+)
+
+var _ pchannel.Data = &Data{}
+
+// Lock returns the lock on the given hash, if it exists.
+func (d *Data) Lock(hash [hashLen]byte) (Lock, bool) {
+	for i := range d.Locks {
+		if d.Locks[i].Hash == hash {
+			return d.Locks[i], true
+		}
+	}
+	return Lock{}, false
+}
+
+// Locked returns the total amount of the asset locked by the sender.
+func (d *Data) Locked(sender pchannel.Index, asset uint16) *big.Int {
+	locked := new(big.Int)
+	for i := range d.Locks {
+		if d.Locks[i].Sender == sender && d.Locks[i].Asset == asset {
+			locked.Add(locked, d.Locks[i].Amount)
+		}
+	}
+	return locked
+}
+
+// MarshalBinary encodes the data into its binary representation.
+func (d *Data) MarshalBinary() ([]byte, error) {
+	if len(d.Locks) > maxLocks {
+		return nil, errors.Errorf("too many locks %d, maximum is %d", len(d.Locks), maxLocks)
+	}
+	b := make([]byte, headerLen+len(d.Locks)*lockLen)
+	copy(b, d.Revealed[:])
+	binary.BigEndian.PutUint16(b[preimageLen:], uint16(len(d.Locks)))
+	for i := range d.Locks {
+		if err := d.Locks[i].encode(b[headerLen+i*lockLen:]); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes the data from its binary representation.
+func (d *Data) UnmarshalBinary(b []byte) error {
+	if len(b) < headerLen {
+		return errors.Errorf("invalid data length %d, expected at least %d", len(b), headerLen)
+	}
+	n := int(binary.BigEndian.Uint16(b[preimageLen:]))
+	if len(b) != headerLen+n*lockLen {
+		return errors.Errorf("invalid data length %d, expected %d", len(b), headerLen+n*lockLen)
+	}
+	copy(d.Revealed[:], b)
+	d.Locks = make([]Lock, n)
+	for i := range d.Locks {
+		d.Locks[i].decode(b[headerLen+i*lockLen:])
+	}
+	return nil
+}
+
+// Clone returns a deep copy of the data.
+func (d *Data) Clone() pchannel.Data {
+	clone := Data{Revealed: d.Revealed, Locks: make([]Lock, len(d.Locks))}
+	for i := range d.Locks {
+		clone.Locks[i] = d.Locks[i].clone()
+	}
+	return &clone
+}
+
+// locksEqual returns true if both the list of locks are the same.
+func locksEqual(a, b []Lock) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (l Lock) equal(other Lock) bool {
+	return l.Sender == other.Sender && l.Hash == other.Hash && l.Asset == other.Asset &&
+		l.Deadline == other.Deadline && amountOf(l).Cmp(amountOf(other)) == 0
+}
+
+func (l Lock) clone() Lock {
+	l.Amount = new(big.Int).Set(amountOf(l))
+	return l
+}
+
+// encode encodes the lock into the first lockLen bytes of b.
+func (l Lock) encode(b []byte) error {
+	amount := amountOf(l)
+	if amount.Sign() < 0 || amount.BitLen() > 8*amountLen {
+		return errors.New("amount out of range")
+	}
+	binary.BigEndian.PutUint16(b, uint16(l.Sender))
+	encodeTerms(b[indexLen:], l.Hash, l.Asset, amount, l.Deadline)
+	return nil
+}
+
+// decode decodes the lock from the first lockLen bytes of b.
+func (l *Lock) decode(b []byte) {
+	l.Sender = pchannel.Index(binary.BigEndian.Uint16(b))
+	l.Hash, l.Asset, l.Amount, l.Deadline = decodeTerms(b[indexLen:])
+}
+
+// encodeTerms encodes the hash, asset, amount and deadline, as done in locks
+// and lock actions. The amount should be in range.
+func encodeTerms(b []byte, hash [hashLen]byte, asset uint16, amount *big.Int, deadline uint64) {
+	copy(b, hash[:])
+	binary.BigEndian.PutUint16(b[hashLen:], asset)
+	amount.FillBytes(b[hashLen+indexLen : hashLen+indexLen+amountLen])
+	binary.BigEndian.PutUint64(b[hashLen+indexLen+amountLen:], deadline)
+}
+
+func decodeTerms(b []byte) (hash [hashLen]byte, asset uint16, amount *big.Int, deadline uint64) {
+	copy(hash[:], b)
+	asset = binary.BigEndian.Uint16(b[hashLen:])
+	amount = new(big.Int).SetBytes(b[hashLen+indexLen : hashLen+indexLen+amountLen])
+	deadline = binary.BigEndian.Uint64(b[hashLen+indexLen+amountLen:])
+	return hash, asset, amount, deadline
+}
+
+func amountOf(l Lock) *big.Int {
+	if l.Amount == nil {
+		return new(big.Int)
+	}
+	return l.Amount
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package htlc implements hash time locked transfers as a channel app, that
+// can be registered on the node. It is used by the routing package for
+// making multi-hop payments, where each hop is paid only if the target is
+// paid.
+//
+// A participant locks an amount of its balance on the SHA-256 hash of a
+// preimage, until a deadline. The amount stays with the sender, but it cannot
+// be spent while locked. Before the deadline, the other participant can claim
+// the lock by revealing the preimage, which transfers the amount to it. After
+// the deadline, the sender can remove the lock without transferring the
+// amount. Besides these, the participants can pay each other with their
+// unlocked balances, as in a payment channel. The channel can be finalized
+// only when there are no locks.
+//
+// The deadlines are checked against the local clock of each participant. So,
+// a claim sent just before the deadline may be rejected by the sender.
+//
+// The init params should be empty. An action is one of the following, with
+// all integers encoded in big endian:
+//   - lock: 0x00, the hash (32 bytes), the index of the asset (uint16), the
+//     amount (256 bit unsigned integer) and the deadline in unix seconds (uint64).
+//   - claim: 0x01, followed by the preimage (32 bytes).
+//   - expire: 0x02, followed by the hash (32 bytes).
+//
+// The app data is encoded as the preimage revealed by the last claim (32
+// bytes), the number of locks (uint16) and then, each lock as the index of
+// the sender (uint16), the hash, the index of the asset, the amount and the
+// deadline encoded as in the lock action.
+package htlc
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metered
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Name is the name of the metered service app.
+const Name = "metered"
+
+// Indices of the consumer and provider in the channel, and the index of the
+// asset used for payments.
+const (
+	consumerIdx pchannel.Index = 0
+	providerIdx pchannel.Index = 1
+	assetIdx                   = 0
+
+	numParts = 2
+)
+
+// App implements the metered service as a channel app.
+type App struct {
+	def pwallet.Address
+}
+
+var _ perun.AppDef = &App{}
+
+// New returns a metered service app with the given app definition address.
+func New(def pwallet.Address) *App {
+	return &App{def: def}
+}
+
+// Name returns the name of the app.
+func (a *App) Name() string {
+	return Name
+}
+
+// Def returns the app definition address.
+func (a *App) Def() pwallet.Address {
+	return a.def
+}
+
+// NewData returns a new instance of the app data.
+func (a *App) NewData() pchannel.Data {
+	return &Data{Price: new(big.Int)}
+}
+
+// InitData returns the initial app data, with no units consumed and the price
+// per unit given in the params.
+func (a *App) InitData(params []byte) (pchannel.Data, error) {
+	price, ok := new(big.Int).SetString(string(params), 10)
+	if !ok || price.Sign() <= 0 {
+		return nil, errors.New("init params should be a positive price per unit")
+	}
+	data := &Data{Price: price}
+	if _, err := data.MarshalBinary(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ApplyAction records the units consumed given in the action and transfers
+// the cost of the units from the consumer to the provider.
+func (a *App) ApplyAction(state *pchannel.State, actor pchannel.Index, action []byte) error {
+	data, ok := state.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", state.Data)
+	}
+	if actor != consumerIdx {
+		return errors.New("only the consumer can record units consumed")
+	}
+	units, err := strconv.ParseUint(string(action), 10, 64)
+	if err != nil || units == 0 {
+		return errors.New("action should be a positive number of units")
+	}
+	if data.Units+units < data.Units {
+		return errors.New("total units consumed overflows")
+	}
+
+	bal := state.Balances[assetIdx]
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(units), data.Price)
+	if bal[consumerIdx].Cmp(cost) < 0 {
+		return errors.New("insufficient balance to pay for the units")
+	}
+	bal[consumerIdx].Sub(bal[consumerIdx], cost)
+	bal[providerIdx].Add(bal[providerIdx], cost)
+	data.Units += units
+	return nil
+}
+
+// ValidInit checks that the initial state has a positive price, no units
+// consumed and that the channel has two participants.
+func (a *App) ValidInit(params *pchannel.Params, state *pchannel.State) error {
+	if len(params.Parts) != numParts {
+		return errors.Errorf("expected %d participants, got %d", numParts, len(params.Parts))
+	}
+	data, ok := state.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", state.Data)
+	}
+	if data.Price == nil || data.Price.Sign() <= 0 {
+		return errors.New("price should be positive")
+	}
+	if data.Units != 0 {
+		return errors.New("units consumed should be zero")
+	}
+	if state.IsFinal {
+		return errors.New("initial state should not be final")
+	}
+	return nil
+}
+
+// ValidTransition checks that the transition is made by the consumer, the
+// price is unchanged, the units consumed have increased and the cost of the
+// additional units has been transferred from the consumer to the provider.
+//
+// A transition that only finalizes the state without changing the data and
+// the balances is also valid for either actor. This allows the participants
+// to close the channel.
+func (a *App) ValidTransition(params *pchannel.Params, from, to *pchannel.State, actor pchannel.Index) error {
+	fromData, ok := from.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", from.Data)
+	}
+	toData, ok := to.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", to.Data)
+	}
+
+	if toData.Price.Cmp(fromData.Price) != 0 {
+		return pchannel.NewStateTransitionError(params.ID(), "price should not change")
+	}
+	if toData.Units == fromData.Units && from.Balances.Equal(to.Balances) && to.IsFinal {
+		return nil
+	}
+	if actor != consumerIdx {
+		return pchannel.NewStateTransitionError(params.ID(), "only the consumer can record units consumed")
+	}
+	if toData.Units <= fromData.Units {
+		return pchannel.NewStateTransitionError(params.ID(), "units consumed should increase")
+	}
+	if to.IsFinal {
+		return pchannel.NewStateTransitionError(params.ID(), "state should not be final")
+	}
+
+	units := new(big.Int).SetUint64(toData.Units - fromData.Units)
+	cost := new(big.Int).Mul(units, fromData.Price)
+	expectedBals := from.Balances.Clone()
+	expectedBals[assetIdx][consumerIdx].Sub(expectedBals[assetIdx][consumerIdx], cost)
+	expectedBals[assetIdx][providerIdx].Add(expectedBals[assetIdx][providerIdx], cost)
+	if !to.Balances.Equal(expectedBals) {
+		return pchannel.NewStateTransitionError(params.ID(), "balances not updated as per the cost of units")
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metered_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node/app/metered"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+)
+
+func newSetup(t *testing.T) (*metered.App, *pchannel.Params, *pchannel.State) {
+	t.Helper()
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	app := metered.New(ethereumtest.NewRandomAddress(prng))
+	parts := []pwallet.Address{ethereumtest.NewRandomAddress(prng), ethereumtest.NewRandomAddress(prng)}
+	params := pchannel.NewParamsUnsafe(10, parts, app, big.NewInt(1), true, false)
+
+	initData, err := app.InitData([]byte("3"))
+	require.NoError(t, err)
+	state := &pchannel.State{
+		ID:  params.ID(),
+		App: app,
+		Allocation: pchannel.Allocation{
+			Balances: pchannel.Balances{
+				{big.NewInt(100), big.NewInt(0)},
+				{big.NewInt(5), big.NewInt(5)},
+			},
+		},
+		Data: initData,
+	}
+	require.NoError(t, app.ValidInit(params, state))
+	return app, params, state
+}
+
+func Test_App_ApplyAction(t *testing.T) {
+	app, params, from := newSetup(t)
+
+	t.Run("happy", func(t *testing.T) {
+		to := from.Clone()
+		require.NoError(t, app.ApplyAction(to, 0, []byte("10")))
+		require.NoError(t, app.ValidTransition(params, from, to, 0))
+
+		assert.Equal(t, uint64(10), to.Data.(*metered.Data).Units)
+		assert.Equal(t, int64(70), to.Balances[0][0].Int64())
+		assert.Equal(t, int64(30), to.Balances[0][1].Int64())
+		assert.Equal(t, from.Balances[1], to.Balances[1])
+	})
+
+	tests := []struct {
+		name   string
+		actor  pchannel.Index
+		action string
+	}{
+		{"error_provider", 1, "1"},
+		{"error_zero_units", 0, "0"},
+		{"error_invalid_units", 0, "abc"},
+		{"error_insufficient_balance", 0, "34"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Error(t, app.ApplyAction(from.Clone(), tc.actor, []byte(tc.action)))
+		})
+	}
+}
+
+func Test_App_ValidTransition(t *testing.T) {
+	app, params, from := newSetup(t)
+	validTo := from.Clone()
+	require.NoError(t, app.ApplyAction(validTo, 0, []byte("2")))
+
+	t.Run("error_price_changed", func(t *testing.T) {
+		to := validTo.Clone()
+		to.Data.(*metered.Data).Price.SetInt64(1)
+		assert.Error(t, app.ValidTransition(params, from, to, 0))
+	})
+	t.Run("error_provider", func(t *testing.T) {
+		assert.Error(t, app.ValidTransition(params, from, validTo, 1))
+	})
+	t.Run("error_underpaid", func(t *testing.T) {
+		to := validTo.Clone()
+		to.Balances[0][0].SetInt64(95)
+		to.Balances[0][1].SetInt64(5)
+		assert.Error(t, app.ValidTransition(params, from, to, 0))
+	})
+	t.Run("error_units_not_increased", func(t *testing.T) {
+		to := from.Clone()
+		to.Balances[0][0].SetInt64(94)
+		to.Balances[0][1].SetInt64(6)
+		assert.Error(t, app.ValidTransition(params, from, to, 0))
+	})
+	t.Run("happy_finalize_only", func(t *testing.T) {
+		to := from.Clone()
+		to.IsFinal = true
+		assert.NoError(t, app.ValidTransition(params, from, to, 1))
+	})
+}
+
+func Test_App_InitData(t *testing.T) {
+	app := metered.New(nil)
+
+	for _, params := range []string{"", "0", "-1", "abc"} {
+		_, err := app.InitData([]byte(params))
+		assert.Error(t, err, params)
+	}
+}
+
+func Test_Data_Marshal(t *testing.T) {
+	data := &metered.Data{Units: 42, Price: big.NewInt(1000)}
+	b, err := data.MarshalBinary()
+	require.NoError(t, err)
+	assert.Len(t, b, 40)
+
+	got := metered.New(nil).NewData()
+	require.NoError(t, got.UnmarshalBinary(b))
+	assert.Equal(t, data, got)
+	assert.Error(t, got.UnmarshalBinary(b[1:]))
+}
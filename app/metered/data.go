@@ -0,0 +1,71 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metered
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+)
+
+const (
+	unitsLen = 8
+	priceLen = 32
+	dataLen  = unitsLen + priceLen
+)
+
+// Data is the app data of the metered service.
+type Data struct {
+	Units uint64   // Total number of units consumed.
+	Price *big.Int // Price per unit.
+}
+
+var _ pchannel.Data = &Data{}
+
+// MarshalBinary encodes the data into its binary representation.
+func (d *Data) MarshalBinary() ([]byte, error) {
+	b := make([]byte, dataLen)
+	binary.BigEndian.PutUint64(b, d.Units)
+	if d.Price != nil {
+		if d.Price.Sign() < 0 || d.Price.BitLen() > 8*priceLen {
+			return nil, errors.New("price out of range")
+		}
+		d.Price.FillBytes(b[unitsLen:])
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes the data from its binary representation.
+func (d *Data) UnmarshalBinary(b []byte) error {
+	if len(b) != dataLen {
+		return errors.Errorf("invalid data length %d, expected %d", len(b), dataLen)
+	}
+	d.Units = binary.BigEndian.Uint64(b)
+	d.Price = new(big.Int).SetBytes(b[unitsLen:])
+	return nil
+}
+
+// Clone returns a deep copy of the data.
+func (d *Data) Clone() pchannel.Data {
+	clone := Data{Units: d.Units}
+	if d.Price != nil {
+		clone.Price = new(big.Int).Set(d.Price)
+	}
+	return &clone
+}
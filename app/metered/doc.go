@@ -0,0 +1,33 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metered implements a metered service as a channel app, that can be
+// registered on the node.
+//
+// The first participant of the channel is the consumer and the second one is
+// the provider of the service. The consumer pays for each unit of the service
+// it consumes at a price fixed when opening the channel. The price is
+// specified in the smallest unit of the first currency in the channel, and
+// payments are made only in this currency.
+//
+// The init params are the price per unit, as a decimal string. An action is
+// the number of units consumed, as a decimal string. Only the consumer can
+// take actions.
+//
+// The app data is encoded as 40 bytes: the total number of units consumed as
+// a big endian uint64, followed by the price per unit as a big endian 256 bit
+// unsigned integer.
+package metered
@@ -39,12 +39,18 @@ func (e Error) Error() string {
 const (
 	ErrInvalidAmount Error = "invalid amount"
 	ErrInvalidPayee  Error = "invalid payee"
+	ErrInvalidPayer  Error = "invalid payer"
 )
 
 type (
 	// Payment contains the info required for making a single payment.
+	//
+	// Payer is optional in a two party channel, where it defaults to the
+	// participant other than the payee. It is required in channels with more
+	// than two participants.
 	Payment struct {
 		Currency string
+		Payer    string
 		Payee    string
 		Amount   string
 	}
@@ -69,16 +75,23 @@ type (
 		Type              perun.ChUpdateType
 		Expiry            int64
 		Error             perun.APIError
+		// Set only for updates responded to as per the session policy. See perun.ChUpdateNotif.
+		AutoResponse perun.AutoResponse
 	}
 )
 
 // SendPayChUpdate sends a payment update on the channel that can send or
 // request funds. Use `self` in the `payee` field to pay the user itself and
-// "alias of the peer" to pay the peer.
+// "alias of the peer" to pay the peer. In channels with more than two
+// participants, the `payer` field should also be set, to specify the
+// participant paying the amount.
 //
 // If there is an error, it will be one of the following codes:
 // - ErrResourceNotFound with ResourceType: "peerID" when any of the peer aliases are not known.
 // - ErrInvalidArgument with Name:"Amount" when the amount is invalid.
+// - ErrInvalidArgument with Name:"payee" when the payee is not a participant of the channel.
+// - ErrInvalidArgument with Name:"payer" when the payer is not a participant of the channel, is same as
+// the payee or is not specified in a channel with more than two participants.
 // or any of the errors returned by the session.SendChUpdate API.
 func SendPayChUpdate(pctx context.Context, ch perun.ChAPI, payments []Payment) (PayChInfo, perun.APIError) {
 	updates := make([]func(state *pchannel.State), len(payments))
@@ -93,9 +106,13 @@ func SendPayChUpdate(pctx context.Context, ch perun.ChAPI, payments []Payment) (
 			err = errors.WithMessage(err, ErrInvalidAmount.Error())
 			return PayChInfo{}, perun.NewAPIErrInvalidArgument(err, perun.ArgNameAmount, payments[i].Amount)
 		}
-		payerIdx, payeeIdx, err := getPayerPayeeIdx(ch.Parts(), payments[i].Payee)
+		payeeIdx, found := partIdx(ch.Parts(), payments[i].Payee)
+		if !found {
+			return PayChInfo{}, perun.NewAPIErrInvalidArgument(ErrInvalidPayee, perun.ArgNamePayee, payments[i].Payee)
+		}
+		payerIdx, err := getPayerIdx(ch.Parts(), payments[i].Payer, payeeIdx)
 		if err != nil {
-			return PayChInfo{}, perun.NewAPIErrInvalidArgument(err, perun.ArgNamePayee, payments[i].Payee)
+			return PayChInfo{}, perun.NewAPIErrInvalidArgument(err, perun.ArgNamePayer, payments[i].Payer)
 		}
 		updates[i] = newUpdate(payerIdx, payeeIdx, idxOfCurrencyInBals, parsedAmount)
 	}
@@ -108,15 +125,34 @@ func SendPayChUpdate(pctx context.Context, ch perun.ChAPI, payments []Payment) (
 	return toPayChInfo(chInfo), apiErr
 }
 
-func getPayerPayeeIdx(parts []string, payee string) (payerIdx, payeeIdx int, _ error) {
+// partIdx returns the index of the alias in the list of channel participants.
+func partIdx(parts []string, alias string) (int, bool) {
 	for i := range parts {
-		if parts[i] == payee {
-			payeeIdx = i
-			payerIdx = payeeIdx ^ 1
-			return payerIdx, payeeIdx, nil
+		if parts[i] == alias {
+			return i, true
 		}
 	}
-	return 0, 0, ErrInvalidPayee
+	return 0, false
+}
+
+// getPayerIdx returns the index of the payer in the list of channel
+// participants. If payer is not specified in a two party channel, the
+// participant other than the payee is the payer.
+func getPayerIdx(parts []string, payer string, payeeIdx int) (int, error) {
+	if payer == "" {
+		if len(parts) != 2 {
+			return 0, errors.WithMessage(ErrInvalidPayer, "payer is required when there are more than two participants")
+		}
+		return payeeIdx ^ 1, nil
+	}
+	payerIdx, found := partIdx(parts, payer)
+	if !found {
+		return 0, ErrInvalidPayer
+	}
+	if payerIdx == payeeIdx {
+		return 0, errors.WithMessage(ErrInvalidPayer, "payer and payee should be different")
+	}
+	return payerIdx, nil
 }
 
 func newUpdate(payerIdx, payeeIdx, idxOfCurrencyInBals int, parsedAmount *big.Int) func(state *pchannel.State) {
@@ -133,6 +169,14 @@ func GetPayChInfo(ch perun.ChAPI) PayChInfo {
 	return toPayChInfo(ch.GetChInfo())
 }
 
+// GetPayChHistory fetches the history of accepted updates on this channel,
+// starting from the given version.
+//
+// See session.GetHistory for the list of errors returned by this API.
+func GetPayChHistory(ch perun.ChAPI, fromVersion, limit uint64) ([]perun.ChHistoryEntry, perun.APIError) {
+	return ch.GetHistory(fromVersion, limit)
+}
+
 // SubPayChUpdates sets up a subscription for incoming channel updates and
 // interprets the notifications as payment update notifiations.
 //
@@ -151,6 +195,7 @@ func SubPayChUpdates(ch perun.ChAPI, notifier PayChUpdateNotifier) perun.APIErro
 			Type:              notif.Type,
 			Expiry:            notif.Expiry,
 			Error:             notif.Error,
+			AutoResponse:      notif.AutoResponse,
 		})
 	})
 }
@@ -18,11 +18,13 @@ package payment_test
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/app/payment"
@@ -119,6 +121,79 @@ func Test_SendPayChUpdate(t *testing.T) {
 	})
 }
 
+func Test_SendPayChUpdate_MultiParty(t *testing.T) {
+	currencies := currency.NewRegistry()
+	//nolint:errcheck	// Safe to ignore the error, as it is first register after init.
+	ethCurrency, _ := currencies.Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+	multiParts := []string{perun.OwnAlias, "bob", "carol", "dave"}
+
+	// Returns a mock with API calls set up for currency and parts.
+	newChAPIMock := func() *mocks.ChAPI {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("Currency", currency.ETHSymbol).Return(0, ethCurrency, true)
+		chAPI.On("Parts").Return(multiParts)
+		return chAPI
+	}
+
+	t.Run("happy_payer_and_payee", func(t *testing.T) {
+		var updater perun.StateUpdater
+		chAPI := newChAPIMock()
+		chAPI.On("SendChUpdate", context.Background(), mock.MatchedBy(func(gotUpdater perun.StateUpdater) bool {
+			updater = gotUpdater
+			return true
+		})).Return(updatedChInfo, nil)
+
+		payments := []payment.Payment{
+			{Currency: currency.ETHSymbol, Payer: "carol", Payee: "bob", Amount: "0.5"},
+			{Currency: currency.ETHSymbol, Payer: perun.OwnAlias, Payee: "dave", Amount: "1"},
+		}
+		_, gotErr := payment.SendPayChUpdate(context.Background(), chAPI, payments)
+		require.NoError(t, gotErr)
+		require.NotNil(t, updater)
+
+		parse := func(amount string) *big.Int {
+			parsed, err := ethCurrency.Parse(amount)
+			require.NoError(t, err)
+			return parsed
+		}
+		state := &pchannel.State{Allocation: pchannel.Allocation{Balances: pchannel.Balances{{
+			parse("2"), parse("2"), parse("2"), parse("2"),
+		}}}}
+		updater(state)
+		want := pchannel.Balances{{parse("1"), parse("2.5"), parse("1.5"), parse("3")}}
+		assert.Equal(t, want, state.Allocation.Balances)
+	})
+
+	t.Run("error_PayerMissing", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		payments := []payment.Payment{makePayment(currency.ETHSymbol, "bob", amountToSend)}
+		_, gotErr := payment.SendPayChUpdate(context.Background(), chAPI, payments)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument, payment.ErrInvalidPayer.Error())
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), perun.ArgNamePayer, "")
+	})
+
+	t.Run("error_InvalidPayer", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		invalidPayer := "invalid-payer"
+		payments := []payment.Payment{
+			{Currency: currency.ETHSymbol, Payer: invalidPayer, Payee: "bob", Amount: amountToSend},
+		}
+		_, gotErr := payment.SendPayChUpdate(context.Background(), chAPI, payments)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument, payment.ErrInvalidPayer.Error())
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), perun.ArgNamePayer, invalidPayer)
+	})
+
+	t.Run("error_PayerSameAsPayee", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		payments := []payment.Payment{
+			{Currency: currency.ETHSymbol, Payer: "bob", Payee: "bob", Amount: amountToSend},
+		}
+		_, gotErr := payment.SendPayChUpdate(context.Background(), chAPI, payments)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument, payment.ErrInvalidPayer.Error())
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), perun.ArgNamePayer, "bob")
+	})
+}
+
 func Test_GetPayChInfo(t *testing.T) {
 	t.Run("happy1", func(t *testing.T) {
 		chAPI := &mocks.ChAPI{}
@@ -136,6 +211,33 @@ func Test_GetPayChInfo(t *testing.T) {
 	})
 }
 
+func Test_GetPayChHistory(t *testing.T) {
+	entries := []perun.ChHistoryEntry{{
+		ChID:         chID,
+		Version:      1,
+		Direction:    perun.PaymentDirectionOutgoing,
+		Counterparty: peerAlias,
+		Currencies:   []string{currency.ETHSymbol},
+		Deltas:       []string{"-0.5"},
+	}}
+	t.Run("happy", func(t *testing.T) {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("GetHistory", uint64(1), uint64(10)).Return(entries, nil)
+
+		gotEntries, gotErr := payment.GetPayChHistory(chAPI, 1, 10)
+		require.NoError(t, gotErr)
+		assert.Equal(t, entries, gotEntries)
+	})
+	t.Run("error", func(t *testing.T) {
+		apiErr := perun.NewAPIErrUnknownInternal(assert.AnError)
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("GetHistory", uint64(0), uint64(10)).Return(nil, apiErr)
+
+		_, gotErr := payment.GetPayChHistory(chAPI, 0, 10)
+		assert.Equal(t, apiErr, gotErr)
+	})
+}
+
 func Test_SubPayChUpdates(t *testing.T) {
 	t.Run("happy", func(t *testing.T) {
 		var notifier perun.ChUpdateNotifier
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package invoice implements payment requests that can be used with the
+// payment API to request a payment from a peer and to reconcile the payment
+// when it is received.
+//
+// The payee creates an invoice for an amount in a currency, with a memo and
+// an expiry, and shares the encoded invoice with the payer. The payer pays it
+// by sending an update on an open payment channel with the payee.
+//
+// Since the payment channels do not have an app data, the state of an update
+// cannot carry the invoice ID. So, the invoice ID is sent to the payee in an
+// app message before the update is sent. The message binds the invoice to the
+// channel on which it is paid. The payee expects only one invoice payment on
+// a channel at a time and matches it with the next update on the channel, in
+// which the payer pays the amount of the invoice; irrespective of its
+// version. The payee accepts the update only if the invoice is still open.
+// Both the payer and the payee record the channel ID and version of the
+// update with the invoice, so that the payment can be reconciled with the
+// channel history.
+//
+// The payer makes only one payment for an invoice at a time. If the payer
+// sends another update for the same amount on the channel while the invoice
+// payment is in progress, it could be matched with the invoice instead. Such
+// updates are not sent by the invoice managers themselves.
+//
+// The invoices created and paid by the user are persisted in a file, so that
+// their status is retained when the node is restarted.
+package invoice
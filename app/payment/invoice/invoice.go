@@ -0,0 +1,103 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Status represents the status of an invoice.
+type Status string
+
+// Enumeration of the values for Status.
+const (
+	StatusOpen    Status = "open"
+	StatusPaid    Status = "paid"
+	StatusExpired Status = "expired"
+)
+
+// Invoice represents a payment request created by the payee.
+//
+// The fields ChID, Version and PaidAt are set only when the invoice is paid.
+type Invoice struct {
+	ID       string `json:"id" yaml:"id"`
+	Payee    string `json:"payee" yaml:"payee"` // Off-chain address of the payee.
+	Currency string `json:"currency" yaml:"currency"`
+	Amount   string `json:"amount" yaml:"amount"`
+	Memo     string `json:"memo,omitempty" yaml:"memo,omitempty"`
+	// Time (in unix seconds) when the invoice was created.
+	CreatedAt int64 `json:"createdAt" yaml:"created_at"`
+	// Time (in unix seconds) after which the invoice cannot be paid. Zero
+	// means the invoice does not expire.
+	Expiry int64 `json:"expiry,omitempty" yaml:"expiry,omitempty"`
+
+	Status  Status `json:"-" yaml:"status"`
+	ChID    string `json:"-" yaml:"ch_id,omitempty"`
+	Version uint64 `json:"-" yaml:"version,omitempty"`
+	PaidAt  int64  `json:"-" yaml:"paid_at,omitempty"` // Time (in unix seconds) when the payment was accepted.
+}
+
+// Encode returns the invoice encoded as a string that can be shared with the
+// payer. Only the fields set by the payee are included.
+func (inv Invoice) Encode() string {
+	data, err := json.Marshal(inv)
+	if err != nil {
+		// Code will not reach here, as invoice contains only basic types.
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode decodes an invoice encoded using Encode. The status of the decoded
+// invoice is open.
+func Decode(encoded string) (Invoice, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Invoice{}, errors.Wrap(err, "decoding base64")
+	}
+	var inv Invoice
+	if err = json.Unmarshal(data, &inv); err != nil {
+		return Invoice{}, errors.Wrap(err, "decoding json")
+	}
+	if inv.ID == "" || inv.Payee == "" || inv.Currency == "" {
+		return Invoice{}, errors.New("id, payee and currency should be set")
+	}
+	if _, ok := parseAmount(inv.Amount); !ok {
+		return Invoice{}, errors.Errorf("invalid amount %s", inv.Amount)
+	}
+	inv.Status = StatusOpen
+	return inv, nil
+}
+
+// IsExpired returns true if the invoice is open and the expiry has passed at
+// the given time.
+func (inv Invoice) IsExpired(at time.Time) bool {
+	return inv.Status == StatusOpen && inv.Expiry != 0 && at.Unix() >= inv.Expiry
+}
+
+// withStatusAt returns the invoice with the status set to expired, if it has
+// expired at the given time.
+func (inv Invoice) withStatusAt(at time.Time) Invoice {
+	if inv.IsExpired(at) {
+		inv.Status = StatusExpired
+	}
+	return inv
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/app/payment/invoice"
+)
+
+func Test_Invoice_EncodeDecode(t *testing.T) {
+	inv := invoice.Invoice{
+		ID:        "1234",
+		Payee:     addrPayee,
+		Currency:  "ETH",
+		Amount:    "0.5",
+		Memo:      "coffee",
+		CreatedAt: 100,
+		Expiry:    200,
+		Status:    invoice.StatusPaid,
+		ChID:      "ch-id",
+		Version:   2,
+		PaidAt:    150,
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		got, err := invoice.Decode(inv.Encode())
+		require.NoError(t, err)
+		want := inv
+		want.Status, want.ChID, want.Version, want.PaidAt = invoice.StatusOpen, "", 0, 0
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalidAmount := inv
+		invalidAmount.Amount = "-1"
+		noID := inv
+		noID.ID = ""
+		for _, encoded := range []string{
+			"not base64!",
+			base64.RawURLEncoding.EncodeToString([]byte("not json")),
+			invalidAmount.Encode(),
+			noID.Encode(),
+		} {
+			_, err := invoice.Decode(encoded)
+			assert.Error(t, err, encoded)
+		}
+	})
+}
+
+func Test_Invoice_IsExpired(t *testing.T) {
+	now := time.Unix(100, 0)
+	assert.False(t, invoice.Invoice{Status: invoice.StatusOpen}.IsExpired(now), "no expiry")
+	assert.False(t, invoice.Invoice{Status: invoice.StatusOpen, Expiry: 101}.IsExpired(now))
+	assert.True(t, invoice.Invoice{Status: invoice.StatusOpen, Expiry: 100}.IsExpired(now))
+	assert.False(t, invoice.Invoice{Status: invoice.StatusPaid, Expiry: 100}.IsExpired(now), "paid")
+}
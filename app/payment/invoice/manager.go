@@ -0,0 +1,676 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// AppID is the ID of the app messages used by the invoice managers.
+const AppID = "invoice"
+
+// DefaultTimeout is the timeout used when it is not set in the config.
+const DefaultTimeout = 10 * time.Second
+
+// Error type is used to define error constants for this package.
+type Error string
+
+// Error implements error interface.
+func (e Error) Error() string {
+	return string(e)
+}
+
+// Definition of error constants for this package.
+const (
+	ErrNoChannel      Error = "no open channel with sufficient balance"
+	ErrUnknownInvoice Error = "invoice not known"
+	ErrExpired        Error = "invoice expired"
+	ErrAlreadyPaid    Error = "invoice already paid"
+	ErrOwnInvoice     Error = "invoice created by the user"
+	ErrPayInProgress  Error = "payment for the invoice is in progress"
+	ErrChBusy         Error = "payment for another invoice is in progress on the channel"
+)
+
+type (
+	// Config represents the configurable parameters of the invoice manager.
+	Config struct {
+		// StoreFile is the path of the file in which the invoices are
+		// persisted. If it is empty, the invoices are not persisted.
+		StoreFile string
+		// Timeout is the time within which the payee should respond to the
+		// payer and the update paying the invoice should be accepted.
+		Timeout time.Duration
+	}
+
+	// Manager creates invoices, pays invoices created by the peers and
+	// tracks their status for a session.
+	Manager struct {
+		log.Logger
+
+		sess perun.SessionAPI
+		cfg  Config
+		self string // Off-chain address of the user.
+
+		mutex    sync.Mutex
+		store    *store
+		expected map[string]*expectedPayment // Payments expected from the payers, by channel ID.
+		pending  map[string]*response        // Responses expected from the payees, by invoice ID.
+		paying   map[string]bool             // Invoices for which an update is being accepted, by invoice ID.
+		payingTo map[string]bool             // Invoices being paid by the user, by invoice ID.
+	}
+
+	// expectedPayment is an update announced by the payer for paying an
+	// invoice.
+	expectedPayment struct {
+		from      string // Alias of the payer.
+		invoiceID string
+		matched   bool // True, once the update is received.
+	}
+
+	// response is used for receiving the responses from a payee.
+	response struct {
+		peerAlias string
+		msgs      chan message
+	}
+)
+
+// New initializes an invoice manager for the session and loads the invoices
+// persisted in the store file, if any.
+//
+// The manager subscribes to the app messages and sets the channel update
+// interceptor on the session, both for AppID. Hence, only one manager can be
+// used with a session at a time.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidConfig with Name:"storeFile" when the store file cannot be loaded.
+// or any of the errors returned by the session.SubAppMsgs API.
+func New(sess perun.SessionAPI, cfg Config) (*Manager, perun.APIError) {
+	s, err := newStore(cfg.StoreFile)
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "storeFile", cfg.StoreFile)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	ownID, apiErr := sess.GetPeerID(perun.OwnAlias)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	m := &Manager{
+		Logger:   log.NewLoggerWithField("invoice", sess.ID()),
+		sess:     sess,
+		cfg:      cfg,
+		self:     normalizeAddr(ownID.OffChainAddrString),
+		store:    s,
+		expected: make(map[string]*expectedPayment),
+		pending:  make(map[string]*response),
+		paying:   make(map[string]bool),
+		payingTo: make(map[string]bool),
+	}
+	if apiErr = sess.SubAppMsgs(AppID, m.handleMsg); apiErr != nil {
+		return nil, apiErr
+	}
+	sess.SetChUpdateInterceptor(AppID, m.intercept)
+	return m, nil
+}
+
+// Close stops the manager from creating and paying invoices. The payments
+// that are in progress will not be completed.
+func (m *Manager) Close() perun.APIError {
+	m.sess.SetChUpdateInterceptor(AppID, nil)
+	return m.sess.UnsubAppMsgs(AppID)
+}
+
+// Create creates an invoice with the user as the payee and persists it. If
+// expiry is zero, the invoice does not expire.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"currency" when the currency is empty.
+// - ErrInvalidArgument with Name:"amount" when the amount is not a positive decimal.
+// - ErrInvalidArgument with Name:"expiry" when the expiry is negative.
+// - ErrUnknownInternal when the invoice cannot be persisted.
+func (m *Manager) Create(currency, amount, memo string, expiry time.Duration) (Invoice, perun.APIError) {
+	if currency == "" {
+		return Invoice{}, perun.NewAPIErrInvalidArgument(errors.New("empty currency"), perun.ArgNameCurrency, currency)
+	}
+	if _, ok := parseAmount(amount); !ok {
+		return Invoice{}, perun.NewAPIErrInvalidArgument(payment.ErrInvalidAmount, perun.ArgNameAmount, amount)
+	}
+	if expiry < 0 {
+		err := errors.New("negative expiry")
+		return Invoice{}, perun.NewAPIErrInvalidArgument(err, perun.ArgNameExpiry, expiry.String())
+	}
+	id, err := randomHex()
+	if err != nil {
+		return Invoice{}, perun.NewAPIErrUnknownInternal(err)
+	}
+
+	now := time.Now()
+	inv := Invoice{
+		ID:        id,
+		Payee:     m.self,
+		Currency:  currency,
+		Amount:    amount,
+		Memo:      memo,
+		CreatedAt: now.Unix(),
+		Status:    StatusOpen,
+	}
+	if expiry != 0 {
+		inv.Expiry = now.Add(expiry).Unix()
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if err = m.store.put(inv); err != nil {
+		return Invoice{}, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "persisting invoice"))
+	}
+	m.WithField("method", "Create").Infof("Created invoice %s", id)
+	return inv, nil
+}
+
+// Get returns the invoice with the given ID, created or paid by the user.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "invoice" when the invoice is not known.
+func (m *Manager) Get(id string) (Invoice, perun.APIError) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	inv, ok := m.store.get(id)
+	if !ok {
+		return Invoice{}, perun.NewAPIErrResourceNotFound(perun.ResTypeInvoice, id)
+	}
+	return inv.withStatusAt(time.Now()), nil
+}
+
+// List returns the invoices created or paid by the user, ordered by the time
+// they were created.
+func (m *Manager) List() []Invoice {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	now := time.Now()
+	invoices := m.store.list()
+	for i := range invoices {
+		invoices[i] = invoices[i].withStatusAt(now)
+	}
+	return invoices
+}
+
+// IsOwn returns true if the invoice was created by the user.
+func (m *Manager) IsOwn(inv Invoice) bool {
+	return normalizeAddr(inv.Payee) == m.self
+}
+
+// PayInvoice pays the encoded invoice on an open channel with the payee, in
+// which the user has sufficient balance. Before sending the update, the
+// channel ID is sent to the payee along with the invoice ID. The paid invoice
+// is persisted along with the channel ID and version of the update.
+//
+// Only one payment for an invoice is made at a time. See the package
+// documentation for details on how the update is matched with the invoice.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"invoice" when the invoice cannot be decoded.
+// - ErrFailedPreCondition when the invoice has expired, was already paid, is being paid or was created by the user.
+// - ErrResourceNotFound with ResourceType: "peerID" when the payee is not known.
+// - ErrFailedPreCondition when there is no open channel with sufficient balance with the payee.
+// - ErrPeerRejected when the payee rejects the payment.
+// - ErrPeerRequestTimedOut when the payee does not respond in time.
+// - ErrUnknownInternal when the paid invoice cannot be persisted.
+// or any of the errors returned by the payment.SendPayChUpdate API.
+func (m *Manager) PayInvoice(pctx context.Context, encoded string) (Invoice, perun.APIError) {
+	m.WithField("method", "PayInvoice").Infof("\nReceived request with params %+v", encoded)
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			m.WithFields(perun.APIErrAsMap("PayInvoice", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	inv, apiErr := m.validateInvoice(encoded)
+	if apiErr != nil {
+		return Invoice{}, apiErr
+	}
+	defer m.donePaying(inv.ID)
+	peerAlias, ok := m.aliasOf(inv.Payee)
+	if !ok {
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypePeerID, inv.Payee)
+		return Invoice{}, apiErr
+	}
+	ch, err := m.chWith(peerAlias, inv.Currency, inv.Amount)
+	if err != nil {
+		apiErr = perun.NewAPIErrFailedPreCondition(err)
+		return Invoice{}, apiErr
+	}
+
+	ctx, cancel := context.WithTimeout(pctx, m.cfg.Timeout)
+	defer cancel()
+	if apiErr = m.requestPayment(ctx, peerAlias, message{
+		Type:      msgPay,
+		InvoiceID: inv.ID,
+		ChID:      ch.ID(),
+	}); apiErr != nil {
+		return Invoice{}, apiErr
+	}
+	payments := []payment.Payment{{Currency: inv.Currency, Payee: peerAlias, Amount: inv.Amount}}
+	payChInfo, apiErr := payment.SendPayChUpdate(ctx, ch, payments)
+	if apiErr != nil {
+		return Invoice{}, apiErr
+	}
+
+	inv.Status = StatusPaid
+	inv.ChID = payChInfo.ChID
+	inv.Version, _ = strconv.ParseUint(payChInfo.Version, 10, 64) //nolint:errcheck
+	inv.PaidAt = time.Now().Unix()
+	if apiErr = m.put(inv); apiErr != nil {
+		return Invoice{}, apiErr
+	}
+	m.WithField("method", "PayInvoice").Infof("Paid invoice %s", inv.ID)
+	return inv, nil
+}
+
+// validateInvoice decodes the invoice and checks if it can be paid by the
+// user. If yes, the invoice is marked as being paid and donePaying should be
+// called once the payment is completed or has failed.
+func (m *Manager) validateInvoice(encoded string) (Invoice, perun.APIError) {
+	inv, err := Decode(encoded)
+	if err != nil {
+		return Invoice{}, perun.NewAPIErrInvalidArgument(err, perun.ArgNameInvoice, encoded)
+	}
+	if m.IsOwn(inv) {
+		return Invoice{}, perun.NewAPIErrFailedPreCondition(ErrOwnInvoice)
+	}
+	if inv.IsExpired(time.Now()) {
+		return Invoice{}, perun.NewAPIErrFailedPreCondition(ErrExpired)
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if existing, ok := m.store.get(inv.ID); ok && existing.Status == StatusPaid {
+		return Invoice{}, perun.NewAPIErrFailedPreCondition(ErrAlreadyPaid)
+	}
+	if m.payingTo[inv.ID] {
+		return Invoice{}, perun.NewAPIErrFailedPreCondition(ErrPayInProgress)
+	}
+	m.payingTo[inv.ID] = true
+	return inv, nil
+}
+
+// donePaying marks that the invoice is no longer being paid by the user.
+func (m *Manager) donePaying(invoiceID string) {
+	m.mutex.Lock()
+	delete(m.payingTo, invoiceID)
+	m.mutex.Unlock()
+}
+
+// requestPayment sends the pay message to the payee and waits for it to
+// acknowledge the payment.
+func (m *Manager) requestPayment(ctx context.Context, peerAlias string, req message) perun.APIError {
+	resp := m.expect(req.InvoiceID, peerAlias)
+	defer m.forget(req.InvoiceID)
+
+	if apiErr := m.sess.SendAppMsg(ctx, peerAlias, AppID, req.encode()); apiErr != nil {
+		return apiErr
+	}
+	select {
+	case r := <-resp.msgs:
+		if r.Type != msgPayAck {
+			return perun.NewAPIErrPeerRejected(errors.New(r.Reason), peerAlias, r.Reason)
+		}
+		return nil
+	case <-ctx.Done():
+		return perun.NewAPIErrPeerRequestTimedOut(ctx.Err(), peerAlias, m.cfg.Timeout.String())
+	}
+}
+
+// chWith returns an open channel with the peer, in which the user has at
+// least the given amount in the currency.
+func (m *Manager) chWith(peerAlias, currency, amount string) (perun.ChAPI, error) {
+	required, ok := parseAmount(amount)
+	if !ok {
+		return nil, errors.WithMessage(payment.ErrInvalidAmount, amount)
+	}
+	for _, chInfo := range m.sess.GetChsInfo() {
+		if len(chInfo.BalInfo.Parts) != 2 || !hasPart(chInfo.BalInfo, peerAlias) {
+			continue
+		}
+		bal, found := balance(chInfo.BalInfo, currency, perun.OwnAlias)
+		if !found || bal.Cmp(required) < 0 {
+			continue
+		}
+		ch, apiErr := m.sess.GetCh(chInfo.ChID)
+		if apiErr == nil {
+			return ch, nil
+		}
+	}
+	return nil, errors.WithMessage(ErrNoChannel, peerAlias)
+}
+
+// aliasOf returns the alias of the peer with the given off-chain address.
+func (m *Manager) aliasOf(addr string) (string, bool) {
+	peerIDs, apiErr := m.sess.ListPeerIDs()
+	if apiErr != nil {
+		return "", false
+	}
+	for i := range peerIDs {
+		if normalizeAddr(peerIDs[i].OffChainAddrString) == normalizeAddr(addr) {
+			return peerIDs[i].Alias, true
+		}
+	}
+	return "", false
+}
+
+// expect registers for the responses from the payee for the invoice.
+func (m *Manager) expect(invoiceID, peerAlias string) *response {
+	resp := &response{peerAlias: peerAlias, msgs: make(chan message, 1)}
+	m.mutex.Lock()
+	m.pending[invoiceID] = resp
+	m.mutex.Unlock()
+	return resp
+}
+
+func (m *Manager) forget(invoiceID string) {
+	m.mutex.Lock()
+	delete(m.pending, invoiceID)
+	m.mutex.Unlock()
+}
+
+// put adds the invoice to the store and persists it.
+func (m *Manager) put(inv Invoice) perun.APIError {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if err := m.store.put(inv); err != nil {
+		return perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "persisting invoice"))
+	}
+	return nil
+}
+
+// handleMsg handles the messages received from the other invoice managers.
+func (m *Manager) handleMsg(appMsg perun.AppMsg) {
+	msg, err := decodeMsg(appMsg.Data)
+	if err != nil {
+		m.Errorf("Decoding message from %s: %v", appMsg.PeerAlias, err)
+		return
+	}
+	switch msg.Type {
+	case msgPay:
+		m.handlePay(appMsg.PeerAlias, msg)
+	case msgPayAck, msgReject:
+		m.mutex.Lock()
+		resp, ok := m.pending[msg.InvoiceID]
+		m.mutex.Unlock()
+		if !ok || resp.peerAlias != appMsg.PeerAlias {
+			m.Infof("Dropped unexpected %s message from %s", msg.Type, appMsg.PeerAlias)
+			return
+		}
+		select {
+		case resp.msgs <- msg:
+		default:
+			m.Infof("Dropped %s message from %s as too many responses were received", msg.Type, appMsg.PeerAlias)
+		}
+	default:
+		m.Infof("Dropped message of unknown type %s from %s", msg.Type, appMsg.PeerAlias)
+	}
+}
+
+// handlePay validates the payment announced by the payer. If it is valid, it
+// records the payment for matching the update on the channel and
+// acknowledges it. Only one payment can be expected on a channel at a time.
+func (m *Manager) handlePay(from string, msg message) {
+	p := &expectedPayment{from: from, invoiceID: msg.InvoiceID}
+	err := m.validatePay(from, msg)
+	if err == nil {
+		m.mutex.Lock()
+		if _, ok := m.expected[msg.ChID]; ok {
+			err = ErrChBusy
+		} else {
+			m.expected[msg.ChID] = p
+		}
+		m.mutex.Unlock()
+	}
+	if err != nil {
+		m.Infof("Rejected payment for invoice %s from %s: %v", msg.InvoiceID, from, err)
+		m.send(from, message{Type: msgReject, InvoiceID: msg.InvoiceID, Reason: err.Error()})
+		return
+	}
+
+	// Forget the payment if the update is not received in time.
+	time.AfterFunc(m.cfg.Timeout, func() {
+		m.mutex.Lock()
+		if curr, ok := m.expected[msg.ChID]; ok && curr == p && !p.matched {
+			delete(m.expected, msg.ChID)
+		}
+		m.mutex.Unlock()
+	})
+	m.send(from, message{Type: msgPayAck, InvoiceID: msg.InvoiceID})
+}
+
+func (m *Manager) validatePay(from string, msg message) error {
+	m.mutex.Lock()
+	inv, ok := m.store.get(msg.InvoiceID)
+	m.mutex.Unlock()
+	switch {
+	case !ok || !m.IsOwn(inv):
+		return ErrUnknownInvoice
+	case inv.Status == StatusPaid:
+		return ErrAlreadyPaid
+	case inv.IsExpired(time.Now()):
+		return ErrExpired
+	}
+	ch, apiErr := m.sess.GetCh(msg.ChID)
+	if apiErr != nil {
+		return ErrNoChannel
+	}
+	balInfo := ch.GetChInfo().BalInfo
+	if len(balInfo.Parts) != 2 || !hasPart(balInfo, from) || !hasCurrency(balInfo, inv.Currency) {
+		return ErrNoChannel
+	}
+	return nil
+}
+
+// intercept handles the updates that pay an invoice and lets the others to be
+// notified to the user.
+//
+// The first update on the channel, in which the payer pays the amount of the
+// expected invoice, is matched with it; irrespective of its version.
+func (m *Manager) intercept(notif perun.ChUpdateNotif) bool {
+	if notif.Type != perun.ChUpdateTypeOpen {
+		return false
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	p, ok := m.expected[notif.CurrChInfo.ChID]
+	if !ok || p.matched {
+		return false
+	}
+	inv, ok := m.store.get(p.invoiceID)
+	if !ok || !isPayment(notif, p.from, inv.Currency, inv.Amount) {
+		m.Infof("Update %s does not pay invoice %s", notif.UpdateID, p.invoiceID)
+		return false
+	}
+	p.matched = true
+	go m.complete(p, notif)
+	return true
+}
+
+// complete accepts the update paying the invoice and marks the invoice as
+// paid. If the invoice was paid or has expired in the meanwhile, the update
+// is rejected.
+func (m *Manager) complete(p *expectedPayment, notif perun.ChUpdateNotif) {
+	defer func() {
+		m.mutex.Lock()
+		delete(m.expected, notif.CurrChInfo.ChID)
+		m.mutex.Unlock()
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), m.cfg.Timeout)
+	defer cancel()
+
+	ch, apiErr := m.sess.GetCh(notif.CurrChInfo.ChID)
+	if apiErr != nil {
+		m.Errorf("Getting channel for invoice %s: %v", p.invoiceID, apiErr)
+		return
+	}
+
+	// Only one update paying an invoice is accepted at a time, so that the
+	// invoice is not paid twice.
+	m.mutex.Lock()
+	inv, _ := m.store.get(p.invoiceID)
+	accept := inv.Status == StatusOpen && !inv.IsExpired(time.Now()) && !m.paying[inv.ID]
+	if accept {
+		m.paying[inv.ID] = true
+	}
+	m.mutex.Unlock()
+	if !accept {
+		if _, apiErr = ch.RespondChUpdate(ctx, notif.UpdateID, false); apiErr != nil {
+			m.Errorf("Rejecting update for invoice %s: %v", p.invoiceID, apiErr)
+		}
+		return
+	}
+
+	_, apiErr = ch.RespondChUpdate(ctx, notif.UpdateID, true)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.paying, inv.ID)
+	if apiErr != nil {
+		m.Errorf("Accepting update for invoice %s: %v", p.invoiceID, apiErr)
+		return
+	}
+	inv.Status = StatusPaid
+	inv.ChID = notif.CurrChInfo.ChID
+	inv.Version, _ = strconv.ParseUint(notif.ProposedChInfo.Version, 10, 64) //nolint:errcheck
+	inv.PaidAt = time.Now().Unix()
+	if err := m.store.put(inv); err != nil {
+		m.Errorf("Persisting paid invoice %s: %v", p.invoiceID, err)
+	}
+	m.Infof("Received payment for invoice %s", p.invoiceID)
+}
+
+// send sends the message to the peer and logs the error, if any.
+func (m *Manager) send(peerAlias string, msg message) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.cfg.Timeout)
+	defer cancel()
+	if apiErr := m.sess.SendAppMsg(ctx, peerAlias, AppID, msg.encode()); apiErr != nil {
+		m.Errorf("Sending %s message to %s: %v", msg.Type, peerAlias, apiErr)
+	}
+}
+
+// isPayment returns true if the only change proposed in the update is the
+// payer paying the amount in the currency to the user.
+func isPayment(notif perun.ChUpdateNotif, payer, currency, amount string) bool {
+	curr, proposed := notif.CurrChInfo.BalInfo, notif.ProposedChInfo.BalInfo
+	if len(curr.Currencies) != len(proposed.Currencies) || len(curr.Bals) != len(proposed.Bals) {
+		return false
+	}
+	paid, ok := parseAmount(amount)
+	if !ok {
+		return false
+	}
+	for i := range curr.Bals {
+		if len(curr.Bals[i]) != len(curr.Parts) || len(proposed.Bals[i]) != len(curr.Parts) {
+			return false
+		}
+		for j := range curr.Bals[i] {
+			currBal, ok1 := new(big.Rat).SetString(curr.Bals[i][j])
+			proposedBal, ok2 := new(big.Rat).SetString(proposed.Bals[i][j])
+			if !ok1 || !ok2 {
+				return false
+			}
+			want := new(big.Rat)
+			if curr.Currencies[i] == currency {
+				switch curr.Parts[j] {
+				case perun.OwnAlias:
+					want.Set(paid)
+				case payer:
+					want.Neg(paid)
+				}
+			}
+			if new(big.Rat).Sub(proposedBal, currBal).Cmp(want) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// balance returns the balance of the participant in the currency.
+func balance(balInfo perun.BalInfo, currency, alias string) (*big.Rat, bool) {
+	for i := range balInfo.Currencies {
+		if balInfo.Currencies[i] != currency || i >= len(balInfo.Bals) {
+			continue
+		}
+		for j := range balInfo.Parts {
+			if balInfo.Parts[j] == alias && j < len(balInfo.Bals[i]) {
+				return new(big.Rat).SetString(balInfo.Bals[i][j])
+			}
+		}
+	}
+	return nil, false
+}
+
+func hasCurrency(balInfo perun.BalInfo, currency string) bool {
+	for i := range balInfo.Currencies {
+		if balInfo.Currencies[i] == currency {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPart(balInfo perun.BalInfo, alias string) bool {
+	for i := range balInfo.Parts {
+		if balInfo.Parts[i] == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAmount parses the amount in decimal representation. It returns false,
+// if the amount is not positive.
+func parseAmount(amount string) (*big.Rat, bool) {
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok || r.Sign() <= 0 {
+		return nil, false
+	}
+	return r, true
+}
+
+// normalizeAddr returns the off-chain address in lower case, so that the
+// addresses can be compared irrespective of the case.
+func normalizeAddr(addr string) string {
+	return strings.ToLower(addr)
+}
+
+func randomHex() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", errors.Wrap(err, "reading random bytes")
+	}
+	return hex.EncodeToString(data), nil
+}
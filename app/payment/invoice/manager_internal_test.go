@@ -0,0 +1,69 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+func Test_isPayment(t *testing.T) {
+	newNotif := func(proposedBals []string) perun.ChUpdateNotif {
+		parts := []string{"bob", perun.OwnAlias}
+		return perun.ChUpdateNotif{
+			CurrChInfo: perun.ChInfo{BalInfo: perun.BalInfo{
+				Currencies: []string{"ETH"}, Parts: parts, Bals: [][]string{{"2", "1"}},
+			}},
+			ProposedChInfo: perun.ChInfo{BalInfo: perun.BalInfo{
+				Currencies: []string{"ETH"}, Parts: parts, Bals: [][]string{proposedBals},
+			}},
+		}
+	}
+
+	assert.True(t, isPayment(newNotif([]string{"1.5", "1.5"}), "bob", "ETH", "0.5"))
+	assert.False(t, isPayment(newNotif([]string{"1.6", "1.4"}), "bob", "ETH", "0.5"), "amount is less")
+	assert.False(t, isPayment(newNotif([]string{"1.4", "1.6"}), "bob", "ETH", "0.5"), "amount is more")
+	assert.False(t, isPayment(newNotif([]string{"2.5", "0.5"}), "bob", "ETH", "0.5"), "payment in other direction")
+	assert.False(t, isPayment(newNotif([]string{"1.5", "1.5"}), "alice", "ETH", "0.5"), "other payer")
+	assert.False(t, isPayment(newNotif([]string{"1.5", "1.5"}), "bob", "USD", "0.5"), "other currency")
+}
+
+func Test_store(t *testing.T) {
+	filePath := t.TempDir() + "/invoices.yaml"
+	s, err := newStore(filePath)
+	require.NoError(t, err)
+	assert.Empty(t, s.list())
+
+	invoices := []Invoice{
+		{ID: "b", CreatedAt: 1, Status: StatusOpen},
+		{ID: "a", CreatedAt: 2, Status: StatusPaid, ChID: "ch-id", Version: 3, PaidAt: 4},
+	}
+	for i := range invoices {
+		require.NoError(t, s.put(invoices[i]))
+	}
+
+	reloaded, err := newStore(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, invoices, reloaded.list())
+	inv, found := reloaded.get("a")
+	assert.True(t, found)
+	assert.Equal(t, invoices[1], inv)
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment/invoice"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+)
+
+const (
+	addrPayer  = "0xAAAA"
+	addrPayee  = "0xbbbb"
+	payerAlias = "payer"
+	payeeAlias = "payee"
+	chID       = "ch-id"
+)
+
+func Test_New(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock(addrPayee)
+		m, err := invoice.New(sessionAPI, invoice.Config{})
+		require.NoError(t, err)
+
+		sessionAPI.On("UnsubAppMsgs", invoice.AppID).Return(nil)
+		require.NoError(t, m.Close())
+		sessionAPI.AssertCalled(t, "SetChUpdateInterceptor", invoice.AppID, mock.MatchedBy(
+			func(i perun.ChUpdateInterceptor) bool {
+				return i == nil
+			}))
+	})
+
+	t.Run("invalid_store_file", func(t *testing.T) {
+		_, err := invoice.New(&mocks.SessionAPI{}, invoice.Config{StoreFile: t.TempDir()})
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig)
+	})
+
+	t.Run("subscription_exists", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("ID").Return("session-id")
+		sessionAPI.On("GetPeerID", perun.OwnAlias).Return(perun.PeerID{OffChainAddrString: addrPayee}, nil)
+		sessionAPI.On("SubAppMsgs", invoice.AppID, mock.Anything).Return(
+			perun.NewAPIErrResourceExists(perun.ResTypeAppMsgSub, invoice.AppID))
+		_, err := invoice.New(sessionAPI, invoice.Config{})
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceExists)
+	})
+}
+
+func Test_Manager_Create(t *testing.T) {
+	storeFile := t.TempDir() + "/invoices.yaml"
+	m, err := invoice.New(newSessionAPIMock(addrPayee), invoice.Config{StoreFile: storeFile})
+	require.NoError(t, err)
+
+	t.Run("happy", func(t *testing.T) {
+		inv, err := m.Create(currency.ETHSymbol, "0.5", "coffee", time.Hour)
+		require.NoError(t, err)
+		assert.NotEmpty(t, inv.ID)
+		assert.Equal(t, addrPayee, inv.Payee)
+		assert.Equal(t, invoice.StatusOpen, inv.Status)
+		assert.Greater(t, inv.Expiry, time.Now().Unix())
+		assert.True(t, m.IsOwn(inv))
+
+		got, err := m.Get(inv.ID)
+		require.NoError(t, err)
+		assert.Equal(t, inv, got)
+
+		// Invoices are loaded from the store file by a new manager.
+		reloaded, err := invoice.New(newSessionAPIMock(addrPayee), invoice.Config{StoreFile: storeFile})
+		require.NoError(t, err)
+		assert.Contains(t, reloaded.List(), inv)
+	})
+
+	t.Run("happy_expired", func(t *testing.T) {
+		inv, err := m.Create(currency.ETHSymbol, "0.5", "", time.Nanosecond)
+		require.NoError(t, err)
+		got, err := m.Get(inv.ID)
+		require.NoError(t, err)
+		assert.Equal(t, invoice.StatusExpired, got.Status)
+	})
+
+	t.Run("invalid_args", func(t *testing.T) {
+		_, err := m.Create("", "0.5", "", 0)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameCurrency, "")
+
+		for _, amount := range []string{"abc", "-1", "0"} {
+			_, err = m.Create(currency.ETHSymbol, amount, "", 0)
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+			peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameAmount, amount)
+		}
+
+		_, err = m.Create(currency.ETHSymbol, "0.5", "", -time.Second)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameExpiry, "-1s")
+	})
+
+	t.Run("unknown_invoice", func(t *testing.T) {
+		_, err := m.Get("unknown")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeInvoice, "unknown")
+	})
+}
+
+func Test_Manager_PayInvoice(t *testing.T) {
+	payee, err := invoice.New(newSessionAPIMock(addrPayee), invoice.Config{})
+	require.NoError(t, err)
+	inv, err := payee.Create(currency.ETHSymbol, "0.5", "coffee", time.Hour)
+	require.NoError(t, err)
+
+	t.Run("invalid_invoice", func(t *testing.T) {
+		payer, err := invoice.New(newSessionAPIMock(addrPayer), invoice.Config{})
+		require.NoError(t, err)
+		_, err = payer.PayInvoice(context.Background(), "invalid")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameInvoice, "invalid")
+	})
+
+	t.Run("own_invoice", func(t *testing.T) {
+		_, err := payee.PayInvoice(context.Background(), inv.Encode())
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+
+	t.Run("expired_invoice", func(t *testing.T) {
+		payer, err := invoice.New(newSessionAPIMock(addrPayer), invoice.Config{})
+		require.NoError(t, err)
+		expired := inv
+		expired.Expiry = time.Now().Add(-time.Second).Unix()
+		_, err = payer.PayInvoice(context.Background(), expired.Encode())
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+
+	t.Run("unknown_payee", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock(addrPayer)
+		sessionAPI.On("ListPeerIDs").Return([]perun.PeerID{}, nil)
+		payer, err := invoice.New(sessionAPI, invoice.Config{})
+		require.NoError(t, err)
+		_, err = payer.PayInvoice(context.Background(), inv.Encode())
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePeerID, addrPayee)
+	})
+
+	t.Run("insufficient_balance", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock(addrPayer)
+		sessionAPI.On("ListPeerIDs").Return([]perun.PeerID{{Alias: payeeAlias, OffChainAddrString: addrPayee}}, nil)
+		sessionAPI.On("GetChsInfo").Return([]perun.ChInfo{chInfo(payeeAlias, "0.1", "1", "0")})
+		payer, err := invoice.New(sessionAPI, invoice.Config{})
+		require.NoError(t, err)
+		_, err = payer.PayInvoice(context.Background(), inv.Encode())
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+
+	t.Run("pay_in_progress", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock(addrPayer)
+		sessionAPI.On("ListPeerIDs").Return([]perun.PeerID{{Alias: payeeAlias, OffChainAddrString: addrPayee}}, nil)
+		sessionAPI.On("GetChsInfo").Return([]perun.ChInfo{chInfo(payeeAlias, "2", "1", "3")})
+		payerCh := &mocks.ChAPI{}
+		payerCh.On("ID").Return(chID)
+		sessionAPI.On("GetCh", chID).Return(payerCh, nil)
+		sent, release := make(chan struct{}), make(chan struct{})
+		sessionAPI.On("SendAppMsg", mock.Anything, payeeAlias, invoice.AppID, mock.Anything).Run(func(mock.Arguments) {
+			close(sent)
+			<-release
+		}).Return(perun.NewAPIErrUnknownInternal(errors.New("payee not reachable"))).Once()
+		payer, err := invoice.New(sessionAPI, invoice.Config{})
+		require.NoError(t, err)
+
+		firstErr := make(chan perun.APIError, 1)
+		go func() {
+			_, apiErr := payer.PayInvoice(context.Background(), inv.Encode())
+			firstErr <- apiErr
+		}()
+		<-sent
+		_, err = payer.PayInvoice(context.Background(), inv.Encode())
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		assert.Contains(t, err.Error(), invoice.ErrPayInProgress.Error())
+
+		close(release)
+		peruntest.AssertAPIError(t, <-firstErr, perun.InternalError, perun.ErrUnknownInternal)
+		// Invoice can be paid again, once the previous payment has failed.
+		sessionAPI.On("SendAppMsg", mock.Anything, payeeAlias, invoice.AppID, mock.Anything).Return(
+			perun.NewAPIErrUnknownInternal(errors.New("payee not reachable")))
+		_, err = payer.PayInvoice(context.Background(), inv.Encode())
+		peruntest.AssertAPIError(t, err, perun.InternalError, perun.ErrUnknownInternal)
+	})
+}
+
+func Test_Manager_PayInvoice_Flow(t *testing.T) {
+	payerSess, payeeSess := newSessionAPIMock(addrPayer), newSessionAPIMock(addrPayee)
+	payer, err := invoice.New(payerSess, invoice.Config{Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	payee, err := invoice.New(payeeSess, invoice.Config{Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	connect(payerSess, payerAlias, payeeSess, payeeAlias)
+
+	inv, err := payee.Create(currency.ETHSymbol, "0.5", "coffee", time.Hour)
+	require.NoError(t, err)
+
+	// Channel with balances (payer: 2, payee: 1) at version 3, as seen by both the users.
+	payerSess.On("ListPeerIDs").Return([]perun.PeerID{{Alias: payeeAlias, OffChainAddrString: addrPayee}}, nil)
+	payerSess.On("GetChsInfo").Return([]perun.ChInfo{chInfo(payeeAlias, "2", "1", "3")})
+	payeeCh := &mocks.ChAPI{}
+	payeeCh.On("GetChInfo").Return(chInfo(payerAlias, "1", "2", "3"))
+	payeeCh.On("RespondChUpdate", mock.Anything, chID+"_5", true).Return(chInfo(payerAlias, "1.6", "1.4", "5"), nil)
+	payeeSess.On("GetCh", chID).Return(payeeCh, nil)
+
+	eth := currencytest.Registry().Currency(currency.ETHSymbol)
+	payerCh := &mocks.ChAPI{}
+	payerCh.On("ID").Return(chID)
+	payerCh.On("Currency", currency.ETHSymbol).Return(0, eth, true)
+	payerCh.On("Parts").Return([]string{perun.OwnAlias, payeeAlias})
+	payerCh.On("SendChUpdate", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		// Another update, that does not pay the invoice, was made on the channel in the meanwhile.
+		intercepted := interceptorOf(payeeSess)(perun.ChUpdateNotif{
+			UpdateID:       chID + "_4",
+			CurrChInfo:     chInfo(payerAlias, "1", "2", "3"),
+			ProposedChInfo: chInfo(payerAlias, "1.1", "1.9", "4"),
+			Type:           perun.ChUpdateTypeOpen,
+		})
+		assert.False(t, intercepted)
+
+		// Deliver the update to the payee. It is matched irrespective of its version.
+		intercepted = interceptorOf(payeeSess)(perun.ChUpdateNotif{
+			UpdateID:       chID + "_5",
+			CurrChInfo:     chInfo(payerAlias, "1.1", "1.9", "4"),
+			ProposedChInfo: chInfo(payerAlias, "1.6", "1.4", "5"),
+			Type:           perun.ChUpdateTypeOpen,
+		})
+		assert.True(t, intercepted)
+	}).Return(chInfo(payeeAlias, "1.4", "1.6", "5"), nil)
+	payerSess.On("GetCh", chID).Return(payerCh, nil)
+
+	paid, err := payer.PayInvoice(context.Background(), inv.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, invoice.StatusPaid, paid.Status)
+	assert.Equal(t, chID, paid.ChID)
+	assert.Equal(t, uint64(5), paid.Version)
+	assert.Equal(t, "coffee", paid.Memo)
+
+	assert.Eventually(t, func() bool {
+		got, apiErr := payee.Get(inv.ID)
+		return apiErr == nil && got.Status == invoice.StatusPaid && got.ChID == chID && got.Version == 5
+	}, time.Second, 10*time.Millisecond)
+
+	t.Run("already_paid", func(t *testing.T) {
+		_, err := payer.PayInvoice(context.Background(), inv.Encode())
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
+
+// newSessionAPIMock returns a session API mock, with the calls for own peer
+// ID, session ID, app message subscription and update interceptor set up.
+func newSessionAPIMock(ownAddr string) *mocks.SessionAPI {
+	sessionAPI := &mocks.SessionAPI{}
+	sessionAPI.On("ID").Return("session-id")
+	sessionAPI.On("GetPeerID", perun.OwnAlias).Return(perun.PeerID{OffChainAddrString: ownAddr}, nil)
+	sessionAPI.On("SubAppMsgs", invoice.AppID, mock.Anything).Return(nil)
+	sessionAPI.On("SetChUpdateInterceptor", invoice.AppID, mock.Anything).Return()
+	return sessionAPI
+}
+
+// connect sets up the session API mocks to deliver the app messages sent by
+// each of them to the other.
+func connect(sess1 *mocks.SessionAPI, alias1 string, sess2 *mocks.SessionAPI, alias2 string) {
+	deliver := func(to *mocks.SessionAPI, fromAlias string) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			notifier := argOf(to, "SubAppMsgs", 1).(perun.AppMsgNotifier)
+			notifier(perun.AppMsg{PeerAlias: fromAlias, Data: args.Get(3).([]byte)})
+		}
+	}
+	sess1.On("SendAppMsg", mock.Anything, alias2, invoice.AppID, mock.Anything).Run(deliver(sess2, alias1)).Return(nil)
+	sess2.On("SendAppMsg", mock.Anything, alias1, invoice.AppID, mock.Anything).Run(deliver(sess1, alias2)).Return(nil)
+}
+
+// interceptorOf returns the update interceptor set on the session API mock.
+func interceptorOf(sess *mocks.SessionAPI) perun.ChUpdateInterceptor {
+	return argOf(sess, "SetChUpdateInterceptor", 1).(perun.ChUpdateInterceptor)
+}
+
+// argOf returns the argument at the index in the first call to the method.
+func argOf(sess *mocks.SessionAPI, method string, idx int) interface{} {
+	for _, call := range sess.Calls {
+		if call.Method == method {
+			return call.Arguments.Get(idx)
+		}
+	}
+	return nil
+}
+
+// chInfo returns the info of a two party channel in ETH between the user and
+// the peer, with the given balances and version.
+func chInfo(peerAlias, ownBal, peerBal, version string) perun.ChInfo {
+	return perun.ChInfo{
+		ChID: chID,
+		BalInfo: perun.BalInfo{
+			Currencies: []string{currency.ETHSymbol},
+			Parts:      []string{perun.OwnAlias, peerAlias},
+			Bals:       [][]string{{ownBal, peerBal}},
+		},
+		Version: version,
+	}
+}
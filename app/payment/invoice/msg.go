@@ -0,0 +1,58 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice
+
+import (
+	"encoding/json"
+)
+
+// Enumeration of message types used in the invoice protocol.
+const (
+	msgPay    msgType = "pay"    // Payer to payee: the next update on the given channel pays the invoice.
+	msgPayAck msgType = "payAck" // Payee to payer: payment expected, the update can be sent.
+	msgReject msgType = "reject" // Payee to payer: invoice cannot be paid.
+)
+
+type (
+	msgType string
+
+	// message is the message exchanged between the invoice managers. Only
+	// the fields relevant for the message type are set.
+	message struct {
+		Type      msgType `json:"type"`
+		InvoiceID string  `json:"invoiceID"`
+		// ID of the channel on which the invoice is paid. Used only in pay
+		// messages.
+		ChID   string `json:"chID,omitempty"`
+		Reason string `json:"reason,omitempty"`
+	}
+)
+
+func (m message) encode() []byte {
+	data, err := json.Marshal(m)
+	if err != nil {
+		// Code will not reach here, as message contains only basic types.
+		panic(err)
+	}
+	return data
+}
+
+func decodeMsg(data []byte) (message, error) {
+	var m message
+	err := json.Unmarshal(data, &m)
+	return m, err
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger-labs/perun-node/internal/fileutil"
+)
+
+// storeFileMode is the file mode used for creating the invoices file.
+const storeFileMode = os.FileMode(0o600)
+
+// store persists the invoices in a YAML file on the disk.
+//
+// Each change is written to the file immediately. The file is updated by
+// writing to a temporary file and renaming it, so that the file is never left
+// partially written.
+//
+// If the file path is empty, the store does not persist anything. The methods
+// on it are not safe for concurrent use, the manager mutex should be held when
+// accessing it.
+type store struct {
+	filePath string
+	invoices map[string]Invoice
+}
+
+// newStore loads the invoices from the given file. If the file does not
+// exist, an empty store is returned and the file will be created on the first
+// write.
+func newStore(filePath string) (*store, error) {
+	s := &store{
+		filePath: filePath,
+		invoices: make(map[string]Invoice),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+
+	f, err := os.Open(filepath.Clean(filePath))
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "opening invoices file")
+	}
+	defer f.Close() //nolint:errcheck
+
+	var invoices []Invoice
+	if err = yaml.NewDecoder(f).Decode(&invoices); err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "decoding invoices file")
+	}
+	for _, inv := range invoices {
+		s.invoices[inv.ID] = inv
+	}
+	return s, nil
+}
+
+// list returns the invoices ordered by the time they were created.
+func (s *store) list() []Invoice {
+	invoices := make([]Invoice, 0, len(s.invoices))
+	for _, inv := range s.invoices {
+		invoices = append(invoices, inv)
+	}
+	sort.Slice(invoices, func(i, j int) bool {
+		if invoices[i].CreatedAt != invoices[j].CreatedAt {
+			return invoices[i].CreatedAt < invoices[j].CreatedAt
+		}
+		return invoices[i].ID < invoices[j].ID
+	})
+	return invoices
+}
+
+// get returns the invoice for the given ID.
+func (s *store) get(id string) (_ Invoice, found bool) {
+	inv, found := s.invoices[id]
+	return inv, found
+}
+
+// put adds the invoice to the store and writes the store to the disk.
+func (s *store) put(inv Invoice) error {
+	s.invoices[inv.ID] = inv
+	return s.persist()
+}
+
+func (s *store) persist() error {
+	if s.filePath == "" {
+		return nil
+	}
+	data, err := yaml.Marshal(s.list())
+	if err != nil {
+		return errors.Wrap(err, "encoding data as yaml")
+	}
+	return fileutil.WriteFileAtomic(s.filePath, data, storeFileMode)
+}
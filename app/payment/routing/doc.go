@@ -0,0 +1,41 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package routing implements multi-hop payments, that can be used to pay a
+// peer with whom the user does not have a channel, through a path of
+// existing channels. The channels should use the htlc app, so that each hop
+// is paid using a hash time locked transfer.
+//
+// The path is found over a locally known graph of channels, where each node
+// is identified by its off-chain address. The payer requests a payment hash
+// from the target, whose preimage is known only to the target. Each hop
+// forwards the payment in two steps: it announces the payment (its hash,
+// deadline and the remaining hops) to the next hop and then, locks the amount
+// to be paid to the next hop on the payment hash, until the deadline. The
+// next hop accepts the lock, forwards the payment further and claims the lock
+// using the preimage, once the lock it had sent is claimed. The target claims
+// its lock using the preimage it had generated. So, the preimage received by
+// the payer serves as the proof of payment.
+//
+// Each hop forwards the payment with a deadline reduced by the hop timeout.
+// So, each intermediary has at least the hop timeout to claim the lock from
+// the previous hop, after the lock it had sent was claimed. If the payment
+// fails, the locks are removed by their senders after the deadlines.
+//
+// Updates removing the locks, by claiming them or after they expire, are
+// always accepted by the router, as they are validated by the htlc app.
+// Other updates on the htlc channels are notified to the user as usual.
+package routing
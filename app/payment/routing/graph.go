@@ -0,0 +1,231 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Link represents a channel in the graph, over which payments can be
+	// forwarded from one participant to the other. A channel usable in both
+	// the directions is represented by two links.
+	Link struct {
+		From string // Off-chain address of the participant forwarding the payment.
+		To   string // Off-chain address of the participant receiving the payment.
+
+		Currency string
+		// Fee charged by the participant forwarding the payment. It is not
+		// charged when the payment is made by the participant itself.
+		Fee string
+	}
+
+	// Graph is the locally known graph of channels, used for finding the
+	// path for payments. It is safe for concurrent use.
+	Graph struct {
+		mutex sync.RWMutex
+		links map[string][]link // Links from each node, by off-chain address.
+	}
+
+	// link is the parsed form of Link.
+	link struct {
+		Link
+		fee *big.Rat
+	}
+
+	// routeEntry is the best known path to a node, when finding a route.
+	routeEntry struct {
+		fee  *big.Rat // Total fee charged by the intermediaries.
+		hops int
+		prev link // Last link in the path.
+	}
+)
+
+// less returns true if the path is better than the other one.
+func (e *routeEntry) less(other *routeEntry) bool {
+	if c := e.fee.Cmp(other.fee); c != 0 {
+		return c < 0
+	}
+	return e.hops < other.hops
+}
+
+// NewGraph returns an empty graph.
+func NewGraph() *Graph {
+	return &Graph{links: make(map[string][]link)}
+}
+
+// Add adds the link to the graph. If a link exists with the same participants
+// and currency, it is replaced.
+func (g *Graph) Add(l Link) error {
+	parsed, err := newLink(l)
+	if err != nil {
+		return err
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.remove(parsed.From, parsed.To, parsed.Currency)
+	g.links[parsed.From] = append(g.links[parsed.From], parsed)
+	return nil
+}
+
+// Remove removes the link with the given participants and currency, if it
+// exists.
+func (g *Graph) Remove(from, to, currency string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.remove(normalizeAddr(from), normalizeAddr(to), currency)
+}
+
+func (g *Graph) remove(from, to, currency string) {
+	links := g.links[from]
+	for i := range links {
+		if links[i].To == to && links[i].Currency == currency {
+			g.links[from] = append(links[:i], links[i+1:]...)
+			return
+		}
+	}
+}
+
+// Links returns all the links in the graph.
+func (g *Graph) Links() []Link {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	var links []Link
+	for _, fromNode := range g.links {
+		for i := range fromNode {
+			links = append(links, fromNode[i].Link)
+		}
+	}
+	return links
+}
+
+// Route returns the path with the least fee for paying from one node to
+// another in the given currency. Among the paths with the same fee, the one
+// with least number of hops is chosen. It returns false if there is no path.
+func (g *Graph) Route(from, to, currency string) ([]Link, bool) {
+	return g.route(from, to, currency, nil)
+}
+
+// route finds the path as in Route, after including the extra links.
+func (g *Graph) route(from, to, currency string, extra []link) ([]Link, bool) {
+	from, to = normalizeAddr(from), normalizeAddr(to)
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	linksFrom := func(node string) []link {
+		var links []link
+		for _, nodeLinks := range [][]link{g.links[node], extra} {
+			for i := range nodeLinks {
+				if nodeLinks[i].From == node && nodeLinks[i].Currency == currency {
+					links = append(links, nodeLinks[i])
+				}
+			}
+		}
+		return links
+	}
+
+	// Dijkstra's algorithm, with the fee of the links as the distance.
+	best := map[string]*routeEntry{from: {fee: new(big.Rat)}}
+	visited := make(map[string]bool)
+	for {
+		node, ok := nextNode(best, visited)
+		if !ok {
+			return nil, false
+		}
+		if node == to {
+			break
+		}
+		visited[node] = true
+
+		for _, l := range linksFrom(node) {
+			candidate := &routeEntry{fee: new(big.Rat).Set(best[node].fee), hops: best[node].hops + 1, prev: l}
+			if node != from { // Payer does not charge itself a fee.
+				candidate.fee.Add(candidate.fee, l.fee)
+			}
+			if curr, found := best[l.To]; !found || candidate.less(curr) {
+				best[l.To] = candidate
+			}
+		}
+	}
+
+	path := make([]Link, best[to].hops)
+	for node, i := to, len(path)-1; i >= 0; i-- {
+		path[i] = best[node].prev.Link
+		node = best[node].prev.From
+	}
+	return path, len(path) > 0
+}
+
+// nextNode returns the node that is not visited and has the least distance.
+func nextNode(distances map[string]*routeEntry, visited map[string]bool) (string, bool) {
+	var next string
+	found := false
+	for node, d := range distances {
+		if visited[node] {
+			continue
+		}
+		if !found || d.less(distances[next]) {
+			next, found = node, true
+		}
+	}
+	return next, found
+}
+
+func newLink(l Link) (link, error) {
+	fee := new(big.Rat)
+	if l.Fee != "" {
+		var ok bool
+		if fee, ok = parseAmount(l.Fee); !ok {
+			return link{}, errors.Errorf("invalid fee: %s", l.Fee)
+		}
+	}
+	l.From, l.To = normalizeAddr(l.From), normalizeAddr(l.To)
+	return link{Link: l, fee: fee}, nil
+}
+
+// normalizeAddr returns the off-chain address in the form used as keys in
+// the graph.
+func normalizeAddr(addr string) string {
+	return strings.ToLower(addr)
+}
+
+// parseAmount parses the decimal representation of an amount in the units of
+// a currency. It returns false if the amount is invalid or negative.
+func parseAmount(amount string) (*big.Rat, bool) {
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok || r.Sign() < 0 {
+		return nil, false
+	}
+	return r, true
+}
+
+// formatAmount returns the shortest decimal representation of the amount.
+func formatAmount(amount *big.Rat) string {
+	const maxPrec = 80
+	for prec := 0; prec < maxPrec; prec++ {
+		s := amount.FloatString(prec)
+		if r, ok := new(big.Rat).SetString(s); ok && r.Cmp(amount) == 0 {
+			return s
+		}
+	}
+	return amount.FloatString(maxPrec)
+}
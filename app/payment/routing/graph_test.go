@@ -0,0 +1,124 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/app/payment/routing"
+)
+
+const (
+	eth        = "ETH"
+	addrA      = "0xAAAA"
+	addrB      = "0xbbbb"
+	addrC      = "0xcccc"
+	addrD      = "0xdddd"
+	addrTarget = "0xeeee"
+)
+
+func Test_Graph_Route(t *testing.T) {
+	// A is connected to target via B (fee 0.2) and via C, D (fee 0.05 each).
+	newGraph := func(t *testing.T) *routing.Graph {
+		g := routing.NewGraph()
+		for _, l := range []routing.Link{
+			{From: addrA, To: addrB, Currency: eth, Fee: "0.1"},
+			{From: addrB, To: addrTarget, Currency: eth, Fee: "0.2"},
+			{From: addrA, To: addrC, Currency: eth, Fee: "0.1"},
+			{From: addrC, To: addrD, Currency: eth, Fee: "0.05"},
+			{From: addrD, To: addrTarget, Currency: eth, Fee: "0.05"},
+		} {
+			require.NoError(t, g.Add(l))
+		}
+		return g
+	}
+
+	t.Run("happy_least_fee", func(t *testing.T) {
+		path, found := newGraph(t).Route(addrA, addrTarget, eth)
+		require.True(t, found)
+		require.Len(t, path, 3)
+		assert.Equal(t, []string{"0xcccc", "0xdddd", "0xeeee"}, []string{path[0].To, path[1].To, path[2].To})
+	})
+
+	t.Run("happy_least_hops_for_same_fee", func(t *testing.T) {
+		g := newGraph(t)
+		require.NoError(t, g.Add(routing.Link{From: addrB, To: addrTarget, Currency: eth, Fee: "0.1"}))
+		path, found := g.Route(addrA, addrTarget, eth)
+		require.True(t, found)
+		require.Len(t, path, 2)
+		assert.Equal(t, addrB, path[0].To)
+		assert.Equal(t, "0.1", path[1].Fee)
+	})
+
+	t.Run("happy_payer_fee_not_charged", func(t *testing.T) {
+		g := newGraph(t)
+		require.NoError(t, g.Add(routing.Link{From: addrA, To: addrTarget, Currency: eth, Fee: "10"}))
+		path, found := g.Route(addrA, addrTarget, eth)
+		require.True(t, found)
+		require.Len(t, path, 1)
+	})
+
+	t.Run("happy_case_insensitive_addrs", func(t *testing.T) {
+		path, found := newGraph(t).Route("0xaaaa", "0xEEEE", eth)
+		require.True(t, found)
+		assert.Len(t, path, 3)
+	})
+
+	t.Run("removed_link", func(t *testing.T) {
+		g := newGraph(t)
+		g.Remove(addrB, addrTarget, eth)
+		g.Remove(addrC, addrD, eth)
+		_, found := g.Route(addrA, addrTarget, eth)
+		assert.False(t, found)
+		assert.Len(t, g.Links(), 3)
+	})
+
+	t.Run("unknown_currency", func(t *testing.T) {
+		_, found := newGraph(t).Route(addrA, addrTarget, "PRN")
+		assert.False(t, found)
+	})
+
+	t.Run("no_path_in_reverse", func(t *testing.T) {
+		_, found := newGraph(t).Route(addrTarget, addrA, eth)
+		assert.False(t, found)
+	})
+
+	t.Run("same_from_and_to", func(t *testing.T) {
+		_, found := newGraph(t).Route(addrA, addrA, eth)
+		assert.False(t, found)
+	})
+}
+
+func Test_Graph_Add(t *testing.T) {
+	t.Run("happy_replace", func(t *testing.T) {
+		g := routing.NewGraph()
+		require.NoError(t, g.Add(routing.Link{From: addrA, To: addrB, Currency: eth, Fee: "0.1"}))
+		require.NoError(t, g.Add(routing.Link{From: addrA, To: addrB, Currency: eth, Fee: "0.2"}))
+		links := g.Links()
+		require.Len(t, links, 1)
+		assert.Equal(t, "0.2", links[0].Fee)
+	})
+
+	t.Run("invalid_fee", func(t *testing.T) {
+		g := routing.NewGraph()
+		assert.Error(t, g.Add(routing.Link{From: addrA, To: addrB, Currency: eth, Fee: "-1"}))
+		assert.Error(t, g.Add(routing.Link{From: addrA, To: addrB, Currency: eth, Fee: "abc"}))
+	})
+}
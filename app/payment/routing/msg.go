@@ -0,0 +1,82 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"encoding/json"
+)
+
+// Enumeration of message types used in the routing protocol.
+const (
+	msgHashReq     msgType = "hashReq"     // Payer to target: request a payment hash.
+	msgHash        msgType = "hash"        // Target to payer: payment hash.
+	msgAnnounce    msgType = "announce"    // Hop to next hop: announce the payment before sending the lock.
+	msgAnnounceAck msgType = "announceAck" // Next hop to hop: payment accepted, the lock can be sent.
+	msgFail        msgType = "fail"        // Next hop to hop (or target to payer): payment cannot be forwarded.
+
+	// Not sent to the peers. It is delivered locally, when the next hop
+	// claims the lock by revealing the preimage in the channel state.
+	msgClaimed msgType = "claimed"
+)
+
+type (
+	msgType string
+
+	// message is the message exchanged between the routers. Only the fields
+	// relevant for the message type are set.
+	message struct {
+		Type msgType `json:"type"`
+		// ID of a hash request. Used only in hashReq, hash and fail messages
+		// sent in response to hashReq.
+		ReqID string `json:"reqID,omitempty"`
+		// Payment hash (hex encoded) that identifies the payment.
+		Hash     string `json:"hash,omitempty"`
+		Currency string `json:"currency,omitempty"`
+		// Amount to be paid to the target. Used only in hashReq.
+		Amount string `json:"amount,omitempty"`
+		// Remaining hops of the path, starting with the receiver of the message.
+		Hops []hop `json:"hops,omitempty"`
+		// Time (in unix seconds) before which the receiver of an announce
+		// message can claim the lock. It is the deadline of the lock.
+		Expiry   int64  `json:"expiry,omitempty"`
+		Preimage string `json:"preimage,omitempty"`
+		Reason   string `json:"reason,omitempty"`
+	}
+
+	// hop is a node in the path of a payment.
+	hop struct {
+		Addr string `json:"addr"` // Off-chain address.
+		// Amount to be received by the node, including the fees for the
+		// following hops.
+		Amount string `json:"amount"`
+	}
+)
+
+func (m message) encode() []byte {
+	data, err := json.Marshal(m)
+	if err != nil {
+		// Code will not reach here, as message contains only basic types.
+		panic(err)
+	}
+	return data
+}
+
+func decodeMsg(data []byte) (message, error) {
+	var m message
+	err := json.Unmarshal(data, &m)
+	return m, err
+}
@@ -0,0 +1,904 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app"
+	"github.com/hyperledger-labs/perun-node/app/htlc"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// AppID is the ID of the app messages used by the routers.
+const AppID = "routing"
+
+// DefaultHopTimeout is the hop timeout used when it is not set in the config.
+const DefaultHopTimeout = 5 * time.Second
+
+// DefaultInvoiceExpiry is the invoice expiry used when it is not set in the config.
+const DefaultInvoiceExpiry = 10 * time.Minute
+
+// maxInvoices is the maximum number of payment hashes generated for the
+// payments to the user, that can be pending at a time. Requests for more
+// payment hashes are rejected, until the pending ones are paid or expire.
+const maxInvoices = 1000
+
+// claimRetryInterval is the interval at which a claim is retried if it fails,
+// until the deadline of the lock.
+const claimRetryInterval = 500 * time.Millisecond
+
+// maxExpireAttempts is the maximum number of attempts for removing an expired
+// lock. Attempts are made at an interval of hop timeout, as the peer may
+// reject them until the deadline has passed as per its clock.
+const maxExpireAttempts = 3
+
+// Error type is used to define error constants for this package.
+type Error string
+
+// Error implements error interface.
+func (e Error) Error() string {
+	return string(e)
+}
+
+// Definition of error constants for this package.
+const (
+	ErrNoChannel       Error = "no open htlc channel with sufficient unlocked balance"
+	ErrUnknownHop      Error = "hop not found in ID provider"
+	ErrUnknownPayment  Error = "payment hash not known"
+	ErrInsufficientFee Error = "insufficient fee"
+	ErrExpiry          Error = "not enough time left before expiry"
+	ErrInvalidHash     Error = "invalid payment hash"
+	ErrTooManyInvoices Error = "too many pending payment requests"
+)
+
+type (
+	// Config represents the configurable parameters of the router.
+	Config struct {
+		// HopTimeout is the time each hop has for claiming the lock from the
+		// previous hop, after the next hop had claimed its lock. The payer
+		// allows the hop timeout for each hop in the path. Hence, it should
+		// be more than the response timeout of the sessions along the path.
+		HopTimeout time.Duration
+		// Fee is the amount charged by the user for forwarding payments. It
+		// is in the units of the currency of the payment.
+		Fee string
+		// InvoiceExpiry is the duration for which a payment hash generated
+		// for a payment to the user can be paid.
+		InvoiceExpiry time.Duration
+	}
+
+	// Router makes and forwards multi-hop payments for a session.
+	Router struct {
+		log.Logger
+
+		sess  perun.SessionAPI
+		graph *Graph
+		cfg   Config
+		fee   *big.Rat
+		self  string // Off-chain address of the user.
+
+		mutex    sync.Mutex
+		invoices map[string]invoice          // Preimages of payments to the user, by payment hash.
+		incoming map[string]*incomingPayment // Payments announced by the previous hop, by payment hash.
+		outgoing map[string]*response        // Responses expected from the next hop, by payment hash or request ID.
+	}
+
+	invoice struct {
+		preimage [sha256.Size]byte
+		currency string
+		amount   *big.Rat
+		expiry   time.Time
+	}
+
+	// incomingPayment is a payment announced by the previous hop, for which a
+	// lock is expected.
+	incomingPayment struct {
+		from     string // Alias of the previous hop.
+		chID     string
+		hash     string
+		currency string
+		asset    uint16   // Index of the currency in the channel.
+		amount   *big.Int // Amount to be locked, in the base unit of the currency.
+		hops     []hop    // Remaining hops, starting with the user.
+		expiry   time.Time
+		preimage [sha256.Size]byte // Preimage of the payment hash, set only if the user is the target.
+		matched  bool              // True, once the lock is received.
+	}
+
+	// htlcCh is an htlc channel with a peer, in which a payment can be made.
+	htlcCh struct {
+		perun.ChAPI
+		peerAlias string
+		asset     uint16   // Index of the currency of the payment in the channel.
+		amount    *big.Int // Amount of the payment, in the base unit of the currency.
+	}
+
+	// response is used for receiving the responses from a peer.
+	response struct {
+		peerAlias string
+		msgs      chan message
+	}
+)
+
+// New initializes a router for the session and the local graph of channels.
+// The channels of the user need not be added to the graph, as they are
+// included when finding each path.
+//
+// The router subscribes to the app messages and sets the channel update
+// interceptor on the session, both for AppID. Hence, only one router can be
+// used with a session at a time.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidConfig with Name:"fee" when the fee is invalid.
+// or any of the errors returned by the session.SubAppMsgs API.
+func New(sess perun.SessionAPI, graph *Graph, cfg Config) (*Router, perun.APIError) {
+	fee := new(big.Rat)
+	if cfg.Fee != "" {
+		var ok bool
+		if fee, ok = parseAmount(cfg.Fee); !ok {
+			return nil, perun.NewAPIErrInvalidConfig(errors.New("invalid amount"), "fee", cfg.Fee)
+		}
+	}
+	if cfg.HopTimeout <= 0 {
+		cfg.HopTimeout = DefaultHopTimeout
+	}
+	if cfg.InvoiceExpiry <= 0 {
+		cfg.InvoiceExpiry = DefaultInvoiceExpiry
+	}
+	ownID, apiErr := sess.GetPeerID(perun.OwnAlias)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	r := &Router{
+		Logger:   log.NewLoggerWithField("routing", sess.ID()),
+		sess:     sess,
+		graph:    graph,
+		cfg:      cfg,
+		fee:      fee,
+		self:     normalizeAddr(ownID.OffChainAddrString),
+		invoices: make(map[string]invoice),
+		incoming: make(map[string]*incomingPayment),
+		outgoing: make(map[string]*response),
+	}
+	if apiErr = sess.SubAppMsgs(AppID, r.handleMsg); apiErr != nil {
+		return nil, apiErr
+	}
+	sess.SetChUpdateInterceptor(AppID, r.intercept)
+	return r, nil
+}
+
+// Close stops the router from making and forwarding payments. The payments
+// that are in progress will not be completed.
+func (r *Router) Close() perun.APIError {
+	r.sess.SetChUpdateInterceptor(AppID, nil)
+	return r.sess.UnsubAppMsgs(AppID)
+}
+
+// Pay pays the amount to the peer corresponding to the given alias, through
+// the path with the least fee in the graph. The payer pays the fee for each
+// intermediary in addition to the amount.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "peerID" when the alias of the target is not known.
+// - ErrInvalidArgument with Name:"amount" when the amount is invalid.
+// - ErrResourceNotFound with ResourceType: "route" when there is no path to the target.
+// - ErrPeerRejected when any of the hops or the target fail the payment.
+// - ErrPeerRequestTimedOut when any of the hops does not respond in time.
+// or any of the errors returned by the app.SendAppAction API.
+func (r *Router) Pay(pctx context.Context, targetAlias, currency, amount string) (perun.RoutedPayment, perun.APIError) {
+	r.WithField("method", "Pay").Infof("\nReceived request with params %+v,%+v,%+v", targetAlias, currency, amount)
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			r.WithFields(perun.APIErrAsMap("Pay", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	target, apiErr := r.sess.GetPeerID(targetAlias)
+	if apiErr != nil {
+		return perun.RoutedPayment{}, apiErr
+	}
+	parsedAmount, ok := parseAmount(amount)
+	if !ok || parsedAmount.Sign() == 0 {
+		apiErr = perun.NewAPIErrInvalidArgument(payment.ErrInvalidAmount, perun.ArgNameAmount, amount)
+		return perun.RoutedPayment{}, apiErr
+	}
+	path, ok := r.graph.route(r.self, target.OffChainAddrString, currency, r.ownLinks(currency))
+	if !ok {
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypeRoute, targetAlias)
+		return perun.RoutedPayment{}, apiErr
+	}
+	hops := makeHops(path, parsedAmount)
+
+	hash, apiErr := r.requestHash(pctx, targetAlias, currency, amount)
+	if apiErr != nil {
+		return perun.RoutedPayment{}, apiErr
+	}
+	expiry := time.Now().Add(time.Duration(len(hops)) * r.cfg.HopTimeout)
+	preimage, apiErr := r.forward(pctx, hash, currency, hops, expiry)
+	if apiErr != nil {
+		return perun.RoutedPayment{}, apiErr
+	}
+
+	info := perun.RoutedPayment{
+		Hash:     hash,
+		Preimage: hex.EncodeToString(preimage[:]),
+		Route:    make([]string, len(hops)),
+		Amount:   hops[0].Amount,
+	}
+	for i := range hops {
+		info.Route[i] = hops[i].Addr
+	}
+	paid, _ := parseAmount(hops[0].Amount)
+	info.Fee = formatAmount(paid.Sub(paid, parsedAmount))
+	r.WithField("method", "Pay").Info("Payment made successfully")
+	return info, nil
+}
+
+// ownLinks returns the links for the open htlc channels of the user in the
+// currency.
+func (r *Router) ownLinks(currency string) []link {
+	var links []link
+	for _, chInfo := range r.sess.GetChsInfo() {
+		if !isHTLCCh(chInfo) || !hasCurrency(chInfo.BalInfo, currency) {
+			continue
+		}
+		for _, alias := range chInfo.BalInfo.Parts {
+			if alias == perun.OwnAlias {
+				continue
+			}
+			peerID, apiErr := r.sess.GetPeerID(alias)
+			if apiErr != nil {
+				continue
+			}
+			l, err := newLink(Link{From: r.self, To: peerID.OffChainAddrString, Currency: currency})
+			if err == nil {
+				links = append(links, l)
+			}
+		}
+	}
+	return links
+}
+
+// makeHops returns the hops for the path, with the amount to be received by
+// each of them so that the target receives the given amount.
+func makeHops(path []Link, amount *big.Rat) []hop {
+	hops := make([]hop, len(path))
+	received := new(big.Rat).Set(amount)
+	for i := len(path) - 1; i >= 0; i-- {
+		hops[i] = hop{Addr: normalizeAddr(path[i].To), Amount: formatAmount(received)}
+		if i > 0 {
+			fee, _ := newLink(path[i])
+			received = new(big.Rat).Add(received, fee.fee)
+		}
+	}
+	return hops
+}
+
+// requestHash requests the target to generate a payment hash for the payment.
+func (r *Router) requestHash(pctx context.Context, targetAlias, currency, amount string) (string, perun.APIError) {
+	reqID, err := randomHex()
+	if err != nil {
+		return "", perun.NewAPIErrUnknownInternal(err)
+	}
+	resp := r.expect(reqID, targetAlias)
+	defer r.forget(reqID)
+
+	ctx, cancel := context.WithTimeout(pctx, r.cfg.HopTimeout)
+	defer cancel()
+	req := message{Type: msgHashReq, ReqID: reqID, Currency: currency, Amount: amount}
+	if apiErr := r.sess.SendAppMsg(ctx, targetAlias, AppID, req.encode()); apiErr != nil {
+		return "", apiErr
+	}
+	m, apiErr := r.await(ctx, resp, targetAlias)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	if m.Type != msgHash {
+		return "", perun.NewAPIErrPeerRejected(errors.New(m.Reason), targetAlias, m.Reason)
+	}
+	if _, ok := parseHash(m.Hash); !ok {
+		return "", perun.NewAPIErrPeerRejected(ErrInvalidHash, targetAlias, ErrInvalidHash.Error())
+	}
+	return m.Hash, nil
+}
+
+// forward announces the payment to the next hop and locks the amount to be
+// paid to it on the payment hash, until the expiry. It returns the preimage
+// revealed by the next hop when claiming the lock.
+//
+// If the lock is not claimed, it is removed after the expiry.
+func (r *Router) forward(pctx context.Context, hash, currency string, hops []hop, expiry time.Time) (
+	[sha256.Size]byte, perun.APIError,
+) {
+	var preimage [sha256.Size]byte
+	expiry = time.Unix(expiry.Unix(), 0) // Deadlines of the locks are in unix seconds.
+	ctx, cancel := context.WithDeadline(pctx, expiry)
+	defer cancel()
+
+	next := hops[0]
+	ch, err := r.chWith(next.Addr, currency, next.Amount)
+	if err != nil {
+		return preimage, perun.NewAPIErrFailedPreCondition(err)
+	}
+	hashBytes, _ := parseHash(hash)
+	action, err := htlc.LockAction(hashBytes, ch.asset, ch.amount, expiry)
+	if err != nil {
+		return preimage, perun.NewAPIErrFailedPreCondition(err)
+	}
+	resp := r.expect(hash, ch.peerAlias)
+	defer r.forget(hash)
+
+	announce := message{Type: msgAnnounce, Hash: hash, Currency: currency, Hops: hops, Expiry: expiry.Unix()}
+	if apiErr := r.sess.SendAppMsg(ctx, ch.peerAlias, AppID, announce.encode()); apiErr != nil {
+		return preimage, apiErr
+	}
+	m, apiErr := r.await(ctx, resp, ch.peerAlias)
+	if apiErr != nil {
+		return preimage, apiErr
+	}
+	if m.Type != msgAnnounceAck {
+		return preimage, perun.NewAPIErrPeerRejected(errors.New(m.Reason), ch.peerAlias, m.Reason)
+	}
+
+	if _, apiErr = app.SendAppAction(ctx, ch, action); apiErr != nil {
+		return preimage, apiErr
+	}
+	go r.expire(ch, hashBytes, expiry)
+
+	if m, apiErr = r.await(ctx, resp, ch.peerAlias); apiErr != nil {
+		return preimage, apiErr
+	}
+	if m.Type != msgClaimed {
+		return preimage, perun.NewAPIErrPeerRejected(errors.New(m.Reason), ch.peerAlias, m.Reason)
+	}
+	preimage, _ = parseHash(m.Preimage)
+	return preimage, nil
+}
+
+// expire removes the lock on the hash from the channel after the deadline,
+// if it has not been claimed by then.
+func (r *Router) expire(ch htlcCh, hash [sha256.Size]byte, deadline time.Time) {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	for attempt := 0; attempt < maxExpireAttempts; attempt++ {
+		<-timer.C
+		data, ok := ch.GetChInfo().App.Data.(*htlc.Data)
+		if !ok {
+			return
+		}
+		if _, locked := data.Lock(hash); !locked {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), r.cfg.HopTimeout)
+		_, apiErr := app.SendAppAction(ctx, ch, htlc.ExpireAction(hash))
+		cancel()
+		if apiErr == nil {
+			r.Infof("Removed expired lock %x", hash)
+			return
+		}
+		r.Errorf("Removing expired lock %x: %v", hash, apiErr)
+		timer.Reset(r.cfg.HopTimeout)
+	}
+}
+
+// claim claims the lock on the hash of the preimage from the previous hop. It
+// is retried until the context expires, which should be the deadline of the
+// lock. It returns true if the lock was claimed.
+func (r *Router) claim(ctx context.Context, ch perun.ChAPI, preimage [sha256.Size]byte) bool {
+	for {
+		_, apiErr := app.SendAppAction(ctx, ch, htlc.ClaimAction(preimage))
+		if apiErr == nil {
+			return true
+		}
+		r.Errorf("Claiming lock on channel %s: %v", ch.ID(), apiErr)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(claimRetryInterval):
+		}
+	}
+}
+
+// chWith returns an open htlc channel with the peer with the given off-chain
+// address, in which the unlocked balance of the user is at least the given
+// amount in the currency.
+func (r *Router) chWith(addr, currency, amount string) (htlcCh, error) {
+	peerAlias, ok := r.aliasOf(addr)
+	if !ok {
+		return htlcCh{}, errors.WithMessage(ErrUnknownHop, addr)
+	}
+	for _, chInfo := range r.sess.GetChsInfo() {
+		if !isHTLCCh(chInfo) || !hasPart(chInfo.BalInfo, peerAlias) || !hasCurrency(chInfo.BalInfo, currency) {
+			continue
+		}
+		ch, apiErr := r.sess.GetCh(chInfo.ChID)
+		if apiErr != nil {
+			continue
+		}
+		asset, parsedAmount, err := parseLockAmount(ch, currency, amount)
+		if err != nil {
+			return htlcCh{}, err
+		}
+		unlocked, ok := unlockedBal(ch, asset)
+		if ok && unlocked.Cmp(parsedAmount) >= 0 {
+			return htlcCh{ChAPI: ch, peerAlias: peerAlias, asset: asset, amount: parsedAmount}, nil
+		}
+	}
+	return htlcCh{}, errors.WithMessage(ErrNoChannel, peerAlias)
+}
+
+// incomingCh returns an open htlc channel with the peer, in which the peer
+// can pay the amount in the currency.
+func (r *Router) incomingCh(peerAlias, currency, amount string) (htlcCh, error) {
+	for _, chInfo := range r.sess.GetChsInfo() {
+		if !isHTLCCh(chInfo) || !hasPart(chInfo.BalInfo, peerAlias) || !hasCurrency(chInfo.BalInfo, currency) {
+			continue
+		}
+		ch, apiErr := r.sess.GetCh(chInfo.ChID)
+		if apiErr != nil {
+			continue
+		}
+		asset, parsedAmount, err := parseLockAmount(ch, currency, amount)
+		if err != nil {
+			return htlcCh{}, err
+		}
+		return htlcCh{ChAPI: ch, peerAlias: peerAlias, asset: asset, amount: parsedAmount}, nil
+	}
+	return htlcCh{}, errors.WithMessage(ErrNoChannel, peerAlias)
+}
+
+// parseLockAmount returns the index of the currency in the channel and the
+// amount in the base unit of the currency.
+func parseLockAmount(ch perun.ChAPI, currency, amount string) (uint16, *big.Int, error) {
+	idx, parser, found := ch.Currency(currency)
+	if !found {
+		return 0, nil, errors.Errorf("currency %s not found in channel", currency)
+	}
+	parsedAmount, err := parser.Parse(amount)
+	if err != nil || parsedAmount.Sign() <= 0 {
+		return 0, nil, errors.WithMessage(payment.ErrInvalidAmount, amount)
+	}
+	return uint16(idx), parsedAmount, nil
+}
+
+// unlockedBal returns the balance of the user in the asset, that is not
+// locked, in the base unit of the currency.
+func unlockedBal(ch perun.ChAPI, asset uint16) (*big.Int, bool) {
+	chInfo := ch.GetChInfo()
+	data, ok := chInfo.App.Data.(*htlc.Data)
+	if !ok {
+		return nil, false
+	}
+	ownIdx, ok := partIdx(ch.Parts(), perun.OwnAlias)
+	if !ok || int(asset) >= len(chInfo.BalInfo.Currencies) {
+		return nil, false
+	}
+	_, parser, _ := ch.Currency(chInfo.BalInfo.Currencies[asset])
+	bal, err := parser.Parse(chInfo.BalInfo.Bals[asset][ownIdx])
+	if err != nil {
+		return nil, false
+	}
+	return bal.Sub(bal, data.Locked(ownIdx, asset)), true
+}
+
+// aliasOf returns the alias of the peer with the given off-chain address.
+func (r *Router) aliasOf(addr string) (string, bool) {
+	peerIDs, apiErr := r.sess.ListPeerIDs()
+	if apiErr != nil {
+		return "", false
+	}
+	for i := range peerIDs {
+		if normalizeAddr(peerIDs[i].OffChainAddrString) == normalizeAddr(addr) {
+			return peerIDs[i].Alias, true
+		}
+	}
+	return "", false
+}
+
+// expect registers for the responses from the peer for the given key.
+func (r *Router) expect(key, peerAlias string) *response {
+	resp := &response{peerAlias: peerAlias, msgs: make(chan message, 2)}
+	r.mutex.Lock()
+	r.outgoing[key] = resp
+	r.mutex.Unlock()
+	return resp
+}
+
+func (r *Router) forget(key string) {
+	r.mutex.Lock()
+	delete(r.outgoing, key)
+	r.mutex.Unlock()
+}
+
+// deliver delivers the response to the one waiting for it from the peer, if
+// any.
+func (r *Router) deliver(key, peerAlias string, m message) {
+	r.mutex.Lock()
+	resp, ok := r.outgoing[key]
+	r.mutex.Unlock()
+	if !ok || resp.peerAlias != peerAlias {
+		r.Infof("Dropped unexpected %s message from %s", m.Type, peerAlias)
+		return
+	}
+	select {
+	case resp.msgs <- m:
+	default:
+		r.Infof("Dropped %s message from %s as too many responses were received", m.Type, peerAlias)
+	}
+}
+
+// await waits for a response from the peer until the context expires.
+func (r *Router) await(ctx context.Context, resp *response, peerAlias string) (message, perun.APIError) {
+	select {
+	case m := <-resp.msgs:
+		return m, nil
+	case <-ctx.Done():
+		return message{}, perun.NewAPIErrPeerRequestTimedOut(ctx.Err(), peerAlias, r.cfg.HopTimeout.String())
+	}
+}
+
+// handleMsg handles the messages received from the other routers.
+func (r *Router) handleMsg(appMsg perun.AppMsg) {
+	m, err := decodeMsg(appMsg.Data)
+	if err != nil {
+		r.Errorf("Decoding message from %s: %v", appMsg.PeerAlias, err)
+		return
+	}
+	switch m.Type {
+	case msgHashReq:
+		r.handleHashReq(appMsg.PeerAlias, m)
+	case msgAnnounce:
+		r.handleAnnounce(appMsg.PeerAlias, m)
+	case msgHash, msgAnnounceAck, msgFail:
+		key := m.Hash
+		if m.ReqID != "" {
+			key = m.ReqID
+		}
+		r.deliver(key, appMsg.PeerAlias, m)
+	default:
+		r.Infof("Dropped message of unknown type %s from %s", m.Type, appMsg.PeerAlias)
+	}
+}
+
+// handleHashReq generates a preimage for a payment to the user and sends its
+// hash to the payer. The request is rejected if too many payment hashes are
+// pending.
+func (r *Router) handleHashReq(from string, req message) {
+	resp := message{Type: msgHash, ReqID: req.ReqID}
+	amount, ok := parseAmount(req.Amount)
+	var preimage [sha256.Size]byte
+	_, err := rand.Read(preimage[:])
+	switch {
+	case !ok:
+		resp = message{Type: msgFail, ReqID: req.ReqID, Reason: payment.ErrInvalidAmount.Error()}
+	case err != nil:
+		resp = message{Type: msgFail, ReqID: req.ReqID, Reason: "generating preimage"}
+	default:
+		hash := sha256.Sum256(preimage[:])
+		resp.Hash = hex.EncodeToString(hash[:])
+		inv := invoice{
+			preimage: preimage,
+			currency: req.Currency,
+			amount:   amount,
+			expiry:   time.Now().Add(r.cfg.InvoiceExpiry),
+		}
+		if !r.addInvoice(resp.Hash, inv) {
+			resp = message{Type: msgFail, ReqID: req.ReqID, Reason: ErrTooManyInvoices.Error()}
+		}
+	}
+	r.send(from, resp)
+}
+
+// addInvoice stores the invoice, after removing the expired ones. It returns
+// false if the maximum number of invoices are pending.
+func (r *Router) addInvoice(hash string, inv invoice) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	now := time.Now()
+	for h := range r.invoices {
+		if !now.Before(r.invoices[h].expiry) {
+			delete(r.invoices, h)
+		}
+	}
+	if len(r.invoices) >= maxInvoices {
+		return false
+	}
+	r.invoices[hash] = inv
+	return true
+}
+
+// handleAnnounce validates the payment announced by the previous hop. If it is
+// valid, it records the payment for matching the lock and acknowledges it.
+func (r *Router) handleAnnounce(from string, m message) {
+	p, err := r.validateAnnounce(from, m)
+	if err != nil {
+		r.Infof("Rejected payment %s from %s: %v", m.Hash, from, err)
+		r.send(from, message{Type: msgFail, Hash: m.Hash, Reason: err.Error()})
+		return
+	}
+
+	r.mutex.Lock()
+	r.incoming[p.hash] = p
+	r.mutex.Unlock()
+	// Forget the payment if the lock is not received before expiry.
+	time.AfterFunc(time.Until(p.expiry), func() {
+		r.mutex.Lock()
+		if curr, ok := r.incoming[p.hash]; ok && curr == p && !p.matched {
+			delete(r.incoming, p.hash)
+		}
+		r.mutex.Unlock()
+	})
+	r.send(from, message{Type: msgAnnounceAck, Hash: m.Hash})
+}
+
+func (r *Router) validateAnnounce(from string, m message) (*incomingPayment, error) {
+	if len(m.Hops) == 0 || normalizeAddr(m.Hops[0].Addr) != r.self {
+		return nil, errors.New("invalid hops")
+	}
+	if _, ok := parseHash(m.Hash); !ok {
+		return nil, ErrInvalidHash
+	}
+	received, ok := parseAmount(m.Hops[0].Amount)
+	if !ok {
+		return nil, payment.ErrInvalidAmount
+	}
+	ch, err := r.incomingCh(from, m.Currency, m.Hops[0].Amount)
+	if err != nil {
+		return nil, err
+	}
+	expiry := time.Unix(m.Expiry, 0)
+
+	var preimage [sha256.Size]byte
+	if len(m.Hops) == 1 { // User is the target.
+		r.mutex.Lock()
+		inv, ok := r.invoices[m.Hash]
+		r.mutex.Unlock()
+		if !ok || !time.Now().Before(inv.expiry) || inv.currency != m.Currency || received.Cmp(inv.amount) < 0 {
+			return nil, ErrUnknownPayment
+		}
+		if time.Until(expiry) <= 0 {
+			return nil, ErrExpiry
+		}
+		preimage = inv.preimage
+	} else {
+		forwarded, ok := parseAmount(m.Hops[1].Amount)
+		if !ok {
+			return nil, payment.ErrInvalidAmount
+		}
+		if new(big.Rat).Sub(received, forwarded).Cmp(r.fee) < 0 {
+			return nil, ErrInsufficientFee
+		}
+		if time.Until(expiry) <= r.cfg.HopTimeout {
+			return nil, ErrExpiry
+		}
+		if _, err := r.chWith(m.Hops[1].Addr, m.Currency, m.Hops[1].Amount); err != nil {
+			return nil, err
+		}
+	}
+	return &incomingPayment{
+		from:     from,
+		chID:     ch.ID(),
+		hash:     m.Hash,
+		currency: m.Currency,
+		asset:    ch.asset,
+		amount:   ch.amount,
+		hops:     m.Hops,
+		expiry:   expiry,
+		preimage: preimage,
+	}, nil
+}
+
+// intercept handles the updates on htlc channels that add the lock for an
+// announced payment or remove a lock, and lets the others to be notified to
+// the user.
+//
+// Updates removing a lock (by claiming it, or after it has expired) are
+// always accepted, as they are valid as per the htlc app.
+func (r *Router) intercept(notif perun.ChUpdateNotif) bool {
+	if notif.Type != perun.ChUpdateTypeOpen {
+		return false
+	}
+	curr, ok := notif.CurrChInfo.App.Data.(*htlc.Data)
+	if !ok {
+		return false
+	}
+	proposed, ok := notif.ProposedChInfo.App.Data.(*htlc.Data)
+	if !ok {
+		return false
+	}
+
+	switch {
+	case len(proposed.Locks) == len(curr.Locks)+1:
+		l := proposed.Locks[len(proposed.Locks)-1]
+		hash := hex.EncodeToString(l.Hash[:])
+		r.mutex.Lock()
+		p, ok := r.incoming[hash]
+		ok = ok && !p.matched && p.chID == notif.CurrChInfo.ChID && p.isLock(l)
+		if ok {
+			p.matched = true
+		}
+		r.mutex.Unlock()
+		if !ok {
+			return false
+		}
+		go r.complete(p, notif.UpdateID)
+		return true
+	case len(proposed.Locks)+1 == len(curr.Locks):
+		go r.acceptUnlock(notif, curr, proposed)
+		return true
+	default:
+		return false
+	}
+}
+
+// isLock returns true if the lock pays the amount of the payment, with the
+// deadline as the expiry of the payment.
+func (p *incomingPayment) isLock(l htlc.Lock) bool {
+	return l.Asset == p.asset && l.Amount.Cmp(p.amount) == 0 && int64(l.Deadline) == p.expiry.Unix()
+}
+
+// complete accepts the lock for the payment and forwards the payment to the
+// next hop (if the user is not the target). It then claims the lock using
+// the preimage. If the payment cannot be forwarded, the previous hop is
+// notified and the lock is left to expire.
+func (r *Router) complete(p *incomingPayment, updateID string) {
+	defer func() {
+		r.mutex.Lock()
+		delete(r.incoming, p.hash)
+		r.mutex.Unlock()
+	}()
+	ctx, cancel := context.WithDeadline(context.Background(), p.expiry)
+	defer cancel()
+
+	ch, apiErr := r.sess.GetCh(p.chID)
+	if apiErr != nil {
+		r.Errorf("Getting channel for payment %s: %v", p.hash, apiErr)
+		return
+	}
+	if _, apiErr = ch.RespondChUpdate(ctx, updateID, true); apiErr != nil {
+		r.Errorf("Accepting lock for payment %s: %v", p.hash, apiErr)
+		return
+	}
+
+	preimage := p.preimage
+	if len(p.hops) == 1 {
+		r.mutex.Lock()
+		delete(r.invoices, p.hash)
+		r.mutex.Unlock()
+	} else {
+		preimage, apiErr = r.forward(ctx, p.hash, p.currency, p.hops[1:], p.expiry.Add(-r.cfg.HopTimeout))
+		if apiErr != nil {
+			r.Errorf("Forwarding payment %s: %v", p.hash, apiErr)
+			r.send(p.from, message{Type: msgFail, Hash: p.hash, Reason: apiErr.Message()})
+			return
+		}
+	}
+	if r.claim(ctx, ch, preimage) {
+		r.Infof("Received payment %s", p.hash)
+	}
+}
+
+// acceptUnlock accepts the update removing a lock. If the lock was claimed
+// by revealing the preimage, the preimage is delivered to the one waiting
+// for it, before accepting the update.
+func (r *Router) acceptUnlock(notif perun.ChUpdateNotif, curr, proposed *htlc.Data) {
+	peerAlias := peerOf(notif.CurrChInfo.BalInfo.Parts)
+	for _, l := range curr.Locks {
+		if _, ok := proposed.Lock(l.Hash); !ok && sha256.Sum256(proposed.Revealed[:]) == l.Hash {
+			hash := hex.EncodeToString(l.Hash[:])
+			r.deliver(hash, peerAlias, message{
+				Type: msgClaimed, Hash: hash, Preimage: hex.EncodeToString(proposed.Revealed[:]),
+			})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.cfg.HopTimeout)
+	defer cancel()
+	ch, apiErr := r.sess.GetCh(notif.CurrChInfo.ChID)
+	if apiErr == nil {
+		_, apiErr = ch.RespondChUpdate(ctx, notif.UpdateID, true)
+	}
+	if apiErr != nil {
+		r.Errorf("Accepting update removing lock on channel %s: %v", notif.CurrChInfo.ChID, apiErr)
+	}
+}
+
+// send sends the message to the peer and logs the error, if any.
+func (r *Router) send(peerAlias string, m message) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.cfg.HopTimeout)
+	defer cancel()
+	if apiErr := r.sess.SendAppMsg(ctx, peerAlias, AppID, m.encode()); apiErr != nil {
+		r.Errorf("Sending %s message to %s: %v", m.Type, peerAlias, apiErr)
+	}
+}
+
+// isHTLCCh returns true if the channel is a two party channel using the htlc app.
+func isHTLCCh(chInfo perun.ChInfo) bool {
+	_, ok := chInfo.App.Data.(*htlc.Data)
+	return ok && len(chInfo.BalInfo.Parts) == 2
+}
+
+// peerOf returns the alias of the peer in a two party channel.
+func peerOf(parts []string) string {
+	for i := range parts {
+		if parts[i] != perun.OwnAlias {
+			return parts[i]
+		}
+	}
+	return ""
+}
+
+// partIdx returns the index of the alias in the list of channel participants.
+func partIdx(parts []string, alias string) (pchannel.Index, bool) {
+	for i := range parts {
+		if parts[i] == alias {
+			return pchannel.Index(i), true
+		}
+	}
+	return 0, false
+}
+
+func hasCurrency(balInfo perun.BalInfo, currency string) bool {
+	for i := range balInfo.Currencies {
+		if balInfo.Currencies[i] == currency {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPart(balInfo perun.BalInfo, alias string) bool {
+	for i := range balInfo.Parts {
+		if balInfo.Parts[i] == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// parseHash parses the hex encoded payment hash or preimage.
+func parseHash(s string) ([sha256.Size]byte, bool) {
+	var hash [sha256.Size]byte
+	data, err := hex.DecodeString(s)
+	if err != nil || len(data) != sha256.Size {
+		return hash, false
+	}
+	copy(hash[:], data)
+	return hash, true
+}
+
+func randomHex() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", errors.Wrap(err, "reading random bytes")
+	}
+	return hex.EncodeToString(data), nil
+}
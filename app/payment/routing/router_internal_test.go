@@ -0,0 +1,165 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/htlc"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+func Test_makeHops(t *testing.T) {
+	path := []Link{
+		{From: "0xa", To: "0xB", Currency: "ETH", Fee: "0.5"},
+		{From: "0xb", To: "0xc", Currency: "ETH", Fee: "0.1"},
+		{From: "0xc", To: "0xd", Currency: "ETH", Fee: "0.02"},
+	}
+	hops := makeHops(path, big.NewRat(1, 1))
+	assert.Equal(t, []hop{
+		{Addr: "0xb", Amount: "1.12"},
+		{Addr: "0xc", Amount: "1.02"},
+		{Addr: "0xd", Amount: "1"},
+	}, hops)
+}
+
+func Test_formatAmount(t *testing.T) {
+	for input, want := range map[string]string{
+		"1":                    "1",
+		"0.100":                "0.1",
+		"1.000000000000000001": "1.000000000000000001",
+		"0":                    "0",
+	} {
+		amount, ok := parseAmount(input)
+		assert.True(t, ok)
+		assert.Equal(t, want, formatAmount(amount))
+	}
+	_, ok := parseAmount("-1")
+	assert.False(t, ok)
+}
+
+func Test_incomingPayment_isLock(t *testing.T) {
+	expiry := time.Unix(1000, 0)
+	p := &incomingPayment{asset: 1, amount: big.NewInt(50), expiry: expiry}
+	valid := htlc.Lock{Asset: 1, Amount: big.NewInt(50), Deadline: 1000}
+
+	assert.True(t, p.isLock(valid))
+	for name, modify := range map[string]func(*htlc.Lock){
+		"other asset":      func(l *htlc.Lock) { l.Asset = 0 },
+		"amount is less":   func(l *htlc.Lock) { l.Amount = big.NewInt(49) },
+		"amount is more":   func(l *htlc.Lock) { l.Amount = big.NewInt(51) },
+		"later deadline":   func(l *htlc.Lock) { l.Deadline = 1001 },
+		"earlier deadline": func(l *htlc.Lock) { l.Deadline = 999 },
+	} {
+		l := valid
+		modify(&l)
+		assert.False(t, p.isLock(l), name)
+	}
+}
+
+func Test_Router_intercept_claim(t *testing.T) {
+	preimage := [sha256.Size]byte{1}
+	hash := sha256.Sum256(preimage[:])
+	hashHex := hex.EncodeToString(hash[:])
+	lock := htlc.Lock{Sender: 0, Hash: hash, Amount: big.NewInt(1), Deadline: 1000}
+	newNotif := func(revealed [sha256.Size]byte) perun.ChUpdateNotif {
+		return perun.ChUpdateNotif{
+			UpdateID: "update-id",
+			Type:     perun.ChUpdateTypeOpen,
+			CurrChInfo: perun.ChInfo{
+				ChID:    "ch-id",
+				App:     perun.App{Data: &htlc.Data{Locks: []htlc.Lock{lock}}},
+				BalInfo: perun.BalInfo{Parts: []string{perun.OwnAlias, "bob"}},
+			},
+			ProposedChInfo: perun.ChInfo{App: perun.App{Data: &htlc.Data{Revealed: revealed}}},
+		}
+	}
+
+	r := &Router{
+		Logger:   log.NewLoggerWithField("test", "routing"),
+		sess:     noChSession{},
+		outgoing: make(map[string]*response),
+	}
+	resp := r.expect(hashHex, "bob")
+
+	t.Run("expired", func(t *testing.T) {
+		assert.True(t, r.intercept(newNotif([sha256.Size]byte{})))
+		assert.Never(t, func() bool { return len(resp.msgs) != 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	})
+
+	t.Run("claimed", func(t *testing.T) {
+		assert.True(t, r.intercept(newNotif(preimage)))
+		select {
+		case m := <-resp.msgs:
+			assert.Equal(t, msgClaimed, m.Type)
+			assert.Equal(t, hex.EncodeToString(preimage[:]), m.Preimage)
+		case <-time.After(time.Second):
+			t.Fatal("preimage not delivered")
+		}
+	})
+
+	t.Run("not_htlc", func(t *testing.T) {
+		assert.False(t, r.intercept(perun.ChUpdateNotif{Type: perun.ChUpdateTypeOpen}))
+	})
+}
+
+// noChSession is a session that has no channels. Calling any other method
+// will panic.
+type noChSession struct {
+	perun.SessionAPI
+}
+
+func (noChSession) GetCh(chID string) (perun.ChAPI, perun.APIError) {
+	return nil, perun.NewAPIErrResourceNotFound(perun.ResTypeChannel, chID)
+}
+
+func Test_parseHash(t *testing.T) {
+	const hash = "6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d"
+	parsed, ok := parseHash(hash)
+	assert.True(t, ok)
+	assert.Equal(t, hash, hex.EncodeToString(parsed[:]))
+	for _, invalid := range []string{"00", "invalid", hash + "00"} {
+		_, ok = parseHash(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func Test_Router_addInvoice(t *testing.T) {
+	r := &Router{invoices: make(map[string]invoice)}
+	expired := invoice{expiry: time.Now().Add(-time.Second)}
+	valid := invoice{expiry: time.Now().Add(time.Minute)}
+
+	assert.True(t, r.addInvoice("expired", expired))
+	for i := 1; i < maxInvoices; i++ {
+		assert.True(t, r.addInvoice(fmt.Sprint(i), valid))
+	}
+	// Expired invoice is removed, to make space for the new one.
+	assert.True(t, r.addInvoice("new", valid))
+	assert.NotContains(t, r.invoices, "expired")
+	assert.Len(t, r.invoices, maxInvoices)
+
+	assert.False(t, r.addInvoice("one-too-many", valid))
+	assert.NotContains(t, r.invoices, "one-too-many")
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment/routing"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+)
+
+const targetAlias = "target"
+
+func Test_New(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock()
+		sessionAPI.On("SubAppMsgs", routing.AppID, mock.Anything).Return(nil)
+		sessionAPI.On("SetChUpdateInterceptor", routing.AppID, mock.Anything).Return()
+		r, err := routing.New(sessionAPI, routing.NewGraph(), routing.Config{Fee: "0.01"})
+		require.NoError(t, err)
+
+		sessionAPI.On("UnsubAppMsgs", routing.AppID).Return(nil)
+		require.NoError(t, r.Close())
+		sessionAPI.AssertCalled(t, "SetChUpdateInterceptor", routing.AppID, mock.MatchedBy(func(i perun.ChUpdateInterceptor) bool {
+			return i == nil
+		}))
+	})
+
+	t.Run("invalid_fee", func(t *testing.T) {
+		_, err := routing.New(newSessionAPIMock(), routing.NewGraph(), routing.Config{Fee: "abc"})
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig)
+	})
+
+	t.Run("subscription_exists", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock()
+		sessionAPI.On("SubAppMsgs", routing.AppID, mock.Anything).Return(
+			perun.NewAPIErrResourceExists(perun.ResTypeAppMsgSub, routing.AppID))
+		_, err := routing.New(sessionAPI, routing.NewGraph(), routing.Config{})
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceExists)
+	})
+}
+
+func Test_Router_Pay(t *testing.T) {
+	newRouter := func(t *testing.T, sessionAPI *mocks.SessionAPI) *routing.Router {
+		sessionAPI.On("SubAppMsgs", routing.AppID, mock.Anything).Return(nil)
+		sessionAPI.On("SetChUpdateInterceptor", routing.AppID, mock.Anything).Return()
+		r, err := routing.New(sessionAPI, routing.NewGraph(), routing.Config{})
+		require.NoError(t, err)
+		return r
+	}
+
+	t.Run("unknown_target", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock()
+		sessionAPI.On("GetPeerID", targetAlias).Return(perun.PeerID{},
+			perun.NewAPIErrResourceNotFound(perun.ResTypePeerID, targetAlias))
+		r := newRouter(t, sessionAPI)
+
+		_, err := r.Pay(context.Background(), targetAlias, eth, "1")
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+
+	t.Run("invalid_amount", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock()
+		sessionAPI.On("GetPeerID", targetAlias).Return(perun.PeerID{OffChainAddrString: addrTarget}, nil)
+		r := newRouter(t, sessionAPI)
+
+		for _, amount := range []string{"abc", "-1", "0"} {
+			_, err := r.Pay(context.Background(), targetAlias, eth, amount)
+			require.Error(t, err)
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+			peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameAmount, amount)
+		}
+	})
+
+	t.Run("no_route", func(t *testing.T) {
+		sessionAPI := newSessionAPIMock()
+		sessionAPI.On("GetPeerID", targetAlias).Return(perun.PeerID{OffChainAddrString: addrTarget}, nil)
+		sessionAPI.On("GetChsInfo").Return([]perun.ChInfo{})
+		r := newRouter(t, sessionAPI)
+
+		_, err := r.Pay(context.Background(), targetAlias, eth, "1")
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeRoute, targetAlias)
+	})
+}
+
+// newSessionAPIMock returns a session API mock, with the calls for own peer ID
+// and session ID set up.
+func newSessionAPIMock() *mocks.SessionAPI {
+	sessionAPI := &mocks.SessionAPI{}
+	sessionAPI.On("ID").Return("session-id")
+	sessionAPI.On("GetPeerID", perun.OwnAlias).Return(perun.PeerID{OffChainAddrString: addrA}, nil)
+	return sessionAPI
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+// +build integration
+
+package routing_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app"
+	"github.com/hyperledger-labs/perun-node/app/htlc"
+	"github.com/hyperledger-labs/perun-node/app/payment/routing"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+const aliceAlias, bobAlias, carolAlias = "alice", "bob", "carol"
+
+// Test_Integ_Pay tests a payment from alice to carol, routed through bob,
+// with whom both of them have a channel. Only the first two sessions have
+// funded on-chain accounts. So, carol does not fund its channel.
+func Test_Integ_Pay(t *testing.T) {
+	ctx := context.Background()
+
+	currencies := currency.NewRegistry()
+	_, err := currencies.Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+	require.NoError(t, err)
+	contracts, err := ethereumtest.SetupContracts(ethereumtest.ChainURL, ethereumtest.ChainID,
+		ethereumtest.OnChainTxTimeout, false)
+	require.NoError(t, err)
+
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	aliases := []string{aliceAlias, bobAlias, carolAlias}
+	cfgs := make(map[string]session.Config, len(aliases))
+	for _, alias := range aliases {
+		cfgs[alias] = sessiontest.NewConfigT(t, prng)
+	}
+	// Routing is enabled via the session config, with the channel between bob
+	// and carol known to all of them.
+	bobCarolLink := routing.Link{
+		From:     cfgs[bobAlias].User.OffChainAddr,
+		To:       cfgs[carolAlias].User.OffChainAddr,
+		Currency: currency.ETHSymbol,
+		Fee:      "0.01",
+	}
+	sessions := make(map[string]*session.Session, len(aliases))
+	peerIDs := make(map[string]perun.PeerID, len(aliases))
+	for _, alias := range aliases {
+		cfg := cfgs[alias]
+		cfg.Routing = session.RoutingConfig{Enable: true, Fee: "0.01", Links: []routing.Link{bobCarolLink}}
+		sess, err := session.New(cfg, currencies, contracts)
+		require.NoError(t, err)
+		t.Cleanup(func() { sess.Close(true) }) //nolint:errcheck
+		sessions[alias] = sess
+
+		peerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		peerID.Alias = alias
+		peerIDs[alias] = peerID
+	}
+	for _, alias := range aliases {
+		for _, peerAlias := range aliases {
+			if peerAlias != alias {
+				require.NoError(t, sessions[alias].AddPeerID(peerIDs[peerAlias]))
+			}
+		}
+	}
+
+	htlcApp := htlc.New(ethereumtest.NewRandomAddress(prng))
+	pchannel.RegisterApp(htlcApp)
+	openHTLCCh(t, htlcApp, sessions[aliceAlias], sessions[bobAlias], bobAlias)
+	openHTLCCh(t, htlcApp, sessions[bobAlias], sessions[carolAlias], carolAlias)
+
+	info, err := sessions[aliceAlias].Pay(ctx, carolAlias, currency.ETHSymbol, "0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "0.11", info.Amount)
+	assert.Equal(t, "0.01", info.Fee)
+	assert.Len(t, info.Route, 2)
+	assert.NotEmpty(t, info.Preimage)
+
+	// Bob had claimed the lock from alice, after carol claimed the lock from bob.
+	assert.Equal(t, [][]string{{"0.89", "0.11"}}, sessions[aliceAlias].GetChsInfo()[0].BalInfo.Bals)
+	assert.Equal(t, [][]string{{"0.9", "0.1"}}, sessions[carolAlias].GetChsInfo()[0].BalInfo.Bals)
+}
+
+// openHTLCCh opens a channel using the htlc app between the sessions, funded
+// only by the proposer.
+func openHTLCCh(t *testing.T, htlcApp *htlc.App, proposer, proposee *session.Session, proposeeAlias string) {
+	t.Helper()
+	ctx := context.Background()
+
+	notifs := make(chan app.ChProposalNotif, 1)
+	require.NoError(t, app.SubAppChProposals(proposee, func(notif app.ChProposalNotif) {
+		notifs <- notif
+	}))
+	defer app.UnsubAppChProposals(proposee) //nolint:errcheck
+
+	errs := make(chan error, 1)
+	go func() {
+		notif := <-notifs
+		_, err := app.RespondAppChProposal(ctx, proposee, notif.ProposalID, true)
+		errs <- err
+	}()
+
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, proposeeAlias},
+		Bals:       [][]string{{"1", "0"}},
+	}
+	_, err := app.OpenAppCh(ctx, proposer, htlcApp, nil, openingBalInfo, 10)
+	require.NoError(t, err)
+	require.NoError(t, <-errs)
+}
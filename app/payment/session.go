@@ -31,6 +31,10 @@ type (
 		OpeningBalInfo   perun.BalInfo
 		ChallengeDurSecs uint64
 		Expiry           int64
+		// Set only for virtual channel proposals. See perun.ChProposalNotif.
+		ParentChID string
+		// Set only for proposals responded to as per the session policy. See perun.ChProposalNotif.
+		AutoResponse perun.AutoResponse
 	}
 
 	// PayChProposalNotifier represents the channel update notification function for payment app.
@@ -41,8 +45,10 @@ type (
 // payment channels info.
 //
 // See node.OpenSession for the list of errors returned by this API.
-func OpenSession(n perun.NodeAPI, configFile string) (string, []PayChInfo, perun.APIError) {
-	sessionID, restoredChsInfo, err := n.OpenSession(configFile)
+func OpenSession(n perun.NodeAPI, configFile string, passwords perun.WalletPasswords) (
+	string, []PayChInfo, perun.APIError,
+) {
+	sessionID, restoredChsInfo, err := n.OpenSession(configFile, passwords)
 	return sessionID, toPayChsInfo(restoredChsInfo), err
 }
 
@@ -62,6 +68,23 @@ func OpenPayCh(pctx context.Context, s perun.SessionAPI, openingBalInfo perun.Ba
 	return toPayChInfo(chInfo), err
 }
 
+// OpenVirtualPayCh opens a virtual channel with payment app, funded from the
+// specified parent channels. It interprets the returned channel info as
+// payment channel info.
+//
+// See session.OpenVirtualCh for the list of errors returned by this API.
+func OpenVirtualPayCh(pctx context.Context, s perun.SessionAPI, parents perun.VirtualChParents,
+	openingBalInfo perun.BalInfo, challengeDurSecs uint64,
+) (PayChInfo, perun.APIError) {
+	paymentApp := perun.App{
+		Def:  pchannel.NoApp(),
+		Data: pchannel.NoData(),
+	}
+
+	chInfo, err := s.OpenVirtualCh(pctx, parents, openingBalInfo, paymentApp, challengeDurSecs)
+	return toPayChInfo(chInfo), err
+}
+
 // GetPayChsInfo fetches the list of all channels info in the session and
 // interprets them as payment channel info.
 func GetPayChsInfo(s perun.SessionAPI) []PayChInfo {
@@ -85,6 +108,8 @@ func SubPayChProposals(s perun.SessionAPI, notifier PayChProposalNotifier) perun
 			OpeningBalInfo:   notif.OpeningBalInfo,
 			ChallengeDurSecs: notif.ChallengeDurSecs,
 			Expiry:           notif.Expiry,
+			ParentChID:       notif.ParentChID,
+			AutoResponse:     notif.AutoResponse,
 		})
 	})
 }
@@ -107,6 +132,34 @@ func RespondPayChProposal(pctx context.Context, s perun.SessionAPI, proposalID s
 	return toPayChInfo(chInfo), apiErr
 }
 
+// RespondVirtualPayChProposal sends the response to a virtual payment channel
+// proposal notification and interprets the opening channel info as payment
+// channel info.
+//
+// See session.RespondVirtualChProposal for the list of errors returned by this API.
+func RespondVirtualPayChProposal(pctx context.Context, s perun.SessionAPI, proposalID string, accept bool) (
+	PayChInfo, perun.APIError,
+) {
+	chInfo, apiErr := s.RespondVirtualChProposal(pctx, proposalID, accept)
+	return toPayChInfo(chInfo), apiErr
+}
+
+// UpdatePeerID updates the peer ID in the session and interprets the info of
+// open channels in the error (if any) as payment channels info.
+//
+// See session.UpdatePeerID for the list of errors returned by this API.
+func UpdatePeerID(s perun.SessionAPI, peerID perun.PeerID) perun.APIError {
+	return toPayChsUnclosedChsErr(s.UpdatePeerID(peerID))
+}
+
+// DeletePeerID deletes the peer ID from the session and interprets the info of
+// open channels in the error (if any) as payment channels info.
+//
+// See session.DeletePeerID for the list of errors returned by this API.
+func DeletePeerID(s perun.SessionAPI, alias string) perun.APIError {
+	return toPayChsUnclosedChsErr(s.DeletePeerID(alias))
+}
+
 // ErrInfoFailedPreCondUnclosedPayChs is the interpretation of
 // ErrInfoFailedPreCondUnclosedChs for payment application.
 type ErrInfoFailedPreCondUnclosedPayChs struct {
@@ -118,11 +171,22 @@ type ErrInfoFailedPreCondUnclosedPayChs struct {
 // See session.CloseSession for the list of errors returned by this API.
 func CloseSession(s perun.SessionAPI, force bool) ([]PayChInfo, perun.APIError) {
 	openChsInfo, err := s.Close(force)
-	err = toPayChsCloseSessionErr(err)
+	err = toPayChsUnclosedChsErr(err)
+	return toPayChsInfo(openChsInfo), err
+}
+
+// CloseNodeSession closes the session with the given ID using the node
+// administration API and interprets the open channels info as payment channels
+// info.
+//
+// See node.CloseSession for the list of errors returned by this API.
+func CloseNodeSession(n perun.NodeAPI, sessionID string, force bool) ([]PayChInfo, perun.APIError) {
+	openChsInfo, err := n.CloseSession(sessionID, force)
+	err = toPayChsUnclosedChsErr(err)
 	return toPayChsInfo(openChsInfo), err
 }
 
-func toPayChsCloseSessionErr(err perun.APIError) perun.APIError {
+func toPayChsUnclosedChsErr(err perun.APIError) perun.APIError {
 	if err == nil {
 		return err
 	}
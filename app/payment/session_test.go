@@ -79,7 +79,9 @@ var (
 		ChallengeDurSecs: challengeDurSecs,
 		Expiry:           expiry,
 	}
-	wantPayChProposalNotif = payment.PayChProposalNotif{proposalID, openingBalInfo, challengeDurSecs, expiry}
+	wantPayChProposalNotif = payment.PayChProposalNotif{
+		proposalID, openingBalInfo, challengeDurSecs, expiry, "", perun.AutoResponseNone,
+	}
 
 	// Updated channel data.
 	amountToSend   = "0.5"
@@ -137,6 +139,31 @@ func Test_OpenPayCh(t *testing.T) {
 	})
 }
 
+func Test_OpenVirtualPayCh(t *testing.T) {
+	parents := perun.VirtualChParents{ChID: "parent1", PeerChID: "parent2", PeerIdx: 0}
+	t.Run("happy", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("OpenVirtualCh", context.Background(), parents, openingBalInfoInput, app, challengeDurSecs).Return(
+			openedChInfo, nil)
+
+		gotPayChInfo, gotErr := payment.OpenVirtualPayCh(context.Background(), sessionAPI, parents,
+			openingBalInfoInput, challengeDurSecs)
+		require.NoError(t, gotErr)
+		assert.Equal(t, wantOpenedPayChInfo, gotPayChInfo)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("OpenVirtualCh", context.Background(), parents, openingBalInfoInput, app, challengeDurSecs).Return(
+			perun.ChInfo{}, perun.NewAPIErrUnknownInternal(assert.AnError))
+
+		_, gotErr := payment.OpenVirtualPayCh(context.Background(), sessionAPI, parents,
+			openingBalInfoInput, challengeDurSecs)
+		require.Error(t, gotErr)
+		t.Log(gotErr)
+	})
+}
+
 func Test_GetPayChs(t *testing.T) {
 	t.Run("happy", func(t *testing.T) {
 		sessionAPI := &mocks.SessionAPI{}
@@ -229,6 +256,30 @@ func Test_RespondPayChProposal(t *testing.T) {
 	})
 }
 
+func Test_RespondVirtualPayChProposal(t *testing.T) {
+	proposalID := "proposal-id-1"
+	t.Run("happy_accept", func(t *testing.T) {
+		accept := true
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("RespondVirtualChProposal", context.Background(), proposalID, accept).Return(openedChInfo, nil)
+
+		gotOpenedPayChInfo, gotErr := payment.RespondVirtualPayChProposal(context.Background(), sessionAPI,
+			proposalID, accept)
+		assert.NoError(t, gotErr)
+		assert.Equal(t, wantOpenedPayChInfo, gotOpenedPayChInfo)
+	})
+	t.Run("error", func(t *testing.T) {
+		accept := true
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("RespondVirtualChProposal", context.Background(), proposalID, accept).Return(perun.ChInfo{},
+			perun.NewAPIErrUnknownInternal(assert.AnError))
+
+		_, gotErr := payment.RespondVirtualPayChProposal(context.Background(), sessionAPI, proposalID, accept)
+		assert.Error(t, gotErr)
+		t.Log(gotErr)
+	})
+}
+
 func Test_CloseSession(t *testing.T) {
 	t.Run("happy_noForce", func(t *testing.T) {
 		force := false
@@ -263,3 +314,51 @@ func Test_CloseSession(t *testing.T) {
 		assert.Equal(t, paymentAddInfo.PayChs[0], wantUpdatedPayChInfo)
 	})
 }
+
+func Test_UpdatePeerID(t *testing.T) {
+	peerID := perun.PeerID{Alias: "peer-alias", CommAddr: "127.0.0.1:5751"}
+
+	t.Run("happy", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("UpdatePeerID", peerID).Return(nil)
+
+		err := payment.UpdatePeerID(sessionAPI, peerID)
+		require.NoError(t, err)
+	})
+	t.Run("OpenChs", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		unclosedChsErr := perun.NewAPIErrFailedPreConditionUnclosedChs(assert.AnError, []perun.ChInfo{updatedChInfo})
+		sessionAPI.On("UpdatePeerID", peerID).Return(unclosedChsErr)
+
+		apiErr := payment.UpdatePeerID(sessionAPI, peerID)
+		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrFailedPreCondition)
+		paymentAddInfo, ok := apiErr.AddInfo().(payment.ErrInfoFailedPreCondUnclosedPayChs)
+		require.True(t, ok)
+		require.Len(t, paymentAddInfo.PayChs, 1)
+		assert.Equal(t, wantUpdatedPayChInfo, paymentAddInfo.PayChs[0])
+	})
+}
+
+func Test_DeletePeerID(t *testing.T) {
+	alias := "peer-alias"
+
+	t.Run("happy", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("DeletePeerID", alias).Return(nil)
+
+		err := payment.DeletePeerID(sessionAPI, alias)
+		require.NoError(t, err)
+	})
+	t.Run("OpenChs", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		unclosedChsErr := perun.NewAPIErrFailedPreConditionUnclosedChs(assert.AnError, []perun.ChInfo{updatedChInfo})
+		sessionAPI.On("DeletePeerID", alias).Return(unclosedChsErr)
+
+		apiErr := payment.DeletePeerID(sessionAPI, alias)
+		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrFailedPreCondition)
+		paymentAddInfo, ok := apiErr.AddInfo().(payment.ErrInfoFailedPreCondUnclosedPayChs)
+		require.True(t, ok)
+		require.Len(t, paymentAddInfo.PayChs, 1)
+		assert.Equal(t, wantUpdatedPayChInfo, paymentAddInfo.PayChs[0])
+	})
+}
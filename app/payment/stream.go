@@ -0,0 +1,413 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// endedStreamRetention is the duration for which an ended stream is retained,
+// if its final status is not reported.
+const endedStreamRetention = 10 * time.Minute
+
+// StreamState represents the state of a payment stream.
+type StreamState string
+
+// Enumeration of the values for StreamState.
+const (
+	StreamRunning   StreamState = "running"
+	StreamCompleted StreamState = "completed" // Cap was reached.
+	StreamStopped   StreamState = "stopped"   // Stopped by the user.
+	StreamFailed    StreamState = "failed"    // Stopped due to an error in sending an update.
+)
+
+type (
+	// StreamStatus represents the status of a payment stream. All amounts
+	// are in the units of the currency of the stream.
+	StreamStatus struct {
+		StreamID          string
+		ChID              string
+		Payee             string
+		Currency          string
+		AmountPerInterval string
+		Interval          time.Duration
+		Cap               string // Empty, if the stream does not have a cap.
+
+		State   StreamState
+		Paid    string // Total amount paid so far.
+		Ticks   uint64 // Number of intervals paid for so far.
+		Updates uint64 // Number of updates sent so far.
+		Error   perun.APIError
+	}
+
+	// StreamStatusNotifier is the notifier function that is used for sending
+	// the status of a payment stream.
+	StreamStatusNotifier func(StreamStatus)
+
+	// Streams manages the payment streams of a session, indexed by stream
+	// IDs. An ended stream is retained until its final status is reported,
+	// either to the subscriber or when it is retrieved; but at most for
+	// endedStreamRetention.
+	Streams struct {
+		mtx     sync.Mutex
+		streams map[string]*stream
+	}
+
+	stream struct {
+		ch        perun.ChAPI
+		currency  perun.Currency
+		amount    *big.Int // Amount per interval, in base units.
+		capAmount *big.Int // Nil, if the stream does not have a cap.
+		paid      *big.Int
+		start     time.Time
+		stop      context.CancelFunc
+		done      chan struct{}
+		forget    func() // Removes the stream from the streams.
+
+		// mtx protects the status and the notifier.
+		mtx      sync.Mutex
+		status   StreamStatus
+		notifier StreamStatusNotifier
+	}
+)
+
+// NewStreams initializes a payment stream manager.
+func NewStreams() *Streams {
+	return &Streams{
+		streams: make(map[string]*stream),
+	}
+}
+
+// StartPaymentStream starts a payment stream on the channel that pays the
+// amountPerInterval to the payee once every interval, until the total amount
+// paid reaches the cap, an update fails or the stream is stopped. The cap is
+// optional, use an empty string for a stream without a cap.
+//
+// Each interval is paid for only after it has elapsed. If an update takes
+// longer than the interval, the intervals elapsed in the meanwhile are paid
+// for together in the next update. The last update is reduced so that the
+// total amount paid does not exceed the cap.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "currency" when the currency is not used in the channel.
+// - ErrInvalidArgument with Name:"payee" when the payee is not a peer in the channel.
+// - ErrInvalidArgument with Name:"amount" when the amount per interval is invalid.
+// - ErrInvalidArgument with Name:"interval" when the interval is not positive.
+// - ErrInvalidArgument with Name:"cap" when the cap is invalid or is less than the amount per interval.
+func (s *Streams) StartPaymentStream(ch perun.ChAPI, payee, currency, amountPerInterval string, interval time.Duration,
+	capAmount string,
+) (StreamStatus, perun.APIError) {
+	st, apiErr := newStream(ch, payee, currency, amountPerInterval, interval, capAmount)
+	if apiErr != nil {
+		return StreamStatus{}, apiErr
+	}
+	streamID, err := randomStreamID()
+	if err != nil {
+		return StreamStatus{}, perun.NewAPIErrUnknownInternal(err)
+	}
+	st.status.StreamID = streamID
+
+	ctx, cancel := context.WithCancel(context.Background())
+	st.stop = cancel
+	st.forget = func() { s.forget(streamID) }
+	s.mtx.Lock()
+	s.streams[streamID] = st
+	s.mtx.Unlock()
+	go st.run(ctx)
+	return st.getStatus(), nil
+}
+
+func newStream(ch perun.ChAPI, payee, currency, amountPerInterval string, interval time.Duration, capAmount string) (
+	*stream, perun.APIError,
+) {
+	_, c, found := ch.Currency(currency)
+	if !found {
+		return nil, perun.NewAPIErrResourceNotFound(perun.ResTypeCurrency, currency)
+	}
+	if _, found = partIdx(ch.Parts(), payee); !found || payee == perun.OwnAlias {
+		return nil, perun.NewAPIErrInvalidArgument(ErrInvalidPayee, perun.ArgNamePayee, payee)
+	}
+	amount, err := c.Parse(amountPerInterval)
+	if err != nil {
+		err = errors.WithMessage(err, ErrInvalidAmount.Error())
+		return nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNameAmount, amountPerInterval)
+	}
+	if interval <= 0 {
+		err = errors.New("interval should be positive")
+		return nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNameInterval, interval.String())
+	}
+	var parsedCap *big.Int
+	if capAmount != "" {
+		if parsedCap, err = c.Parse(capAmount); err != nil {
+			err = errors.WithMessage(err, ErrInvalidAmount.Error())
+			return nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNameCap, capAmount)
+		}
+		if parsedCap.Cmp(amount) < 0 {
+			err = errors.New("cap should not be less than the amount per interval")
+			return nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNameCap, capAmount)
+		}
+	}
+
+	return &stream{
+		ch:        ch,
+		currency:  c,
+		amount:    amount,
+		capAmount: parsedCap,
+		paid:      new(big.Int),
+		start:     time.Now(),
+		done:      make(chan struct{}),
+		status: StreamStatus{
+			ChID:              ch.ID(),
+			Payee:             payee,
+			Currency:          currency,
+			AmountPerInterval: amountPerInterval,
+			Interval:          interval,
+			Cap:               capAmount,
+			State:             StreamRunning,
+			Paid:              c.Print(new(big.Int)),
+		},
+	}, nil
+}
+
+// StopPaymentStream stops the payment stream and returns its final status.
+// If an update is in progress, it is cancelled. If the stream has already
+// ended, its final status is returned.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "payStream" when the stream ID is not known.
+func (s *Streams) StopPaymentStream(streamID string) (StreamStatus, perun.APIError) {
+	st, apiErr := s.get(streamID)
+	if apiErr != nil {
+		return StreamStatus{}, apiErr
+	}
+	st.stop()
+	<-st.done
+	return st.reportStatus(), nil
+}
+
+// GetPaymentStreamStatus returns the current status of the payment stream.
+// Once the final status of an ended stream is returned, the stream is
+// removed.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "payStream" when the stream ID is not known.
+func (s *Streams) GetPaymentStreamStatus(streamID string) (StreamStatus, perun.APIError) {
+	st, apiErr := s.get(streamID)
+	if apiErr != nil {
+		return StreamStatus{}, apiErr
+	}
+	return st.reportStatus(), nil
+}
+
+// SubPaymentStreamStatus sets up a subscription for the status of the payment
+// stream. The notifier is called with the current status, when subscribing,
+// and then with the status after each update and when the stream ends. The
+// notifier should not call any of the stream APIs, as it is called while
+// holding the lock on the stream.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "payStream" when the stream ID is not known.
+// - ErrResourceExists with ResourceType: "payStreamSub" when a subscription already exists for the stream.
+func (s *Streams) SubPaymentStreamStatus(streamID string, notifier StreamStatusNotifier) perun.APIError {
+	st, apiErr := s.get(streamID)
+	if apiErr != nil {
+		return apiErr
+	}
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	if st.notifier != nil {
+		return perun.NewAPIErrResourceExists(perun.ResTypePayStreamSub, streamID)
+	}
+	st.notifier = notifier
+	st.notify()
+	return nil
+}
+
+// UnsubPaymentStreamStatus deletes the subscription for the status of the
+// payment stream.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "payStream" when the stream ID is not known.
+// - ErrResourceNotFound with ResourceType: "payStreamSub" when there is no subscription for the stream.
+func (s *Streams) UnsubPaymentStreamStatus(streamID string) perun.APIError {
+	st, apiErr := s.get(streamID)
+	if apiErr != nil {
+		return apiErr
+	}
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	if st.notifier == nil {
+		return perun.NewAPIErrResourceNotFound(perun.ResTypePayStreamSub, streamID)
+	}
+	st.notifier = nil
+	return nil
+}
+
+// Close stops all the running payment streams. It is to be called when the
+// session is closed.
+func (s *Streams) Close() {
+	s.mtx.Lock()
+	streams := make([]*stream, 0, len(s.streams))
+	for _, st := range s.streams {
+		streams = append(streams, st)
+	}
+	s.mtx.Unlock()
+	for _, st := range streams {
+		st.stop()
+		<-st.done
+	}
+}
+
+func (s *Streams) get(streamID string) (*stream, perun.APIError) {
+	s.mtx.Lock()
+	st, ok := s.streams[streamID]
+	s.mtx.Unlock()
+	if !ok {
+		return nil, perun.NewAPIErrResourceNotFound(perun.ResTypePayStream, streamID)
+	}
+	return st, nil
+}
+
+func (s *Streams) forget(streamID string) {
+	s.mtx.Lock()
+	delete(s.streams, streamID)
+	s.mtx.Unlock()
+}
+
+// run sends the updates for the stream once every interval, until the stream
+// ends.
+func (st *stream) run(ctx context.Context) {
+	defer close(st.done)
+	defer time.AfterFunc(endedStreamRetention, st.forget)
+	ticker := time.NewTicker(st.status.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			st.end(StreamStopped, nil)
+			return
+		case <-ticker.C:
+		}
+		if ended := st.pay(ctx); ended {
+			return
+		}
+	}
+}
+
+// pay sends an update paying for all the intervals that have elapsed since
+// the last update. It returns true if the stream has ended.
+func (st *stream) pay(ctx context.Context) bool {
+	// Status fields other than notifier are modified only in this go-routine,
+	// hence they can be read without the lock.
+	ticks := uint64(time.Since(st.start)/st.status.Interval) - st.status.Ticks
+	if ticks == 0 {
+		return false
+	}
+	amount := new(big.Int).Mul(st.amount, new(big.Int).SetUint64(ticks))
+	capReached := false
+	if st.capAmount != nil {
+		remaining := new(big.Int).Sub(st.capAmount, st.paid)
+		if amount.Cmp(remaining) >= 0 {
+			amount, capReached = remaining, true
+		}
+	}
+
+	payments := []Payment{{
+		Currency: st.status.Currency,
+		Payer:    perun.OwnAlias,
+		Payee:    st.status.Payee,
+		Amount:   st.currency.Print(amount),
+	}}
+	if _, apiErr := SendPayChUpdate(ctx, st.ch, payments); apiErr != nil {
+		if ctx.Err() != nil {
+			st.end(StreamStopped, nil)
+		} else {
+			st.end(StreamFailed, apiErr)
+		}
+		return true
+	}
+
+	st.paid.Add(st.paid, amount)
+	st.mtx.Lock()
+	st.status.Paid = st.currency.Print(st.paid)
+	st.status.Ticks += ticks
+	st.status.Updates++
+	if capReached {
+		st.status.State = StreamCompleted
+	}
+	st.notify()
+	st.mtx.Unlock()
+	return capReached
+}
+
+// end marks the stream as ended, with the given state and error.
+func (st *stream) end(state StreamState, apiErr perun.APIError) {
+	st.mtx.Lock()
+	st.status.State = state
+	st.status.Error = apiErr
+	st.notify()
+	st.mtx.Unlock()
+}
+
+// notify sends the status to the subscriber, if any. The lock on the stream
+// should be held when calling it.
+func (st *stream) notify() {
+	if st.notifier != nil {
+		st.notifier(st.status)
+		st.forgetIfEnded()
+	}
+}
+
+// forgetIfEnded removes the stream, if it has ended, as its final status has
+// been reported. The lock on the stream should be held when calling it.
+func (st *stream) forgetIfEnded() {
+	if st.status.State != StreamRunning {
+		st.forget()
+	}
+}
+
+func (st *stream) getStatus() StreamStatus {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	return st.status
+}
+
+// reportStatus returns the current status of the stream and removes the
+// stream, if it has ended.
+func (st *stream) reportStatus() StreamStatus {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	st.forgetIfEnded()
+	return st.status
+}
+
+func randomStreamID() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", errors.Wrap(err, "reading random bytes")
+	}
+	return hex.EncodeToString(data), nil
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+)
+
+const streamInterval = 10 * time.Millisecond
+
+func Test_Streams_StartPaymentStream(t *testing.T) {
+	t.Run("happy_cap", func(t *testing.T) {
+		chAPI := newStreamChAPIMock()
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(updatedChInfo, nil)
+		streams := payment.NewStreams()
+		status, err := streams.StartPaymentStream(chAPI, peerAlias, currency.ETHSymbol, "1", streamInterval, "2.5")
+		require.NoError(t, err)
+		assert.Equal(t, payment.StreamRunning, status.State)
+		assert.Equal(t, chID, status.ChID)
+		assert.Equal(t, "0", status.Paid)
+
+		notifs := subStreamStatus(t, streams, status.StreamID)
+		final := awaitStreamEnd(t, notifs)
+		assert.Equal(t, payment.StreamCompleted, final.State)
+		assert.Equal(t, "2.5", final.Paid)
+		assert.GreaterOrEqual(t, final.Ticks, uint64(3))
+		assert.Nil(t, final.Error)
+
+		// Ended stream is removed, once its final status is reported to the subscriber.
+		_, err = streams.GetPaymentStreamStatus(status.StreamID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+
+	t.Run("happy_cap_no_subscriber", func(t *testing.T) {
+		chAPI := newStreamChAPIMock()
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(updatedChInfo, nil)
+		streams := payment.NewStreams()
+		status, err := streams.StartPaymentStream(chAPI, peerAlias, currency.ETHSymbol, "1", streamInterval, "1")
+		require.NoError(t, err)
+
+		// Ended stream is retained until its final status is retrieved.
+		var got payment.StreamStatus
+		require.Eventually(t, func() bool {
+			got, err = streams.GetPaymentStreamStatus(status.StreamID)
+			require.NoError(t, err)
+			return got.State != payment.StreamRunning
+		}, time.Second, streamInterval)
+		assert.Equal(t, payment.StreamCompleted, got.State)
+		_, err = streams.GetPaymentStreamStatus(status.StreamID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+
+	t.Run("happy_stop", func(t *testing.T) {
+		chAPI := newStreamChAPIMock()
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(updatedChInfo, nil)
+		streams := payment.NewStreams()
+		status, err := streams.StartPaymentStream(chAPI, peerAlias, currency.ETHSymbol, "1", streamInterval, "")
+		require.NoError(t, err)
+
+		time.Sleep(5 * streamInterval)
+		final, err := streams.StopPaymentStream(status.StreamID)
+		require.NoError(t, err)
+		assert.Equal(t, payment.StreamStopped, final.State)
+		assert.NotZero(t, final.Updates)
+		chAPI.AssertNumberOfCalls(t, "SendChUpdate", int(final.Updates))
+
+		_, err = streams.StopPaymentStream(status.StreamID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+
+	t.Run("happy_batched_ticks", func(t *testing.T) {
+		chAPI := newStreamChAPIMock()
+		// Peer takes longer than the interval to respond.
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).After(4*streamInterval).Return(updatedChInfo, nil)
+		streams := payment.NewStreams()
+		status, err := streams.StartPaymentStream(chAPI, peerAlias, currency.ETHSymbol, "1", streamInterval, "")
+		require.NoError(t, err)
+
+		time.Sleep(15 * streamInterval)
+		final, err := streams.StopPaymentStream(status.StreamID)
+		require.NoError(t, err)
+		assert.Greater(t, final.Ticks, final.Updates)
+	})
+
+	t.Run("update_error", func(t *testing.T) {
+		chAPI := newStreamChAPIMock()
+		updateErr := perun.NewAPIErrPeerRejected(errors.New("rejected"), peerAlias, "rejected")
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(perun.ChInfo{}, updateErr)
+		streams := payment.NewStreams()
+		status, err := streams.StartPaymentStream(chAPI, peerAlias, currency.ETHSymbol, "1", streamInterval, "")
+		require.NoError(t, err)
+
+		final := awaitStreamEnd(t, subStreamStatus(t, streams, status.StreamID))
+		assert.Equal(t, payment.StreamFailed, final.State)
+		assert.Equal(t, updateErr, final.Error)
+		assert.Equal(t, "0", final.Paid)
+	})
+
+	t.Run("invalid_args", func(t *testing.T) {
+		chAPI := newStreamChAPIMock()
+		chAPI.On("Currency", "XYZ").Return(0, nil, false)
+		streams := payment.NewStreams()
+
+		_, err := streams.StartPaymentStream(chAPI, peerAlias, "XYZ", "1", streamInterval, "")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+
+		tests := []struct {
+			name                     string
+			payee, amount, capAmount string
+			interval                 time.Duration
+			wantArgName              perun.ArgumentName
+			wantArgValue             string
+		}{
+			{"unknown_payee", "unknown", "1", "", streamInterval, perun.ArgNamePayee, "unknown"},
+			{"payee_self", perun.OwnAlias, "1", "", streamInterval, perun.ArgNamePayee, perun.OwnAlias},
+			{"invalid_amount", peerAlias, "abc", "", streamInterval, perun.ArgNameAmount, "abc"},
+			{"zero_amount", peerAlias, "0", "", streamInterval, perun.ArgNameAmount, "0"},
+			{"zero_interval", peerAlias, "1", "", 0, perun.ArgNameInterval, "0s"},
+			{"invalid_cap", peerAlias, "1", "abc", streamInterval, perun.ArgNameCap, "abc"},
+			{"cap_less_than_amount", peerAlias, "1", "0.5", streamInterval, perun.ArgNameCap, "0.5"},
+		}
+		for _, tc := range tests {
+			tc := tc
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := streams.StartPaymentStream(chAPI, tc.payee, currency.ETHSymbol, tc.amount, tc.interval,
+					tc.capAmount)
+				peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+				peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), tc.wantArgName, tc.wantArgValue)
+			})
+		}
+	})
+}
+
+func Test_Streams_UnknownStream(t *testing.T) {
+	streams := payment.NewStreams()
+	_, err := streams.StopPaymentStream("unknown")
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePayStream, "unknown")
+
+	_, err = streams.GetPaymentStreamStatus("unknown")
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	err = streams.SubPaymentStreamStatus("unknown", func(payment.StreamStatus) {})
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	err = streams.UnsubPaymentStreamStatus("unknown")
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+}
+
+func Test_Streams_SubUnsub(t *testing.T) {
+	chAPI := newStreamChAPIMock()
+	chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(updatedChInfo, nil)
+	streams := payment.NewStreams()
+	status, err := streams.StartPaymentStream(chAPI, peerAlias, currency.ETHSymbol, "1", time.Hour, "")
+	require.NoError(t, err)
+	defer streams.Close()
+
+	err = streams.UnsubPaymentStreamStatus(status.StreamID)
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePayStreamSub, status.StreamID)
+
+	notifs := subStreamStatus(t, streams, status.StreamID)
+	assert.Equal(t, status, <-notifs, "current status is sent on subscribing")
+	err = streams.SubPaymentStreamStatus(status.StreamID, func(payment.StreamStatus) {})
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceExists)
+	peruntest.AssertErrInfoResourceExists(t, err.AddInfo(), perun.ResTypePayStreamSub, status.StreamID)
+
+	require.NoError(t, streams.UnsubPaymentStreamStatus(status.StreamID))
+	require.NoError(t, streams.SubPaymentStreamStatus(status.StreamID, func(payment.StreamStatus) {}))
+}
+
+// newStreamChAPIMock returns a mock with API calls set up for currency, parts
+// and ID.
+func newStreamChAPIMock() *mocks.ChAPI {
+	chAPI := &mocks.ChAPI{}
+	chAPI.On("Currency", currency.ETHSymbol).Return(0, currencytest.Registry().Currency(currency.ETHSymbol), true)
+	chAPI.On("Parts").Return(parts)
+	chAPI.On("ID").Return(chID)
+	return chAPI
+}
+
+// subStreamStatus subscribes to the stream status and returns a channel on
+// which the notifications are sent.
+func subStreamStatus(t *testing.T, streams *payment.Streams, streamID string) <-chan payment.StreamStatus {
+	t.Helper()
+	notifs := make(chan payment.StreamStatus, 100)
+	var once sync.Once
+	require.NoError(t, streams.SubPaymentStreamStatus(streamID, func(status payment.StreamStatus) {
+		notifs <- status
+		if status.State != payment.StreamRunning {
+			once.Do(func() { close(notifs) })
+		}
+	}))
+	return notifs
+}
+
+// awaitStreamEnd returns the last status received on the channel, once it is
+// closed on the stream ending.
+func awaitStreamEnd(t *testing.T, notifs <-chan payment.StreamStatus) payment.StreamStatus {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var last payment.StreamStatus
+	for {
+		select {
+		case status, ok := <-notifs:
+			if !ok {
+				return last
+			}
+			last = status
+		case <-ctx.Done():
+			t.Fatal("stream did not end in time")
+			return last
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Registry implements an app registry with apps indexed by their names.
+//
+// Apps registered here are also registered with the app registry of
+// go-perun, so that channels using these apps can be resolved when they are
+// proposed by a peer or restored from persistence.
+//
+// It uses a slice to keep track of registered names because iterating over
+// map to retrieve the names each time will result in different ordering of
+// names in the list.
+type Registry struct {
+	mtx   sync.RWMutex
+	names []string
+	apps  map[string]perun.AppDef
+	defs  map[pwallet.AddrKey]string
+}
+
+// NewRegistry initializes an app registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		apps: make(map[string]perun.AppDef),
+		defs: make(map[pwallet.AddrKey]string),
+	}
+}
+
+// Register registers the app with the registry.
+//
+// Returns an error if the app name is empty, or if an app is already
+// registered with the same name or with the same app definition.
+func (r *Registry) Register(app perun.AppDef) error {
+	if app.Name() == "" {
+		return errors.New("app name should not be empty")
+	}
+	if app.Def() == nil {
+		return errors.New("app definition should not be nil")
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if _, ok := r.apps[app.Name()]; ok {
+		return errors.New("app already registered for the given name")
+	}
+	defKey := pwallet.Key(app.Def())
+	if name, ok := r.defs[defKey]; ok {
+		return errors.Errorf("app definition already registered for app %s", name)
+	}
+	pchannel.RegisterApp(app)
+	r.apps[app.Name()] = app
+	r.defs[defKey] = app.Name()
+	r.names = append(r.names, app.Name())
+	return nil
+}
+
+// App returns the app registered for the given name.
+func (r *Registry) App(name string) (perun.AppDef, bool) {
+	r.mtx.RLock()
+	app, ok := r.apps[name]
+	r.mtx.RUnlock()
+	return app, ok
+}
+
+// Names returns the names of all the apps registered in the registry, in the
+// order in which they were registered.
+func (r *Registry) Names() []string {
+	r.mtx.RLock()
+	names := make([]string, len(r.names))
+	copy(names, r.names)
+	r.mtx.RUnlock()
+	return names
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+
+	"github.com/hyperledger-labs/perun-node/app"
+	"github.com/hyperledger-labs/perun-node/app/metered"
+	"github.com/hyperledger-labs/perun-node/app/tictactoe"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+)
+
+func Test_Registry(t *testing.T) {
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	gameDef := ethereumtest.NewRandomAddress(prng)
+	game := tictactoe.New(gameDef)
+	service := metered.New(ethereumtest.NewRandomAddress(prng))
+
+	r := app.NewRegistry()
+	require.NoError(t, r.Register(game))
+	require.NoError(t, r.Register(service))
+
+	t.Run("happy_App", func(t *testing.T) {
+		got, ok := r.App(tictactoe.Name)
+		require.True(t, ok)
+		assert.Equal(t, game, got)
+
+		_, ok = r.App("unknown")
+		assert.False(t, ok)
+	})
+
+	t.Run("happy_Names", func(t *testing.T) {
+		assert.Equal(t, []string{tictactoe.Name, metered.Name}, r.Names())
+	})
+
+	t.Run("happy_resolved_by_go-perun", func(t *testing.T) {
+		got, err := pchannel.Resolve(gameDef)
+		require.NoError(t, err)
+		assert.Equal(t, game, got)
+	})
+
+	t.Run("error_name_exists", func(t *testing.T) {
+		assert.Error(t, r.Register(tictactoe.New(ethereumtest.NewRandomAddress(prng))))
+	})
+
+	t.Run("error_def_exists", func(t *testing.T) {
+		assert.Error(t, r.Register(metered.New(gameDef)))
+	})
+
+	t.Run("error_nil_def", func(t *testing.T) {
+		assert.Error(t, app.NewRegistry().Register(tictactoe.New(nil)))
+	})
+}
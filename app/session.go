@@ -0,0 +1,106 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+type (
+	// ChProposalNotif represents the channel proposal notification data
+	// for app channels.
+	ChProposalNotif struct {
+		ProposalID       string
+		App              string
+		Data             []byte
+		OpeningBalInfo   perun.BalInfo
+		ChallengeDurSecs uint64
+		Expiry           int64
+	}
+
+	// ChProposalNotifier represents the channel proposal notification
+	// function for app channels.
+	ChProposalNotifier func(ChProposalNotif)
+)
+
+// OpenAppCh opens a channel with the given app, with the initial app data
+// constructed from the app specific init params. It interprets the returned
+// channel info as app channel info.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"initParams" when the app cannot construct
+// the initial data from the init params.
+// or any of the errors returned by the session.OpenCh API.
+func OpenAppCh(pctx context.Context, s perun.SessionAPI, app perun.AppDef, initParams []byte,
+	openingBalInfo perun.BalInfo, challengeDurSecs uint64,
+) (ChInfo, perun.APIError) {
+	initData, err := app.InitData(initParams)
+	if err != nil {
+		err = errors.WithMessage(err, ErrInvalidInitParams.Error())
+		return ChInfo{}, perun.NewAPIErrInvalidArgument(err, perun.ArgNameInitParams, hex.EncodeToString(initParams))
+	}
+
+	chInfo, apiErr := s.OpenCh(pctx, openingBalInfo, perun.App{Def: app, Data: initData}, challengeDurSecs)
+	return toAppChInfo(chInfo), apiErr
+}
+
+// GetAppChsInfo fetches the list of all channels info in the session and
+// interprets them as app channel info.
+func GetAppChsInfo(s perun.SessionAPI) []ChInfo {
+	return toAppChsInfo(s.GetChsInfo())
+}
+
+// SubAppChProposals sets up a subscription for incoming channel proposals and
+// interprets the notifications as app channel notifications.
+//
+// See session.SubChProposals for the list of errors returned by this API.
+func SubAppChProposals(s perun.SessionAPI, notifier ChProposalNotifier) perun.APIError {
+	return s.SubChProposals(func(notif perun.ChProposalNotif) {
+		name, data := toAppNameAndData(notif.App)
+		notifier(ChProposalNotif{
+			ProposalID:       notif.ProposalID,
+			App:              name,
+			Data:             data,
+			OpeningBalInfo:   notif.OpeningBalInfo,
+			ChallengeDurSecs: notif.ChallengeDurSecs,
+			Expiry:           notif.Expiry,
+		})
+	})
+}
+
+// UnsubAppChProposals deletes the existing subscription for channel proposals.
+//
+// See session.UnsubChProposals for the list of errors returned by this API.
+func UnsubAppChProposals(s perun.SessionAPI) perun.APIError {
+	return s.UnsubChProposals()
+}
+
+// RespondAppChProposal sends the response to an app channel proposal
+// notification and interprets the opening channel info as app channel info.
+//
+// See session.RespondChProposal for the list of errors returned by this API.
+func RespondAppChProposal(pctx context.Context, s perun.SessionAPI, proposalID string, accept bool) (
+	ChInfo, perun.APIError,
+) {
+	chInfo, apiErr := s.RespondChProposal(pctx, proposalID, accept)
+	return toAppChInfo(chInfo), apiErr
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tictactoe
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Name is the name of the tic-tac-toe app.
+const Name = "tictactoe"
+
+// App implements the tic-tac-toe game as a channel app.
+type App struct {
+	def pwallet.Address
+}
+
+var _ perun.AppDef = &App{}
+
+// New returns a tic-tac-toe app with the given app definition address.
+func New(def pwallet.Address) *App {
+	return &App{def: def}
+}
+
+// Name returns the name of the app.
+func (a *App) Name() string {
+	return Name
+}
+
+// Def returns the app definition address.
+func (a *App) Def() pwallet.Address {
+	return a.def
+}
+
+// NewData returns a new instance of the app data.
+func (a *App) NewData() pchannel.Data {
+	return &Data{}
+}
+
+// InitData returns the initial app data, with an empty grid and the given
+// participant to make the first move.
+func (a *App) InitData(params []byte) (pchannel.Data, error) {
+	switch {
+	case len(params) == 0:
+		return &Data{}, nil
+	case len(params) == 1 && params[0] < numParts:
+		return &Data{NextActor: params[0]}, nil
+	default:
+		return nil, errors.New("init params should be empty or index of the first actor (0 or 1)")
+	}
+}
+
+// ApplyAction marks the field given in the action for the actor. If the actor
+// wins or the grid is full, the state is finalized.
+func (a *App) ApplyAction(state *pchannel.State, actor pchannel.Index, action []byte) error {
+	data, ok := state.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", state.Data)
+	}
+	if state.IsFinal {
+		return errors.New("game is over")
+	}
+	if uint8(actor) != data.NextActor {
+		return errors.New("not the turn of the actor")
+	}
+	if len(action) != 1 || action[0] >= numFields {
+		return errors.New("action should be index of the field (0 to 8)")
+	}
+	if data.Grid[action[0]] != free {
+		return errors.New("field is already marked")
+	}
+
+	data.Grid[action[0]] = mark(actor)
+	data.NextActor = uint8(actor ^ 1)
+	if winner, won := data.winner(); won {
+		state.Balances = settle(state.Balances, winner)
+		state.IsFinal = true
+	} else if data.isFull() {
+		state.IsFinal = true
+	}
+	return nil
+}
+
+// ValidInit checks that the initial state has an empty grid, a valid first
+// actor and that the channel has two participants.
+func (a *App) ValidInit(params *pchannel.Params, state *pchannel.State) error {
+	if len(params.Parts) != numParts {
+		return errors.Errorf("expected %d participants, got %d", numParts, len(params.Parts))
+	}
+	data, ok := state.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", state.Data)
+	}
+	if data.NextActor >= numParts {
+		return errors.Errorf("invalid first actor %d", data.NextActor)
+	}
+	if *data != (Data{NextActor: data.NextActor}) {
+		return errors.New("grid should be empty")
+	}
+	if state.IsFinal {
+		return errors.New("initial state should not be final")
+	}
+	return nil
+}
+
+// ValidTransition checks that the transition is a valid move by the actor.
+// That is, the actor has marked exactly one free field in its turn and, if
+// the game has ended, the state is finalized with the balances settled
+// accordingly.
+//
+// A transition that only finalizes the state without changing the data and
+// the balances is also valid for either actor. This allows the participants
+// to close the channel before the game ends, if both of them agree.
+func (a *App) ValidTransition(params *pchannel.Params, from, to *pchannel.State, actor pchannel.Index) error {
+	fromData, ok := from.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", from.Data)
+	}
+	toData, ok := to.Data.(*Data)
+	if !ok {
+		return errors.Errorf("invalid data type %T", to.Data)
+	}
+
+	if *fromData == *toData && from.Balances.Equal(to.Balances) && to.IsFinal {
+		return nil
+	}
+	if uint8(actor) != fromData.NextActor || uint8(actor^1) != toData.NextActor {
+		return pchannel.NewStateTransitionError(params.ID(), "not the turn of the actor")
+	}
+	if err := validMove(fromData, toData, actor); err != nil {
+		return pchannel.NewStateTransitionError(params.ID(), err.Error())
+	}
+
+	expectedBals, expectedFinal := from.Balances, false
+	if winner, won := toData.winner(); won {
+		expectedBals, expectedFinal = settle(from.Balances, winner), true
+	} else if toData.isFull() {
+		expectedFinal = true
+	}
+	if to.IsFinal != expectedFinal {
+		return pchannel.NewStateTransitionError(params.ID(), fmt.Sprintf("final should be %t", expectedFinal))
+	}
+	if !to.Balances.Equal(expectedBals) {
+		return pchannel.NewStateTransitionError(params.ID(), "balances not settled as per the result")
+	}
+	return nil
+}
+
+// validMove checks that exactly one field, that was free, is marked by the
+// actor.
+func validMove(from, to *Data, actor pchannel.Index) error {
+	changed := 0
+	for i := range from.Grid {
+		if from.Grid[i] == to.Grid[i] {
+			continue
+		}
+		if from.Grid[i] != free || to.Grid[i] != mark(actor) {
+			return errors.Errorf("invalid change in field %d", i)
+		}
+		changed++
+	}
+	if changed != 1 {
+		return errors.Errorf("expected one field to be marked, got %d", changed)
+	}
+	return nil
+}
+
+// settle returns a copy of the balances, with the balances of the loser in all
+// the assets transferred to the winner.
+func settle(bals pchannel.Balances, winner pchannel.Index) pchannel.Balances {
+	settled := bals.Clone()
+	loser := winner ^ 1
+	for i := range settled {
+		settled[i][winner].Add(settled[i][winner], settled[i][loser])
+		settled[i][loser].SetInt64(0)
+	}
+	return settled
+}
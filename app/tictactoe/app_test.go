@@ -0,0 +1,162 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tictactoe_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node/app/tictactoe"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+)
+
+func newSetup(t *testing.T) (*tictactoe.App, *pchannel.Params, *pchannel.State) {
+	t.Helper()
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	app := tictactoe.New(ethereumtest.NewRandomAddress(prng))
+	parts := []pwallet.Address{ethereumtest.NewRandomAddress(prng), ethereumtest.NewRandomAddress(prng)}
+	params := pchannel.NewParamsUnsafe(10, parts, app, big.NewInt(1), true, false)
+
+	initData, err := app.InitData(nil)
+	require.NoError(t, err)
+	state := &pchannel.State{
+		ID:  params.ID(),
+		App: app,
+		Allocation: pchannel.Allocation{
+			Balances: pchannel.Balances{{big.NewInt(10), big.NewInt(20)}},
+		},
+		Data: initData,
+	}
+	require.NoError(t, app.ValidInit(params, state))
+	return app, params, state
+}
+
+// move applies the action to a copy of the state, checks that the resulting
+// state is a valid transition and returns it.
+func move(t *testing.T, app *tictactoe.App, params *pchannel.Params, from *pchannel.State,
+	actor pchannel.Index, field byte,
+) *pchannel.State {
+	t.Helper()
+	to := from.Clone()
+	to.Version++
+	require.NoError(t, app.ApplyAction(to, actor, []byte{field}))
+	require.NoError(t, app.ValidTransition(params, from, to, actor))
+	return to
+}
+
+func Test_App_Win(t *testing.T) {
+	app, params, state := newSetup(t)
+
+	// Participant 0 marks the top row.
+	for i, field := range []byte{0, 3, 1, 4, 2} {
+		state = move(t, app, params, state, pchannel.Index(i%2), field)
+	}
+
+	assert.True(t, state.IsFinal)
+	assert.Equal(t, int64(30), state.Balances[0][0].Int64())
+	assert.Equal(t, int64(0), state.Balances[0][1].Int64())
+	assert.Error(t, app.ApplyAction(state.Clone(), 1, []byte{5}))
+}
+
+func Test_App_Draw(t *testing.T) {
+	app, params, state := newSetup(t)
+
+	for i, field := range []byte{0, 1, 2, 4, 3, 5, 7, 6, 8} {
+		state = move(t, app, params, state, pchannel.Index(i%2), field)
+	}
+
+	assert.True(t, state.IsFinal)
+	assert.Equal(t, int64(10), state.Balances[0][0].Int64())
+	assert.Equal(t, int64(20), state.Balances[0][1].Int64())
+}
+
+func Test_App_ApplyAction_Errors(t *testing.T) {
+	app, params, state := newSetup(t)
+	state = move(t, app, params, state, 0, 4)
+
+	tests := []struct {
+		name   string
+		actor  pchannel.Index
+		action []byte
+	}{
+		{"not_turn", 0, []byte{0}},
+		{"field_marked", 1, []byte{4}},
+		{"invalid_field", 1, []byte{9}},
+		{"invalid_length", 1, []byte{0, 1}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Error(t, app.ApplyAction(state.Clone(), tc.actor, tc.action))
+		})
+	}
+}
+
+func Test_App_ValidTransition_Errors(t *testing.T) {
+	app, params, from := newSetup(t)
+
+	t.Run("two_fields", func(t *testing.T) {
+		to := from.Clone()
+		data := to.Data.(*tictactoe.Data)
+		data.Grid[0], data.Grid[1], data.NextActor = 1, 1, 1
+		assert.Error(t, app.ValidTransition(params, from, to, 0))
+	})
+	t.Run("not_turn", func(t *testing.T) {
+		to := from.Clone()
+		data := to.Data.(*tictactoe.Data)
+		data.Grid[0], data.NextActor = 2, 0
+		assert.Error(t, app.ValidTransition(params, from, to, 1))
+	})
+	t.Run("balances_changed", func(t *testing.T) {
+		to := move(t, app, params, from, 0, 0)
+		to.Balances[0][0].SetInt64(0)
+		to.Balances[0][1].SetInt64(30)
+		assert.Error(t, app.ValidTransition(params, from, to, 0))
+	})
+	t.Run("finalize_only", func(t *testing.T) {
+		to := from.Clone()
+		to.IsFinal = true
+		assert.NoError(t, app.ValidTransition(params, from, to, 1))
+	})
+}
+
+func Test_App_InitData(t *testing.T) {
+	app := tictactoe.New(nil)
+
+	data, err := app.InitData([]byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, &tictactoe.Data{NextActor: 1}, data)
+
+	_, err = app.InitData([]byte{2})
+	assert.Error(t, err)
+}
+
+func Test_Data_Marshal(t *testing.T) {
+	data := &tictactoe.Data{NextActor: 1, Grid: [9]uint8{1, 0, 2, 0, 1, 0, 0, 0, 2}}
+	b, err := data.MarshalBinary()
+	require.NoError(t, err)
+
+	got := tictactoe.New(nil).NewData()
+	require.NoError(t, got.UnmarshalBinary(b))
+	assert.Equal(t, data, got)
+	assert.Error(t, got.UnmarshalBinary(b[1:]))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tictactoe
+
+import (
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+)
+
+const (
+	numParts  = 2
+	numFields = 9
+	dataLen   = 1 + numFields
+
+	free uint8 = 0
+)
+
+// winningLines is the list of rows, columns and diagonals. A player marking
+// all fields in any of them wins the game.
+var winningLines = [][3]int{
+	{0, 1, 2}, {3, 4, 5}, {6, 7, 8},
+	{0, 3, 6}, {1, 4, 7}, {2, 5, 8},
+	{0, 4, 8}, {2, 4, 6},
+}
+
+// Data is the app data of the tic-tac-toe game.
+type Data struct {
+	NextActor uint8
+	Grid      [numFields]uint8
+}
+
+var _ pchannel.Data = &Data{}
+
+// MarshalBinary encodes the data into its binary representation.
+func (d *Data) MarshalBinary() ([]byte, error) {
+	b := make([]byte, dataLen)
+	b[0] = d.NextActor
+	copy(b[1:], d.Grid[:])
+	return b, nil
+}
+
+// UnmarshalBinary decodes the data from its binary representation.
+func (d *Data) UnmarshalBinary(b []byte) error {
+	if len(b) != dataLen {
+		return errors.Errorf("invalid data length %d, expected %d", len(b), dataLen)
+	}
+	d.NextActor = b[0]
+	copy(d.Grid[:], b[1:])
+	return nil
+}
+
+// Clone returns a deep copy of the data.
+func (d *Data) Clone() pchannel.Data {
+	clone := *d
+	return &clone
+}
+
+// mark returns the value of the fields marked by the participant at the given
+// index.
+func mark(idx pchannel.Index) uint8 {
+	return uint8(idx) + 1
+}
+
+// winner returns the index of the participant who has won the game, if any.
+func (d *Data) winner() (pchannel.Index, bool) {
+	for _, line := range winningLines {
+		v := d.Grid[line[0]]
+		if v != free && v == d.Grid[line[1]] && v == d.Grid[line[2]] {
+			return pchannel.Index(v - 1), true
+		}
+	}
+	return 0, false
+}
+
+// isFull returns true if all the fields in the grid are marked.
+func (d *Data) isFull() bool {
+	for i := range d.Grid {
+		if d.Grid[i] == free {
+			return false
+		}
+	}
+	return true
+}
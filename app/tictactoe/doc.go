@@ -0,0 +1,33 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tictactoe implements a two player, turn-based tic-tac-toe game as a
+// channel app, that can be registered on the node.
+//
+// Each player stakes the amount they deposit into the channel. When a player
+// wins, the balances of the other player in all the currencies are
+// transferred to the winner and the channel is finalized. When the game ends
+// in a draw, the channel is finalized without changing the balances.
+//
+// The init params are either empty, in which case the first participant makes
+// the first move; or a single byte with the index (0 or 1) of the participant
+// making the first move. An action is a single byte with the index (0 to 8) of
+// the field to be marked, with fields numbered row-wise from the top left.
+//
+// The app data is encoded as 10 bytes: the index of the participant making
+// the next move, followed by the value of each field (0 for free, 1 and 2 for
+// fields marked by the first and the second participant respectively).
+package tictactoe
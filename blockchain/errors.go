@@ -74,3 +74,31 @@ func NewAssetERC20RegisteredError(asset, symbol string) error {
 		Symbol: symbol,
 	})
 }
+
+// TxReorgedError indicates that a transaction was mined, but was later
+// removed from the chain in a chain reorganisation before it reached the
+// finality depth.
+type TxReorgedError struct {
+	TxType string
+	TxID   string
+	err    error
+}
+
+// Error implements error interface.
+func (e TxReorgedError) Error() string {
+	return fmt.Sprintf("%s tx (ID:%s) was removed from the chain in a reorganisation: %v", e.TxType, e.TxID, e.err)
+}
+
+// Unwrap returns the original error.
+func (e TxReorgedError) Unwrap() error {
+	return e.err
+}
+
+// NewTxReorgedError constructs and returns a TxReorgedError.
+func NewTxReorgedError(txType, txID string, err error) error {
+	return errors.WithStack(TxReorgedError{
+		TxType: txType,
+		TxID:   txID,
+		err:    err,
+	})
+}
@@ -55,3 +55,19 @@ func Test_NewAssetERC20RegisteredError(t *testing.T) {
 	assert.Equal(t, asset, assetERC20RegisteredError.Asset)
 	assert.Equal(t, symbol, assetERC20RegisteredError.Symbol)
 }
+
+func Test_NewTxReorgedError(t *testing.T) {
+	txType := "some-type"
+	txID := "some-id"
+	err := assert.AnError
+
+	gotErr := blockchain.NewTxReorgedError(txType, txID, err)
+	require.Error(t, gotErr)
+
+	txReorgedErr := blockchain.TxReorgedError{}
+	require.True(t, errors.As(gotErr, &txReorgedErr))
+
+	assert.Equal(t, txType, txReorgedErr.TxType)
+	assert.Equal(t, txID, txReorgedErr.TxID)
+	assert.True(t, errors.Is(gotErr, err), "should return the underlying error for comparison")
+}
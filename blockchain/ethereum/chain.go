@@ -28,6 +28,7 @@ import (
 	"github.com/pkg/errors"
 	pethchannel "perun.network/go-perun/backend/ethereum/channel"
 	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
+	phd "perun.network/go-perun/backend/ethereum/wallet/hd"
 	pkeystore "perun.network/go-perun/backend/ethereum/wallet/keystore"
 	pwallet "perun.network/go-perun/wallet"
 
@@ -39,15 +40,27 @@ import (
 // reading on-chain data.
 const roChainBackendTxTimeout = 1 * time.Second
 
-// Use a default value of 1 for tx finality depth as the node is currently
-// used only with simulated backend or ganache-cli.
-const txFinalityDepth = 1
+// DefaultTxFinalityDepth is the tx finality depth used when it is not
+// configured. It is suitable only for the simulated backend or ganache-cli,
+// use larger values for public networks.
+const DefaultTxFinalityDepth = 1
 
 // NewChainBackend initializes a connection to blockchain node and sets up a
 // wallet with given credentials for funding on-chain transactions and channel
 // balances.
 //
-// It uses the provided credentials to initialize a new keystore wallet.
+// It uses the provided credentials to initialize a new keystore wallet. If the
+// wallet in the credentials uses an external signer, the txs are signed by it
+// instead.
+//
+// Transactions are considered final after they are included in
+// txFinalityDepth blocks. If it is zero, DefaultTxFinalityDepth is used. The
+// txs that are removed from the chain in a reorganisation before reaching the
+// finality depth are reported as blockchain.TxReorgedError.
+//
+// The fees of the transactions are set as per the gas config. If fee bump is
+// configured, the transactions that are not mined within the fee bump
+// interval are replaced by the ones with increased fees.
 //
 // The function signature uses only types defined in the root package of this
 // project and types from std lib.  This enables the function to be loaded as
@@ -56,9 +69,14 @@ func NewChainBackend(url string,
 	chainID int,
 	chainConnTimeout,
 	onChainTxTimeout time.Duration,
+	txFinalityDepth uint64,
+	gas perun.GasConfig,
 	cred perun.Credential) (
 	perun.ChainBackend, error,
 ) {
+	if err := ValidateGasConfig(gas, onChainTxTimeout); err != nil {
+		return nil, errors.WithMessage(err, "validating gas config")
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), chainConnTimeout)
 	defer cancel()
 	ethereumBackend, err := ethclient.DialContext(ctx, url)
@@ -66,9 +84,43 @@ func NewChainBackend(url string,
 		return nil, errors.Wrap(err, "connecting to ethereum node at "+url)
 	}
 
+	baseTr, err := newTransactor(cred, big.NewInt(int64(chainID)))
+	if err != nil {
+		ethereumBackend.Close()
+		return nil, err
+	}
+	tr := internal.NewGasTransactor(baseTr, gas)
+	if txFinalityDepth == 0 {
+		txFinalityDepth = DefaultTxFinalityDepth
+	}
+	var ci pethchannel.ContractInterface = ethereumBackend
+	if gas.FeeBumpPercent != 0 {
+		ci = internal.NewFeeBumper(ethereumBackend, tr, gas.FeeBumpInterval, gas.FeeBumpPercent)
+	}
+	// Reorg detector should wrap the fee bumper, as the txs are tracked using
+	// the hash of the originally sent tx.
+	reorgs := internal.NewReorgDetector(ci)
+	cb := pethchannel.NewContractBackend(reorgs, tr, txFinalityDepth)
+	return &internal.ChainBackend{Cb: &cb, TxTimeout: onChainTxTimeout, Reorgs: reorgs, Conn: ethereumBackend}, nil
+}
+
+// newTransactor returns a transactor for signing the txs using the keys in
+// the wallet of the credential.
+//
+// If the wallet uses an external signer, the txs are signed by it. If it is
+// an HD wallet, the txs are signed using the derived keys. Else, the keystore
+// in the credential is unlocked and used for signing the txs.
+func newTransactor(cred perun.Credential, chainID *big.Int) (pethchannel.Transactor, error) {
+	if signer, ok := internal.ExternalSigner(cred.Wallet); ok {
+		return internal.NewExternalTransactor(signer, chainID), nil
+	}
+	if hdWallet, ok := cred.Wallet.(*internal.HDWallet); ok {
+		return phd.NewTransactor(hdWallet.Wallet(), types.LatestSignerForChainID(chainID)), nil
+	}
+
 	ks := keystore.NewKeyStore(cred.Keystore, internal.StandardScryptN, internal.StandardScryptP)
 	acc := accounts.Account{Address: pethwallet.AsEthAddr(cred.Addr)}
-	if err = ks.Unlock(acc, cred.Password); err != nil {
+	if err := ks.Unlock(acc, cred.Password); err != nil {
 		return nil, errors.Wrap(err, "unlocking on-chain keystore for addr - "+cred.Addr.String())
 	}
 
@@ -76,9 +128,17 @@ func NewChainBackend(url string,
 	if err != nil {
 		return nil, err
 	}
-	tr := pkeystore.NewTransactor(*ksWallet, types.LatestSignerForChainID(big.NewInt(int64(chainID))))
-	cb := pethchannel.NewContractBackend(ethereumBackend, tr, txFinalityDepth)
-	return &internal.ChainBackend{Cb: &cb, TxTimeout: onChainTxTimeout}, nil
+	return pkeystore.NewTransactor(*ksWallet, types.LatestSignerForChainID(chainID)), nil
+}
+
+// ValidateGasConfig checks if the gas config is valid for use with the given
+// on-chain tx timeout.
+//
+// The function signature uses only types defined in the root package of this
+// project and types from std lib.  This enables the function to be loaded as
+// symbol without importing this package when it is compiled as plugin.
+func ValidateGasConfig(gas perun.GasConfig, onChainTxTimeout time.Duration) error {
+	return internal.ValidateGasConfig(gas, onChainTxTimeout)
 }
 
 // NewROChainBackend initializes a connection to blockchain node that can be
@@ -97,7 +157,8 @@ func NewROChainBackend(url string, chainConnTimeout time.Duration) (
 		return nil, errors.Wrap(err, "connecting to ethereum node at "+url)
 	}
 
-	cb := pethchannel.NewContractBackend(ethereumBackend, nil, txFinalityDepth)
+	// No txs are sent using this backend, so the finality depth does not matter.
+	cb := pethchannel.NewContractBackend(ethereumBackend, nil, DefaultTxFinalityDepth)
 	return &internal.ChainBackend{Cb: &cb, TxTimeout: roChainBackendTxTimeout}, nil
 }
 
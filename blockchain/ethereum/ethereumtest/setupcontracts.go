@@ -120,7 +120,8 @@ func SetupContracts(chainURL string, chainID int, onChainTxTimeout time.Duration
 	}
 	// If any of the values are nil, deploy contracts, set the package level
 	// variables and return the addresses.
-	chain, err := ethereum.NewChainBackend(chainURL, chainID, ChainConnTimeout, onChainTxTimeout, onChainCred)
+	chain, err := ethereum.NewChainBackend(chainURL, chainID, ChainConnTimeout, onChainTxTimeout, txFinalityDepth,
+		perun.GasConfig{}, onChainCred)
 	if err != nil {
 		return nil, errors.WithMessage(err, "initializaing chain backend")
 	}
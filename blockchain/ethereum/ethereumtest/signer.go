@@ -0,0 +1,52 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ethereumtest
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/extsigner"
+)
+
+// NewExternalSignerT starts a reference external signer that holds the keys
+// in the keystore of the wallet setup and returns the path to its IPC socket.
+// It uses the passed testing.T to handle the errors and registers the cleanup
+// functions on it.
+func NewExternalSignerT(t *testing.T, ws *WalletSetup) string {
+	// Use a short path, as the length of IPC socket paths is limited.
+	dir, err := os.MkdirTemp("", "perun-signer-*")
+	require.NoError(t, err)
+	ipcPath := filepath.Join(dir, "signer.ipc")
+
+	// Password for test accounts is always empty string.
+	signer, err := extsigner.Serve(ws.Keystore, "", big.NewInt(ChainID), ipcPath)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := signer.Close(); err != nil {
+			t.Log("error in cleanup - ", err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Log("error in cleanup - ", err)
+		}
+	})
+	return ipcPath
+}
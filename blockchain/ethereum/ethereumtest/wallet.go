@@ -100,3 +100,16 @@ func NewRandomAddress(rnd *rand.Rand) pwallet.Address {
 	rnd.Read(a[:])
 	return pethwallet.AsWalletAddr(a)
 }
+
+// HDMnemonic is the BIP-39 mnemonic from the test vectors, used for the HD
+// wallets in tests.
+const HDMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// NewHDUserAddrsT returns the addresses of the user accounts (on-chain and
+// off-chain respectively) derived by the HD wallet from the given mnemonic.
+func NewHDUserAddrsT(t *testing.T, mnemonic string) (onChainAddr, offChainAddr pwallet.Address) {
+	w, err := internal.NewHDWallet(mnemonic, "")
+	require.NoError(t, err)
+	accs := w.Wallet().Accounts()
+	return pethwallet.AsWalletAddr(accs[0].Address), pethwallet.AsWalletAddr(accs[1].Address)
+}
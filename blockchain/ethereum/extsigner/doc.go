@@ -0,0 +1,24 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package extsigner implements a reference external signer for the perun
+// node. It holds the keys in a keystore and serves the subset of the account
+// namespace of the clef JSON-RPC API, that is used by the node, over an IPC
+// socket.
+//
+// It is meant for local use and testing. For production, use clef or another
+// signer implementing the same API.
+package extsigner
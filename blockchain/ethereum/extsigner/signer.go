@@ -0,0 +1,137 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extsigner
+
+import (
+	"math/big"
+	"net"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+)
+
+// Version is the version of the signer, as reported to the clients.
+const Version = "1.0.0"
+
+// Signer serves the signing requests for the accounts in a keystore.
+type Signer struct {
+	listener net.Listener
+	server   *rpc.Server
+}
+
+// Serve unlocks all the accounts in the keystore using the password and
+// serves the signing requests for them on the IPC socket at the given path.
+// The transactions are signed only for the given chain ID.
+func Serve(ks *keystore.KeyStore, password string, chainID *big.Int, ipcPath string) (*Signer, error) {
+	for _, acc := range ks.Accounts() {
+		if err := ks.Unlock(acc, password); err != nil {
+			return nil, errors.Wrap(err, "unlocking account "+acc.Address.Hex())
+		}
+	}
+	apis := []rpc.API{{
+		Namespace: "account",
+		Version:   "1.0",
+		Service:   &accountAPI{ks: ks, chainID: chainID},
+		Public:    true,
+	}}
+	listener, server, err := rpc.StartIPCEndpoint(ipcPath, apis)
+	if err != nil {
+		return nil, errors.Wrap(err, "starting ipc endpoint")
+	}
+	return &Signer{listener: listener, server: server}, nil
+}
+
+// Close stops serving the requests and closes the IPC socket.
+func (s *Signer) Close() error {
+	s.server.Stop()
+	return errors.Wrap(s.listener.Close(), "closing ipc endpoint")
+}
+
+// accountAPI implements the account namespace of the clef JSON-RPC API.
+type accountAPI struct {
+	ks      *keystore.KeyStore
+	chainID *big.Int
+}
+
+// signTransactionResult is the result of signing a transaction, in the same
+// format as returned by clef.
+type signTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// Version returns the version of the signer.
+func (api *accountAPI) Version() string {
+	return Version
+}
+
+// List returns the addresses of the accounts held by the signer.
+func (api *accountAPI) List() []common.Address {
+	accs := api.ks.Accounts()
+	addrs := make([]common.Address, len(accs))
+	for i := range accs {
+		addrs[i] = accs[i].Address
+	}
+	return addrs
+}
+
+// SignData signs the data using the account. Only the text/plain content
+// type is supported, for which the data is signed as an ethereum signed
+// message. As in clef, V of the signature is in the 27/28 form.
+func (api *accountAPI) SignData(contentType string, addr common.MixedcaseAddress, data hexutil.Bytes) (
+	hexutil.Bytes, error,
+) {
+	if contentType != accounts.MimetypeTextPlain {
+		return nil, errors.Errorf("unsupported content type %s", contentType)
+	}
+	sig, err := api.ks.SignHash(accounts.Account{Address: addr.Address()}, accounts.TextHash(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "signing data")
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+// SignTransaction signs the transaction using the account in the from field.
+// If the chain ID is specified, it should match the one configured for the
+// signer.
+//
+// The method selector is accepted for compatibility with the clef API, but
+// not used.
+func (api *accountAPI) SignTransaction(args apitypes.SendTxArgs, _ *string) (*signTransactionResult, error) {
+	if args.ChainID != nil && (*big.Int)(args.ChainID).Cmp(api.chainID) != 0 {
+		return nil, errors.Errorf("requested chain ID %v does not match the chain ID %v of the signer",
+			args.ChainID, api.chainID)
+	}
+	args.ChainID = (*hexutil.Big)(api.chainID)
+
+	tx, err := api.ks.SignTx(accounts.Account{Address: args.From.Address()}, args.ToTransaction(), api.chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "signing tx")
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding tx")
+	}
+	return &signTransactionResult{Raw: raw, Tx: tx}, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extsigner_test
+
+import (
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/extsigner"
+)
+
+func Test_Serve(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+
+	t.Run("invalid_password", func(t *testing.T) {
+		ipcPath := filepath.Join(newSocketDir(t), "signer.ipc")
+		_, err := extsigner.Serve(ws.Keystore, "invalid-password", big.NewInt(ethereumtest.ChainID), ipcPath)
+		assert.Error(t, err)
+	})
+}
+
+func Test_Signer(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+	addr := pethwallet.AsEthAddr(ws.Accs[0].Address())
+	mixedcaseAddr := common.NewMixedcaseAddress(addr)
+	client, err := rpc.Dial(ethereumtest.NewExternalSignerT(t, ws))
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	t.Run("happy_version_list", func(t *testing.T) {
+		var version string
+		require.NoError(t, client.Call(&version, "account_version"))
+		assert.Equal(t, extsigner.Version, version)
+
+		var addrs []common.Address
+		require.NoError(t, client.Call(&addrs, "account_list"))
+		assert.Equal(t, []common.Address{addr}, addrs)
+	})
+	t.Run("happy_signData", func(t *testing.T) {
+		msg := []byte("perun-node")
+		var sig hexutil.Bytes
+		require.NoError(t, client.Call(&sig, "account_signData", accounts.MimetypeTextPlain, &mixedcaseAddr,
+			hexutil.Encode(msg)))
+		// Data is signed as text and V is in the 27/28 form.
+		require.Len(t, sig, crypto.SignatureLength)
+		sig[crypto.RecoveryIDOffset] -= 27
+		pubKey, err := crypto.SigToPub(accounts.TextHash(msg), sig)
+		require.NoError(t, err)
+		assert.Equal(t, addr, crypto.PubkeyToAddress(*pubKey))
+	})
+	t.Run("signData_unsupported_content_type", func(t *testing.T) {
+		var sig hexutil.Bytes
+		err := client.Call(&sig, "account_signData", accounts.MimetypeClique, &mixedcaseAddr, "0x00")
+		assert.Error(t, err)
+	})
+	t.Run("happy_signTransaction", func(t *testing.T) {
+		var res struct {
+			Raw hexutil.Bytes      `json:"raw"`
+			Tx  *types.Transaction `json:"tx"`
+		}
+		args := newSendTxArgs(addr, nil)
+		require.NoError(t, client.Call(&res, "account_signTransaction", args))
+
+		signer := types.LatestSignerForChainID(big.NewInt(ethereumtest.ChainID))
+		sender, err := types.Sender(signer, res.Tx)
+		require.NoError(t, err)
+		assert.Equal(t, addr, sender)
+		assert.Equal(t, uint64(args.Nonce), res.Tx.Nonce())
+	})
+	t.Run("signTransaction_chainID_mismatch", func(t *testing.T) {
+		var res interface{}
+		args := newSendTxArgs(addr, big.NewInt(ethereumtest.ChainID+1))
+		assert.Error(t, client.Call(&res, "account_signTransaction", args))
+	})
+	t.Run("signTransaction_account_not_present", func(t *testing.T) {
+		var res interface{}
+		args := newSendTxArgs(pethwallet.AsEthAddr(ethereumtest.NewRandomAddress(rng)), nil)
+		assert.Error(t, client.Call(&res, "account_signTransaction", args))
+	})
+}
+
+func newSendTxArgs(from common.Address, chainID *big.Int) *apitypes.SendTxArgs {
+	to := common.NewMixedcaseAddress(common.HexToAddress("0x1234"))
+	return &apitypes.SendTxArgs{
+		From:     common.NewMixedcaseAddress(from),
+		To:       &to,
+		Gas:      21000,
+		GasPrice: (*hexutil.Big)(big.NewInt(1)),
+		Value:    hexutil.Big(*big.NewInt(1)),
+		Nonce:    3,
+		ChainID:  (*hexutil.Big)(chainID),
+	}
+}
+
+func newSocketDir(t *testing.T) string {
+	t.Helper()
+	// Use a short path, as the length of IPC socket paths is limited.
+	dir, err := os.MkdirTemp("", "perun-signer-*")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) }) //nolint:errcheck
+	return dir
+}
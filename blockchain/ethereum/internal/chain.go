@@ -40,6 +40,15 @@ import (
 // See perun.Funder for more info.
 type Funder struct {
 	*pethchannel.Funder
+	reorgs *ReorgDetector
+}
+
+// Fund wraps the Fund on the actual ETH funder to report the funding txs that
+// were removed from the chain in a reorganisation.
+func (f *Funder) Fund(ctx context.Context, req pchannel.FundingReq) error {
+	return f.reorgs.watch(ctx, pethchannel.Fund.String(), func(ctx context.Context) error {
+		return f.Funder.Fund(ctx, req)
+	})
 }
 
 // RegisterAssetERC20 wraps the RegisterAssetERC20 on the actual ETH funder
@@ -75,6 +84,20 @@ type ChainBackend struct {
 	// If this expires, a transactions is considered failed.
 	// Use sufficiently large values when connecting to mainnet.
 	TxTimeout time.Duration
+	// Reorgs is the reorg detector wrapping the contract interface of Cb. If
+	// nil, the txs removed from the chain are reported as timed out.
+	Reorgs *ReorgDetector
+	// Conn is the connection to the blockchain node used by Cb. If not nil,
+	// it is closed when the chain backend is closed.
+	Conn interface{ Close() }
+}
+
+// Close closes the connection to the blockchain node.
+func (cb *ChainBackend) Close() error {
+	if cb.Conn != nil {
+		cb.Conn.Close()
+	}
+	return nil
 }
 
 // NewFunder initializes and returns an instance of ethereum funder.
@@ -84,14 +107,45 @@ func (cb *ChainBackend) NewFunder(assetETHAddr pwallet.Address, txSender pwallet
 	funder := pethchannel.NewFunder(*cb.Cb)
 	// Registering unique assets on a newly initialized funder will always return true.
 	funder.RegisterAsset(*assetETH, pethchannel.NewETHDepositor(), txSenderAcc)
-	return &Funder{funder}
+	return &Funder{Funder: funder, reorgs: cb.Reorgs}
 }
 
 // NewAdjudicator initializes and returns an instance of ethereum adjudicator.
 func (cb *ChainBackend) NewAdjudicator(adjAddr, txSender pwallet.Address) pchannel.Adjudicator {
 	txSenderAcc := accounts.Account{Address: pethwallet.AsEthAddr(txSender)}
-	return pethchannel.NewAdjudicator(*cb.Cb, pethwallet.AsEthAddr(adjAddr),
+	adjudicator := pethchannel.NewAdjudicator(*cb.Cb, pethwallet.AsEthAddr(adjAddr),
 		pethwallet.AsEthAddr(txSender), txSenderAcc)
+	return &Adjudicator{Adjudicator: adjudicator, reorgs: cb.Reorgs}
+}
+
+// Adjudicator implements a wrapper around ETH Adjudicator to report the txs
+// that were removed from the chain in a reorganisation.
+type Adjudicator struct {
+	*pethchannel.Adjudicator
+	reorgs *ReorgDetector
+}
+
+// Register wraps the Register on the actual ETH adjudicator.
+func (a *Adjudicator) Register(ctx context.Context, req pchannel.AdjudicatorReq,
+	subChannels []pchannel.SignedState,
+) error {
+	return a.reorgs.watch(ctx, pethchannel.Register.String(), func(ctx context.Context) error {
+		return a.Adjudicator.Register(ctx, req, subChannels)
+	})
+}
+
+// Withdraw wraps the Withdraw on the actual ETH adjudicator.
+func (a *Adjudicator) Withdraw(ctx context.Context, req pchannel.AdjudicatorReq, subStates pchannel.StateMap) error {
+	return a.reorgs.watch(ctx, pethchannel.Withdraw.String(), func(ctx context.Context) error {
+		return a.Adjudicator.Withdraw(ctx, req, subStates)
+	})
+}
+
+// Progress wraps the Progress on the actual ETH adjudicator.
+func (a *Adjudicator) Progress(ctx context.Context, req pchannel.ProgressReq) error {
+	return a.reorgs.watch(ctx, pethchannel.Progress.String(), func(ctx context.Context) error {
+		return a.Adjudicator.Progress(ctx, req)
+	})
 }
 
 // ERC20Info reads the symbol and number of decimal values decimals for the
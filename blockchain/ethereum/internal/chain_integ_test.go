@@ -118,7 +118,8 @@ func deployAssetETH(t *testing.T, adjudicator pwallet.Address) pwallet.Address {
 		Password: "",
 	}
 	chainBackend, err := ethereum.NewChainBackend(ethereumtest.ChainURL, ethereumtest.ChainID,
-		ethereumtest.ChainConnTimeout, ethereumtest.OnChainTxTimeout, onChainCred)
+		ethereumtest.ChainConnTimeout, ethereumtest.OnChainTxTimeout, ethereum.DefaultTxFinalityDepth,
+		perun.GasConfig{}, onChainCred)
 	require.NoError(t, err)
 	assetETH, err := chainBackend.DeployAssetETH(adjudicator, ws.Accs[0].Address())
 	require.NoError(t, err)
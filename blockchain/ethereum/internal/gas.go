@@ -0,0 +1,289 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// MinFeeBumpPercent is the minimum percentage by which the fees of a pending
+// transaction should be increased for the blockchain nodes to accept its
+// replacement.
+const MinFeeBumpPercent = 10
+
+// ValidateGasConfig checks if the gas config is valid for use with the given
+// on-chain tx timeout.
+func ValidateGasConfig(cfg perun.GasConfig, onChainTxTimeout time.Duration) error {
+	if cfg.GasPrice != 0 && (cfg.MaxFeePerGas != 0 || cfg.MaxPriorityFeePerGas != 0) {
+		return errors.New("gas price cannot be used along with max fee per gas or max priority fee per gas")
+	}
+	if cfg.MaxFeePerGas != 0 && cfg.MaxPriorityFeePerGas > cfg.MaxFeePerGas {
+		return errors.New("max priority fee per gas cannot be more than max fee per gas")
+	}
+	if cfg.FeeBumpPercent != 0 && cfg.FeeBumpPercent < MinFeeBumpPercent {
+		return errors.Errorf("fee bump percent should be at least %d", MinFeeBumpPercent)
+	}
+	if cfg.FeeBumpPercent != 0 && (cfg.FeeBumpInterval <= 0 || cfg.FeeBumpInterval >= onChainTxTimeout) {
+		return errors.Errorf("fee bump interval should be positive and shorter than the on-chain tx timeout %v",
+			onChainTxTimeout)
+	}
+	return nil
+}
+
+// GasTransactor wraps a transactor to set the fees on the transactions as per
+// the gas config and to enforce the cap on the total fee of a transaction.
+type GasTransactor struct {
+	pethchannel.Transactor
+	cfg perun.GasConfig
+}
+
+// NewGasTransactor returns a gas transactor that wraps the given transactor.
+// The gas config should be validated using ValidateGasConfig.
+func NewGasTransactor(tr pethchannel.Transactor, cfg perun.GasConfig) *GasTransactor {
+	return &GasTransactor{Transactor: tr, cfg: cfg}
+}
+
+// NewTransactor returns the transact opts for the given account, with the
+// fees set as per the gas config.
+//
+// The signer in the transact opts refuses to sign the transactions, whose
+// total fee exceeds the cap.
+func (t *GasTransactor) NewTransactor(acc accounts.Account) (*bind.TransactOpts, error) {
+	opts, err := t.Transactor.NewTransactor(acc)
+	if err != nil {
+		return nil, err
+	}
+	if t.cfg.GasPrice != 0 {
+		opts.GasPrice = new(big.Int).SetUint64(t.cfg.GasPrice)
+	}
+	if t.cfg.MaxFeePerGas != 0 {
+		opts.GasFeeCap = new(big.Int).SetUint64(t.cfg.MaxFeePerGas)
+	}
+	if t.cfg.MaxPriorityFeePerGas != 0 {
+		opts.GasTipCap = new(big.Int).SetUint64(t.cfg.MaxPriorityFeePerGas)
+	}
+	if t.cfg.MaxTxFee == 0 {
+		return opts, nil
+	}
+
+	signer := opts.Signer
+	maxTxFee := new(big.Int).SetUint64(t.cfg.MaxTxFee)
+	opts.Signer = func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		// For legacy txs, gas fee cap is the gas price.
+		txFee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap())
+		if txFee.Cmp(maxTxFee) > 0 {
+			return nil, errors.Errorf("tx fee %v exceeds the max tx fee %v", txFee, maxTxFee)
+		}
+		return signer(addr, tx)
+	}
+	return opts, nil
+}
+
+// FeeBumper wraps a contract interface to replace the transactions that are
+// not mined within the bump interval, by the ones with increased fees.
+//
+// While waiting for a transaction to be mined, the contract backend polls for
+// its receipt using the hash of the transaction that was originally sent. So,
+// the receipts are looked up for all the transactions sent for replacing it.
+// Once a receipt is returned for any of them, the transaction is no longer
+// tracked, as the contract backend stops polling after receiving it. It is
+// also no longer tracked, once the context used for sending it is done, as
+// the same context is used for waiting for it to be mined.
+//
+// The methods on it are safe for concurrent use.
+type FeeBumper struct {
+	pethchannel.ContractInterface
+	tr           pethchannel.Transactor
+	bumpInterval time.Duration
+	bumpPercent  uint64
+
+	mtx     sync.Mutex
+	pending map[common.Hash]*pendingTx // Indexed by the hash of the original tx.
+}
+
+// pendingTx represents a transaction and the ones sent for replacing it.
+type pendingTx struct {
+	from   common.Address
+	txs    []*types.Transaction // In the order they were sent.
+	sentAt time.Time            // Time at which the last tx was sent or its fees were last bumped.
+	done   chan struct{}        // Closed when the tx is no longer tracked.
+}
+
+// NewFeeBumper returns a fee bumper that wraps the given contract interface.
+// The replacement transactions are signed using the given transactor.
+func NewFeeBumper(ci pethchannel.ContractInterface, tr pethchannel.Transactor, bumpInterval time.Duration,
+	bumpPercent uint64,
+) *FeeBumper {
+	return &FeeBumper{
+		ContractInterface: ci,
+		tr:                tr,
+		bumpInterval:      bumpInterval,
+		bumpPercent:       bumpPercent,
+		pending:           make(map[common.Hash]*pendingTx),
+	}
+}
+
+// SendTransaction sends the transaction using the wrapped contract interface
+// and tracks it for bumping the fees, until the context is done.
+func (b *FeeBumper) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.ContractInterface.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil // Tx was sent, but it cannot be replaced without knowing the sender.
+	}
+
+	p := &pendingTx{from: from, txs: []*types.Transaction{tx}, sentAt: time.Now(), done: make(chan struct{})}
+	b.mtx.Lock()
+	b.pending[tx.Hash()] = p
+	b.mtx.Unlock()
+
+	// Context that is never done cannot time out, so the tx is tracked until it is mined.
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				b.untrack(tx.Hash(), p)
+			case <-p.done:
+			}
+		}()
+	}
+	return nil
+}
+
+// untrack stops tracking the pending tx, if it is still tracked.
+func (b *FeeBumper) untrack(txHash common.Hash, p *pendingTx) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.pending[txHash] == p {
+		delete(b.pending, txHash)
+		close(p.done)
+	}
+}
+
+// TransactionReceipt returns the receipt of the transaction or of any of the
+// transactions that were sent for replacing it. Once a receipt is returned,
+// the transaction is no longer tracked.
+//
+// If none of them is mined and the bump interval has passed, the transaction
+// is replaced by the one with increased fees.
+func (b *FeeBumper) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	b.mtx.Lock()
+	p, ok := b.pending[txHash]
+	var txs []*types.Transaction
+	if ok {
+		txs = append(txs, p.txs...)
+	}
+	b.mtx.Unlock()
+
+	if !ok {
+		return b.ContractInterface.TransactionReceipt(ctx, txHash)
+	}
+	for i := len(txs) - 1; i >= 0; i-- {
+		receipt, err := b.ContractInterface.TransactionReceipt(ctx, txs[i].Hash())
+		if err == nil {
+			b.untrack(txHash, p)
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return receipt, err
+		}
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	// Do not bump, if a replacement was sent or the tx was untracked in the meanwhile.
+	if b.pending[txHash] == p && len(p.txs) == len(txs) && time.Since(p.sentAt) >= b.bumpInterval {
+		// Retry only after the interval, even if the bump fails.
+		p.sentAt = time.Now()
+		if err := b.bump(ctx, p); err != nil {
+			return nil, errors.WithMessage(err, "bumping fees of pending tx")
+		}
+	}
+	return nil, ethereum.NotFound
+}
+
+// bump sends a transaction with increased fees for replacing the pending
+// transaction.
+func (b *FeeBumper) bump(ctx context.Context, p *pendingTx) error {
+	tx, err := bumpedTx(p.txs[len(p.txs)-1], b.bumpPercent)
+	if err != nil {
+		return err
+	}
+	opts, err := b.tr.NewTransactor(accounts.Account{Address: p.from})
+	if err != nil {
+		return errors.WithMessage(err, "creating transactor")
+	}
+	if tx, err = opts.Signer(p.from, tx); err != nil {
+		return errors.WithMessage(err, "signing tx")
+	}
+	if err = b.ContractInterface.SendTransaction(ctx, tx); err != nil {
+		return errors.WithMessage(err, "sending tx")
+	}
+	p.txs = append(p.txs, tx)
+	return nil
+}
+
+// bumpedTx returns an unsigned copy of the transaction with the fees
+// increased by the given percentage.
+func bumpedTx(tx *types.Transaction, percent uint64) (*types.Transaction, error) {
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: bumpedFee(tx.GasPrice(), percent),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  bumpedFee(tx.GasTipCap(), percent),
+			GasFeeCap:  bumpedFee(tx.GasFeeCap(), percent),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}), nil
+	default:
+		return nil, errors.Errorf("unsupported tx type %d", tx.Type())
+	}
+}
+
+// bumpedFee returns the fee increased by the given percentage, rounded up.
+func bumpedFee(fee *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+	pethchanneltest "perun.network/go-perun/backend/ethereum/channel/test"
+	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
+	pkeystore "perun.network/go-perun/backend/ethereum/wallet/keystore"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/internal"
+)
+
+func Test_ValidateGasConfig(t *testing.T) {
+	txTimeout := time.Minute
+	t.Run("happy", func(t *testing.T) {
+		assert.NoError(t, internal.ValidateGasConfig(perun.GasConfig{}, txTimeout))
+		assert.NoError(t, internal.ValidateGasConfig(perun.GasConfig{
+			GasPrice: 10, MaxTxFee: 100, FeeBumpPercent: 10, FeeBumpInterval: txTimeout / 2,
+		}, txTimeout))
+		assert.NoError(t, internal.ValidateGasConfig(perun.GasConfig{MaxFeePerGas: 10, MaxPriorityFeePerGas: 10}, txTimeout))
+		assert.NoError(t, internal.ValidateGasConfig(perun.GasConfig{MaxPriorityFeePerGas: 10}, txTimeout))
+	})
+	t.Run("legacy_and_eip1559", func(t *testing.T) {
+		assert.Error(t, internal.ValidateGasConfig(perun.GasConfig{GasPrice: 10, MaxFeePerGas: 10}, txTimeout))
+		assert.Error(t, internal.ValidateGasConfig(perun.GasConfig{GasPrice: 10, MaxPriorityFeePerGas: 10}, txTimeout))
+	})
+	t.Run("tip_more_than_max_fee", func(t *testing.T) {
+		assert.Error(t, internal.ValidateGasConfig(perun.GasConfig{MaxFeePerGas: 10, MaxPriorityFeePerGas: 11}, txTimeout))
+	})
+	t.Run("fee_bump_percent_too_low", func(t *testing.T) {
+		assert.Error(t, internal.ValidateGasConfig(perun.GasConfig{
+			FeeBumpPercent: internal.MinFeeBumpPercent - 1, FeeBumpInterval: txTimeout / 2,
+		}, txTimeout))
+	})
+	t.Run("fee_bump_interval_missing", func(t *testing.T) {
+		assert.Error(t, internal.ValidateGasConfig(perun.GasConfig{FeeBumpPercent: 10}, txTimeout))
+	})
+	t.Run("fee_bump_interval_not_shorter_than_tx_timeout", func(t *testing.T) {
+		assert.Error(t, internal.ValidateGasConfig(perun.GasConfig{
+			FeeBumpPercent: 10, FeeBumpInterval: txTimeout,
+		}, txTimeout))
+	})
+}
+
+func Test_GasTransactor(t *testing.T) {
+	t.Run("happy_legacy", func(t *testing.T) {
+		cfg := perun.GasConfig{GasPrice: 2 * pethchanneltest.InitialGasBaseFee}
+		cb, sb, acc := newSimChainBackendWGas(t, cfg)
+
+		_, err := cb.DeployAdjudicator(acc)
+		require.NoError(t, err)
+
+		tx := lastTx(t, sb)
+		assert.Equal(t, uint8(types.LegacyTxType), tx.Type())
+		assert.Equal(t, new(big.Int).SetUint64(cfg.GasPrice), tx.GasPrice())
+	})
+	t.Run("happy_eip1559", func(t *testing.T) {
+		cfg := perun.GasConfig{MaxFeePerGas: 2 * pethchanneltest.InitialGasBaseFee, MaxPriorityFeePerGas: 1000}
+		cb, sb, acc := newSimChainBackendWGas(t, cfg)
+
+		_, err := cb.DeployAdjudicator(acc)
+		require.NoError(t, err)
+
+		tx := lastTx(t, sb)
+		assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+		assert.Equal(t, new(big.Int).SetUint64(cfg.MaxFeePerGas), tx.GasFeeCap())
+		assert.Equal(t, new(big.Int).SetUint64(cfg.MaxPriorityFeePerGas), tx.GasTipCap())
+	})
+	t.Run("max_tx_fee_exceeded", func(t *testing.T) {
+		cfg := perun.GasConfig{GasPrice: 2 * pethchanneltest.InitialGasBaseFee, MaxTxFee: 1}
+		cb, _, acc := newSimChainBackendWGas(t, cfg)
+
+		_, err := cb.DeployAdjudicator(acc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeds the max tx fee")
+	})
+}
+
+// newSimChainBackendWGas returns a chain backend connected to a simulated
+// backend, that sets the fees on the transactions as per the given gas
+// config. It also returns the simulated backend and a funded account.
+func newSimChainBackendWGas(t *testing.T, cfg perun.GasConfig) (
+	*internal.ChainBackend, *pethchanneltest.SimulatedBackend, pwallet.Address,
+) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+	return newSimChainBackendWTransactor(t, ws, internal.NewGasTransactor(newKeystoreTransactor(t, ws), cfg))
+}
+
+// newSimChainBackendWTransactor returns a chain backend connected to a
+// simulated backend, that uses the given transactor. It also returns the
+// simulated backend and the first account in the wallet setup, that is funded.
+func newSimChainBackendWTransactor(t *testing.T, ws *ethereumtest.WalletSetup, tr pethchannel.Transactor) (
+	*internal.ChainBackend, *pethchanneltest.SimulatedBackend, pwallet.Address,
+) {
+	t.Helper()
+	acc := ws.Accs[0].Address()
+	sb := pethchanneltest.NewSimulatedBackend()
+	ctx, cancel := context.WithTimeout(context.Background(), ethereumtest.OnChainTxTimeout)
+	defer cancel()
+	sb.FundAddress(ctx, pethwallet.AsEthAddr(acc))
+
+	cb := pethchannel.NewContractBackend(sb, tr, 1)
+	return &internal.ChainBackend{Cb: &cb, TxTimeout: ethereumtest.OnChainTxTimeout}, sb, acc
+}
+
+func newKeystoreTransactor(t *testing.T, ws *ethereumtest.WalletSetup) pethchannel.Transactor {
+	t.Helper()
+	ksWallet, err := pkeystore.NewWallet(ws.Keystore, "") // Password for test accounts is always empty string.
+	require.NoError(t, err)
+	return pkeystore.NewTransactor(*ksWallet, types.LatestSignerForChainID(big.NewInt(ethereumtest.ChainID)))
+}
+
+func lastTx(t *testing.T, sb *pethchanneltest.SimulatedBackend) *types.Transaction {
+	t.Helper()
+	block, err := sb.BlockByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, block.Transactions(), 1)
+	return block.Transactions()[0]
+}
+
+// pendingCI is a contract interface that records the transactions sent on it
+// and returns a receipt only for the transactions marked as mined.
+type pendingCI struct {
+	pethchannel.ContractInterface
+	sent  []*types.Transaction
+	mined map[common.Hash]bool
+}
+
+func (ci *pendingCI) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	ci.sent = append(ci.sent, tx)
+	return nil
+}
+
+func (ci *pendingCI) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if ci.mined[txHash] {
+		return &types.Receipt{TxHash: txHash}, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func Test_FeeBumper(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+	from := pethwallet.AsEthAddr(ws.Accs[0].Address())
+	to := common.HexToAddress("0x1234")
+	chainID := big.NewInt(ethereumtest.ChainID)
+	bumpPercent := uint64(20)
+
+	newBumper := func(t *testing.T, cfg perun.GasConfig, bumpInterval time.Duration) (*internal.FeeBumper, *pendingCI) {
+		t.Helper()
+		ci := &pendingCI{mined: make(map[common.Hash]bool)}
+		tr := internal.NewGasTransactor(newKeystoreTransactor(t, ws), cfg)
+		return internal.NewFeeBumper(ci, tr, bumpInterval, bumpPercent), ci
+	}
+	sendTxWCtx := func(t *testing.T, ctx context.Context, b *internal.FeeBumper, tx *types.Transaction,
+	) *types.Transaction {
+		t.Helper()
+		opts, err := newKeystoreTransactor(t, ws).NewTransactor(accounts.Account{Address: from})
+		require.NoError(t, err)
+		signedTx, err := opts.Signer(from, tx)
+		require.NoError(t, err)
+		require.NoError(t, b.SendTransaction(ctx, signedTx))
+		return signedTx
+	}
+	sendTx := func(t *testing.T, b *internal.FeeBumper, tx *types.Transaction) *types.Transaction {
+		t.Helper()
+		return sendTxWCtx(t, context.Background(), b, tx)
+	}
+	legacyTx := types.NewTx(&types.LegacyTx{Nonce: 5, GasPrice: big.NewInt(100), Gas: 21000, To: &to})
+	dynamicTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID: chainID, Nonce: 5, GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(100), Gas: 21000, To: &to,
+	})
+
+	t.Run("happy_legacy", func(t *testing.T) {
+		b, ci := newBumper(t, perun.GasConfig{}, 0)
+		tx := sendTx(t, b, legacyTx)
+
+		_, err := b.TransactionReceipt(context.Background(), tx.Hash())
+		require.ErrorIs(t, err, ethereum.NotFound)
+		require.Len(t, ci.sent, 2)
+		assert.Equal(t, tx.Nonce(), ci.sent[1].Nonce())
+		assert.Equal(t, big.NewInt(120), ci.sent[1].GasPrice())
+
+		ci.mined[ci.sent[1].Hash()] = true
+		receipt, err := b.TransactionReceipt(context.Background(), tx.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, ci.sent[1].Hash(), receipt.TxHash)
+
+		// Tx is no longer tracked, so only the original tx is looked up and it is not bumped again.
+		_, err = b.TransactionReceipt(context.Background(), tx.Hash())
+		require.ErrorIs(t, err, ethereum.NotFound)
+		assert.Len(t, ci.sent, 2)
+	})
+	t.Run("happy_eip1559", func(t *testing.T) {
+		b, ci := newBumper(t, perun.GasConfig{}, 0)
+		tx := sendTx(t, b, dynamicTx)
+
+		_, err := b.TransactionReceipt(context.Background(), tx.Hash())
+		require.ErrorIs(t, err, ethereum.NotFound)
+		require.Len(t, ci.sent, 2)
+		assert.Equal(t, uint8(types.DynamicFeeTxType), ci.sent[1].Type())
+		assert.Equal(t, tx.Nonce(), ci.sent[1].Nonce())
+		assert.Equal(t, big.NewInt(12), ci.sent[1].GasTipCap())
+		assert.Equal(t, big.NewInt(120), ci.sent[1].GasFeeCap())
+	})
+	t.Run("happy_original_mined_after_bump", func(t *testing.T) {
+		b, ci := newBumper(t, perun.GasConfig{}, 0)
+		tx := sendTx(t, b, legacyTx)
+		_, err := b.TransactionReceipt(context.Background(), tx.Hash())
+		require.ErrorIs(t, err, ethereum.NotFound)
+		require.Len(t, ci.sent, 2)
+
+		ci.mined[tx.Hash()] = true
+		receipt, err := b.TransactionReceipt(context.Background(), tx.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, tx.Hash(), receipt.TxHash)
+	})
+	t.Run("happy_bump_interval_not_passed", func(t *testing.T) {
+		b, ci := newBumper(t, perun.GasConfig{}, time.Minute)
+		tx := sendTx(t, b, legacyTx)
+
+		_, err := b.TransactionReceipt(context.Background(), tx.Hash())
+		require.ErrorIs(t, err, ethereum.NotFound)
+		assert.Len(t, ci.sent, 1)
+	})
+	t.Run("happy_untracked_when_ctx_done", func(t *testing.T) {
+		b, ci := newBumper(t, perun.GasConfig{}, 0)
+		ctx, cancel := context.WithCancel(context.Background())
+		tx := sendTxWCtx(t, ctx, b, legacyTx)
+		cancel()
+
+		// Once the tx is untracked, it is no longer bumped.
+		notBumped := func() bool {
+			sent := len(ci.sent)
+			_, err := b.TransactionReceipt(context.Background(), tx.Hash())
+			return errors.Is(err, ethereum.NotFound) && len(ci.sent) == sent
+		}
+		require.Eventually(t, notBumped, time.Second, 10*time.Millisecond)
+		assert.True(t, notBumped())
+	})
+	t.Run("max_tx_fee_exceeded", func(t *testing.T) {
+		// Max tx fee is the fee of the original tx.
+		b, ci := newBumper(t, perun.GasConfig{MaxTxFee: 100 * 21000}, 0)
+		tx := sendTx(t, b, legacyTx)
+
+		_, err := b.TransactionReceipt(context.Background(), tx.Hash())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeds the max tx fee")
+		assert.Len(t, ci.sent, 1)
+	})
+}
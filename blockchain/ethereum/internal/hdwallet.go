@@ -0,0 +1,364 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
+	phd "perun.network/go-perun/backend/ethereum/wallet/hd"
+	pwallet "perun.network/go-perun/wallet"
+)
+
+// BIP-44 derivation paths used by the HD wallet.
+//
+// The user accounts (for on-chain transactions and off-chain communication)
+// are derived along the default ethereum path. By convention, the first one
+// is used as the on-chain and the second one as the off-chain account. The
+// participant accounts for the channels are derived along the path of the
+// next BIP-44 account, so that they do not overlap with the user accounts.
+const (
+	HDUserRootPath = "m/44'/60'/0'/0/"
+	HDPartRootPath = "m/44'/60'/1'/0/"
+
+	// HDNumUserAccs is the number of user accounts derived by the HD wallet.
+	HDNumUserAccs = 2
+)
+
+const (
+	// bip32MasterKey is the key for deriving the master key from the seed,
+	// as defined in BIP-32.
+	bip32MasterKey = "Bitcoin seed"
+
+	// bip32HardenedKeyStart is the index of the first hardened child key.
+	bip32HardenedKeyStart = 0x80000000
+)
+
+// HDWalletBackend provides ethereum specific wallet backend functionality,
+// where the keys are derived from a BIP-39 mnemonic using BIP-44 paths,
+// instead of being stored in a keystore.
+type HDWalletBackend struct {
+	WalletBackend
+}
+
+// NewWallet derives the keys from the given mnemonic and passphrase and
+// returns a wallet holding the user accounts. See NewHDWallet.
+func (wb *HDWalletBackend) NewWallet(mnemonic, passphrase string) (pwallet.Wallet, error) {
+	w, err := NewHDWallet(mnemonic, passphrase)
+	if err != nil {
+		return nil, errors.WithMessage(err, "initializing new wallet")
+	}
+	return w, nil
+}
+
+// HDWallet is a perun wallet, where the keys are derived from a mnemonic.
+//
+// It holds the user accounts derived along HDUserRootPath and the participant
+// accounts derived along HDPartRootPath. A fresh participant account can be
+// derived for each channel using NewPartAccount.
+type HDWallet struct {
+	keys *mnemonicWallet
+
+	mutex       sync.Mutex
+	numPartAccs uint
+}
+
+// NewHDWallet initializes an HD wallet using the seed derived from the given
+// BIP-39 mnemonic and passphrase, and derives the user accounts. The mnemonic
+// should use the english wordlist.
+func NewHDWallet(mnemonic, passphrase string) (*HDWallet, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "deriving seed from mnemonic")
+	}
+	master, err := newMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	w := &HDWallet{keys: &mnemonicWallet{master: master, keys: make(map[common.Address]*ecdsa.PrivateKey)}}
+	for i := 0; i < HDNumUserAccs; i++ {
+		if _, err = w.keys.derive(fmt.Sprintf("%s%d", HDUserRootPath, i)); err != nil {
+			return nil, errors.WithMessage(err, "deriving user account")
+		}
+	}
+	return w, nil
+}
+
+// Unlock returns the account corresponding to the given address, if it has
+// been derived by the wallet.
+func (w *HDWallet) Unlock(addr pwallet.Address) (pwallet.Account, error) {
+	acc := accounts.Account{Address: pethwallet.AsEthAddr(addr)}
+	if !w.keys.Contains(acc) {
+		return nil, errors.New("account not found in wallet")
+	}
+	return phd.NewAccountFromEth(w.keys, acc), nil
+}
+
+// LockAll is a noop, as the keys are derived and held in memory.
+func (w *HDWallet) LockAll() {}
+
+// IncrementUsage is a noop, as the keys are derived and held in memory.
+func (w *HDWallet) IncrementUsage(pwallet.Address) {}
+
+// DecrementUsage is a noop, as the keys are derived and held in memory.
+func (w *HDWallet) DecrementUsage(pwallet.Address) {}
+
+// NewPartAccount derives the next participant account and returns it.
+func (w *HDWallet) NewPartAccount() (pwallet.Account, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	acc, err := w.keys.derive(fmt.Sprintf("%s%d", HDPartRootPath, w.numPartAccs))
+	if err != nil {
+		return nil, errors.WithMessage(err, "deriving participant account")
+	}
+	w.numPartAccs++
+	return phd.NewAccountFromEth(w.keys, acc), nil
+}
+
+// NumPartAccounts returns the number of participant accounts derived so far.
+func (w *HDWallet) NumPartAccounts() uint {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.numPartAccs
+}
+
+// DerivePartAccounts derives the participant accounts until there are at least
+// n of them. It is used for deriving the accounts used in the channels again,
+// when the wallet is re-initialized.
+func (w *HDWallet) DerivePartAccounts(n uint) error {
+	for w.NumPartAccounts() < n {
+		if _, err := w.NewPartAccount(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Wallet returns the underlying ethereum wallet, that can be used for
+// initializing a transactor.
+func (w *HDWallet) Wallet() accounts.Wallet {
+	return w.keys
+}
+
+// extendedKey is a private key along with its chain code, as defined in BIP-32.
+type extendedKey struct {
+	key       *ecdsa.PrivateKey
+	chainCode []byte
+}
+
+// newMasterKey derives the master key from the seed as defined in BIP-32.
+func newMasterKey(seed []byte) (*extendedKey, error) {
+	sum := hmacSHA512([]byte(bip32MasterKey), seed)
+	key, err := crypto.ToECDSA(sum[:32])
+	if err != nil {
+		return nil, errors.Wrap(err, "deriving master key")
+	}
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
+
+// child derives the child key at the given index as defined in BIP-32.
+// Indices starting from 2^31 are used for hardened keys.
+func (k *extendedKey) child(idx uint32) (*extendedKey, error) {
+	var data []byte
+	if idx >= bip32HardenedKeyStart {
+		data = append([]byte{0x00}, crypto.FromECDSA(k.key)...)
+	} else {
+		data = crypto.CompressPubkey(&k.key.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, idx)
+	sum := hmacSHA512(k.chainCode, data)
+
+	curveOrder := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(curveOrder) >= 0 {
+		return nil, errors.Errorf("invalid child key at index %d", idx)
+	}
+	childKey := il.Add(il, k.key.D)
+	childKey.Mod(childKey, curveOrder)
+	key, err := crypto.ToECDSA(math.PaddedBigBytes(childKey, 32))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid child key at index %d", idx)
+	}
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data) //nolint:errcheck,gosec // Write on hash never returns an error.
+	return mac.Sum(nil)
+}
+
+// mnemonicWallet implements the ethereum accounts.Wallet interface, for the
+// keys derived from the master key. Only the pinned accounts are held by it.
+type mnemonicWallet struct {
+	master *extendedKey
+
+	mutex sync.RWMutex
+	accs  []accounts.Account
+	keys  map[common.Address]*ecdsa.PrivateKey
+}
+
+// derive derives the account at the given path and pins it.
+func (w *mnemonicWallet) derive(path string) (accounts.Account, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return accounts.Account{}, errors.Wrap(err, "parsing derivation path")
+	}
+	return w.Derive(derivationPath, true)
+}
+
+// URL implements accounts.Wallet. The wallet has no URL, only the scheme is set.
+func (w *mnemonicWallet) URL() accounts.URL {
+	return accounts.URL{Scheme: "mnemonic"}
+}
+
+// Status implements accounts.Wallet. The keys are always unlocked.
+func (w *mnemonicWallet) Status() (string, error) {
+	return "Unlocked", nil
+}
+
+// Open implements accounts.Wallet. It is a noop.
+func (w *mnemonicWallet) Open(string) error {
+	return nil
+}
+
+// Close implements accounts.Wallet. It is a noop.
+func (w *mnemonicWallet) Close() error {
+	return nil
+}
+
+// Accounts implements accounts.Wallet. It returns the pinned accounts.
+func (w *mnemonicWallet) Accounts() []accounts.Account {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	return append([]accounts.Account{}, w.accs...)
+}
+
+// Contains implements accounts.Wallet. Only the address of the account is
+// compared.
+func (w *mnemonicWallet) Contains(acc accounts.Account) bool {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	_, ok := w.keys[acc.Address]
+	return ok
+}
+
+// Derive implements accounts.Wallet. It derives the account at the given
+// path from the master key and pins it, if requested.
+func (w *mnemonicWallet) Derive(path accounts.DerivationPath, pin bool) (accounts.Account, error) {
+	var err error
+	k := w.master
+	for _, idx := range path {
+		if k, err = k.child(idx); err != nil {
+			return accounts.Account{}, err
+		}
+	}
+	acc := accounts.Account{
+		Address: crypto.PubkeyToAddress(k.key.PublicKey),
+		URL:     accounts.URL{Scheme: w.URL().Scheme, Path: path.String()},
+	}
+	if pin {
+		w.mutex.Lock()
+		if _, ok := w.keys[acc.Address]; !ok {
+			w.accs = append(w.accs, acc)
+			w.keys[acc.Address] = k.key
+		}
+		w.mutex.Unlock()
+	}
+	return acc, nil
+}
+
+// SelfDerive implements accounts.Wallet. It is a noop, as the accounts are
+// derived only on request.
+func (w *mnemonicWallet) SelfDerive([]accounts.DerivationPath, ethereum.ChainStateReader) {}
+
+// SignData implements accounts.Wallet. It signs the keccak256 hash of data.
+func (w *mnemonicWallet) SignData(acc accounts.Account, _ string, data []byte) ([]byte, error) {
+	return w.SignHash(acc, crypto.Keccak256(data))
+}
+
+// SignDataWithPassphrase implements accounts.Wallet. Passphrase is not used.
+func (w *mnemonicWallet) SignDataWithPassphrase(acc accounts.Account, _, mimeType string, data []byte) (
+	[]byte, error,
+) {
+	return w.SignData(acc, mimeType, data)
+}
+
+// SignText implements accounts.Wallet. It signs the hash of the text prefixed
+// with the ethereum signed message header.
+func (w *mnemonicWallet) SignText(acc accounts.Account, text []byte) ([]byte, error) {
+	return w.SignHash(acc, accounts.TextHash(text))
+}
+
+// SignTextWithPassphrase implements accounts.Wallet. Passphrase is not used.
+func (w *mnemonicWallet) SignTextWithPassphrase(acc accounts.Account, _ string, text []byte) ([]byte, error) {
+	return w.SignText(acc, text)
+}
+
+// SignTx implements accounts.Wallet. It signs the tx for the given chain ID.
+func (w *mnemonicWallet) SignTx(acc accounts.Account, tx *types.Transaction, chainID *big.Int) (
+	*types.Transaction, error,
+) {
+	key, err := w.key(acc)
+	if err != nil {
+		return nil, err
+	}
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+}
+
+// SignTxWithPassphrase implements accounts.Wallet. Passphrase is not used.
+func (w *mnemonicWallet) SignTxWithPassphrase(acc accounts.Account, _ string, tx *types.Transaction,
+	chainID *big.Int,
+) (*types.Transaction, error) {
+	return w.SignTx(acc, tx, chainID)
+}
+
+// SignHash signs the given hash. It is used by the hd transactor for signing
+// the txs according to the signer of the chain.
+func (w *mnemonicWallet) SignHash(acc accounts.Account, hash []byte) ([]byte, error) {
+	key, err := w.key(acc)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash, key)
+}
+
+func (w *mnemonicWallet) key(acc accounts.Account) (*ecdsa.PrivateKey, error) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	key, ok := w.keys[acc.Address]
+	if !ok {
+		return nil, accounts.ErrUnknownAccount
+	}
+	return key, nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+	pethchanneltest "perun.network/go-perun/backend/ethereum/channel/test"
+	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
+	phd "perun.network/go-perun/backend/ethereum/wallet/hd"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/internal"
+)
+
+// testAddr0 is the well known address of the first account on the default
+// ethereum path, derived from the mnemonic in the BIP-39 test vectors.
+const testAddr0 = "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"
+
+func Test_HDWalletBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.WalletBackend)(nil), new(internal.HDWalletBackend))
+}
+
+func Test_HDWalletBackend_NewWallet(t *testing.T) {
+	wb := &internal.HDWalletBackend{}
+
+	t.Run("happy", func(t *testing.T) {
+		w, err := wb.NewWallet(ethereumtest.HDMnemonic, "")
+		require.NoError(t, err)
+		addr, err := wb.ParseAddr(testAddr0)
+		require.NoError(t, err)
+		_, err = wb.UnlockAccount(w, addr)
+		assert.NoError(t, err)
+	})
+	t.Run("happy_extra_whitespaces", func(t *testing.T) {
+		w, err := wb.NewWallet(" "+ethereumtest.HDMnemonic+"\n", "")
+		require.NoError(t, err)
+		addr, err := wb.ParseAddr(testAddr0)
+		require.NoError(t, err)
+		_, err = wb.UnlockAccount(w, addr)
+		assert.NoError(t, err)
+	})
+	t.Run("passphrase_derives_other_accounts", func(t *testing.T) {
+		w, err := wb.NewWallet(ethereumtest.HDMnemonic, "passphrase")
+		require.NoError(t, err)
+		addr, err := wb.ParseAddr(testAddr0)
+		require.NoError(t, err)
+		_, err = wb.UnlockAccount(w, addr)
+		assert.Error(t, err)
+	})
+	t.Run("invalid_checksum", func(t *testing.T) {
+		_, err := wb.NewWallet("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon "+
+			"abandon", "")
+		assert.Error(t, err)
+	})
+	t.Run("invalid_word", func(t *testing.T) {
+		_, err := wb.NewWallet("perun abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon "+
+			"about", "")
+		assert.Error(t, err)
+	})
+}
+
+func Test_HDWallet(t *testing.T) {
+	w, err := internal.NewHDWallet(ethereumtest.HDMnemonic, "")
+	require.NoError(t, err)
+
+	t.Run("user_accounts", func(t *testing.T) {
+		accs := w.Wallet().Accounts()
+		require.Len(t, accs, internal.HDNumUserAccs)
+		assert.Equal(t, common.HexToAddress(testAddr0), accs[0].Address)
+		assert.Equal(t, internal.HDUserRootPath+"0", accs[0].URL.Path)
+		assert.Equal(t, internal.HDUserRootPath+"1", accs[1].URL.Path)
+	})
+	t.Run("sign_data", func(t *testing.T) {
+		acc, err := w.Unlock(pethwallet.AsWalletAddr(common.HexToAddress(testAddr0)))
+		require.NoError(t, err)
+		data := []byte("perun-node")
+		sig, err := acc.SignData(data)
+		require.NoError(t, err)
+		ok, err := new(pethwallet.Backend).VerifySignature(data, sig, acc.Address())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("unlock_unknown_account", func(t *testing.T) {
+		_, err := w.Unlock(pethwallet.AsWalletAddr(common.Address{}))
+		assert.Error(t, err)
+	})
+	t.Run("new_part_accounts", func(t *testing.T) {
+		acc1, err := w.NewPartAccount()
+		require.NoError(t, err)
+		acc2, err := w.NewPartAccount()
+		require.NoError(t, err)
+		assert.False(t, acc1.Address().Equal(acc2.Address()))
+		assert.Equal(t, uint(2), w.NumPartAccounts())
+		_, err = w.Unlock(acc2.Address())
+		assert.NoError(t, err)
+
+		// Re-initialized wallet should derive the same participant accounts.
+		w2, err := internal.NewHDWallet(ethereumtest.HDMnemonic, "")
+		require.NoError(t, err)
+		_, err = w2.Unlock(acc2.Address())
+		assert.Error(t, err)
+		require.NoError(t, w2.DerivePartAccounts(2))
+		assert.Equal(t, uint(2), w2.NumPartAccounts())
+		_, err = w2.Unlock(acc1.Address())
+		assert.NoError(t, err)
+		_, err = w2.Unlock(acc2.Address())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_HDWallet_Transactor(t *testing.T) {
+	w, err := internal.NewHDWallet(ethereumtest.HDMnemonic, "")
+	require.NoError(t, err)
+	tr := phd.NewTransactor(w.Wallet(), types.LatestSignerForChainID(big.NewInt(ethereumtest.ChainID)))
+	acc := pethwallet.AsWalletAddr(w.Wallet().Accounts()[0].Address)
+
+	for name, cfg := range map[string]perun.GasConfig{
+		"happy_legacy":  {GasPrice: 2 * pethchanneltest.InitialGasBaseFee},
+		"happy_eip1559": {},
+	} {
+		cfg := cfg
+		t.Run(name, func(t *testing.T) {
+			sb := pethchanneltest.NewSimulatedBackend()
+			ctx, cancel := context.WithTimeout(context.Background(), ethereumtest.OnChainTxTimeout)
+			defer cancel()
+			sb.FundAddress(ctx, pethwallet.AsEthAddr(acc))
+			pcb := pethchannel.NewContractBackend(sb, internal.NewGasTransactor(tr, cfg), 1)
+			cb := &internal.ChainBackend{Cb: &pcb, TxTimeout: ethereumtest.OnChainTxTimeout}
+
+			adjudicator, err := cb.DeployAdjudicator(acc)
+			require.NoError(t, err)
+			assert.NoError(t, cb.ValidateAdjudicator(adjudicator))
+		})
+	}
+	t.Run("account_not_present", func(t *testing.T) {
+		_, err := tr.NewTransactor(accounts.Account{Address: common.Address{}})
+		assert.Error(t, err)
+	})
+}
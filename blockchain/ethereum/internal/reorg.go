@@ -0,0 +1,126 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+	pclient "perun.network/go-perun/client"
+
+	"github.com/hyperledger-labs/perun-node/blockchain"
+)
+
+// ReorgDetector wraps a contract interface to detect the transactions that
+// were removed from the chain in a reorganisation.
+//
+// While waiting for a transaction to reach the finality depth, the contract
+// backend polls for its receipt. A transaction, for which a receipt was
+// found earlier and is not found anymore, was removed from the chain.
+//
+// Only the transactions sent by an on-chain operation run using Watch are
+// tracked and they are tracked only until the operation returns.
+//
+// The methods on it are safe for concurrent use.
+type ReorgDetector struct {
+	pethchannel.ContractInterface
+}
+
+// reorgWatch tracks the transactions of one on-chain operation.
+type reorgWatch struct {
+	cancel context.CancelFunc
+
+	mtx     sync.Mutex
+	mined   map[common.Hash]struct{}
+	reorged *common.Hash
+}
+
+// reorgWatchKey is the context key for the reorg watch of an operation.
+type reorgWatchKey struct{}
+
+// NewReorgDetector returns a reorg detector that wraps the given contract
+// interface.
+func NewReorgDetector(ci pethchannel.ContractInterface) *ReorgDetector {
+	return &ReorgDetector{ContractInterface: ci}
+}
+
+// TransactionReceipt returns the receipt of the transaction as returned by
+// the wrapped contract interface.
+//
+// If the context belongs to an operation run using Watch, it tracks if the
+// transaction was removed from the chain. If yes, the context of the
+// operation is cancelled, so that waiting for the transaction returns
+// immediately.
+func (d *ReorgDetector) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := d.ContractInterface.TransactionReceipt(ctx, txHash)
+
+	w, ok := ctx.Value(reorgWatchKey{}).(*reorgWatch)
+	if !ok {
+		return receipt, err
+	}
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	switch _, wasMined := w.mined[txHash]; {
+	case err == nil && receipt != nil:
+		w.mined[txHash] = struct{}{}
+	case errors.Is(err, ethereum.NotFound) && wasMined && w.reorged == nil:
+		w.reorged = &txHash
+		w.cancel()
+	}
+	return receipt, err
+}
+
+// Watch runs the on-chain operation with a context derived from the passed
+// one and tracks the transactions, for which receipts are polled using this
+// context.
+//
+// If any of them is removed from the chain, the operation is cancelled and a
+// blockchain.TxReorgedError wrapping the error returned by the operation is
+// returned. The tx type is taken from the error, if it is a
+// pclient.TxTimedoutError; else the passed tx type is used. In all other
+// cases, the error returned by the operation is returned as such.
+func (d *ReorgDetector) Watch(ctx context.Context, txType string, op func(context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := &reorgWatch{cancel: cancel, mined: make(map[common.Hash]struct{})}
+	err := op(context.WithValue(ctx, reorgWatchKey{}, w))
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if w.reorged == nil || err == nil {
+		return err
+	}
+	txTimedOutError := pclient.TxTimedoutError{}
+	if errors.As(err, &txTimedOutError) {
+		txType = txTimedOutError.TxType
+	}
+	return blockchain.NewTxReorgedError(txType, w.reorged.Hex(), err)
+}
+
+// watch is same as Watch, but it can be called on a nil detector, in which
+// case the operation is run as such.
+func (d *ReorgDetector) watch(ctx context.Context, txType string, op func(context.Context) error) error {
+	if d == nil {
+		return op(ctx)
+	}
+	return d.Watch(ctx, txType, op)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+	pclient "perun.network/go-perun/client"
+
+	"github.com/hyperledger-labs/perun-node/blockchain"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/internal"
+)
+
+// receiptsCI is a contract interface that returns a receipt for the
+// transactions marked as mined and ethereum.NotFound for all others.
+type receiptsCI struct {
+	pethchannel.ContractInterface
+	mined map[common.Hash]bool
+}
+
+func (ci *receiptsCI) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if ci.mined[txHash] {
+		return &types.Receipt{TxHash: txHash}, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func Test_ReorgDetector(t *testing.T) {
+	txID := "0x1234000000000000000000000000000000000000000000000000000000000000"
+	txHash := common.HexToHash(txID)
+	txType := pethchannel.Fund.String()
+	txTimedOutError := pclient.TxTimedoutError{TxType: txType, TxID: txID}
+
+	newDetector := func() (*internal.ReorgDetector, *receiptsCI) {
+		ci := &receiptsCI{mined: make(map[common.Hash]bool)}
+		return internal.NewReorgDetector(ci), ci
+	}
+	pollReceipt := func(ctx context.Context, d *internal.ReorgDetector) {
+		_, _ = d.TransactionReceipt(ctx, txHash) //nolint:errcheck
+	}
+
+	t.Run("happy_reorged_reported_on_detection", func(t *testing.T) {
+		d, ci := newDetector()
+		err := d.Watch(context.Background(), "", func(ctx context.Context) error {
+			ci.mined[txHash] = true
+			pollReceipt(ctx, d)
+			ci.mined[txHash] = false
+			pollReceipt(ctx, d)
+
+			// Operation is cancelled without waiting for the tx to time out.
+			select {
+			case <-ctx.Done():
+				return txTimedOutError
+			case <-time.After(time.Second):
+				return errors.New("operation not cancelled")
+			}
+		})
+
+		txReorgedError := blockchain.TxReorgedError{}
+		require.True(t, errors.As(err, &txReorgedError))
+		assert.Equal(t, txType, txReorgedError.TxType)
+		assert.Equal(t, txID, txReorgedError.TxID)
+		assert.True(t, errors.As(err, &pclient.TxTimedoutError{}))
+	})
+	t.Run("happy_reorged_default_tx_type", func(t *testing.T) {
+		d, ci := newDetector()
+		err := d.Watch(context.Background(), txType, func(ctx context.Context) error {
+			ci.mined[txHash] = true
+			pollReceipt(ctx, d)
+			ci.mined[txHash] = false
+			pollReceipt(ctx, d)
+			return ctx.Err()
+		})
+
+		txReorgedError := blockchain.TxReorgedError{}
+		require.True(t, errors.As(err, &txReorgedError))
+		assert.Equal(t, txType, txReorgedError.TxType)
+		assert.Equal(t, txID, txReorgedError.TxID)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+	t.Run("happy_mined", func(t *testing.T) {
+		d, ci := newDetector()
+		err := d.Watch(context.Background(), txType, func(ctx context.Context) error {
+			ci.mined[txHash] = true
+			pollReceipt(ctx, d)
+			pollReceipt(ctx, d)
+			return ctx.Err()
+		})
+		assert.NoError(t, err)
+	})
+	t.Run("never_mined", func(t *testing.T) {
+		d, _ := newDetector()
+		err := d.Watch(context.Background(), txType, func(ctx context.Context) error {
+			pollReceipt(ctx, d)
+			require.NoError(t, ctx.Err())
+			return txTimedOutError
+		})
+		assert.Equal(t, txTimedOutError, err)
+	})
+	t.Run("not_tracked_after_watch_returns", func(t *testing.T) {
+		d, ci := newDetector()
+		ci.mined[txHash] = true
+		require.NoError(t, d.Watch(context.Background(), txType, func(ctx context.Context) error {
+			pollReceipt(ctx, d)
+			return nil
+		}))
+
+		ci.mined[txHash] = false
+		err := d.Watch(context.Background(), txType, func(ctx context.Context) error {
+			pollReceipt(ctx, d)
+			require.NoError(t, ctx.Err())
+			return txTimedOutError
+		})
+		assert.Equal(t, txTimedOutError, err)
+	})
+	t.Run("not_tracked_without_watch", func(t *testing.T) {
+		d, ci := newDetector()
+		ci.mined[txHash] = true
+		pollReceipt(context.Background(), d)
+		ci.mined[txHash] = false
+		pollReceipt(context.Background(), d)
+
+		err := d.Watch(context.Background(), txType, func(ctx context.Context) error {
+			pollReceipt(ctx, d)
+			require.NoError(t, ctx.Err())
+			return txTimedOutError
+		})
+		assert.Equal(t, txTimedOutError, err)
+	})
+}
@@ -17,13 +17,19 @@
 package internal
 
 import (
+	"math/big"
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/external"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
+	phd "perun.network/go-perun/backend/ethereum/wallet/hd"
 	pkeystore "perun.network/go-perun/backend/ethereum/wallet/keystore"
 	pwallet "perun.network/go-perun/wallet"
 )
@@ -95,3 +101,70 @@ func (wb *WalletBackend) ParseAddr(str string) (pwallet.Address, error) {
 	}
 	return addr, nil
 }
+
+// ExternalWalletBackend provides ethereum specific wallet backend
+// functionality, where the keys are held by an external signer. The signer
+// should serve the account namespace of the clef JSON-RPC API.
+//
+// Since the keys are not held by the node, they need not be unlocked using a
+// password.
+type ExternalWalletBackend struct {
+	WalletBackend
+}
+
+// NewWallet connects to the external signer at the given URL and returns a
+// wallet that delegates all the signatures to it. The URL can be the path to
+// the IPC socket of the signer. Password is not used.
+func (wb *ExternalWalletBackend) NewWallet(signerURL, _ string) (pwallet.Wallet, error) {
+	signer, err := external.NewExternalSigner(signerURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to external signer")
+	}
+	// Accounts are not derived, but only those listed by the signer are used.
+	w, err := phd.NewWallet(signer, phd.DefaultRootDerivationPath.String(), 0)
+	return w, errors.Wrap(err, "initializing new wallet")
+}
+
+// ExternalSigner returns the external signer used by the wallet, if it was
+// initialized by the ExternalWalletBackend.
+func ExternalSigner(w pwallet.Wallet) (*external.ExternalSigner, bool) {
+	hdWallet, ok := w.(*phd.Wallet)
+	if !ok {
+		return nil, false
+	}
+	signer, ok := hdWallet.Wallet().(*external.ExternalSigner)
+	return signer, ok
+}
+
+// ExternalTransactor can be used to make transact opts for the accounts held
+// by an external signer.
+type ExternalTransactor struct {
+	signer  *external.ExternalSigner
+	chainID *big.Int
+}
+
+// NewExternalTransactor returns a transactor that requests the external
+// signer to sign the transactions for the given chain ID.
+func NewExternalTransactor(signer *external.ExternalSigner, chainID *big.Int) *ExternalTransactor {
+	return &ExternalTransactor{signer: signer, chainID: chainID}
+}
+
+// NewTransactor returns the transact opts for the given account. It errors if
+// the account is not held by the external signer.
+func (t *ExternalTransactor) NewTransactor(acc accounts.Account) (*bind.TransactOpts, error) {
+	if !t.signer.Contains(acc) {
+		return nil, errors.New("account not found in external signer")
+	}
+	return &bind.TransactOpts{
+		From: acc.Address,
+		Signer: func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if addr != acc.Address {
+				return nil, errors.New("not authorized to sign for this account")
+			}
+			// Chain ID is passed explicitly, as it cannot be derived from
+			// an unsigned legacy tx.
+			signedTx, err := t.signer.SignTx(acc, tx, t.chainID)
+			return signedTx, errors.Wrap(err, "signing tx using external signer")
+		},
+	}, nil
+}
@@ -17,11 +17,15 @@
 package internal_test
 
 import (
+	"math/big"
 	"math/rand"
+	"path/filepath"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	pethchanneltest "perun.network/go-perun/backend/ethereum/channel/test"
 	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
 
 	"github.com/hyperledger-labs/perun-node"
@@ -31,6 +35,7 @@ import (
 
 func Test_WalletBackend_Interface(t *testing.T) {
 	assert.Implements(t, (*perun.WalletBackend)(nil), new(internal.WalletBackend))
+	assert.Implements(t, (*perun.WalletBackend)(nil), new(internal.ExternalWalletBackend))
 }
 
 func Test_WalletBackend_NewWallet(t *testing.T) {
@@ -146,3 +151,81 @@ func Test_WalletBackend_ParseAddr(t *testing.T) {
 		}
 	})
 }
+
+func Test_ExternalWalletBackend_NewWallet(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	wb := &internal.ExternalWalletBackend{}
+	setup := ethereumtest.NewWalletSetupT(t, rng, 1)
+	signerURL := ethereumtest.NewExternalSignerT(t, setup)
+
+	t.Run("happy", func(t *testing.T) {
+		w, err := wb.NewWallet(signerURL, "")
+		require.NoError(t, err)
+		require.NotNil(t, w)
+		_, ok := internal.ExternalSigner(w)
+		assert.True(t, ok)
+	})
+	t.Run("invalid_signer_url", func(t *testing.T) {
+		w, err := wb.NewWallet(filepath.Join(t.TempDir(), "missing.ipc"), "")
+		assert.Error(t, err)
+		assert.Nil(t, w)
+	})
+}
+
+func Test_ExternalWalletBackend_UnlockAccount(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	wb := &internal.ExternalWalletBackend{}
+	setup := ethereumtest.NewWalletSetupT(t, rng, 1)
+	w, err := wb.NewWallet(ethereumtest.NewExternalSignerT(t, setup), "")
+	require.NoError(t, err)
+
+	t.Run("happy_sign_data", func(t *testing.T) {
+		acc, err := wb.UnlockAccount(w, setup.Accs[0].Address())
+		require.NoError(t, err)
+
+		msg := []byte("perun-node")
+		sig, err := acc.SignData(msg)
+		require.NoError(t, err)
+		ok, err := pethwallet.VerifySignature(msg, sig, setup.Accs[0].Address())
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		// Signature should be same as the one made by the keystore wallet.
+		wantSig, err := setup.Accs[0].SignData(msg)
+		require.NoError(t, err)
+		assert.Equal(t, wantSig, sig)
+	})
+	t.Run("account_not_present", func(t *testing.T) {
+		acc, err := wb.UnlockAccount(w, ethereumtest.NewRandomAddress(rng))
+		assert.Error(t, err)
+		assert.Nil(t, acc)
+	})
+}
+
+func Test_ExternalTransactor(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+	w, err := (&internal.ExternalWalletBackend{}).NewWallet(ethereumtest.NewExternalSignerT(t, ws), "")
+	require.NoError(t, err)
+	signer, ok := internal.ExternalSigner(w)
+	require.True(t, ok)
+	tr := internal.NewExternalTransactor(signer, big.NewInt(ethereumtest.ChainID))
+
+	for name, cfg := range map[string]perun.GasConfig{
+		"happy_legacy":  {GasPrice: 2 * pethchanneltest.InitialGasBaseFee},
+		"happy_eip1559": {},
+	} {
+		cfg := cfg
+		t.Run(name, func(t *testing.T) {
+			cb, _, acc := newSimChainBackendWTransactor(t, ws, internal.NewGasTransactor(tr, cfg))
+			adjudicator, err := cb.DeployAdjudicator(acc)
+			require.NoError(t, err)
+			assert.NoError(t, cb.ValidateAdjudicator(adjudicator))
+		})
+	}
+	t.Run("account_not_present", func(t *testing.T) {
+		randomAddr := pethwallet.AsEthAddr(ethereumtest.NewRandomAddress(rng))
+		_, err := tr.NewTransactor(accounts.Account{Address: randomAddr})
+		assert.Error(t, err)
+	})
+}
@@ -31,3 +31,24 @@ func NewWalletBackend() perun.WalletBackend {
 		P: internal.WeakScryptP,
 	}}
 }
+
+// NewExternalWalletBackend initializes an ethereum specific wallet backend,
+// where the keys are held by an external signer, instead of a keystore. The
+// signer should serve the account namespace of the clef JSON-RPC API.
+//
+// The function signature uses only types defined in the root package of this project and types from std lib.
+// This enables the function to be loaded as symbol without importing this package when it is compiled as plugin.
+func NewExternalWalletBackend() perun.WalletBackend {
+	return &internal.ExternalWalletBackend{}
+}
+
+// NewHDWalletBackend initializes an ethereum specific wallet backend, where
+// the keys are derived from a BIP-39 mnemonic using BIP-44 paths, instead of
+// being stored in a keystore. A fresh participant account can be derived from
+// the wallet for each channel.
+//
+// The function signature uses only types defined in the root package of this project and types from std lib.
+// This enables the function to be loaded as symbol without importing this package when it is compiled as plugin.
+func NewHDWalletBackend() perun.WalletBackend {
+	return &internal.HDWalletBackend{}
+}
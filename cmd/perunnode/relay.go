@@ -0,0 +1,81 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/comm/relay"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// flag names for relay command.
+	relayAddrF = "address"
+
+	// default values for flags in relay command.
+	defaultRelayAddr     = ":5780"
+	defaultRelayLogLevel = "info"
+)
+
+func init() {
+	rootCmd.AddCommand(relayCmd)
+	relayCmd.Flags().String(relayAddrF, defaultRelayAddr, "address (host:port) for the relay server to listen on")
+	relayCmd.Flags().String(loglevelF, defaultRelayLogLevel, "Log level. Supported levels: debug, info, error")
+	relayCmd.Flags().String(logfileF, "", "Log file path. Use empty string for stdout")
+}
+
+var relayCmd = &cobra.Command{
+	Use:   "relay",
+	Short: "Start a relay server for off-chain communication",
+	Long: `Start a relay server for off-chain communication between the perun nodes.
+
+Nodes that cannot accept incoming connections (for example, when behind a NAT)
+can use the relay comm type. Such nodes keep a connection to the relay server
+and the connections from their peers are relayed through it. The comm address
+of such a node is the address of the relay server.`,
+	Run: runRelay,
+}
+
+func runRelay(cmd *cobra.Command, _ []string) {
+	relayAddr, err := cmd.Flags().GetString(relayAddrF)
+	if err != nil {
+		panic("unknown flag address\n")
+	}
+	logLevel, err := cmd.Flags().GetString(loglevelF)
+	if err != nil {
+		panic("unknown flag loglevel\n")
+	}
+	logFile, err := cmd.Flags().GetString(logfileF)
+	if err != nil {
+		panic("unknown flag logfile\n")
+	}
+	if err = log.InitLogger(logLevel, logFile); err != nil {
+		fmt.Printf("Error initializing logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	server := relay.NewServer(ethereum.NewWalletBackend(), log.NewLoggerWithField("component", "relay"))
+	fmt.Printf("Serving relay for off-chain communication at %s\n\n", relayAddr)
+	if err = server.ListenAndServe(relayAddr); err != nil {
+		fmt.Printf("Relay server returned with error: %v\n", err)
+	}
+}
@@ -20,14 +20,21 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	pwallet "perun.network/go-perun/wallet"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/api/grpc"
+	"github.com/hyperledger-labs/perun-node/app/htlc"
+	"github.com/hyperledger-labs/perun-node/app/metered"
+	"github.com/hyperledger-labs/perun-node/app/tictactoe"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
 	"github.com/hyperledger-labs/perun-node/node"
 )
 
@@ -41,6 +48,7 @@ const (
 	chainconntimeoutF = "chainconntimeout"
 	onchaintxtimeoutF = "onchaintxtimeout"
 	responsetimeoutF  = "responsetimeout"
+	sessionsfileF     = "sessionsfile"
 	configfileF       = "configfile" // can only be specified in flag, not via config file.
 	grpcPortF         = "grpcport"   // can only be specified in flag, not via config file.
 	serviceF          = "service"    // can only be specified in flag, not via config file.
@@ -70,15 +78,24 @@ var (
 		chainconntimeoutF,
 		onchaintxtimeoutF,
 		responsetimeoutF,
+		sessionsfileF,
 	}
 
 	// List of supported adapters by the node for the respective components.
 	// Currently this is fixed and hence hard coded here.
 	// It can be moved to config file or flags at the point when the user will
 	// be able to choose (when starting the node) which ones to load or support.
-	supportedCommTypes             = []string{"tcp"}
-	supportedIDProviderTypes       = []string{"local"}
+	supportedCommTypes             = []string{"tcp", "websocket", "tls", "relay"}
+	supportedIDProviderTypes       = []string{"local", "remote"}
 	supportedCurrencyInterpretters = []string{"ETH"}
+
+	// Built-in apps that can be enabled on the node, by specifying the
+	// address of their app definition in the apps section of the config file.
+	supportedApps = map[string]func(pwallet.Address) perun.AppDef{
+		tictactoe.Name: func(def pwallet.Address) perun.AppDef { return tictactoe.New(def) },
+		metered.Name:   func(def pwallet.Address) perun.AppDef { return metered.New(def) },
+		htlc.Name:      func(def pwallet.Address) perun.AppDef { return htlc.New(def) },
+	}
 )
 
 func init() {
@@ -115,12 +132,18 @@ func defineFlags() {
 		"Max duration to wait for an on-chain transaction to be mined.")
 	runCmd.Flags().Duration(responsetimeoutF, time.Duration(0),
 		"Max duration to wait for a response in off-chain communication.")
+	runCmd.Flags().String(sessionsfileF, "",
+		"File to persist open sessions, so they are re-opened on restart. Use empty string to disable")
 }
 
 var runCmd = &cobra.Command{
 	Use:   "run",
 	Short: "Start the perunnode",
-	Long: `Start the perun node. Currently, the node serves the payment API via grpc.
+	Long: `Start the perun node. Currently, the node serves the payment API, the
+app API and the admin API via grpc.
+
+Built-in apps (tictactoe, metered, htlc) can be enabled by specifying the address of
+their app definitions in the apps section of the config file.
 
 Configuration can be specified in the config file or via flags. Values in the
 flags override that in the config file.
@@ -138,7 +161,12 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 	grpcAddr := fmt.Sprintf(":%d", grpcPort)
 
-	nodeAPI, err := node.New(nodeCfg)
+	apps, err := initApps(nodeCfg.Apps)
+	if err != nil {
+		fmt.Printf("Error initializing apps: %v\n", err)
+		return
+	}
+	nodeAPI, err := node.New(nodeCfg, apps...)
 	if err != nil {
 		fmt.Printf("Error initializing nodeAPI: %v\n", err)
 		return
@@ -168,6 +196,30 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 }
 
+// initApps initializes the built-in apps that are enabled in the config, using
+// the given addresses for their app definitions.
+func initApps(appDefs map[string]string) ([]perun.AppDef, error) {
+	names := make([]string, 0, len(appDefs))
+	for name := range appDefs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	apps := make([]perun.AppDef, len(names))
+	for i, name := range names {
+		newApp, ok := supportedApps[name]
+		if !ok {
+			return nil, errors.Errorf("unsupported app %s", name)
+		}
+		def, err := ethereum.NewWalletBackend().ParseAddr(appDefs[name])
+		if err != nil {
+			return nil, errors.WithMessagef(err, "parsing app definition address of %s", name)
+		}
+		apps[i] = newApp(def)
+	}
+	return apps, nil
+}
+
 func parseNodeConfig(fs *pflag.FlagSet, v *viper.Viper) perun.NodeConfig {
 	// Ignore config file, if all config flags are specified.
 	if !areAllFlagsSpecified(fs, nodeCfgFlags...) {
@@ -0,0 +1,50 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/app/metered"
+	"github.com/hyperledger-labs/perun-node/app/tictactoe"
+)
+
+func Test_InitApps(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		apps, err := initApps(map[string]string{
+			tictactoe.Name: "0x9daEdAcb21dce86Af8604Ba1A1D7F9BFE55ddd63",
+			metered.Name:   "0x5992089d61cE79B6CF90506F70DD42B8E42FB21d",
+		})
+		require.NoError(t, err)
+		require.Len(t, apps, 2)
+		assert.Equal(t, metered.Name, apps[0].Name())
+		assert.Equal(t, tictactoe.Name, apps[1].Name())
+	})
+
+	t.Run("error_unsupported_app", func(t *testing.T) {
+		_, err := initApps(map[string]string{"chess": "0x9daEdAcb21dce86Af8604Ba1A1D7F9BFE55ddd63"})
+		assert.Error(t, err)
+	})
+
+	t.Run("error_invalid_address", func(t *testing.T) {
+		_, err := initApps(map[string]string{tictactoe.Name: "invalid"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/abiosoft/ishell"
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+)
+
+const (
+	// historyPageSize is the number of history entries fetched in each request.
+	historyPageSize = 1000
+
+	// historyFileMode is the file mode used for creating the exported history file.
+	historyFileMode = os.FileMode(0o600)
+)
+
+// historyRecord is the format in which the channel history is exported. Each
+// history entry is exported as one record for each currency in the channel.
+type historyRecord struct {
+	ChID         string `json:"chID"`
+	Version      uint64 `json:"version"`
+	Timestamp    string `json:"timestamp"`
+	Direction    string `json:"direction"`
+	Counterparty string `json:"counterparty"`
+	Currency     string `json:"currency"`
+	Delta        string `json:"delta"`
+}
+
+var historyCSVHeader = []string{"chID", "version", "timestamp", "direction", "counterparty", "currency", "delta"}
+
+func paymentExportHistoryFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment export-history [channel alias or ID] [csv|json] [output file]
+	countReqArgs := 3
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	chID := c.Args[0]
+	if chInfo, ok := openChannelsMap[c.Args[0]]; ok {
+		chID = chInfo.id
+	}
+	format, outputFile := c.Args[1], c.Args[2]
+	if format != "csv" && format != "json" {
+		c.Printf("%s\n\n", redf("Unknown format %s, should be csv or json.", format))
+		return
+	}
+
+	entries, errMsg := fetchPayChHistory(chID)
+	if errMsg != nil {
+		c.Printf("%s\n\n", redf("Error fetching payment channel history: %v", apiErrorString(errMsg)))
+		return
+	}
+	if err := exportHistory(outputFile, format, toHistoryRecords(entries)); err != nil {
+		c.Printf("%s\n\n", redf("Error exporting payment channel history: %v", err))
+		return
+	}
+	c.Printf("%s\n\n", greenf("Exported %d history entries for channel %s to %s.", len(entries), chID, outputFile))
+}
+
+// fetchPayChHistory fetches the complete history of the channel, one page
+// at a time.
+func fetchPayChHistory(chID string) ([]*pb.ChHistoryEntry, *pb.MsgError) {
+	entries := []*pb.ChHistoryEntry{}
+	var fromVersion uint64
+	for {
+		req := pb.GetPayChHistoryReq{
+			SessionID:   sessionID,
+			ChID:        chID,
+			FromVersion: fromVersion,
+			Limit:       historyPageSize,
+		}
+		resp, err := client.GetPayChHistory(context.Background(), &req)
+		if err != nil {
+			return nil, &pb.MsgError{Message: err.Error()}
+		}
+		if msgErr, ok := resp.Response.(*pb.GetPayChHistoryResp_Error); ok {
+			return nil, msgErr.Error
+		}
+		page := resp.Response.(*pb.GetPayChHistoryResp_MsgSuccess_).MsgSuccess.Entries
+		entries = append(entries, page...)
+		if len(page) < historyPageSize {
+			return entries, nil
+		}
+		fromVersion = page[len(page)-1].Version + 1
+	}
+}
+
+func toHistoryRecords(entries []*pb.ChHistoryEntry) []historyRecord {
+	records := []historyRecord{}
+	for _, entry := range entries {
+		for i := range entry.Currencies {
+			records = append(records, historyRecord{
+				ChID:         entry.ChID,
+				Version:      entry.Version,
+				Timestamp:    time.Unix(entry.Timestamp, 0).UTC().Format(time.RFC3339),
+				Direction:    entry.Direction.String(),
+				Counterparty: entry.Counterparty,
+				Currency:     entry.Currencies[i],
+				Delta:        entry.Deltas[i],
+			})
+		}
+	}
+	return records
+}
+
+// exportHistory writes the records to the output file in the given format.
+func exportHistory(outputFile, format string, records []historyRecord) error {
+	f, err := os.OpenFile(filepath.Clean(outputFile), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, historyFileMode)
+	if err != nil {
+		return errors.Wrap(err, "opening output file")
+	}
+	if format == "json" {
+		err = writeHistoryJSON(f, records)
+	} else {
+		err = writeHistoryCSV(f, records)
+	}
+	if err != nil {
+		f.Close() //nolint:errcheck
+		return err
+	}
+	return errors.Wrap(f.Close(), "closing output file")
+}
+
+func writeHistoryJSON(w io.Writer, records []historyRecord) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return errors.Wrap(encoder.Encode(records), "writing json")
+}
+
+func writeHistoryCSV(w io.Writer, records []historyRecord) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(historyCSVHeader); err != nil {
+		return errors.Wrap(err, "writing csv header")
+	}
+	for _, r := range records {
+		row := []string{
+			r.ChID, strconv.FormatUint(r.Version, 10), r.Timestamp, r.Direction, r.Counterparty, r.Currency, r.Delta,
+		}
+		if err := csvWriter.Write(row); err != nil {
+			return errors.Wrapf(err, "writing csv record for version %d", r.Version)
+		}
+	}
+	csvWriter.Flush()
+	return errors.Wrap(csvWriter.Error(), "writing csv")
+}
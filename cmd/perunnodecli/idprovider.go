@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/abiosoft/ishell"
 
@@ -29,7 +30,7 @@ var (
 	peerIDCmdUsage = "Usage: peer-id [sub-command]"
 	peerIDCmd      = &ishell.Cmd{
 		Name: "peer-id",
-		Help: "Use this command to add/get peer ID." + peerIDCmdUsage,
+		Help: "Use this command to add/get/list/update/delete peer ID or check peer status." + peerIDCmdUsage,
 		Func: peerIDFn,
 	}
 
@@ -47,14 +48,56 @@ var (
 		Func: peerIDGetFn,
 	}
 
+	peerIDListCmdUsage = "Usage: peer-id list"
+	peerIDListCmd      = &ishell.Cmd{
+		Name: "list",
+		Help: "List all the peer IDs in the ID provider." + peerIDListCmdUsage,
+		Func: peerIDListFn,
+	}
+
+	peerIDUpdateCmdUsage = "Usage: peer-id update [peer alias] [off-chain address] [comm address] [comm type]"
+	peerIDUpdateCmd      = &ishell.Cmd{
+		Name: "update",
+		Help: "Update the peer ID corresponding to the given alias in the ID provider." + peerIDUpdateCmdUsage,
+		Completer: func([]string) []string {
+			return knownAliasesList
+		},
+		Func: peerIDUpdateFn,
+	}
+
+	peerIDDeleteCmdUsage = "Usage: peer-id delete [peer alias]"
+	peerIDDeleteCmd      = &ishell.Cmd{
+		Name: "delete",
+		Help: "Delete the peer ID corresponding to the given alias from the ID provider." + peerIDDeleteCmdUsage,
+		Completer: func([]string) []string {
+			return knownAliasesList
+		},
+		Func: peerIDDeleteFn,
+	}
+
+	peerIDStatusCmdUsage = "Usage: peer-id status [peer alias]"
+	peerIDStatusCmd      = &ishell.Cmd{
+		Name: "status",
+		Help: "Check if the peer corresponding to the given alias is reachable." + peerIDStatusCmdUsage,
+		Completer: func([]string) []string {
+			return knownAliasesList
+		},
+		Func: peerIDStatusFn,
+	}
+
 	// List of known aliases that will be used for autocompletion. Entries will be
-	// added when "peer-id get" or "peer-id add" commands return without error.
+	// added when "peer-id get", "peer-id add" or "peer-id list" commands return
+	// without error and removed when "peer-id delete" returns without error.
 	knownAliasesList = []string{}
 )
 
 func init() {
 	peerIDCmd.AddCmd(peerIDAddCmd)
 	peerIDCmd.AddCmd(peerIDGetCmd)
+	peerIDCmd.AddCmd(peerIDListCmd)
+	peerIDCmd.AddCmd(peerIDUpdateCmd)
+	peerIDCmd.AddCmd(peerIDDeleteCmd)
+	peerIDCmd.AddCmd(peerIDStatusCmd)
 }
 
 // Add alias to known aliases list for autocompletion.
@@ -72,6 +115,16 @@ func addPeerAlias(alias string) {
 	}
 }
 
+// Remove alias from known aliases list for autocompletion.
+func removePeerAlias(alias string) {
+	for idx := range knownAliasesList {
+		if knownAliasesList[idx] == alias {
+			knownAliasesList = append(knownAliasesList[:idx], knownAliasesList[idx+1:]...)
+			return
+		}
+	}
+}
+
 func peerIDFn(c *ishell.Context) {
 	if client == nil {
 		printNodeNotConnectedError(c)
@@ -148,6 +201,145 @@ func peerIDGetFn(c *ishell.Context) {
 	c.Printf("%s\n\n", greenf("%s", prettifyPeer(msg.MsgSuccess.PeerID)))
 }
 
+func peerIDListFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id list
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.ListPeerIDsReq{
+		SessionID: sessionID,
+	}
+	resp, err := client.ListPeerIDs(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.ListPeerIDsResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error listing peer IDs : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	msg := resp.Response.(*pb.ListPeerIDsResp_MsgSuccess_)
+	for _, peerID := range msg.MsgSuccess.PeerIDs {
+		addPeerAlias(peerID.Alias)
+		c.Printf("%s\n", greenf("%s", prettifyPeer(peerID)))
+	}
+	c.Println()
+}
+
+func peerIDUpdateFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id update [peer alias] [off-chain address] [comm address] [comm type]",
+	countReqArgs := 4
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.UpdatePeerIDReq{
+		SessionID: sessionID,
+		PeerID: &pb.PeerID{
+			Alias:           c.Args[0],
+			OffChainAddress: c.Args[1],
+			CommAddress:     c.Args[2],
+			CommType:        c.Args[3],
+		},
+	}
+	resp, err := client.UpdatePeerID(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.UpdatePeerIDResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error updating peer ID : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	c.Printf("%s\n\n", greenf("Peer ID updated successfully."))
+}
+
+func peerIDDeleteFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id delete [peer alias]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.DeletePeerIDReq{
+		SessionID: sessionID,
+		Alias:     c.Args[0],
+	}
+	resp, err := client.DeletePeerID(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.DeletePeerIDResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error deleting peer ID : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	removePeerAlias(c.Args[0])
+	c.Printf("%s\n\n", greenf("Peer ID deleted successfully."))
+}
+
+func peerIDStatusFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id status [peer alias]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetPeerStatusReq{
+		SessionID: sessionID,
+		Alias:     c.Args[0],
+	}
+	resp, err := client.GetPeerStatus(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetPeerStatusResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error getting peer status : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	msg := resp.Response.(*pb.GetPeerStatusResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("%s", prettifyPeerStatus(msg.MsgSuccess.PeerStatus)))
+}
+
+func prettifyPeerStatus(s *pb.PeerStatus) string {
+	if s.LastSeen == 0 {
+		return fmt.Sprintf("Alias: %s, Reachable: %t, Last Seen: never", s.Alias, s.Reachable)
+	}
+	return fmt.Sprintf("Alias: %s, Reachable: %t, Last Seen: %s, Latency: %s", s.Alias, s.Reachable,
+		time.Unix(s.LastSeen, 0).Format(time.RFC3339), time.Duration(s.LatencyMicros)*time.Microsecond)
+}
+
 func prettifyPeer(p *pb.PeerID) string {
 	return fmt.Sprintf("Alias: %s, Off-chain Addr: %s, Comm Addr: %s, Comm Type: %s",
 		p.Alias, p.OffChainAddress, p.CommAddress, p.CommType)
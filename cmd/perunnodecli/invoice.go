@@ -0,0 +1,177 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/currency"
+)
+
+func paymentInvoiceCreateFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment invoice-create [amount] [expiry] [memo (optional, can have spaces)]
+	countReqArgs := 2
+	if len(c.Args) < countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	expiry, err := time.ParseDuration(c.Args[1])
+	if err != nil || expiry < 0 {
+		c.Printf("%s\n\n", redf("Invalid expiry %s, should be a duration like 30m or 0 for no expiry.", c.Args[1]))
+		return
+	}
+
+	req := pb.CreateInvoiceReq{
+		SessionID:  sessionID,
+		Currency:   currency.ETHSymbol,
+		Amount:     c.Args[0],
+		Memo:       strings.Join(c.Args[2:], " "),
+		ExpirySecs: uint64(expiry / time.Second),
+	}
+	resp, err := client.CreateInvoice(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.CreateInvoiceResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error creating invoice: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	inv := resp.Response.(*pb.CreateInvoiceResp_MsgSuccess_).MsgSuccess.Invoice
+	c.Printf("%s\n\n", greenf("Invoice created:\n%s\nShare the encoded invoice with the payer:\n%s",
+		prettifyInvoice(inv), inv.Encoded))
+}
+
+func paymentInvoiceGetFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment invoice-get [invoice ID]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetInvoiceReq{
+		SessionID: sessionID,
+		InvoiceID: c.Args[0],
+	}
+	resp, err := client.GetInvoice(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.GetInvoiceResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error getting invoice: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	inv := resp.Response.(*pb.GetInvoiceResp_MsgSuccess_).MsgSuccess.Invoice
+	c.Printf("%s\n\n", greenf("%s", prettifyInvoice(inv)))
+}
+
+func paymentInvoiceListFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment invoice-list
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	resp, err := client.ListInvoices(context.Background(), &pb.ListInvoicesReq{SessionID: sessionID})
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.ListInvoicesResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error listing invoices: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	invoices := resp.Response.(*pb.ListInvoicesResp_MsgSuccess_).MsgSuccess.Invoices
+	if len(invoices) == 0 {
+		c.Printf("%s\n\n", greenf("No invoices in the session."))
+		return
+	}
+	for _, inv := range invoices {
+		c.Printf("%s\n", greenf("%s", prettifyInvoice(inv)))
+	}
+	c.Println()
+}
+
+func paymentInvoicePayFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment invoice-pay [encoded invoice]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.PayInvoiceReq{
+		SessionID:      sessionID,
+		EncodedInvoice: c.Args[0],
+	}
+	resp, err := client.PayInvoice(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.PayInvoiceResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error paying invoice: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	inv := resp.Response.(*pb.PayInvoiceResp_MsgSuccess_).MsgSuccess.Invoice
+	c.Printf("%s\n\n", greenf("Invoice paid:\n%s", prettifyInvoice(inv)))
+}
+
+func prettifyInvoice(inv *pb.Invoice) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID: %s, Pay %s %s to %s", inv.Id, inv.Amount, inv.Currency, inv.Payee)
+	if inv.Memo != "" {
+		fmt.Fprintf(&b, " for \"%s\"", inv.Memo)
+	}
+	fmt.Fprintf(&b, "\n\tStatus: %s, Created at: %s", inv.Status, formatUnix(inv.CreatedAt))
+	if inv.Expiry != 0 {
+		fmt.Fprintf(&b, ", Expires at: %s", formatUnix(inv.Expiry))
+	}
+	if inv.Status == pb.Invoice_paid {
+		fmt.Fprintf(&b, ", Paid at: %s in channel %s, version %d", formatUnix(inv.PaidAt), inv.ChID, inv.Version)
+	}
+	return b.String()
+}
+
+func formatUnix(secs int64) string {
+	return time.Unix(secs, 0).UTC().Format(time.RFC3339)
+}
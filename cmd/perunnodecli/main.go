@@ -43,6 +43,10 @@ var (
 	// access without a mutex.
 	client pb.Payment_APIClient
 
+	// Singleton instance of grpc node administration client that will be
+	// used by the node sub-commands for managing sessions on the node.
+	adminClient pb.Admin_APIClient
+
 	// Session ID for the currently active session. The cli application
 	// allows only one session to be open at a time and all channel requests,
 	// payments and payment requests are sent and received in this context of
@@ -59,12 +59,43 @@ var (
 		Help: "Print node config." + nodeConfigCmdUsage,
 		Func: nodeConfigFn,
 	}
+
+	nodeListSessionsCmdUsage = "Usage: node list-sessions"
+	nodeListSessionsCmd      = &ishell.Cmd{
+		Name: "list-sessions",
+		Help: "Print the info of all sessions open on the node." + nodeListSessionsCmdUsage,
+		Func: nodeListSessionsFn,
+	}
+
+	nodeSessionInfoCmdUsage = "Usage: node session-info [session ID]"
+	nodeSessionInfoCmd      = &ishell.Cmd{
+		Name: "session-info",
+		Help: "Print the info of a session open on the node." + nodeSessionInfoCmdUsage,
+		Func: nodeSessionInfoFn,
+	}
+
+	nodeCloseSessionCmdUsage = "Usage: node close-session [session ID] [no-force|force]"
+	nodeCloseSessionCmd      = &ishell.Cmd{
+		Name: "close-session",
+		Help: "Close a session open on the node. Use tab completion to cycle through available options." +
+			nodeCloseSessionCmdUsage,
+		Completer: func(args []string) []string {
+			if len(args) == 1 {
+				return []string{"no-force", "force"}
+			}
+			return nil
+		},
+		Func: nodeCloseSessionFn,
+	}
 )
 
 func init() {
 	nodeCmd.AddCmd(nodeConnectCmd)
 	nodeCmd.AddCmd(nodeTimeCmd)
 	nodeCmd.AddCmd(nodeConfigCmd)
+	nodeCmd.AddCmd(nodeListSessionsCmd)
+	nodeCmd.AddCmd(nodeSessionInfoCmd)
+	nodeCmd.AddCmd(nodeCloseSessionCmd)
 }
 
 func nodeFn(c *ishell.Context) {
@@ -84,6 +115,7 @@ func nodeConnectFn(c *ishell.Context) {
 		sh.Printf("Error connecting to perun node at %s: %v", nodeAddr, err)
 	}
 	client = pb.NewPayment_APIClient(conn)
+	adminClient = pb.NewAdmin_APIClient(conn)
 	t, err := getNodeTime()
 	if err != nil {
 		c.Printf("%s\n\n", redf("Error connecting to perun node: %v", err))
@@ -139,3 +171,90 @@ func nodeConfigFn(c *ishell.Context) {
 	}
 	c.Printf("%s\n\n", greenf("Perun node config:\n%v", prettify(getConfigResp)))
 }
+
+func nodeListSessionsFn(c *ishell.Context) {
+	if adminClient == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	listSessionsResp, err := adminClient.ListSessions(context.Background(), &pb.ListSessionsReq{})
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	c.Printf("%s\n\n", greenf("Sessions on the node:\n%v", prettify(listSessionsResp.SessionsInfo)))
+}
+
+func nodeSessionInfoFn(c *ishell.Context) {
+	if adminClient == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	getSessionInfoReq := pb.GetSessionInfoReq{
+		SessionID: c.Args[0],
+	}
+	getSessionInfoResp, err := adminClient.GetSessionInfo(context.Background(), &getSessionInfoReq)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := getSessionInfoResp.Response.(*pb.GetSessionInfoResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error getting session info: %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	sessionInfo := getSessionInfoResp.Response.(*pb.GetSessionInfoResp_MsgSuccess_).MsgSuccess.SessionInfo
+	c.Printf("%s\n\n", greenf("Session info:\n%v", prettify(sessionInfo)))
+}
+
+func nodeCloseSessionFn(c *ishell.Context) {
+	if adminClient == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 2
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	var force bool
+	switch c.Args[1] {
+	case "no-force":
+		force = false
+	case "force":
+		force = true
+	default:
+		c.Printf("%s\n\n", redf("Invalid option, should be 'no-force' or 'force'"))
+		return
+	}
+
+	closeSessionReq := pb.AdminCloseSessionReq{
+		SessionID: c.Args[0],
+		Force:     force,
+	}
+	closeSessionResp, err := adminClient.CloseSession(context.Background(), &closeSessionReq)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := closeSessionResp.Response.(*pb.AdminCloseSessionResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error closing session: %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	openPayChsInfo := closeSessionResp.Response.(*pb.AdminCloseSessionResp_MsgSuccess_).MsgSuccess.OpenPayChsInfo
+	c.Printf("%s\n\n", greenf("Session closed. Open channels that were persisted:\n%v", prettify(openPayChsInfo)))
+}
@@ -89,6 +89,71 @@ var (
 		},
 		Func: paymentReject,
 	}
+	paymentExportHistoryCmdUsage = "Usage: payment export-history [channel alias or ID] [csv|json] [output file]"
+	paymentExportHistoryCmd      = &ishell.Cmd{
+		Name: "export-history",
+		Help: "Export the history of payments on the channel to a file." + paymentExportHistoryCmdUsage,
+		Completer: func([]string) []string {
+			return openChannelsList
+		},
+		Func: paymentExportHistoryFn,
+	}
+	paymentScheduleCreateCmdUsage = "Usage: payment schedule-create [channel alias] [amount] [every] " +
+		"[minute|hour|day|week|month]"
+	paymentScheduleCreateCmd = &ishell.Cmd{
+		Name: "schedule-create",
+		Help: "Schedule recurring payments to the peer, starting now." + paymentScheduleCreateCmdUsage,
+		Completer: func([]string) []string {
+			return openChannelsList
+		},
+		Func: paymentScheduleCreateFn,
+	}
+	paymentScheduleListCmdUsage = "Usage: payment schedule-list"
+	paymentScheduleListCmd      = &ishell.Cmd{
+		Name: "schedule-list",
+		Help: "List the payment schedules in the session." + paymentScheduleListCmdUsage,
+		Func: paymentScheduleListFn,
+	}
+	paymentScheduleCancelCmdUsage = "Usage: payment schedule-cancel [schedule ID]"
+	paymentScheduleCancelCmd      = &ishell.Cmd{
+		Name: "schedule-cancel",
+		Help: "Cancel a payment schedule." + paymentScheduleCancelCmdUsage,
+		Func: paymentScheduleCancelFn,
+	}
+	paymentSendRoutedCmdUsage = "Usage: payment send-routed [peer alias] [amount]"
+	paymentSendRoutedCmd      = &ishell.Cmd{
+		Name: "send-routed",
+		Help: "Send a payment to the peer through a path of htlc channels, when there is no channel with the peer. " +
+			"Routing should be enabled in the session config." + paymentSendRoutedCmdUsage,
+		Completer: func([]string) []string {
+			return knownAliasesList
+		},
+		Func: paymentSendRoutedFn,
+	}
+	paymentInvoiceCreateCmdUsage = "Usage: payment invoice-create [amount] [expiry, e.g. 30m or 0 for none] [memo]"
+	paymentInvoiceCreateCmd      = &ishell.Cmd{
+		Name: "invoice-create",
+		Help: "Create an invoice for requesting a payment from a peer." + paymentInvoiceCreateCmdUsage,
+		Func: paymentInvoiceCreateFn,
+	}
+	paymentInvoiceGetCmdUsage = "Usage: payment invoice-get [invoice ID]"
+	paymentInvoiceGetCmd      = &ishell.Cmd{
+		Name: "invoice-get",
+		Help: "Get the status of an invoice created or paid by the user." + paymentInvoiceGetCmdUsage,
+		Func: paymentInvoiceGetFn,
+	}
+	paymentInvoiceListCmdUsage = "Usage: payment invoice-list"
+	paymentInvoiceListCmd      = &ishell.Cmd{
+		Name: "invoice-list",
+		Help: "List the invoices created or paid by the user." + paymentInvoiceListCmdUsage,
+		Func: paymentInvoiceListFn,
+	}
+	paymentInvoicePayCmdUsage = "Usage: payment invoice-pay [encoded invoice]"
+	paymentInvoicePayCmd      = &ishell.Cmd{
+		Name: "invoice-pay",
+		Help: "Pay an invoice created by a peer." + paymentInvoicePayCmdUsage,
+		Func: paymentInvoicePayFn,
+	}
 )
 
 func init() {
@@ -98,6 +163,15 @@ func init() {
 	paymentCmd.AddCmd(paymentUnsubCmd)
 	paymentCmd.AddCmd(paymentAcceptCmd)
 	paymentCmd.AddCmd(paymentRejectCmd)
+	paymentCmd.AddCmd(paymentExportHistoryCmd)
+	paymentCmd.AddCmd(paymentScheduleCreateCmd)
+	paymentCmd.AddCmd(paymentScheduleListCmd)
+	paymentCmd.AddCmd(paymentScheduleCancelCmd)
+	paymentCmd.AddCmd(paymentSendRoutedCmd)
+	paymentCmd.AddCmd(paymentInvoiceCreateCmd)
+	paymentCmd.AddCmd(paymentInvoiceGetCmd)
+	paymentCmd.AddCmd(paymentInvoiceListCmd)
+	paymentCmd.AddCmd(paymentInvoicePayCmd)
 }
 
 func paymentFn(c *ishell.Context) {
@@ -0,0 +1,67 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/currency"
+)
+
+func paymentSendRoutedFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment send-routed [peer alias] [amount]
+	countReqArgs := 2
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.PayReq{
+		SessionID:   sessionID,
+		TargetAlias: c.Args[0],
+		Currency:    currency.ETHSymbol,
+		Amount:      c.Args[1],
+	}
+	resp, err := client.Pay(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.PayResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error sending routed payment: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	p := resp.Response.(*pb.PayResp_MsgSuccess_).MsgSuccess.Payment
+	c.Printf("%s\n\n", greenf("Payment sent to %s:\n%s", c.Args[0], prettifyRoutedPayment(p)))
+}
+
+func prettifyRoutedPayment(p *pb.RoutedPayment) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Paid %s %s, including a fee of %s, via %s", p.Amount, currency.ETHSymbol, p.Fee,
+		strings.Join(p.Route, " -> "))
+	fmt.Fprintf(&b, "\n\tPayment hash: %s, Preimage (proof of payment): %s", p.Hash, p.Preimage)
+	return b.String()
+}
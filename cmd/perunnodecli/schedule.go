@@ -0,0 +1,159 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/currency"
+)
+
+func paymentScheduleCreateFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment schedule-create [channel alias] [amount] [every] [minute|hour|day|week|month]
+	countReqArgs := 4
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	chInfo, ok := openChannelsMap[c.Args[0]]
+	if !ok {
+		printUnknownChannelAliasError(c, c.Args[0])
+		return
+	}
+	every, err := strconv.ParseUint(c.Args[2], 10, 64)
+	if err != nil {
+		c.Printf("%s\n\n", redf("Invalid interval %s, should be a positive integer.", c.Args[2]))
+		return
+	}
+	unit, ok := pb.PaymentSchedule_Unit_value[c.Args[3]]
+	if !ok {
+		c.Printf("%s\n\n", redf("Unknown unit %s, should be minute, hour, day, week or month.", c.Args[3]))
+		return
+	}
+
+	req := pb.CreatePaymentScheduleReq{
+		SessionID: sessionID,
+		Schedule: &pb.PaymentSchedule{
+			ChID:     chInfo.id,
+			Payee:    chInfo.peerAlias,
+			Currency: currency.ETHSymbol,
+			Amount:   c.Args[1],
+			Every:    every,
+			Unit:     pb.PaymentSchedule_Unit(unit),
+		},
+	}
+	resp, err := client.CreatePaymentSchedule(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.CreatePaymentScheduleResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error creating payment schedule: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	schedule := resp.Response.(*pb.CreatePaymentScheduleResp_MsgSuccess_).MsgSuccess.Schedule
+	c.Printf("%s\n\n", greenf("Payment schedule created:\n%s", prettifyPaymentSchedule(schedule)))
+}
+
+func paymentScheduleListFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment schedule-list
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	resp, err := client.ListPaymentSchedules(context.Background(), &pb.ListPaymentSchedulesReq{SessionID: sessionID})
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.ListPaymentSchedulesResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error listing payment schedules: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	schedules := resp.Response.(*pb.ListPaymentSchedulesResp_MsgSuccess_).MsgSuccess.Schedules
+	if len(schedules) == 0 {
+		c.Printf("%s\n\n", greenf("No payment schedules in the session."))
+		return
+	}
+	for _, schedule := range schedules {
+		c.Printf("%s\n", greenf("%s", prettifyPaymentSchedule(schedule)))
+	}
+	c.Println()
+}
+
+func paymentScheduleCancelFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	// Usage: payment schedule-cancel [schedule ID]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.CancelPaymentScheduleReq{
+		SessionID:  sessionID,
+		ScheduleID: c.Args[0],
+	}
+	resp, err := client.CancelPaymentSchedule(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	if msgErr, ok := resp.Response.(*pb.CancelPaymentScheduleResp_Error); ok {
+		c.Printf("%s\n\n", redf("Error cancelling payment schedule: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	schedule := resp.Response.(*pb.CancelPaymentScheduleResp_MsgSuccess_).MsgSuccess.Schedule
+	c.Printf("%s\n\n", greenf("Payment schedule cancelled:\n%s", prettifyPaymentSchedule(schedule)))
+}
+
+func prettifyPaymentSchedule(s *pb.PaymentSchedule) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID: %s, Channel: %s, Pay %s %s to %s every %d %s(s)",
+		s.Id, s.ChID, s.Amount, s.Currency, s.Payee, s.Every, s.Unit)
+	fmt.Fprintf(&b, "\n\tStatus: %s, Payments made: %d", s.Status, s.Payments)
+	if s.LastPaidAt != 0 {
+		fmt.Fprintf(&b, ", Last paid at: %s", time.Unix(s.LastPaidAt, 0).UTC().Format(time.RFC3339))
+	}
+	if s.Status == pb.PaymentSchedule_active {
+		fmt.Fprintf(&b, ", Next payment at: %s", time.Unix(s.NextAt, 0).UTC().Format(time.RFC3339))
+	}
+	if s.LastError != "" {
+		fmt.Fprintf(&b, ", Error: %s", s.LastError)
+	}
+	return b.String()
+}
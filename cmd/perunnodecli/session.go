@@ -22,6 +22,8 @@ import (
 	"github.com/abiosoft/ishell"
 
 	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/internal/secret"
+	"github.com/hyperledger-labs/perun-node/session"
 )
 
 var (
@@ -80,6 +82,13 @@ func sessionOpenFn(c *ishell.Context) {
 	req := pb.OpenSessionReq{
 		ConfigFile: c.Args[0],
 	}
+	// The node runs in the background and cannot prompt for the passwords.
+	// So, read them here and send them along with the request. If the config
+	// cannot be parsed, the node will report the error.
+	if cfg, err := session.ParseConfig(c.Args[0]); err == nil {
+		req.OnChainPassword = readPasswordIfPrompt(c, cfg.User.OnChainWallet, "On-chain wallet password: ")
+		req.OffChainPassword = readPasswordIfPrompt(c, cfg.User.OffChainWallet, "Off-chain wallet password: ")
+	}
 	resp, err := client.OpenSession(context.Background(), &req)
 	if err != nil {
 		printCommandSendingError(c, err)
@@ -106,6 +115,18 @@ func sessionOpenFn(c *ishell.Context) {
 	channelSub(c)
 }
 
+// readPasswordIfPrompt reads the password from the terminal, without echoing
+// it, if the wallet password is configured as prompt.
+func readPasswordIfPrompt(c *ishell.Context, cfg session.WalletConfig, message string) string {
+	if cfg.Password != secret.Prompt {
+		return ""
+	}
+	c.ShowPrompt(false)
+	defer c.ShowPrompt(true)
+	c.Print(message)
+	return c.ReadPassword()
+}
+
 func sessionCloseFn(c *ishell.Context) {
 	if client == nil {
 		printNodeNotConnectedError(c)
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package perunnodesigner implements a reference external signer for the
+// perun node. It holds the keys in a keystore and serves the signing requests
+// from the node over an IPC socket, using the clef JSON-RPC API.
+//
+// It is meant for local use and testing. For production, use clef or another
+// signer implementing the same API.
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/extsigner"
+	"github.com/hyperledger-labs/perun-node/internal/secret"
+)
+
+const (
+	// flag names for the root command.
+	keystoreF = "keystore"
+	passwordF = "password"
+	chainIDF  = "chainid"
+	ipcF      = "ipc"
+
+	// default values for flags in the root command.
+	defaultChainID = 1337 // Default chain id for ganache-cli private network.
+	defaultIPC     = "perunnodesigner.ipc"
+)
+
+var (
+	// values of the flags for the root command.
+	ksPath, password, ipcPath string
+	chainID                   int64
+
+	rootCmd = &cobra.Command{
+		Use:   "perunnodesigner",
+		Short: "A reference external signer for the perun node.",
+		Long: `
+A reference external signer for the perun node. It unlocks all the keys in the
+keystore and signs the transactions and data requested by the node, over an IPC
+socket. Use the path of the socket as the signerURL in the wallet config of the
+session, to delegate signing to it.
+
+It is meant for local use and testing, it signs all the requests without any
+confirmation.`,
+		Args: cobra.NoArgs,
+		RunE: run,
+	}
+)
+
+func init() {
+	rootCmd.Flags().StringVar(&ksPath, keystoreF, "", "Path to the keystore directory")
+	rootCmd.Flags().StringVar(&password, passwordF, "",
+		`Password for unlocking the keys in the keystore. Use "prompt" to enter it in the terminal,
+"env:VAR" to read it from an environment variable or "file:PATH" to read it from a file`)
+	rootCmd.Flags().Int64Var(&chainID, chainIDF, defaultChainID, "Chain ID for signing the transactions")
+	rootCmd.Flags().StringVar(&ipcPath, ipcF, defaultIPC, "Path to the IPC socket for serving the requests")
+	if err := rootCmd.MarkFlagRequired(keystoreF); err != nil {
+		panic(err)
+	}
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func run(_ *cobra.Command, _ []string) error {
+	if _, err := os.Stat(ksPath); err != nil {
+		return fmt.Errorf("reading keystore: %w", err)
+	}
+	pw, err := secret.ResolveWithPrompt(password, "Keystore password: ")
+	if err != nil {
+		return fmt.Errorf("reading password: %w", err)
+	}
+	ks := keystore.NewKeyStore(ksPath, keystore.StandardScryptN, keystore.StandardScryptP)
+	signer, err := extsigner.Serve(ks, pw, big.NewInt(chainID), ipcPath)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Serving signing requests for %d accounts at %s\n", len(ks.Accounts()), ipcPath)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+	return signer.Close()
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+type (
+	// conn is one end of an in-memory connection. The envelopes are encoded
+	// using the serializer when sent and decoded when received, so that the
+	// serialization is exercised as in the other backends.
+	conn struct {
+		hub        *Hub
+		localAddr  string
+		remoteAddr string
+		serializer pwire.EnvelopeSerializer
+
+		in  *queue // Messages received from the remote end.
+		out *queue // Messages sent to the remote end.
+
+		closeOnce sync.Once
+	}
+
+	// queue is an unbounded FIFO queue of encoded messages for one direction
+	// of a connection. Once closed, no more messages can be pushed. The
+	// messages already in the queue can still be popped, unless they were
+	// discarded when closing.
+	queue struct {
+		mutex  sync.Mutex
+		cond   *sync.Cond
+		msgs   []message
+		closed bool
+	}
+
+	message struct {
+		data      []byte
+		deliverAt time.Time
+	}
+)
+
+var _ pnet.Conn = (*conn)(nil)
+
+// newConnPair returns the two ends of a connection between the comm addresses.
+func newConnPair(hub *Hub, addr1, addr2 string, ser pwire.EnvelopeSerializer) (conn1, conn2 *conn) {
+	q1, q2 := newQueue(), newQueue()
+	conn1 = &conn{hub: hub, localAddr: addr1, remoteAddr: addr2, serializer: ser, in: q1, out: q2}
+	conn2 = &conn{hub: hub, localAddr: addr2, remoteAddr: addr1, serializer: ser, in: q2, out: q1}
+	return conn1, conn2
+}
+
+// Send implements pnet.Conn.Send. If the message is to be dropped, it returns
+// without error, as in an unreliable network.
+func (c *conn) Send(e *pwire.Envelope) error {
+	var buf bytes.Buffer
+	if err := c.serializer.Encode(&buf, e); err != nil {
+		c.Close() //nolint:errcheck,gosec // Error in sending is returned.
+		return errors.Wrap(err, "encoding envelope")
+	}
+	delay, ok := c.hub.deliveryDelay(c.localAddr, c.remoteAddr)
+	if !ok {
+		return nil
+	}
+	return c.out.push(message{data: buf.Bytes(), deliverAt: time.Now().Add(delay)})
+}
+
+// Recv implements pnet.Conn.Recv.
+func (c *conn) Recv() (*pwire.Envelope, error) {
+	msg, err := c.in.pop()
+	if err != nil {
+		return nil, err
+	}
+	time.Sleep(time.Until(msg.deliverAt))
+	e, err := c.serializer.Decode(bytes.NewReader(msg.data))
+	if err != nil {
+		c.Close() //nolint:errcheck,gosec // Error in receiving is returned.
+		return nil, errors.Wrap(err, "decoding envelope")
+	}
+	return e, nil
+}
+
+// Close implements pnet.Conn.Close. It closes both the ends of the connection.
+// The messages already sent from this end can still be received by the remote
+// end, as in a tcp connection.
+func (c *conn) Close() error {
+	err := errors.New("already closed")
+	c.closeOnce.Do(func() {
+		c.in.close(true)
+		c.out.close(false)
+		err = nil
+	})
+	return err
+}
+
+func newQueue() *queue {
+	q := &queue{}
+	q.cond = sync.NewCond(&q.mutex)
+	return q
+}
+
+func (q *queue) push(msg message) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.closed {
+		return errors.New("connection closed")
+	}
+	q.msgs = append(q.msgs, msg)
+	q.cond.Signal()
+	return nil
+}
+
+// pop blocks until a message is available or the queue is closed.
+func (q *queue) pop() (message, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for len(q.msgs) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if len(q.msgs) == 0 {
+		return message{}, errors.New("connection closed")
+	}
+	msg := q.msgs[0]
+	q.msgs = q.msgs[1:]
+	return msg, nil
+}
+
+// close closes the queue. If discard is true, the messages in the queue are
+// discarded.
+func (q *queue) close(discard bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if discard {
+		q.msgs = nil
+	}
+	q.closed = true
+	q.cond.Broadcast()
+}
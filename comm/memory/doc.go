@@ -0,0 +1,26 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memory implements an off-chain communication backend, where the
+// listeners and dialers are connected through an in-process hub keyed by comm
+// address. It does not use any network resources and is intended for testing
+// multiple nodes or sessions in a single process. Sessions support the comm
+// type "memory" only in tests, where the hub is set using a test hook.
+//
+// The hub has switches for injecting latency, message drops and network
+// partitions, so that the behavior of the nodes under such conditions can be
+// tested deterministically.
+package memory
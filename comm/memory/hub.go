@@ -0,0 +1,163 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// dropRateSeed is the seed for the random source used for dropping messages.
+// It is fixed, so that the same messages are dropped in each run.
+const dropRateSeed = 1729
+
+type (
+	// Hub connects the listeners and dialers of the memory backends that use it.
+	// It is safe for concurrent use.
+	Hub struct {
+		mutex       sync.Mutex
+		listeners   map[string]*listener
+		latency     time.Duration
+		dropRate    float64
+		rng         *rand.Rand
+		partitioned map[link]struct{}
+	}
+
+	// link identifies the connectivity between two comm addresses. The
+	// addresses are sorted, so that the link is the same in both directions.
+	link struct {
+		addr1, addr2 string
+	}
+)
+
+//nolint:gochecknoglobals // Default hub is used when no hub is explicitly specified.
+var defaultHub = NewHub()
+
+// DefaultHub returns the hub shared by all the users in this process, that do
+// not use a hub of their own.
+func DefaultHub() *Hub {
+	return defaultHub
+}
+
+// NewHub returns a hub with no latency, no message drops and no partitions.
+func NewHub() *Hub {
+	return &Hub{
+		listeners:   make(map[string]*listener),
+		rng:         rand.New(rand.NewSource(dropRateSeed)), //nolint:gosec // Randomness is only for testing.
+		partitioned: make(map[link]struct{}),
+	}
+}
+
+// SetLatency sets the latency for delivering each message. It applies to the
+// messages sent after this call.
+func (h *Hub) SetLatency(latency time.Duration) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.latency = latency
+}
+
+// SetDropRate sets the fraction (in the range [0, 1]) of the messages that
+// will be dropped silently. For a given rate, the random source for choosing
+// the messages to be dropped is reset, so that the same sequence of messages
+// are dropped on each run.
+func (h *Hub) SetDropRate(rate float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.dropRate = rate
+	h.rng = rand.New(rand.NewSource(dropRateSeed)) //nolint:gosec // Randomness is only for testing.
+}
+
+// Partition partitions the network between the two comm addresses. New
+// connections between them cannot be established and the messages on the
+// existing connections are dropped silently, until the partition is healed.
+func (h *Hub) Partition(commAddr1, commAddr2 string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.partitioned[newLink(commAddr1, commAddr2)] = struct{}{}
+}
+
+// Heal heals the partition between the two comm addresses.
+func (h *Hub) Heal(commAddr1, commAddr2 string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	delete(h.partitioned, newLink(commAddr1, commAddr2))
+}
+
+// HealAll heals all the partitions.
+func (h *Hub) HealAll() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.partitioned = make(map[link]struct{})
+}
+
+func (h *Hub) addListener(commAddr string, l *listener) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if _, ok := h.listeners[commAddr]; ok {
+		return errors.Errorf("comm address %s already in use", commAddr)
+	}
+	h.listeners[commAddr] = l
+	return nil
+}
+
+func (h *Hub) removeListener(commAddr string, l *listener) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.listeners[commAddr] == l {
+		delete(h.listeners, commAddr)
+	}
+}
+
+// listener returns the listener for the remote comm address, if it is
+// reachable from the local comm address.
+func (h *Hub) listener(localAddr, remoteAddr string) (*listener, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if _, ok := h.partitioned[newLink(localAddr, remoteAddr)]; ok {
+		return nil, errors.New("network partitioned")
+	}
+	l, ok := h.listeners[remoteAddr]
+	if !ok {
+		return nil, errors.New("connection refused: no listener at " + remoteAddr)
+	}
+	return l, nil
+}
+
+// deliveryDelay returns the delay for delivering a message sent from the local
+// to the remote comm address. It returns false, if the message is to be
+// dropped.
+func (h *Hub) deliveryDelay(localAddr, remoteAddr string) (time.Duration, bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if _, ok := h.partitioned[newLink(localAddr, remoteAddr)]; ok {
+		return 0, false
+	}
+	if h.dropRate > 0 && h.rng.Float64() < h.dropRate {
+		return 0, false
+	}
+	return h.latency, true
+}
+
+func newLink(addr1, addr2 string) link {
+	if addr1 > addr2 {
+		addr1, addr2 = addr2, addr1
+	}
+	return link{addr1: addr1, addr2: addr2}
+}
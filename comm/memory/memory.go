@@ -0,0 +1,175 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pkgsync "polycry.pt/poly-go/sync"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+type (
+	// Backend is an off-chain communication backend that implements `CommBackend`
+	// for in-memory connections through a hub.
+	//
+	// Each node should use its own backend instance, because the comm address of
+	// the listener initialized using the backend is used as the local address of
+	// the connections dialed using it. This is required for simulating network
+	// partitions between comm addresses.
+	Backend struct {
+		hub *Hub
+
+		mutex     sync.RWMutex
+		localAddr string
+	}
+
+	// listener accepts the in-memory connections dialed to its comm address.
+	listener struct {
+		hub      *Hub
+		commAddr string
+		conns    chan *conn
+
+		closeOnce sync.Once
+		closed    chan struct{}
+	}
+
+	// dialer is a lookup-table based dialer that can dial known peers through
+	// the hub. New peer addresses can be added via Register().
+	dialer struct {
+		backend *Backend
+
+		mutex sync.RWMutex               // Protects peers.
+		peers map[pwallet.AddrKey]string // Known peer addresses.
+
+		pkgsync.Closer
+	}
+)
+
+var (
+	_ pnet.Listener = (*listener)(nil)
+	_ pnet.Dialer   = (*dialer)(nil)
+)
+
+// NewMemoryBackend returns a backend that can initialize off-chain
+// communication adapters for in-memory connections through the hub.
+func NewMemoryBackend(hub *Hub) *Backend {
+	return &Backend{hub: hub}
+}
+
+// NewListener returns a listener that can listen for incoming connections at
+// the specified comm address in the hub. An error is returned if another
+// listener is already using the address.
+func (b *Backend) NewListener(addr string) (pnet.Listener, error) {
+	l := &listener{
+		hub:      b.hub,
+		commAddr: addr,
+		conns:    make(chan *conn),
+		closed:   make(chan struct{}),
+	}
+	if err := b.hub.addListener(addr, l); err != nil {
+		return nil, errors.WithMessage(err, "initializing listener")
+	}
+	b.mutex.Lock()
+	b.localAddr = addr
+	b.mutex.Unlock()
+	return l, nil
+}
+
+// NewDialer returns a dialer that can dial outgoing connections through the
+// hub.
+func (b *Backend) NewDialer() perun.Dialer {
+	return &dialer{
+		backend: b,
+		peers:   make(map[pwallet.AddrKey]string),
+	}
+}
+
+func (b *Backend) getLocalAddr() string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.localAddr
+}
+
+// Accept implements pnet.Listener.Accept.
+func (l *listener) Accept(ser pwire.EnvelopeSerializer) (pnet.Conn, error) {
+	select {
+	case c := <-l.conns:
+		c.serializer = ser
+		return c, nil
+	case <-l.closed:
+		return nil, errors.New("accept failed: listener closed")
+	}
+}
+
+// Close implements pnet.Listener.Close.
+func (l *listener) Close() error {
+	err := errors.New("already closed")
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		l.hub.removeListener(l.commAddr, l)
+		err = nil
+	})
+	return err
+}
+
+// Dial implements pnet.Dialer.Dial.
+func (d *dialer) Dial(ctx context.Context, addr pwire.Address, ser pwire.EnvelopeSerializer) (pnet.Conn, error) {
+	commAddr, ok := d.commAddr(pwallet.Key(addr))
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+	localAddr := d.backend.getLocalAddr()
+	l, err := d.backend.hub.listener(localAddr, commAddr)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to dial peer")
+	}
+
+	dialedConn, acceptedConn := newConnPair(d.backend.hub, localAddr, commAddr, ser)
+	select {
+	case l.conns <- acceptedConn:
+		return dialedConn, nil
+	case <-l.closed:
+		return nil, errors.New("failed to dial peer: listener closed")
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "failed to dial peer")
+	case <-d.Closed():
+		return nil, errors.New("failed to dial peer: dialer closed")
+	}
+}
+
+func (d *dialer) commAddr(key pwallet.AddrKey) (string, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	commAddr, ok := d.peers[key]
+	return commAddr, ok
+}
+
+// Register registers a comm address for a peer address.
+func (d *dialer) Register(addr pwire.Address, commAddr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.peers[pwallet.Key(addr)] = commAddr
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pperunioserializer "perun.network/go-perun/wire/perunio/serializer"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/comm/memory"
+)
+
+const (
+	aliceCommAddr = "alice"
+	bobCommAddr   = "bob"
+)
+
+var (
+	ser = pperunioserializer.Serializer()
+
+	aliceAddr, bobAddr pwire.Address
+)
+
+func init() {
+	var err error
+	wb := ethereum.NewWalletBackend()
+	if aliceAddr, err = wb.ParseAddr("0x9282681723920798983380581376586951466585"); err != nil {
+		panic(err)
+	}
+	if bobAddr, err = wb.ParseAddr("0x3369783337071807248093730889602727505701"); err != nil {
+		panic(err)
+	}
+}
+
+func Test_CommBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.CommBackend)(nil), new(memory.Backend))
+}
+
+func Test_Backend(t *testing.T) {
+	t.Run("happy_dial_accept_send_recv", func(t *testing.T) {
+		aliceConn, bobConn := newConnPairT(t, memory.NewHub())
+
+		require.NoError(t, aliceConn.Send(newPing(aliceAddr, bobAddr)))
+		require.NoError(t, aliceConn.Send(newPing(aliceAddr, bobAddr)))
+		assertRecvPing(t, bobConn, aliceAddr)
+		assertRecvPing(t, bobConn, aliceAddr)
+
+		require.NoError(t, bobConn.Send(newPing(bobAddr, aliceAddr)))
+		assertRecvPing(t, aliceConn, bobAddr)
+	})
+
+	t.Run("happy_close_delivers_sent_messages", func(t *testing.T) {
+		aliceConn, bobConn := newConnPairT(t, memory.NewHub())
+
+		require.NoError(t, aliceConn.Send(newPing(aliceAddr, bobAddr)))
+		require.NoError(t, aliceConn.Close())
+		assert.Error(t, aliceConn.Close())
+		assertRecvPing(t, bobConn, aliceAddr)
+		_, err := bobConn.Recv()
+		assert.Error(t, err)
+		assert.Error(t, bobConn.Send(newPing(bobAddr, aliceAddr)))
+		assert.NoError(t, bobConn.Close())
+	})
+
+	t.Run("listener_addr_in_use", func(t *testing.T) {
+		hub := memory.NewHub()
+		listener, err := memory.NewMemoryBackend(hub).NewListener(bobCommAddr)
+		require.NoError(t, err)
+		_, err = memory.NewMemoryBackend(hub).NewListener(bobCommAddr)
+		require.Error(t, err)
+		t.Log(err)
+
+		// Address can be used again after the listener is closed.
+		require.NoError(t, listener.Close())
+		assert.Error(t, listener.Close())
+		listener, err = memory.NewMemoryBackend(hub).NewListener(bobCommAddr)
+		require.NoError(t, err)
+		require.NoError(t, listener.Close())
+	})
+
+	t.Run("dial_no_listener", func(t *testing.T) {
+		dialer := memory.NewMemoryBackend(memory.NewHub()).NewDialer()
+		dialer.Register(bobAddr, bobCommAddr)
+		_, err := dialer.Dial(context.Background(), bobAddr, ser)
+		require.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("dial_unknown_peer", func(t *testing.T) {
+		dialer := memory.NewMemoryBackend(memory.NewHub()).NewDialer()
+		_, err := dialer.Dial(context.Background(), bobAddr, ser)
+		require.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func Test_Hub_Latency(t *testing.T) {
+	latency := 50 * time.Millisecond
+	hub := memory.NewHub()
+	hub.SetLatency(latency)
+	aliceConn, bobConn := newConnPairT(t, hub)
+
+	start := time.Now()
+	require.NoError(t, aliceConn.Send(newPing(aliceAddr, bobAddr)))
+	assertRecvPing(t, bobConn, aliceAddr)
+	assert.GreaterOrEqual(t, time.Since(start), latency)
+}
+
+func Test_Hub_DropRate(t *testing.T) {
+	// countReceived sends 100 pings at the given drop rate, followed by a pong
+	// that is not dropped and returns the number of pings received.
+	countReceived := func(hub *memory.Hub, aliceConn, bobConn pnet.Conn, rate float64) int {
+		hub.SetDropRate(rate)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, aliceConn.Send(newPing(aliceAddr, bobAddr)))
+		}
+		hub.SetDropRate(0)
+		require.NoError(t, aliceConn.Send(&pwire.Envelope{Sender: aliceAddr, Recipient: bobAddr, Msg: pwire.NewPongMsg()}))
+
+		count := 0
+		for {
+			env, err := bobConn.Recv()
+			require.NoError(t, err)
+			if env.Msg.Type() == pwire.Pong {
+				return count
+			}
+			count++
+		}
+	}
+
+	hub := memory.NewHub()
+	aliceConn, bobConn := newConnPairT(t, hub)
+	assert.Equal(t, 0, countReceived(hub, aliceConn, bobConn, 1))
+	assert.Equal(t, 100, countReceived(hub, aliceConn, bobConn, 0))
+	received := countReceived(hub, aliceConn, bobConn, 0.5)
+	assert.Greater(t, received, 0)
+	assert.Less(t, received, 100)
+
+	// Same messages are dropped each time the rate is set, irrespective of the hub.
+	assert.Equal(t, received, countReceived(hub, aliceConn, bobConn, 0.5))
+	otherHub := memory.NewHub()
+	aliceConn, bobConn = newConnPairT(t, otherHub)
+	assert.Equal(t, received, countReceived(otherHub, aliceConn, bobConn, 0.5))
+}
+
+func Test_Hub_Partition(t *testing.T) {
+	hub := memory.NewHub()
+	aliceConn, bobConn := newConnPairT(t, hub)
+
+	carolBackend := memory.NewMemoryBackend(hub)
+	carolListener, err := carolBackend.NewListener("carol")
+	require.NoError(t, err)
+	t.Cleanup(func() { carolListener.Close() }) //nolint:errcheck,gosec
+
+	hub.Partition(bobCommAddr, aliceCommAddr)
+	hub.Partition("carol", bobCommAddr)
+	// Messages on existing connection are dropped.
+	require.NoError(t, aliceConn.Send(newPing(aliceAddr, bobAddr)))
+	// New connections cannot be dialed.
+	dialer := carolBackend.NewDialer()
+	dialer.Register(bobAddr, bobCommAddr)
+	_, err = dialer.Dial(context.Background(), bobAddr, ser)
+	require.Error(t, err)
+	t.Log(err)
+
+	hub.Heal(aliceCommAddr, bobCommAddr)
+	pong := &pwire.Envelope{Sender: aliceAddr, Recipient: bobAddr, Msg: pwire.NewPongMsg()}
+	require.NoError(t, aliceConn.Send(pong))
+	// Only the message sent after healing is received.
+	env, err := bobConn.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pwire.Pong, env.Msg.Type())
+
+	hub.Partition(bobCommAddr, aliceCommAddr)
+	hub.HealAll()
+	require.NoError(t, aliceConn.Send(newPing(aliceAddr, bobAddr)))
+	assertRecvPing(t, bobConn, aliceAddr)
+}
+
+// newConnPairT initializes a listener for bob and a dialer for alice using the
+// hub and returns the connections at both the ends, after alice dials bob.
+func newConnPairT(t *testing.T, hub *memory.Hub) (aliceConn, bobConn pnet.Conn) {
+	t.Helper()
+	bobListener, err := memory.NewMemoryBackend(hub).NewListener(bobCommAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() { bobListener.Close() }) //nolint:errcheck,gosec
+
+	aliceBackend := memory.NewMemoryBackend(hub)
+	aliceListener, err := aliceBackend.NewListener(aliceCommAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() { aliceListener.Close() }) //nolint:errcheck,gosec
+	dialer := aliceBackend.NewDialer()
+	dialer.Register(bobAddr, bobCommAddr)
+
+	accepted := make(chan pnet.Conn, 1)
+	go func() {
+		conn, acceptErr := bobListener.Accept(ser)
+		assert.NoError(t, acceptErr)
+		accepted <- conn
+	}()
+	aliceConn, err = dialer.Dial(context.Background(), bobAddr, ser)
+	require.NoError(t, err)
+	bobConn = <-accepted
+	require.NotNil(t, bobConn)
+	return aliceConn, bobConn
+}
+
+func newPing(sender, recipient pwire.Address) *pwire.Envelope {
+	return &pwire.Envelope{Sender: sender, Recipient: recipient, Msg: pwire.NewPingMsg()}
+}
+
+func assertRecvPing(t *testing.T, conn pnet.Conn, sender pwire.Address) {
+	t.Helper()
+	env, err := conn.Recv()
+	require.NoError(t, err)
+	assert.True(t, env.Sender.Equal(sender))
+	assert.Equal(t, pwire.Ping, env.Msg.Type())
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pkgsync "polycry.pt/poly-go/sync"
+)
+
+// dialer is a lookup-table based dialer that can dial known peers via the
+// relay servers at which they are registered. New peer addresses can be added
+// via Register().
+type dialer struct {
+	mutex sync.RWMutex               // Protects peers.
+	peers map[pwallet.AddrKey]string // Addresses of the relays for the known peers.
+
+	netDialer net.Dialer // Used to dial connections to the relays.
+
+	pkgsync.Closer
+}
+
+var _ pnet.Dialer = (*dialer)(nil)
+
+func newDialer(timeout time.Duration) *dialer {
+	return &dialer{
+		peers:     make(map[pwallet.AddrKey]string),
+		netDialer: net.Dialer{Timeout: timeout},
+	}
+}
+
+func (d *dialer) relayAddr(key pwallet.AddrKey) (string, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	relayAddr, ok := d.peers[key]
+	return relayAddr, ok
+}
+
+// Dial implements pnet.Dialer.Dial.
+func (d *dialer) Dial(ctx context.Context, addr pwire.Address, ser pwire.EnvelopeSerializer) (pnet.Conn, error) {
+	done := make(chan struct{})
+	defer close(done)
+
+	relayAddr, ok := d.relayAddr(pwallet.Key(addr))
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+
+	// Combine the provided context with the dialer's closer, so that dialing
+	// is aborted when the dialer is closed.
+	wrappedCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+
+		select {
+		case <-d.Closed():
+		case <-done:
+		}
+	}()
+
+	conn, err := d.netDialer.DialContext(wrappedCtx, "tcp", relayAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial relay")
+	}
+	if err = requestConn(wrappedCtx, conn, addr); err != nil {
+		conn.Close() //nolint:errcheck,gosec // Error in dialing is returned.
+		return nil, errors.WithMessage(err, "failed to dial peer via relay")
+	}
+	return pnet.NewIoConn(conn, ser), nil
+}
+
+// requestConn requests the relay for a connection to the peer. The request is
+// aborted if the context is done.
+func requestConn(ctx context.Context, conn net.Conn, addr pwire.Address) error {
+	conn.SetDeadline(time.Now().Add(handshakeTimeout)) //nolint:errcheck,gosec // Handshake fails if not set.
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Now()) //nolint:errcheck,gosec // Aborts the pending read or write.
+		case <-stop:
+		}
+	}()
+
+	err := writeLine(conn, cmdDial, addr.String())
+	if err == nil {
+		err = readResponse(conn)
+	}
+	close(stop)
+	<-stopped
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return errors.Wrap(ctxErr, "dialing aborted")
+	}
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(conn.SetDeadline(time.Time{}), "resetting deadline")
+}
+
+// Register registers the relay address for a peer address.
+func (d *dialer) Register(addr pwire.Address, relayAddr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.peers[pwallet.Key(addr)] = relayAddr
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package relay implements an off-chain communication backend, where the
+// connections between the nodes are relayed through a relay server. It is
+// useful for nodes that cannot accept incoming connections, for example when
+// they are behind a NAT.
+//
+// Each node keeps an outbound control connection to a relay server, using
+// which it is registered at the relay for its off-chain address. The comm
+// address of such a node is the address (host:port) of the relay server.
+// Other nodes dial it by connecting to the relay and requesting a connection
+// to its off-chain address. The relay then notifies the node over the control
+// connection, the node opens a new connection to the relay for accepting it,
+// and the relay forwards the data between the two connections.
+//
+// The relay protocol is line based. Each message is a single line of text,
+// terminated by a newline character:
+//
+//	Listener (node being dialed)          Relay
+//	  LISTEN <off-chain addr>       ->
+//	                                <-    CHALLENGE <hex nonce>
+//	  SIG <hex signature on nonce>  ->
+//	                                <-    OK | ERR <message>
+//	                                <-    CONNECT <conn id> | PING (repeatedly)
+//	  ACCEPT <conn id>              ->    (on a new connection, followed by data)
+//
+//	Dialer                                Relay
+//	  DIAL <off-chain addr>         ->
+//	                                <-    OK | ERR <message> (OK is followed by data)
+//
+// The listener signs the nonce using the account for its off-chain address,
+// so that only the owner of an off-chain address can register for it at the
+// relay. The relay does not read or modify the data that it forwards.
+package relay
@@ -0,0 +1,219 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"encoding/hex"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+const (
+	// minReconnectDelay and maxReconnectDelay are the bounds of the delay
+	// between the attempts to re-connect to the relay. The delay is doubled
+	// after each failed attempt.
+	minReconnectDelay = 1 * time.Second
+	maxReconnectDelay = 30 * time.Second
+
+	// controlConnTimeout is the duration after which the control connection is
+	// considered broken, if nothing was received from the relay.
+	controlConnTimeout = 3 * pingInterval
+)
+
+// listener accepts the connections relayed by a relay server. It keeps a
+// control connection to the relay, over which it is notified of the incoming
+// connections.
+type listener struct {
+	relayAddr string
+	acc       pwallet.Account
+	timeout   time.Duration
+	accepted  chan net.Conn
+
+	mutex       sync.Mutex
+	controlConn net.Conn
+	isClosed    bool
+	closed      chan struct{}
+}
+
+var _ pnet.Listener = (*listener)(nil)
+
+func newListener(relayAddr string, acc pwallet.Account, timeout time.Duration) (*listener, error) {
+	l := &listener{
+		relayAddr: relayAddr,
+		acc:       acc,
+		timeout:   timeout,
+		accepted:  make(chan net.Conn),
+		closed:    make(chan struct{}),
+	}
+	conn, err := l.register()
+	if err != nil {
+		return nil, errors.WithMessage(err, "registering at relay")
+	}
+	l.controlConn = conn
+	go l.run(conn)
+	return l, nil
+}
+
+// register connects to the relay and registers the listener for the off-chain
+// address of its account.
+func (l *listener) register() (net.Conn, error) {
+	conn, err := net.DialTimeout("tcp", l.relayAddr, l.timeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to relay")
+	}
+	if err = l.authenticate(conn); err != nil {
+		conn.Close() //nolint:errcheck,gosec // Error in authentication is returned.
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{}) //nolint:errcheck,gosec // Read deadline is set before each read.
+	return conn, nil
+}
+
+func (l *listener) authenticate(conn net.Conn) error {
+	conn.SetDeadline(time.Now().Add(handshakeTimeout)) //nolint:errcheck,gosec // Handshake fails if not set.
+	if err := writeLine(conn, cmdListen, l.acc.Address().String()); err != nil {
+		return err
+	}
+	cmd, nonce, err := readLine(conn)
+	if err != nil {
+		return err
+	}
+	if cmd == cmdErr {
+		return errors.New("relay: " + nonce)
+	}
+	if cmd != cmdChallenge {
+		return errors.New("unexpected response from relay: " + cmd)
+	}
+	sig, err := l.acc.SignData(challengeMsg(nonce))
+	if err != nil {
+		return errors.Wrap(err, "signing challenge")
+	}
+	if err = writeLine(conn, cmdSig, hex.EncodeToString(sig)); err != nil {
+		return err
+	}
+	return readResponse(conn)
+}
+
+// run serves the control connection and re-connects to the relay, each time
+// the connection is lost, until the listener is closed.
+func (l *listener) run(conn net.Conn) {
+	for {
+		l.serve(conn)
+		if conn = l.reconnect(); conn == nil {
+			return
+		}
+	}
+}
+
+// reconnect registers at the relay again, retrying with increasing delays
+// until it succeeds. It returns nil, if the listener is closed.
+func (l *listener) reconnect() net.Conn {
+	delay := minReconnectDelay
+	for {
+		select {
+		case <-l.closed:
+			return nil
+		case <-time.After(delay):
+		}
+		if conn, err := l.register(); err == nil {
+			return conn
+		}
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// serve reads the notifications on the control connection and accepts the
+// incoming connections. It returns when the control connection is broken or
+// the listener is closed.
+func (l *listener) serve(conn net.Conn) {
+	if !l.setControlConn(conn) {
+		return
+	}
+	defer conn.Close() //nolint:errcheck
+	for {
+		conn.SetReadDeadline(time.Now().Add(controlConnTimeout)) //nolint:errcheck,gosec // Read fails if not set.
+		cmd, connID, err := readLine(conn)
+		if err != nil {
+			return
+		}
+		if cmd == cmdConnect {
+			go l.accept(connID)
+		}
+	}
+}
+
+// accept opens a new connection to the relay for accepting the incoming
+// connection with the given ID and passes it on to the Accept call.
+func (l *listener) accept(connID string) {
+	conn, err := net.DialTimeout("tcp", l.relayAddr, l.timeout)
+	if err != nil {
+		return
+	}
+	if err = writeLine(conn, cmdAccept, connID); err != nil {
+		conn.Close() //nolint:errcheck,gosec // Connection is discarded.
+		return
+	}
+	select {
+	case l.accepted <- conn:
+	case <-l.closed:
+		conn.Close() //nolint:errcheck,gosec // Listener is closed, connection is discarded.
+	}
+}
+
+// setControlConn sets the control connection, so that it can be closed when
+// the listener is closed. It returns false, if the listener is already closed.
+func (l *listener) setControlConn(conn net.Conn) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.isClosed {
+		conn.Close() //nolint:errcheck,gosec // Listener is closed.
+		return false
+	}
+	l.controlConn = conn
+	return true
+}
+
+// Accept implements pnet.Listener.Accept.
+func (l *listener) Accept(ser pwire.EnvelopeSerializer) (pnet.Conn, error) {
+	select {
+	case conn := <-l.accepted:
+		return pnet.NewIoConn(conn, ser), nil
+	case <-l.closed:
+		return nil, errors.New("accept failed: listener closed")
+	}
+}
+
+// Close implements pnet.Listener.Close. It closes the control connection, so
+// that the listener is unregistered at the relay.
+func (l *listener) Close() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.isClosed {
+		return errors.New("already closed")
+	}
+	l.isClosed = true
+	close(l.closed)
+	return errors.Wrap(l.controlConn.Close(), "closing control connection")
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Commands used in the relay protocol.
+const (
+	cmdListen    = "LISTEN"
+	cmdChallenge = "CHALLENGE"
+	cmdSig       = "SIG"
+	cmdConnect   = "CONNECT"
+	cmdPing      = "PING"
+	cmdAccept    = "ACCEPT"
+	cmdDial      = "DIAL"
+	cmdOK        = "OK"
+	cmdErr       = "ERR"
+)
+
+const (
+	// maxLineLength is the maximum length of a line in the relay protocol.
+	maxLineLength = 1024
+
+	// nonceLength is the length of the nonces used as challenges and connection IDs.
+	nonceLength = 16
+)
+
+// writeLine writes the command and its argument (if any) as one line.
+func writeLine(w io.Writer, cmd string, arg string) error {
+	line := cmd
+	if arg != "" {
+		line += " " + arg
+	}
+	_, err := io.WriteString(w, line+"\n")
+	return errors.Wrap(err, "writing to relay connection")
+}
+
+// readLine reads one line and returns the command and its argument (if any).
+//
+// The line is read one byte at a time, so that no data following the line is
+// consumed. This is required because the connection is used for forwarding
+// data after the protocol messages are exchanged.
+func readLine(r io.Reader) (cmd, arg string, err error) {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		if _, err = io.ReadFull(r, b); err != nil {
+			return "", "", errors.Wrap(err, "reading from relay connection")
+		}
+		if b[0] == '\n' {
+			break
+		}
+		if len(line) == maxLineLength {
+			return "", "", errors.New("line too long in relay protocol")
+		}
+		line = append(line, b[0])
+	}
+	cmd, arg, _ = strings.Cut(string(line), " ")
+	return cmd, arg, nil
+}
+
+// readResponse reads a line and returns nil if it is OK. If it is ERR, an
+// error with the message in it is returned.
+func readResponse(r io.Reader) error {
+	cmd, arg, err := readLine(r)
+	if err != nil {
+		return err
+	}
+	switch cmd {
+	case cmdOK:
+		return nil
+	case cmdErr:
+		return errors.New("relay: " + arg)
+	default:
+		return errors.New("unexpected response from relay: " + cmd)
+	}
+}
+
+// newNonce returns a random nonce encoded as hex string.
+func newNonce() (string, error) {
+	nonce := make([]byte, nonceLength)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "generating nonce")
+	}
+	return hex.EncodeToString(nonce), nil
+}
+
+// addrKey returns the key used for identifying an off-chain address in the
+// relay. It is case insensitive, so that the different representations of
+// hex encoded addresses are treated as equal.
+func addrKey(offChainAddr string) string {
+	return strings.ToLower(offChainAddr)
+}
+
+// challengeMsg returns the message to be signed by a listener for proving
+// the ownership of its off-chain address. The nonce is prefixed, so that the
+// signature cannot be used in any other context.
+func challengeMsg(nonce string) []byte {
+	return []byte("perun-node relay challenge: " + nonce)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"time"
+
+	pwallet "perun.network/go-perun/wallet"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Backend is an off-chain communication backend that implements `CommBackend` for
+// connections relayed through a relay server. It stores configuration required for
+// initializing the adapters.
+type Backend struct {
+	// account for the off-chain address of the user, used for registering at the relay.
+	acc pwallet.Account
+	// timeout to be used when dialing for new outgoing connections.
+	dialerTimeout time.Duration
+}
+
+// NewListener returns a listener that registers at the relay server at the
+// specified address and accepts the incoming connections relayed by it.
+//
+// An error is returned if the registration at the relay fails. If the
+// connection to the relay is lost afterwards, the listener re-connects.
+func (b Backend) NewListener(relayAddr string) (pnet.Listener, error) {
+	return newListener(relayAddr, b.acc, b.dialerTimeout)
+}
+
+// NewDialer returns a dialer that can dial outgoing connections via the relay
+// servers. The comm address registered for each peer should be the address of
+// the relay server at which the peer is registered.
+//
+// It uses the dial timeout configured during backend initialization.
+// If the duration was set to zero, this program will not use any timeout.
+// However default timeouts based on the operating system will still apply.
+func (b Backend) NewDialer() perun.Dialer {
+	return newDialer(b.dialerTimeout)
+}
+
+// NewRelayBackend returns a backend that can initialize off-chain communication
+// adapters for connections relayed through a relay server.
+//
+// The account should be the one corresponding to the off-chain address of the
+// user. It is used for proving the ownership of the address to the relay.
+//
+// The provided dialerTimeout will be used when dialing for new outgoing connections.
+// If the duration was set to zero, this program will not use any timeout.
+// However default timeouts based on the operating system will still apply.
+func NewRelayBackend(acc pwallet.Account, dialerTimeout time.Duration) Backend {
+	return Backend{acc: acc, dialerTimeout: dialerTimeout}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pperunioserializer "perun.network/go-perun/wire/perunio/serializer"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/relay"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+var ser = pperunioserializer.Serializer()
+
+func Test_CommBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.CommBackend)(nil), new(relay.Backend))
+}
+
+func Test_Relay(t *testing.T) {
+	rng := rand.New(rand.NewSource(1729))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 3)
+	alice, bob, tom := ws.Accs[0], ws.Accs[1], ws.Accs[2]
+	relayAddr := newRelayT(t)
+
+	bobListener, err := relay.NewRelayBackend(bob, tcptest.DialerTimeout).NewListener(relayAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() { bobListener.Close() }) //nolint:errcheck,gosec
+
+	t.Run("happy_dial_accept_send_recv", func(t *testing.T) {
+		dialer := relay.NewRelayBackend(alice, tcptest.DialerTimeout).NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(bob.Address(), relayAddr)
+
+		accepted := make(chan pnet.Conn, 1)
+		go func() {
+			conn, acceptErr := bobListener.Accept(ser)
+			assert.NoError(t, acceptErr)
+			accepted <- conn
+		}()
+		dialedConn, err := dialer.Dial(context.Background(), bob.Address(), ser)
+		require.NoError(t, err)
+		defer dialedConn.Close() //nolint:errcheck
+		acceptedConn := <-accepted
+		require.NotNil(t, acceptedConn)
+		defer acceptedConn.Close() //nolint:errcheck
+
+		env := &pwire.Envelope{Sender: alice.Address(), Recipient: bob.Address(), Msg: pwire.NewPingMsg()}
+		require.NoError(t, dialedConn.Send(env))
+		gotEnv, err := acceptedConn.Recv()
+		require.NoError(t, err)
+		assert.True(t, gotEnv.Sender.Equal(alice.Address()))
+		assert.Equal(t, pwire.Ping, gotEnv.Msg.Type())
+
+		env = &pwire.Envelope{Sender: bob.Address(), Recipient: alice.Address(), Msg: pwire.NewPongMsg()}
+		require.NoError(t, acceptedConn.Send(env))
+		gotEnv, err = dialedConn.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, pwire.Pong, gotEnv.Msg.Type())
+
+		require.NoError(t, acceptedConn.Close())
+		_, err = dialedConn.Recv()
+		assert.Error(t, err)
+	})
+
+	t.Run("dial_peer_not_registered", func(t *testing.T) {
+		dialer := relay.NewRelayBackend(alice, tcptest.DialerTimeout).NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(tom.Address(), relayAddr)
+
+		_, err := dialer.Dial(context.Background(), tom.Address(), ser)
+		require.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("dial_unknown_peer", func(t *testing.T) {
+		dialer := relay.NewRelayBackend(alice, tcptest.DialerTimeout).NewDialer()
+		defer dialer.Close() //nolint:errcheck
+
+		_, err := dialer.Dial(context.Background(), bob.Address(), ser)
+		require.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("listen_invalid_signature", func(t *testing.T) {
+		// Try to register for bob's address with a signature from tom.
+		conn, err := net.Dial("tcp", relayAddr)
+		require.NoError(t, err)
+		defer conn.Close() //nolint:errcheck
+		_, err = fmt.Fprintf(conn, "LISTEN %s\n", bob.Address())
+		require.NoError(t, err)
+		var nonce string
+		_, err = fmt.Fscanf(conn, "CHALLENGE %s\n", &nonce)
+		require.NoError(t, err)
+		sig, err := tom.SignData([]byte("perun-node relay challenge: " + nonce))
+		require.NoError(t, err)
+		_, err = fmt.Fprintf(conn, "SIG %s\n", hex.EncodeToString(sig))
+		require.NoError(t, err)
+		var response string
+		_, err = fmt.Fscanf(conn, "%s", &response)
+		require.NoError(t, err)
+		assert.Equal(t, "ERR", response)
+	})
+
+	t.Run("listener_closed", func(t *testing.T) {
+		listener, err := relay.NewRelayBackend(tom, tcptest.DialerTimeout).NewListener(relayAddr)
+		require.NoError(t, err)
+		acceptErr := make(chan error, 1)
+		go func() {
+			_, err := listener.Accept(ser)
+			acceptErr <- err
+		}()
+		require.NoError(t, listener.Close())
+		select {
+		case err = <-acceptErr:
+			assert.Error(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("accept was not aborted when listener was closed")
+		}
+		assert.Error(t, listener.Close())
+
+		// Listener is unregistered at the relay.
+		dialer := relay.NewRelayBackend(alice, tcptest.DialerTimeout).NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(tom.Address(), relayAddr)
+		assert.Eventually(t, func() bool {
+			_, err = dialer.Dial(context.Background(), tom.Address(), ser)
+			return err != nil
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("relay_not_reachable", func(t *testing.T) {
+		port, err := freeport.GetFreePort()
+		require.NoError(t, err)
+		_, err = relay.NewRelayBackend(tom, tcptest.DialerTimeout).NewListener(fmt.Sprintf("127.0.0.1:%d", port))
+		require.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func Test_Listener_Reconnect(t *testing.T) {
+	rng := rand.New(rand.NewSource(1729))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 2)
+	alice, bob := ws.Accs[0], ws.Accs[1]
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	relayAddr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	server := relay.NewServer(ethereum.NewWalletBackend(), log.NewLoggerWithField("relay", 1))
+	netListener, err := net.Listen("tcp", relayAddr)
+	require.NoError(t, err)
+	go server.Serve(netListener) //nolint:errcheck
+
+	bobListener, err := relay.NewRelayBackend(bob, tcptest.DialerTimeout).NewListener(relayAddr)
+	require.NoError(t, err)
+	defer bobListener.Close() //nolint:errcheck
+	go func() {
+		for {
+			conn, err := bobListener.Accept(ser)
+			if err != nil {
+				return
+			}
+			conn.Close() //nolint:errcheck,gosec
+		}
+	}()
+
+	// Restart the relay, listener should re-register.
+	require.NoError(t, server.Close())
+	server = relay.NewServer(ethereum.NewWalletBackend(), log.NewLoggerWithField("relay", 2))
+	netListener, err = net.Listen("tcp", relayAddr)
+	require.NoError(t, err)
+	go server.Serve(netListener) //nolint:errcheck
+	defer server.Close()         //nolint:errcheck
+
+	dialer := relay.NewRelayBackend(alice, tcptest.DialerTimeout).NewDialer()
+	defer dialer.Close() //nolint:errcheck
+	dialer.Register(bob.Address(), relayAddr)
+	assert.Eventually(t, func() bool {
+		conn, err := dialer.Dial(context.Background(), bob.Address(), ser)
+		if err != nil {
+			return false
+		}
+		conn.Close() //nolint:errcheck,gosec
+		return true
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+// newRelayT starts a relay server and returns its address. The server is
+// closed when the test completes.
+func newRelayT(t *testing.T) string {
+	t.Helper()
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	relayAddr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	server := relay.NewServer(ethereum.NewWalletBackend(), log.NewLoggerWithField("relay", relayAddr))
+	netListener, err := net.Listen("tcp", relayAddr)
+	require.NoError(t, err)
+	go server.Serve(netListener) //nolint:errcheck
+	t.Cleanup(func() {
+		if err = server.Close(); err != nil {
+			t.Log("Error closing relay server - ", err)
+		}
+	})
+	return relayAddr
+}
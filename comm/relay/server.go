@@ -0,0 +1,394 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"encoding/hex"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// handshakeTimeout is the time allowed for completing the protocol
+	// messages on a connection, before the data is forwarded.
+	handshakeTimeout = 15 * time.Second
+
+	// acceptTimeout is the time the relay waits for a listener to accept an
+	// incoming connection. It should be less than handshakeTimeout.
+	acceptTimeout = 10 * time.Second
+
+	// pingInterval is the interval at which the relay sends pings on the
+	// control connections, to keep them alive.
+	pingInterval = 30 * time.Second
+)
+
+type (
+	// Server is a relay server that forwards the connections between the nodes.
+	// See the package documentation for details.
+	Server struct {
+		walletBackend perun.WalletBackend
+		log.Logger
+
+		mutex       sync.Mutex
+		netListener net.Listener
+		listeners   map[string]*controlConn  // Control connections of the registered listeners, by address key.
+		pending     map[string]chan net.Conn // Connections waiting to be accepted by listeners, by connection ID.
+		conns       map[net.Conn]struct{}    // All open connections.
+		closed      chan struct{}
+		isClosed    bool
+	}
+
+	// controlConn is the control connection of a listener registered at the relay.
+	controlConn struct {
+		net.Conn
+		writeMtx sync.Mutex
+	}
+)
+
+// NewServer returns a relay server. The wallet backend is used for parsing the
+// off-chain addresses and verifying the signatures of the listeners.
+func NewServer(wb perun.WalletBackend, logger log.Logger) *Server {
+	return &Server{
+		walletBackend: wb,
+		Logger:        logger,
+		listeners:     make(map[string]*controlConn),
+		pending:       make(map[string]chan net.Conn),
+		conns:         make(map[net.Conn]struct{}),
+		closed:        make(chan struct{}),
+	}
+}
+
+// ListenAndServe listens for connections at the given address and serves them.
+// It returns when the server is closed.
+func (s *Server) ListenAndServe(addr string) error {
+	netListener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Wrap(err, "initializing listener")
+	}
+	return s.Serve(netListener)
+}
+
+// Serve serves the connections accepted by the listener. It returns when the
+// server is closed, in which case the error is nil; or when the listener
+// returns an error.
+func (s *Server) Serve(netListener net.Listener) error {
+	s.mutex.Lock()
+	if s.isClosed {
+		s.mutex.Unlock()
+		return errors.New("server closed")
+	}
+	s.netListener = netListener
+	s.mutex.Unlock()
+
+	for {
+		conn, err := netListener.Accept()
+		if err != nil {
+			select {
+			case <-s.closed:
+				return nil
+			default:
+				return errors.Wrap(err, "accepting connection")
+			}
+		}
+		go s.handle(conn)
+	}
+}
+
+// Close stops the server and closes all the open connections.
+func (s *Server) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isClosed {
+		return errors.New("server already closed")
+	}
+	s.isClosed = true
+	close(s.closed)
+
+	var err error
+	if s.netListener != nil {
+		err = errors.Wrap(s.netListener.Close(), "closing listener")
+	}
+	for conn := range s.conns {
+		conn.Close() //nolint:errcheck,gosec // Server is being closed.
+	}
+	return err
+}
+
+func (s *Server) handle(conn net.Conn) {
+	if !s.track(conn) {
+		conn.Close() //nolint:errcheck,gosec // Server is closed.
+		return
+	}
+	conn.SetDeadline(time.Now().Add(handshakeTimeout)) //nolint:errcheck,gosec // Handshake fails if not set.
+	cmd, arg, err := readLine(conn)
+	if err != nil {
+		s.untrack(conn)
+		return
+	}
+	switch cmd {
+	case cmdListen:
+		s.handleListen(conn, arg)
+	case cmdDial:
+		s.handleDial(conn, arg)
+	case cmdAccept:
+		s.handleAccept(conn, arg)
+	default:
+		writeLine(conn, cmdErr, "unknown command") //nolint:errcheck,gosec // Connection is closed anyways.
+		s.untrack(conn)
+	}
+}
+
+// handleListen authenticates the listener and registers the connection as its
+// control connection. It returns when the control connection is closed.
+func (s *Server) handleListen(conn net.Conn, addrString string) {
+	defer s.untrack(conn)
+	addr, err := s.walletBackend.ParseAddr(addrString)
+	if err != nil {
+		writeLine(conn, cmdErr, "invalid off-chain address") //nolint:errcheck,gosec // Connection is closed.
+		return
+	}
+	if err = s.verifyListener(conn, addr); err != nil {
+		s.WithField("address", addrString).Info("Rejected listener:", err)
+		writeLine(conn, cmdErr, "authentication failed") //nolint:errcheck,gosec // Connection is closed.
+		return
+	}
+
+	cc := &controlConn{Conn: conn}
+	if err = cc.writeLine(cmdOK, ""); err != nil {
+		return
+	}
+	conn.SetDeadline(time.Time{}) //nolint:errcheck,gosec // Connection is closed by keep alive, if broken.
+	key := addrKey(addr.String())
+	s.register(key, cc)
+	defer s.unregister(key, cc)
+	s.WithField("address", addr.String()).Info("Registered listener")
+
+	done := make(chan struct{})
+	defer close(done)
+	go s.keepAlive(cc, done)
+	// The listener does not send anything after registration. So, reading
+	// returns only when the connection is closed.
+	io.Copy(io.Discard, conn) //nolint:errcheck,gosec // Connection is closed in either case.
+	s.WithField("address", addr.String()).Info("Listener disconnected")
+}
+
+// verifyListener sends a challenge to the listener and verifies its signature
+// on the challenge for the given address.
+func (s *Server) verifyListener(conn net.Conn, addr pwallet.Address) error {
+	nonce, err := newNonce()
+	if err != nil {
+		return err
+	}
+	if err = writeLine(conn, cmdChallenge, nonce); err != nil {
+		return err
+	}
+	cmd, sigHex, err := readLine(conn)
+	if err != nil {
+		return err
+	}
+	if cmd != cmdSig {
+		return errors.New("expected signature, got " + cmd)
+	}
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return errors.Wrap(err, "decoding signature")
+	}
+	isValid, err := pwallet.VerifySignature(challengeMsg(nonce), sig, addr)
+	if err != nil {
+		return errors.Wrap(err, "verifying signature")
+	}
+	if !isValid {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func (s *Server) keepAlive(cc *controlConn, done chan struct{}) {
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := cc.writeLine(cmdPing, ""); err != nil {
+				cc.Close() //nolint:errcheck,gosec // Connection is broken.
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// handleDial requests the listener for the address to accept a connection and
+// forwards the data between the two connections.
+func (s *Server) handleDial(conn net.Conn, addrString string) {
+	addr, err := s.walletBackend.ParseAddr(addrString)
+	if err != nil {
+		s.reject(conn, "invalid off-chain address")
+		return
+	}
+	cc := s.listener(addrKey(addr.String()))
+	if cc == nil {
+		s.reject(conn, "peer not connected to relay")
+		return
+	}
+
+	connID, err := newNonce()
+	if err != nil {
+		s.reject(conn, "internal error")
+		return
+	}
+	accepted := s.addPending(connID)
+	if err = cc.writeLine(cmdConnect, connID); err != nil {
+		s.removePending(connID)
+		s.reject(conn, "peer not reachable")
+		return
+	}
+
+	var peerConn net.Conn
+	select {
+	case peerConn = <-accepted:
+	case <-time.After(acceptTimeout):
+	case <-s.closed:
+	}
+	if peerConn == nil {
+		if !s.removePending(connID) {
+			// Connection was accepted after the timeout.
+			s.untrack(<-accepted)
+		}
+		s.reject(conn, "peer did not accept connection")
+		return
+	}
+
+	conn.SetDeadline(time.Time{}) //nolint:errcheck,gosec // Data is forwarded without deadline.
+	if err = writeLine(conn, cmdOK, ""); err != nil {
+		s.untrack(conn)
+		s.untrack(peerConn)
+		return
+	}
+	s.forward(conn, peerConn)
+}
+
+// handleAccept passes the connection to the dialer waiting for it.
+func (s *Server) handleAccept(conn net.Conn, connID string) {
+	s.mutex.Lock()
+	accepted, ok := s.pending[connID]
+	delete(s.pending, connID)
+	s.mutex.Unlock()
+	if !ok {
+		s.untrack(conn)
+		return
+	}
+	conn.SetDeadline(time.Time{}) //nolint:errcheck,gosec // Data is forwarded without deadline.
+	accepted <- conn
+}
+
+// forward copies the data between the two connections, until either of them
+// is closed.
+func (s *Server) forward(conn1, conn2 net.Conn) {
+	copyData := func(dst, src net.Conn) {
+		io.Copy(dst, src) //nolint:errcheck,gosec // Connections are closed in either case.
+		s.untrack(dst)
+		s.untrack(src)
+	}
+	go copyData(conn1, conn2)
+	copyData(conn2, conn1)
+}
+
+func (s *Server) reject(conn net.Conn, msg string) {
+	writeLine(conn, cmdErr, msg) //nolint:errcheck,gosec // Connection is closed anyways.
+	s.untrack(conn)
+}
+
+// track adds the connection to the list of open connections. It returns false,
+// if the server is closed.
+func (s *Server) track(conn net.Conn) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isClosed {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+// untrack closes the connection and removes it from the list of open
+// connections.
+func (s *Server) untrack(conn net.Conn) {
+	s.mutex.Lock()
+	delete(s.conns, conn)
+	s.mutex.Unlock()
+	conn.Close() //nolint:errcheck,gosec // Connection may already be closed.
+}
+
+// register registers the control connection for the address key. If a
+// listener was already registered for the address, it is disconnected, as
+// the owner of the address has re-connected.
+func (s *Server) register(key string, cc *controlConn) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if oldCC, ok := s.listeners[key]; ok {
+		oldCC.Close() //nolint:errcheck,gosec // Connection is replaced.
+	}
+	s.listeners[key] = cc
+}
+
+func (s *Server) unregister(key string, cc *controlConn) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.listeners[key] == cc {
+		delete(s.listeners, key)
+	}
+}
+
+func (s *Server) listener(key string) *controlConn {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.listeners[key]
+}
+
+func (s *Server) addPending(connID string) chan net.Conn {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	accepted := make(chan net.Conn, 1)
+	s.pending[connID] = accepted
+	return accepted
+}
+
+// removePending removes the pending connection. It returns false, if the
+// connection was already accepted.
+func (s *Server) removePending(connID string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, ok := s.pending[connID]
+	delete(s.pending, connID)
+	return ok
+}
+
+func (cc *controlConn) writeLine(cmd, arg string) error {
+	cc.writeMtx.Lock()
+	defer cc.writeMtx.Unlock()
+	return writeLine(cc.Conn, cmd, arg)
+}
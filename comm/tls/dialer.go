@@ -0,0 +1,135 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"context"
+	cryptotls "crypto/tls"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pkgsync "polycry.pt/poly-go/sync"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// dialer is a lookup-table based dialer that can dial known peers using tcp
+// protocol secured using TLS. New peer addresses can be added via Register().
+type dialer struct {
+	mutex sync.RWMutex               // Protects peers.
+	peers map[pwallet.AddrKey]string // Known peer addresses.
+
+	timeout  time.Duration
+	tlsCfg   *cryptotls.Config
+	idReader perun.IDReader
+
+	pkgsync.Closer
+}
+
+var _ pnet.Dialer = (*dialer)(nil)
+
+func newDialer(timeout time.Duration, tlsCfg *cryptotls.Config, idReader perun.IDReader) *dialer {
+	return &dialer{
+		peers:    make(map[pwallet.AddrKey]string),
+		timeout:  timeout,
+		tlsCfg:   tlsCfg,
+		idReader: idReader,
+	}
+}
+
+func (d *dialer) commAddr(key pwallet.AddrKey) (string, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	commAddr, ok := d.peers[key]
+	return commAddr, ok
+}
+
+// Dial implements pnet.Dialer.Dial.
+//
+// The TLS handshake is completed before returning the connection. If a
+// certificate fingerprint is pinned for the peer, the connection is established
+// only if the certificate presented by the peer matches it.
+func (d *dialer) Dial(ctx context.Context, addr pwire.Address, ser pwire.EnvelopeSerializer) (pnet.Conn, error) {
+	done := make(chan struct{})
+	defer close(done)
+
+	commAddr, ok := d.commAddr(pwallet.Key(addr))
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+
+	// Combine the provided context with the dialer's closer, so that dialing
+	// is aborted when the dialer is closed.
+	wrappedCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+
+		select {
+		case <-d.Closed():
+		case <-done:
+		}
+	}()
+
+	tlsDialer := cryptotls.Dialer{
+		NetDialer: &net.Dialer{Timeout: d.timeout},
+		Config:    d.tlsCfgForPeer(addr),
+	}
+	conn, err := tlsDialer.DialContext(wrappedCtx, "tcp", commAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial peer")
+	}
+	return pnet.NewIoConn(conn, ser), nil
+}
+
+// tlsCfgForPeer returns the TLS config for dialing the peer. If a certificate
+// fingerprint is pinned for the peer, the config includes a check for it.
+func (d *dialer) tlsCfgForPeer(addr pwire.Address) *cryptotls.Config {
+	if d.idReader == nil {
+		return d.tlsCfg
+	}
+	peerID, ok := d.idReader.ReadByOffChainAddr(addr)
+	if !ok || peerID.CommCertFingerprint == "" {
+		return d.tlsCfg
+	}
+
+	pinned := normalizeFingerprint(peerID.CommCertFingerprint)
+	tlsCfg := d.tlsCfg.Clone()
+	tlsCfg.VerifyConnection = func(cs cryptotls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("peer did not present a certificate")
+		}
+		if got := CertFingerprint(cs.PeerCertificates[0]); got != pinned {
+			return errors.Errorf("certificate fingerprint %s does not match the pinned fingerprint %s", got, pinned)
+		}
+		return nil
+	}
+	return tlsCfg
+}
+
+// Register registers a comm address for a peer address.
+func (d *dialer) Register(addr pwire.Address, commAddr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.peers[pwallet.Key(addr)] = commAddr
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"crypto/sha256"
+	cryptotls "crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	pnet "perun.network/go-perun/wire/net"
+	psimple "perun.network/go-perun/wire/net/simple"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Config defines the paths to the PEM encoded files required for establishing TLS connections.
+type Config struct {
+	CertFile   string // Certificate presented to the peers.
+	KeyFile    string // Private key corresponding to the certificate.
+	CACertFile string // Certificates of the CAs used for verifying the certificates presented by the peers.
+}
+
+// Backend is an off-chain communication backend that implements `CommBackend` for
+// tcp protocol secured using TLS. It stores configuration required for initializing the adapters.
+type Backend struct {
+	// timeout to be used when dialing for new outgoing connections.
+	dialerTimeout time.Duration
+
+	serverCfg *cryptotls.Config
+	clientCfg *cryptotls.Config
+
+	// used for retrieving the pinned certificate fingerprints of the peers.
+	idReader perun.IDReader
+}
+
+// NewListener returns a listener that can listen for incoming connections at
+// the specified address using tcp protocol secured using TLS.
+//
+// Only the peers that present a certificate issued by one of the configured
+// CAs are accepted.
+func (b Backend) NewListener(addr string) (pnet.Listener, error) {
+	netListener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "initializing listener")
+	}
+	return &psimple.Listener{Listener: cryptotls.NewListener(netListener, b.serverCfg)}, nil
+}
+
+// NewDialer returns a dialer that can dial outgoing connections using
+// tcp protocol secured using TLS.
+//
+// It uses the dial timeout configured during backend initialization.
+// If the duration was set to zero, this program will not use any timeout.
+// However default timeouts based on the operating system will still apply.
+func (b Backend) NewDialer() perun.Dialer {
+	return newDialer(b.dialerTimeout, b.clientCfg, b.idReader)
+}
+
+// NewTLSBackend returns a backend that can initialize off-chain communication
+// adapters for tcp protocol secured using TLS.
+//
+// The certificate, key and the CA certificates are loaded from the files
+// specified in the config. The idReader is used for retrieving the pinned
+// certificate fingerprint of a peer when dialing it. It can be nil, if
+// pinning is not used.
+//
+// The provided dialerTimeout will be used when dialing for new outgoing connections.
+// If the duration was set to zero, this program will not use any timeout.
+// However default timeouts based on the operating system will still apply.
+func NewTLSBackend(cfg Config, dialerTimeout time.Duration, idReader perun.IDReader) (Backend, error) {
+	cert, err := cryptotls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return Backend{}, errors.Wrap(err, "loading certificate and key")
+	}
+	caCerts, err := os.ReadFile(filepath.Clean(cfg.CACertFile))
+	if err != nil {
+		return Backend{}, errors.Wrap(err, "reading CA certificates")
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCerts) {
+		return Backend{}, errors.New("no valid CA certificate found in " + cfg.CACertFile)
+	}
+
+	return Backend{
+		dialerTimeout: dialerTimeout,
+		serverCfg: &cryptotls.Config{
+			Certificates: []cryptotls.Certificate{cert},
+			ClientAuth:   cryptotls.RequireAndVerifyClientCert,
+			ClientCAs:    caPool,
+			MinVersion:   cryptotls.VersionTLS12,
+		},
+		clientCfg: &cryptotls.Config{
+			Certificates: []cryptotls.Certificate{cert},
+			RootCAs:      caPool,
+			MinVersion:   cryptotls.VersionTLS12,
+		},
+		idReader: idReader,
+	}, nil
+}
+
+// CertFingerprint returns the fingerprint of the certificate, that can be used
+// for pinning it in the peer ID. It is the hex encoded SHA-256 hash of the DER
+// encoded certificate.
+func CertFingerprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(hash[:])
+}
+
+// normalizeFingerprint converts the fingerprint to lower case and removes the
+// colons (if any), so that fingerprints in either of the commonly used formats
+// can be compared.
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pperunioserializer "perun.network/go-perun/wire/perunio/serializer"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/comm/tls"
+	"github.com/hyperledger-labs/perun-node/comm/tls/tlstest"
+)
+
+var (
+	ser = pperunioserializer.Serializer()
+
+	alice = perun.PeerID{Alias: "alice", OffChainAddrString: "0x9282681723920798983380581376586951466585"}
+	bob   = perun.PeerID{Alias: "bob", OffChainAddrString: "0x3369783337071807248093730889602727505701"}
+)
+
+func init() {
+	var err error
+	wb := ethereum.NewWalletBackend()
+	if alice.OffChainAddr, err = wb.ParseAddr(alice.OffChainAddrString); err != nil {
+		panic(err)
+	}
+	if bob.OffChainAddr, err = wb.ParseAddr(bob.OffChainAddrString); err != nil {
+		panic(err)
+	}
+}
+
+func Test_CommBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.CommBackend)(nil), new(tls.Backend))
+}
+
+func Test_Backend(t *testing.T) {
+	ca := tlstest.NewCAT(t)
+	aliceCfg, _ := ca.NewConfigT(t, "alice")
+	bobCfg, bobFingerprint := ca.NewConfigT(t, "bob")
+
+	bobBackend, err := tls.NewTLSBackend(bobCfg, tcptest.DialerTimeout, nil)
+	require.NoError(t, err)
+	listenerAddr := newListenerT(t, bobBackend)
+
+	t.Run("happy_mutual_auth", func(t *testing.T) {
+		aliceBackend, err := tls.NewTLSBackend(aliceCfg, tcptest.DialerTimeout, nil)
+		require.NoError(t, err)
+		assertSendRecv(t, aliceBackend, listenerAddr)
+	})
+
+	t.Run("happy_pinned_fingerprint", func(t *testing.T) {
+		pinnedBob := bob
+		pinnedBob.CommCertFingerprint = bobFingerprint
+		aliceBackend, err := tls.NewTLSBackend(aliceCfg, tcptest.DialerTimeout, idReader{pinnedBob})
+		require.NoError(t, err)
+		assertSendRecv(t, aliceBackend, listenerAddr)
+	})
+
+	t.Run("pinned_fingerprint_mismatch", func(t *testing.T) {
+		_, otherFingerprint := ca.NewConfigT(t, "other")
+		pinnedBob := bob
+		pinnedBob.CommCertFingerprint = otherFingerprint
+		aliceBackend, err := tls.NewTLSBackend(aliceCfg, tcptest.DialerTimeout, idReader{pinnedBob})
+		require.NoError(t, err)
+		dialer := aliceBackend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(bob.OffChainAddr, listenerAddr)
+
+		_, err = dialer.Dial(context.Background(), bob.OffChainAddr, ser)
+		require.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("server_cert_from_unknown_ca", func(t *testing.T) {
+		otherCfg, _ := tlstest.NewCAT(t).NewConfigT(t, "bob")
+		otherBackend, err := tls.NewTLSBackend(otherCfg, tcptest.DialerTimeout, nil)
+		require.NoError(t, err)
+		otherListenerAddr := newListenerT(t, otherBackend)
+
+		aliceBackend, err := tls.NewTLSBackend(aliceCfg, tcptest.DialerTimeout, nil)
+		require.NoError(t, err)
+		dialer := aliceBackend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(bob.OffChainAddr, otherListenerAddr)
+
+		_, err = dialer.Dial(context.Background(), bob.OffChainAddr, ser)
+		require.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("client_cert_from_unknown_ca", func(t *testing.T) {
+		otherCfg, _ := tlstest.NewCAT(t).NewConfigT(t, "alice")
+		// Trust bob's CA, so that only the client certificate is invalid.
+		otherCfg.CACertFile = bobCfg.CACertFile
+		otherBackend, err := tls.NewTLSBackend(otherCfg, tcptest.DialerTimeout, nil)
+		require.NoError(t, err)
+		dialer := otherBackend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(bob.OffChainAddr, listenerAddr)
+
+		// Depending on the TLS version, the client learns that its certificate
+		// was rejected either during the handshake or on the first read.
+		conn, err := dialer.Dial(context.Background(), bob.OffChainAddr, ser)
+		if err == nil {
+			_, err = conn.Recv()
+		}
+		require.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("dial_unknown_peer", func(t *testing.T) {
+		aliceBackend, err := tls.NewTLSBackend(aliceCfg, tcptest.DialerTimeout, nil)
+		require.NoError(t, err)
+		dialer := aliceBackend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+
+		_, err = dialer.Dial(context.Background(), bob.OffChainAddr, ser)
+		require.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func Test_NewTLSBackend_InvalidConfig(t *testing.T) {
+	ca := tlstest.NewCAT(t)
+	validCfg, _ := ca.NewConfigT(t, "alice")
+
+	tests := []struct {
+		name   string
+		modify func(*tls.Config)
+	}{
+		{"missing_cert_file", func(cfg *tls.Config) { cfg.CertFile = "missing.crt" }},
+		{"missing_key_file", func(cfg *tls.Config) { cfg.KeyFile = "missing.key" }},
+		{"mismatched_key_file", func(cfg *tls.Config) {
+			otherCfg, _ := ca.NewConfigT(t, "other")
+			cfg.KeyFile = otherCfg.KeyFile
+		}},
+		{"missing_ca_cert_file", func(cfg *tls.Config) { cfg.CACertFile = "missing.crt" }},
+		{"invalid_ca_cert_file", func(cfg *tls.Config) { cfg.CACertFile = validCfg.KeyFile }},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validCfg
+			tc.modify(&cfg)
+			_, err := tls.NewTLSBackend(cfg, tcptest.DialerTimeout, nil)
+			require.Error(t, err)
+			t.Log(err)
+		})
+	}
+}
+
+// assertSendRecv dials bob at the listener address using the backend and
+// checks if an envelope can be sent over the connection.
+func assertSendRecv(t *testing.T, backend tls.Backend, listenerAddr string) {
+	t.Helper()
+	dialer := backend.NewDialer()
+	defer dialer.Close() //nolint:errcheck
+	dialer.Register(bob.OffChainAddr, listenerAddr)
+
+	conn, err := dialer.Dial(context.Background(), bob.OffChainAddr, ser)
+	require.NoError(t, err)
+	defer conn.Close() //nolint:errcheck
+	env := &pwire.Envelope{Sender: alice.OffChainAddr, Recipient: bob.OffChainAddr, Msg: pwire.NewPingMsg()}
+	require.NoError(t, conn.Send(env))
+	gotEnv, err := conn.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pwire.Pong, gotEnv.Msg.Type())
+}
+
+// newListenerT starts a listener using the backend, that responds to each
+// received ping message with a pong message. It returns the listener address.
+func newListenerT(t *testing.T, backend tls.Backend) string {
+	t.Helper()
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	listenerAddr := fmt.Sprintf("127.0.0.1:%d", port)
+	listener, err := backend.NewListener(listenerAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err = listener.Close(); err != nil {
+			t.Log("Error closing listener at address - " + listenerAddr)
+		}
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept(ser)
+			if err != nil {
+				return
+			}
+			go respondToPing(conn)
+		}
+	}()
+	return listenerAddr
+}
+
+func respondToPing(conn pnet.Conn) {
+	defer conn.Close() //nolint:errcheck
+	env, err := conn.Recv()
+	if err != nil {
+		return
+	}
+	conn.Send(&pwire.Envelope{ //nolint:errcheck,gosec
+		Sender:    env.Recipient,
+		Recipient: env.Sender,
+		Msg:       pwire.NewPongMsg(),
+	})
+}
+
+// idReader is a perun.IDReader that holds the given peer IDs.
+type idReader []perun.PeerID
+
+func (r idReader) ReadByAlias(alias string) (perun.PeerID, bool) {
+	for _, p := range r {
+		if p.Alias == alias {
+			return p, true
+		}
+	}
+	return perun.PeerID{}, false
+}
+
+func (r idReader) ReadByOffChainAddr(offChainAddr pwire.Address) (perun.PeerID, bool) {
+	for _, p := range r {
+		if p.OffChainAddr.Equal(offChainAddr) {
+			return p, true
+		}
+	}
+	return perun.PeerID{}, false
+}
+
+func (r idReader) ReadAll() []perun.PeerID {
+	return r
+}
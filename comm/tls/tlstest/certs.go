@@ -0,0 +1,121 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlstest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/comm/tls"
+)
+
+// certValidity is the validity period of the generated certificates.
+const certValidity = 24 * time.Hour
+
+// CA is a self-signed certificate authority for issuing certificates in tests.
+type CA struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	dir      string
+}
+
+// NewCAT generates a self-signed CA in a new temp directory. The directory is
+// removed when the test completes.
+func NewCAT(t *testing.T) CA {
+	t.Helper()
+	dir := t.TempDir()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          newSerialNumberT(t),
+		Subject:               pkix.Name{CommonName: "perun-node test CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(certValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "ca.crt")
+	writePEMFileT(t, certFile, "CERTIFICATE", certDER)
+	return CA{cert: cert, key: key, certFile: certFile, dir: dir}
+}
+
+// NewConfigT issues a certificate for the given name, valid for use with the
+// loopback address (127.0.0.1) and localhost, as both client and server. It
+// returns the config for using it with the tls comm backend, along with the
+// fingerprint of the certificate.
+func (ca CA) NewConfigT(t *testing.T, name string) (tls.Config, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumberT(t),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		DNSNames:     []string{"localhost"},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	cfg := tls.Config{
+		CertFile:   filepath.Join(ca.dir, name+".crt"),
+		KeyFile:    filepath.Join(ca.dir, name+".key"),
+		CACertFile: ca.certFile,
+	}
+	writePEMFileT(t, cfg.CertFile, "CERTIFICATE", certDER)
+	writePEMFileT(t, cfg.KeyFile, "EC PRIVATE KEY", keyDER)
+	return cfg, tls.CertFingerprint(cert)
+}
+
+func newSerialNumberT(t *testing.T) *big.Int {
+	t.Helper()
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	require.NoError(t, err)
+	return serialNumber
+}
+
+func writePEMFileT(t *testing.T, file, blockType string, data []byte) {
+	t.Helper()
+	pemData := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data})
+	require.NoError(t, os.WriteFile(file, pemData, 0o600))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+// conn is a connection that sends each envelope as one binary websocket
+// message.
+//
+// The underlying websocket connection supports one concurrent reader and one
+// concurrent writer. Recv is only called from a single go-routine by the wire
+// bus, and Send is protected by a mutex.
+type conn struct {
+	wsConn     *websocket.Conn
+	serializer pwire.EnvelopeSerializer
+	sendMtx    sync.Mutex
+
+	closeOnce sync.Once
+}
+
+var _ pnet.Conn = (*conn)(nil)
+
+func newConn(wsConn *websocket.Conn, ser pwire.EnvelopeSerializer) *conn {
+	return &conn{
+		wsConn:     wsConn,
+		serializer: ser,
+	}
+}
+
+// Send implements pnet.Conn.Send. The connection is closed if there is an error.
+func (c *conn) Send(e *pwire.Envelope) error {
+	c.sendMtx.Lock()
+	defer c.sendMtx.Unlock()
+
+	w, err := c.wsConn.NextWriter(websocket.BinaryMessage)
+	if err == nil {
+		err = c.serializer.Encode(w, e)
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		c.Close() //nolint:errcheck,gosec // Error in sending is returned.
+		return errors.Wrap(err, "sending envelope")
+	}
+	return nil
+}
+
+// Recv implements pnet.Conn.Recv. The connection is closed if there is an error.
+func (c *conn) Recv() (*pwire.Envelope, error) {
+	_, r, err := c.wsConn.NextReader()
+	if err != nil {
+		c.Close() //nolint:errcheck,gosec // Error in receiving is returned.
+		return nil, errors.Wrap(err, "receiving envelope")
+	}
+	e, err := c.serializer.Decode(r)
+	if err != nil {
+		c.Close() //nolint:errcheck,gosec // Error in receiving is returned.
+		return nil, errors.Wrap(err, "decoding envelope")
+	}
+	return e, nil
+}
+
+// Close implements pnet.Conn.Close.
+func (c *conn) Close() error {
+	err := errors.New("already closed")
+	c.closeOnce.Do(func() {
+		err = errors.Wrap(c.wsConn.Close(), "closing websocket connection")
+	})
+	return err
+}
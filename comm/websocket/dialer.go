@@ -0,0 +1,109 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pkgsync "polycry.pt/poly-go/sync"
+)
+
+// dialer is a lookup-table based dialer that can dial known peers using
+// websocket protocol. New peer addresses can be added via Register().
+type dialer struct {
+	mutex    sync.RWMutex               // Protects peers.
+	peers    map[pwallet.AddrKey]string // Known peer addresses.
+	wsDialer websocket.Dialer           // Used to dial connections.
+
+	pkgsync.Closer
+}
+
+var _ pnet.Dialer = (*dialer)(nil)
+
+func newDialer(timeout time.Duration) *dialer {
+	return &dialer{
+		peers: make(map[pwallet.AddrKey]string),
+		wsDialer: websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: timeout,
+		},
+	}
+}
+
+func (d *dialer) commAddr(key pwallet.AddrKey) (string, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	commAddr, ok := d.peers[key]
+	return commAddr, ok
+}
+
+// Dial implements pnet.Dialer.Dial.
+func (d *dialer) Dial(ctx context.Context, addr pwire.Address, ser pwire.EnvelopeSerializer) (pnet.Conn, error) {
+	done := make(chan struct{})
+	defer close(done)
+
+	commAddr, ok := d.commAddr(pwallet.Key(addr))
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+
+	// Combine the provided context with the dialer's closer, so that dialing
+	// is aborted when the dialer is closed.
+	wrappedCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+
+		select {
+		case <-d.Closed():
+		case <-done:
+		}
+	}()
+
+	wsConn, _, err := d.wsDialer.DialContext(wrappedCtx, dialURL(commAddr), nil) //nolint:bodyclose
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial peer")
+	}
+	return newConn(wsConn, ser), nil
+}
+
+// Register registers a comm address for a peer address.
+func (d *dialer) Register(addr pwire.Address, commAddr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.peers[pwallet.Key(addr)] = commAddr
+}
+
+// dialURL returns the websocket URL for the comm address. If the comm address
+// does not include a scheme, it is considered to be a host:port address and
+// ws scheme is used.
+func dialURL(commAddr string) string {
+	if strings.Contains(commAddr, "://") {
+		return commAddr
+	}
+	return "ws://" + commAddr
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package websocket implements the off-chain communication backend to initialize adapters for
+// websocket communication protocol.
+//
+// Websocket connections are established over HTTP and hence can pass through HTTP load balancers
+// and proxies that do not allow plain tcp connections. Each envelope is sent as one binary
+// websocket message.
+//
+// The listener accepts websocket connections on any path at the given host:port address.
+// The comm address of a peer can be either a host:port address (dialed as ws://host:port) or a
+// complete websocket URL using ws or wss scheme (for example, wss://example.com/perun), which is
+// useful when the peer is behind a load balancer. When dialing, proxy configured via the
+// HTTP_PROXY/HTTPS_PROXY environment variables is used.
+package websocket
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+// readHeaderTimeout is the time allowed for reading the headers of the HTTP
+// request that initiates the websocket handshake.
+const readHeaderTimeout = 10 * time.Second
+
+// listener accepts incoming websocket connections. It runs an HTTP server
+// that upgrades each incoming request to a websocket connection and passes it
+// on to the Accept call.
+type listener struct {
+	server   *http.Server
+	upgrader websocket.Upgrader
+	conns    chan *websocket.Conn
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+var _ pnet.Listener = (*listener)(nil)
+
+func newListener(addr string) (*listener, error) {
+	netListener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create listener for '%s'", addr)
+	}
+
+	l := &listener{
+		conns:  make(chan *websocket.Conn),
+		closed: make(chan struct{}),
+	}
+	l.server = &http.Server{
+		Handler:           l,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go l.server.Serve(netListener) //nolint:errcheck // Serve always returns an error, once the listener is closed.
+	return l, nil
+}
+
+// ServeHTTP upgrades the incoming request to a websocket connection and hands
+// it over to an ongoing or future Accept call.
+func (l *listener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	wsConn, err := l.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // Upgrade writes the error response to the client.
+	}
+	select {
+	case l.conns <- wsConn:
+	case <-l.closed:
+		wsConn.Close() //nolint:errcheck,gosec // Listener is closed, connection is discarded.
+	}
+}
+
+// Accept implements pnet.Listener.Accept.
+func (l *listener) Accept(ser pwire.EnvelopeSerializer) (pnet.Conn, error) {
+	select {
+	case wsConn := <-l.conns:
+		return newConn(wsConn, ser), nil
+	case <-l.closed:
+		return nil, errors.New("accept failed: listener closed")
+	}
+}
+
+// Close implements pnet.Listener.Close.
+func (l *listener) Close() error {
+	err := errors.New("already closed")
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		err = errors.Wrap(l.server.Close(), "closing http server")
+	})
+	return err
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+	pperunioserializer "perun.network/go-perun/wire/perunio/serializer"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/comm/websocket"
+)
+
+func Test_CommBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.CommBackend)(nil), new(websocket.Backend))
+}
+
+func Test_Backend(t *testing.T) {
+	backend := websocket.NewWebSocketBackend(tcptest.DialerTimeout)
+	ser := pperunioserializer.Serializer()
+	wb := ethereum.NewWalletBackend()
+	aliceAddr, err := wb.ParseAddr("0x9282681723920798983380581376586951466585")
+	require.NoError(t, err)
+	bobAddr, err := wb.ParseAddr("0x3369783337071807248093730889602727505701")
+	require.NoError(t, err)
+
+	listenerAddr := newListenerAddr(t)
+	listener, err := backend.NewListener(listenerAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err = listener.Close(); err != nil {
+			t.Log("Error closing listener at address - " + listenerAddr)
+		}
+	})
+
+	t.Run("happy_dial_accept_send_recv", func(t *testing.T) {
+		dialer := backend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(bobAddr, "ws://"+listenerAddr+"/perun")
+
+		accepted := make(chan pnet.Conn, 1)
+		go func() {
+			conn, acceptErr := listener.Accept(ser)
+			assert.NoError(t, acceptErr)
+			accepted <- conn
+		}()
+		dialedConn, err := dialer.Dial(context.Background(), bobAddr, ser)
+		require.NoError(t, err)
+		defer dialedConn.Close() //nolint:errcheck
+		acceptedConn := <-accepted
+		require.NotNil(t, acceptedConn)
+		defer acceptedConn.Close() //nolint:errcheck
+
+		env := &pwire.Envelope{Sender: aliceAddr, Recipient: bobAddr, Msg: pwire.NewPingMsg()}
+		require.NoError(t, dialedConn.Send(env))
+		gotEnv, err := acceptedConn.Recv()
+		require.NoError(t, err)
+		assert.True(t, gotEnv.Sender.Equal(aliceAddr))
+		assert.Equal(t, pwire.Ping, gotEnv.Msg.Type())
+
+		env = &pwire.Envelope{Sender: bobAddr, Recipient: aliceAddr, Msg: pwire.NewPongMsg()}
+		require.NoError(t, acceptedConn.Send(env))
+		gotEnv, err = dialedConn.Recv()
+		require.NoError(t, err)
+		assert.True(t, gotEnv.Sender.Equal(bobAddr))
+		assert.Equal(t, pwire.Pong, gotEnv.Msg.Type())
+
+		require.NoError(t, acceptedConn.Close())
+		_, err = dialedConn.Recv()
+		assert.Error(t, err)
+	})
+
+	t.Run("happy_dial_host_port", func(t *testing.T) {
+		dialer := backend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(bobAddr, listenerAddr)
+
+		go func() {
+			conn, acceptErr := listener.Accept(ser)
+			if acceptErr == nil {
+				conn.Close() //nolint:errcheck,gosec
+			}
+		}()
+		conn, err := dialer.Dial(context.Background(), bobAddr, ser)
+		require.NoError(t, err)
+		conn.Close() //nolint:errcheck,gosec
+	})
+
+	t.Run("dial_unknown_peer", func(t *testing.T) {
+		dialer := backend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		_, err := dialer.Dial(context.Background(), aliceAddr, ser)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("dial_not_listening", func(t *testing.T) {
+		dialer := backend.NewDialer()
+		defer dialer.Close() //nolint:errcheck
+		dialer.Register(aliceAddr, newListenerAddr(t))
+		_, err := dialer.Dial(context.Background(), aliceAddr, ser)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func Test_Listener_Close(t *testing.T) {
+	backend := websocket.NewWebSocketBackend(tcptest.DialerTimeout)
+	listener, err := backend.NewListener(newListenerAddr(t))
+	require.NoError(t, err)
+
+	acceptErr := make(chan error, 1)
+	go func() {
+		_, err := listener.Accept(pperunioserializer.Serializer())
+		acceptErr <- err
+	}()
+	require.NoError(t, listener.Close())
+	select {
+	case err = <-acceptErr:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("accept was not aborted when listener was closed")
+	}
+	assert.Error(t, listener.Close())
+}
+
+func Test_NewListener_InvalidAddr(t *testing.T) {
+	backend := websocket.NewWebSocketBackend(tcptest.DialerTimeout)
+	_, err := backend.NewListener("invalid-addr")
+	assert.Error(t, err)
+	t.Log(err)
+}
+
+func newListenerAddr(t *testing.T) string {
+	t.Helper()
+	// Find a free port to start the listener.
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	return fmt.Sprintf("127.0.0.1:%d", port)
+}
@@ -313,6 +313,22 @@ func NewAPIErrChainNotReachable(err error, chainURL string) APIError {
 	)
 }
 
+// NewAPIErrTxReorged returns an ErrTxReorged API Error with the given error
+// message. It is used when a tx that was mined is no longer on the chain,
+// because the block including it was removed in a chain reorganisation.
+func NewAPIErrTxReorged(err error, txType, txID string) APIError {
+	message := fmt.Sprintf("%s tx (ID:%s) was removed from the chain in a reorganisation", txType, txID)
+	return NewAPIErr(
+		ProtocolFatalError,
+		ErrTxReorged,
+		errors.WithMessage(err, message),
+		ErrInfoTxReorged{
+			TxType: txType,
+			TxID:   txID,
+		},
+	)
+}
+
 // NewAPIErrUnknownInternal returns an ErrUnknownInternal API Error with the given
 // error message.
 func NewAPIErrUnknownInternal(err error) APIError {
@@ -349,24 +365,36 @@ const (
 	// For failed pre-condition.
 	ErrChClosed      Error = "action not allowed on a closed channel"
 	ErrSessionClosed Error = "action not allowed on a closed session"
+	ErrNoRouting     Error = "routing is not enabled for the session"
 
 	// For invalid config.
 	ErrUnsupportedType      Error = "type not supported, see node config for supported types"
 	ErrRepeatedPeerAlias    Error = "found repeated entries but each value should be unique"
 	ErrEntryForSelfNotFound Error = "own peer alias (self) not found"
+
+	// For invalid argument.
+	ErrOwnPeerIDNotModifiable Error = "own peer ID (self) cannot be updated or deleted"
 )
 
 // Enumeration of valid resource types for used in ResourceNotFound and
 // ResourceExists errors.
 const (
-	ResTypeUpdate      ResourceType = "update"
-	ResTypeUpdateSub   ResourceType = "updatesSub"
-	ResTypeChannel     ResourceType = "channel"
-	ResTypeProposal    ResourceType = "proposal"
-	ResTypeProposalSub ResourceType = "proposalsSub"
-	ResTypePeerID      ResourceType = "peerID"
-	ResTypeSession     ResourceType = "session"
-	ResTypeCurrency    ResourceType = "currency"
+	ResTypeUpdate        ResourceType = "update"
+	ResTypeUpdateSub     ResourceType = "updatesSub"
+	ResTypeChannel       ResourceType = "channel"
+	ResTypeProposal      ResourceType = "proposal"
+	ResTypeProposalSub   ResourceType = "proposalsSub"
+	ResTypePeerID        ResourceType = "peerID"
+	ResTypePeerStatusSub ResourceType = "peerStatusSub"
+	ResTypeSession       ResourceType = "session"
+	ResTypeCurrency      ResourceType = "currency"
+	ResTypeAppMsgSub     ResourceType = "appMsgSub"
+	ResTypeRoute         ResourceType = "route"
+	ResTypeApp           ResourceType = "app"
+	ResTypeInvoice       ResourceType = "invoice"
+	ResTypePayStream     ResourceType = "payStream"
+	ResTypePayStreamSub  ResourceType = "payStreamSub"
+	ResTypeSchedule      ResourceType = "paymentSchedule"
 )
 
 // Enumeration of valid argument names for using in InvalidArgument error.
@@ -377,6 +405,17 @@ const (
 	ArgNameOffChainAddr ArgumentName = "offChainAddress"
 	ArgNameConfigFile   ArgumentName = "configFile"
 	ArgNamePayee        ArgumentName = "payee"
+	ArgNamePayer        ArgumentName = "payer"
 	ArgNameToken        ArgumentName = "token"
 	ArgNameAsset        ArgumentName = "asset"
+	ArgNamePeerChID     ArgumentName = "peerChID"
+	ArgNamePeerIdx      ArgumentName = "peerIdx"
+	ArgNameApp          ArgumentName = "app"
+	ArgNameInitParams   ArgumentName = "initParams"
+	ArgNameAction       ArgumentName = "action"
+	ArgNameLimit        ArgumentName = "limit"
+	ArgNameInvoice      ArgumentName = "invoice"
+	ArgNameExpiry       ArgumentName = "expiry"
+	ArgNameInterval     ArgumentName = "interval"
+	ArgNameCap          ArgumentName = "cap"
 )
@@ -252,6 +252,17 @@ func Test_NewErrChainNotReachable(t *testing.T) {
 	peruntest.AssertErrInfoChainNotReachable(t, apiErr.AddInfo(), chainURL)
 }
 
+func Test_NewErrTxReorged(t *testing.T) {
+	txType := "any-type"
+	txID := "any-id"
+	err := errors.New("any-error")
+	wantMsg := fmt.Sprintf("%s tx (ID:%s) was removed from the chain in a reorganisation: %v", txType, txID, err)
+
+	apiErr := perun.NewAPIErrTxReorged(err, txType, txID)
+	peruntest.AssertAPIError(t, apiErr, perun.ProtocolFatalError, perun.ErrTxReorged, wantMsg)
+	peruntest.AssertErrInfoTxReorged(t, apiErr.AddInfo(), txType, txID)
+}
+
 func Test_NewErrUnknownInternal(t *testing.T) {
 	err := errors.New("any-error")
 	wantMsg := fmt.Sprintf("unknown internal error: %v", err)
@@ -30,4 +30,5 @@ const (
 	ErrPeerAliasAlreadyUsed    Error = "peer alias is already used for another peer id"
 	ErrPeerIDAlreadyRegistered Error = "peer id already regsitered"
 	ErrParsingOffChainAddress  Error = "parsing off-chain address"
+	ErrOffChainAddrAlreadyUsed Error = "off-chain address is already used by another peer id"
 )
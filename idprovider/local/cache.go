@@ -17,6 +17,7 @@
 package local
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -87,7 +88,10 @@ func (c *idProviderCache) ReadByOffChainAddr(offChainAddr pwire.Address) (_ peru
 func (c *idProviderCache) Write(alias string, p perun.PeerID) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
+	return c.write(alias, p)
+}
 
+func (c *idProviderCache) write(alias string, p perun.PeerID) error {
 	if oldPeerID, ok := c.peerIDsByAlias[alias]; ok {
 		if PeerIDEqual(oldPeerID, p) {
 			return idprovider.ErrPeerIDAlreadyRegistered
@@ -110,9 +114,71 @@ func (c *idProviderCache) Write(alias string, p perun.PeerID) error {
 func (c *idProviderCache) Delete(alias string) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	if _, ok := c.peerIDsByAlias[alias]; !ok {
-		return errors.New("peer not found in ID Provider")
+	_, err := c.delete(alias)
+	return err
+}
+
+// delete removes the peer from the cache and returns the removed peer ID.
+func (c *idProviderCache) delete(alias string) (perun.PeerID, error) {
+	p, ok := c.peerIDsByAlias[alias]
+	if !ok {
+		return perun.PeerID{}, idprovider.ErrPeerIDNotFound
 	}
 	delete(c.peerIDsByAlias, alias)
-	return nil
+	delete(c.aliasByAddr, p.OffChainAddrString)
+	return p, nil
+}
+
+// Update replaces the peer ID for the given alias in the ID Provider cache. Returns an error if peer corresponding
+// to given alias is not found, if the off-chain address is already used by another alias or if the off-chain address
+// of the peer ID cannot be parsed using the wallet backend of this ID Provider.
+func (c *idProviderCache) Update(alias string, p perun.PeerID) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, err := c.update(alias, p)
+	return err
+}
+
+// update replaces the peer ID in the cache and returns the previous peer ID.
+func (c *idProviderCache) update(alias string, p perun.PeerID) (perun.PeerID, error) {
+	oldPeerID, ok := c.peerIDsByAlias[alias]
+	if !ok {
+		return perun.PeerID{}, idprovider.ErrPeerIDNotFound
+	}
+	if otherAlias, ok := c.aliasByAddr[p.OffChainAddrString]; ok && otherAlias != alias {
+		return perun.PeerID{}, idprovider.ErrOffChainAddrAlreadyUsed
+	}
+
+	var err error
+	p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString)
+	if err != nil {
+		return perun.PeerID{}, errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error())
+	}
+	c.set(alias, p)
+	return oldPeerID, nil
+}
+
+// set adds the peer ID to the cache, replacing the existing entry for the alias if any. Unlike write, the off-chain
+// address is not parsed. It is used for reverting the changes to the cache, using peer IDs that were already parsed.
+func (c *idProviderCache) set(alias string, p perun.PeerID) {
+	if oldPeerID, ok := c.peerIDsByAlias[alias]; ok {
+		delete(c.aliasByAddr, oldPeerID.OffChainAddrString)
+	}
+	c.peerIDsByAlias[alias] = p
+	c.aliasByAddr[p.OffChainAddrString] = alias
+}
+
+// ReadAll returns all the peer IDs in the cache, sorted by their aliases.
+func (c *idProviderCache) ReadAll() []perun.PeerID {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	peerIDs := make([]perun.PeerID, 0, len(c.peerIDsByAlias))
+	for _, p := range c.peerIDsByAlias {
+		peerIDs = append(peerIDs, p)
+	}
+	sort.Slice(peerIDs, func(i, j int) bool {
+		return peerIDs[i].Alias < peerIDs[j].Alias
+	})
+	return peerIDs
 }
@@ -21,10 +21,13 @@
 // initialization. The entries in the cache are indexed by both alias and
 // off-chain address of the peer and can be using either of these as reference.
 //
-// Read, Write and Delete operations act only on the cache and do
-// not affect the contents of the file.
+// Read operations act only on the cache. Write, Update and Delete operations
+// update the cache and also write the updated list of peer IDs to the file,
+// so that the changes are retained when the ID provider is initialized again.
+// The file is updated by writing to a temporary file and renaming it, so that
+// it is never left partially written.
 //
-// Latest state of cache can be updated to the file by explicitly calling
-// UpdateStorage method. Normally this should be called before shutting down
-// the node.
+// Changes made to the file by other processes can be merged into the cache by
+// calling Watch. The file is then reloaded each time it changes on the disk,
+// until the watcher is stopped using Close.
 package local
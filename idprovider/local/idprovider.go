@@ -17,7 +17,7 @@
 package local
 
 import (
-	"fmt"
+	"bytes"
 	"io"
 	"os"
 	"path/filepath"
@@ -26,40 +26,46 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/internal/fileutil"
 )
 
+// idProviderFileMode is the file mode used for creating the ID provider file, if it does not exist.
+const idProviderFileMode = os.FileMode(0o600)
+
 // IDProvider represents an ID provider that provides access to peer IDs stored locally in a file on the file system.
 //
-// It generates a cache of all peer IDs in the ID provider file during initialization. Read, Write and Delete
-// operations act only on the cached list of peer IDs and do not update the ID provider file.
-// The changes in cache can be updated to the ID provider file by explicitly calling UpdateStorage method.
+// It generates a cache of all peer IDs in the ID provider file during initialization. Read operations act only on the
+// cache. Write, Update and Delete operations update the cache and write the updated list of peer IDs to the ID
+// provider file before returning. If the file could not be updated, the change to the cache is reverted and an error
+// is returned.
+//
+// The file is updated by writing to a temporary file and renaming it, so that the file is never left partially
+// written. The peer ID of the user registered with the alias perun.OwnAlias is never written to the file, as it
+// is registered by the session during initialization.
 //
 // It also stores an instance of wallet backend that will be used or decoding address strings.
 type IDProvider struct {
 	*idProviderCache
 
 	localFilePath string
+
+	// Aliases of the peer IDs that were added or updated using this instance, after it was initialized.
+	runtimeAliases map[string]struct{}
+	watcher        *fileWatcher
 }
 
 // NewIDprovider returns an instance of ID provider to access the peer IDs in the given ID provider file.
 //
-// All the peer IDs are cached in memory during initialization and Read, Write, Delete operations
-// affect only the cache. The changes are updated to the ID provider file only when UpdateStorage method
-// is explicitly called. There is no mechanism to reload the cache if the ID provider file is updated.
+// All the peer IDs are cached in memory during initialization. Read operations use only the cache, while Write,
+// Update and Delete operations also update the ID provider file. Changes made to the file by other processes are
+// merged into the cache only if Watch is called.
 //
 // Backend is used for decoding the address strings during initialization.
 func NewIDprovider(filePath string, backend perun.WalletBackend) (*IDProvider, error) {
-	f, err := os.Open(filepath.Clean(filePath))
+	cache, err := readIDProviderFile(filePath)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close() //nolint:errcheck
-
-	cache := make(map[string]perun.PeerID)
-	decoder := yaml.NewDecoder(f)
-	if err = decoder.Decode(&cache); err != nil && err != io.EOF {
-		return nil, err
-	}
 
 	idProviderCache, err := newIDProviderCache(cache, backend)
 	if err != nil {
@@ -68,29 +74,127 @@ func NewIDprovider(filePath string, backend perun.WalletBackend) (*IDProvider, e
 	return &IDProvider{
 		idProviderCache: idProviderCache,
 		localFilePath:   filePath,
+		runtimeAliases:  make(map[string]struct{}),
 	}, nil
 }
 
+// readIDProviderFile returns the peer IDs in the ID provider file, indexed by their aliases.
+func readIDProviderFile(filePath string) (map[string]perun.PeerID, error) {
+	f, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck
+
+	peerIDs := make(map[string]perun.PeerID)
+	decoder := yaml.NewDecoder(f)
+	if err = decoder.Decode(&peerIDs); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return peerIDs, nil
+}
+
+// Write adds the peer ID to the ID provider and updates the ID provider file. Returns an error if the alias is
+// already used by same or different peer ID, if the off-chain address of the peer ID cannot be parsed using the
+// wallet backend of this ID Provider or if the ID provider file could not be updated.
+func (c *IDProvider) Write(alias string, p perun.PeerID) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.write(alias, p); err != nil {
+		return err
+	}
+	if alias == perun.OwnAlias {
+		return nil
+	}
+	if err := c.updateStorage(); err != nil {
+		_, _ = c.delete(alias) // Peer was added above, so delete cannot fail.
+		return err
+	}
+	c.runtimeAliases[alias] = struct{}{}
+	return nil
+}
+
+// Delete deletes the peer from the ID provider and updates the ID provider file. Returns an error if peer
+// corresponding to given alias is not found or if the ID provider file could not be updated.
+func (c *IDProvider) Delete(alias string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	p, err := c.delete(alias)
+	if err != nil {
+		return err
+	}
+	if alias == perun.OwnAlias {
+		return nil
+	}
+	if err := c.updateStorage(); err != nil {
+		c.set(alias, p)
+		return err
+	}
+	delete(c.runtimeAliases, alias)
+	return nil
+}
+
+// Update replaces the peer ID for the given alias in the ID provider and updates the ID provider file. Returns an
+// error if peer corresponding to given alias is not found, if the off-chain address of the peer ID cannot be parsed
+// using the wallet backend of this ID Provider or if the ID provider file could not be updated.
+func (c *IDProvider) Update(alias string, p perun.PeerID) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	oldPeerID, err := c.update(alias, p)
+	if err != nil {
+		return err
+	}
+	if alias == perun.OwnAlias {
+		return nil
+	}
+	if err := c.updateStorage(); err != nil {
+		c.set(alias, oldPeerID)
+		return err
+	}
+	c.runtimeAliases[alias] = struct{}{}
+	return nil
+}
+
 // UpdateStorage writes the latest state of ID provider cache to the file on the disk.
+//
+// Since Write, Update and Delete operations update the file, calling this is not required for persisting the changes. It
+// can be used to re-create the file, if it was removed while the ID provider is in use.
 func (c *IDProvider) UpdateStorage() error {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
+	return c.updateStorage()
+}
 
-	f, err := os.Create(c.localFilePath)
-	if err != nil {
-		return errors.Wrap(err, "opening ID provider file for writing")
+// updateStorage writes the peer IDs in the cache, except the one registered with perun.OwnAlias, to the ID
+// provider file. The file permissions are retained and an error is returned if the file is read-only.
+//
+// Caller should hold the mutex on the cache, either for reading or writing.
+func (c *IDProvider) updateStorage() error {
+	perm := idProviderFileMode
+	if fileInfo, err := os.Stat(c.localFilePath); err == nil {
+		perm = fileInfo.Mode().Perm()
+		if perm&0o200 == 0 {
+			return errors.New("ID provider file is read-only")
+		}
 	}
-	defer func() {
-		if fCloseErr := f.Close(); fCloseErr != nil {
-			err = fmt.Errorf("%w; and error closing file - %s", err, fCloseErr.Error())
+
+	peerIDsByAlias := make(map[string]perun.PeerID, len(c.peerIDsByAlias))
+	for alias, peerID := range c.peerIDsByAlias {
+		if alias != perun.OwnAlias {
+			peerIDsByAlias[alias] = peerID
 		}
-	}()
+	}
 
-	encoder := yaml.NewEncoder(f)
-	if err = encoder.Encode(c.peerIDsByAlias); err != nil {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	if err := encoder.Encode(peerIDsByAlias); err != nil {
 		return errors.Wrap(err, "encoding data as yaml")
 	}
-	err = errors.Wrap(encoder.Close(), "closing encoder")
-	// receive the error in "err" before returning to ensure file close error is captured.
-	return err
+	if err := encoder.Close(); err != nil {
+		return errors.Wrap(err, "closing encoder")
+	}
+	return errors.WithMessage(fileutil.WriteFileAtomic(c.localFilePath, buf.Bytes(), perm), "updating ID provider file")
 }
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -235,6 +236,94 @@ func Test_YAML_Delete_Read(t *testing.T) {
 	})
 }
 
+func Test_YAML_ReadAll(t *testing.T) {
+	idProviderFile := idprovidertest.NewIDProviderT(t, peer3, peer1, peer2)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend)
+	require.NoError(t, err)
+
+	assert.Equal(t, []perun.PeerID{peer1, peer2, peer3}, c.ReadAll())
+}
+
+func Test_YAML_Update_Read(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+
+		// Update both the comm address and off-chain address of the peer.
+		updatedPeer1 := peer3
+		updatedPeer1.Alias = peer1.Alias
+		require.NoError(t, c.Update(peer1.Alias, updatedPeer1))
+
+		gotPeer, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, updatedPeer1, gotPeer)
+		_, isPresent = c.ReadByOffChainAddr(peer1.OffChainAddr)
+		assert.False(t, isPresent)
+		gotPeer, isPresent = c.ReadByOffChainAddr(updatedPeer1.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, updatedPeer1, gotPeer)
+
+		reloaded, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+		gotPeer, isPresent = reloaded.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, updatedPeer1, gotPeer)
+	})
+
+	t.Run("missing_peer", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+
+		err = c.Update(peer3.Alias, peer3)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDNotFound))
+	})
+
+	t.Run("invalid_offchain_addr", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+
+		peer1Copy := peer1
+		peer1Copy.OffChainAddrString = "invalid-addr"
+		err = c.Update(peer1Copy.Alias, peer1Copy)
+		assert.True(t, errors.Is(err, idprovider.ErrParsingOffChainAddress))
+	})
+
+	t.Run("offchain_addr_used_by_another_peer", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+
+		peer1Copy := peer1
+		peer1Copy.OffChainAddrString = peer2.OffChainAddrString
+		err = c.Update(peer1Copy.Alias, peer1Copy)
+		assert.True(t, errors.Is(err, idprovider.ErrOffChainAddrAlreadyUsed))
+
+		gotPeer, isPresent := c.ReadByOffChainAddr(peer2.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeer)
+	})
+
+	t.Run("file_permission_error_reverts_cache", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+		require.NoError(t, os.Chmod(idProviderFile, 0o444))
+
+		peer1Copy := peer1
+		peer1Copy.CommAddr = "127.0.0.1:5760"
+		err = c.Update(peer1Copy.Alias, peer1Copy)
+		assert.Error(t, err)
+		t.Log(err)
+
+		gotPeer, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeer)
+	})
+}
+
 func Test_YAML_UpdateStorage(t *testing.T) {
 	t.Run("happy_empty_file", func(t *testing.T) {
 		// Setup: NewYAML with zero entries
@@ -282,6 +371,79 @@ func Test_YAML_UpdateStorage(t *testing.T) {
 	})
 }
 
+func Test_YAML_WriteThrough(t *testing.T) {
+	t.Run("happy_write_delete", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+		require.NoError(t, c.Write(peer3.Alias, peer3))
+		require.NoError(t, c.Delete(peer1.Alias))
+
+		reloaded, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+		_, isPresent := reloaded.ReadByAlias(peer1.Alias)
+		assert.False(t, isPresent)
+		gotPeer, isPresent := reloaded.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeer)
+		gotPeer, isPresent = reloaded.ReadByOffChainAddr(peer3.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer3, gotPeer)
+	})
+
+	t.Run("happy_own_alias_not_persisted", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+
+		ownPeer := peer2
+		ownPeer.Alias = perun.OwnAlias
+		require.NoError(t, c.Write(perun.OwnAlias, ownPeer))
+		require.NoError(t, c.Write(peer3.Alias, peer3))
+
+		assert.True(t, compareFileContent(t, idProviderFile, idprovidertest.NewIDProviderT(t, peer1, peer3)))
+	})
+
+	t.Run("happy_no_temp_files_left", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+		dir := t.TempDir()
+		movedFile := filepath.Join(dir, "idprovider.yaml")
+		require.NoError(t, os.Rename(idProviderFile, movedFile))
+		c, err := local.NewIDprovider(movedFile, walletBackend)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+		require.NoError(t, c.Delete(peer2.Alias))
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "idprovider.yaml", entries[0].Name())
+	})
+
+	t.Run("file_permission_error_reverts_cache", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+		require.NoError(t, os.Chmod(idProviderFile, 0o444))
+
+		err = c.Write(peer3.Alias, peer3)
+		assert.Error(t, err)
+		t.Log(err)
+		_, isPresent := c.ReadByAlias(peer3.Alias)
+		assert.False(t, isPresent)
+
+		err = c.Delete(peer1.Alias)
+		assert.Error(t, err)
+		t.Log(err)
+		gotPeer, isPresent := c.ReadByOffChainAddr(peer1.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeer)
+	})
+}
+
 func compareFileContent(t *testing.T, file1, file2 string) bool {
 	f1, err := os.ReadFile(file1)
 	require.NoError(t, err)
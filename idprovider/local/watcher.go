@@ -0,0 +1,243 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// reloadDelay is the duration to wait after a change to the ID provider file, before reloading it. This ensures a
+// series of changes made in quick succession (as done by some editors) results in a single reload.
+const reloadDelay = 100 * time.Millisecond
+
+// HasOpenChsFunc returns true if there are open channels with the peer. It should return early when the context is
+// done.
+type HasOpenChsFunc func(ctx context.Context, alias string) bool
+
+// fileWatcher holds the resources of the routine that watches the ID provider file.
+type fileWatcher struct {
+	fsWatcher *fsnotify.Watcher
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// Watch starts watching the ID provider file for changes made by other processes. When the file changes, the peer
+// IDs in it are merged into the cache:
+//   - Peer IDs that are not present in the cache are added and their comm address is registered with the registerer.
+//   - Peer IDs that are different from the ones in the cache are updated. If the comm address or off-chain address
+//     of the peer has changed, the comm address is registered with the registerer, so that new connections to the
+//     peer use the updated address.
+//   - Peer IDs that are not present in the file are removed from the cache.
+//
+// Peers for which hasOpenChs returns true are neither removed nor is their off-chain address changed, as is the
+// case when these operations are done via the API. Instead, the cached entry is retained and written back to the
+// file. hasOpenChs is called without holding the mutex on the cache, so it can access the ID provider. The context
+// passed to it is cancelled when Close is called and the reload is then aborted.
+//
+// If a peer ID that was added or updated at runtime using this instance conflicts with the file, it is logged and
+// the file wins: when the entry in the file differs, the entry in the file is used and when the entry is missing in
+// the file, it is removed, unless there are open channels with the peer. The entry for perun.OwnAlias, if present in
+// the file, is ignored.
+//
+// The watcher is stopped by calling Close.
+func (c *IDProvider) Watch(r perun.Registerer, hasOpenChs HasOpenChsFunc, logger log.Logger) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.watcher != nil {
+		return errors.New("already watching the ID provider file")
+	}
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "initializing file watcher")
+	}
+	// Watch the directory, because the file is replaced (and not modified) when it is updated atomically.
+	if err = fsWatcher.Add(filepath.Dir(c.localFilePath)); err != nil {
+		fsWatcher.Close() //nolint:errcheck,gosec // Error in adding the watch is returned.
+		return errors.Wrap(err, "watching ID provider file")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.watcher = &fileWatcher{
+		fsWatcher: fsWatcher,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	go c.watch(c.watcher, r, hasOpenChs, logger)
+	return nil
+}
+
+// Close stops the watcher started using Watch. It is a no-op if the watcher is not running.
+func (c *IDProvider) Close() error {
+	c.mutex.Lock()
+	w := c.watcher
+	c.watcher = nil
+	c.mutex.Unlock()
+	if w == nil {
+		return nil
+	}
+
+	w.cancel()
+	err := w.fsWatcher.Close()
+	<-w.done
+	return errors.Wrap(err, "closing file watcher")
+}
+
+func (c *IDProvider) watch(w *fileWatcher, r perun.Registerer, hasOpenChs HasOpenChsFunc, logger log.Logger) {
+	defer close(w.done)
+
+	fileName := filepath.Base(c.localFilePath)
+	var reload <-chan time.Time
+	for {
+		select {
+		case event, ok := <-w.fsWatcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Base(event.Name) == fileName && event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				reload = time.After(reloadDelay)
+			}
+		case err, ok := <-w.fsWatcher.Errors:
+			if !ok {
+				return
+			}
+			logger.Error("Watching ID provider file:", err)
+		case <-reload:
+			reload = nil
+			c.reload(w.ctx, r, hasOpenChs, logger)
+		}
+	}
+}
+
+// reload reads the ID provider file and merges the peer IDs in it into the cache. See Watch for details.
+func (c *IDProvider) reload(ctx context.Context, r perun.Registerer, hasOpenChs HasOpenChsFunc, logger log.Logger) {
+	filePeerIDs, err := readIDProviderFile(c.localFilePath)
+	if err != nil {
+		logger.Error("Reloading ID provider file, retaining the cached peer IDs:", err)
+		return
+	}
+
+	// Peers that will be removed or whose off-chain address will change are checked for open channels before
+	// acquiring the mutex for writing, because the session holds its own lock when accessing the ID provider.
+	c.mutex.RLock()
+	candidates := []string{}
+	for alias, oldPeerID := range c.peerIDsByAlias {
+		p, isPresent := filePeerIDs[alias]
+		if alias != perun.OwnAlias && (!isPresent || p.OffChainAddrString != oldPeerID.OffChainAddrString) {
+			candidates = append(candidates, alias)
+		}
+	}
+	c.mutex.RUnlock()
+	inUse := make(map[string]bool, len(candidates))
+	for _, alias := range candidates {
+		inUse[alias] = hasOpenChs(ctx, alias)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	retainedUpdated := c.mergeFilePeerIDs(filePeerIDs, inUse, r, logger)
+	retainedMissing := c.removeMissingPeerIDs(filePeerIDs, inUse, logger)
+	if retainedUpdated || retainedMissing {
+		if err = c.updateStorage(); err != nil {
+			logger.Error("Writing retained peer IDs to ID provider file:", err)
+		}
+	}
+}
+
+// mergeFilePeerIDs adds or updates the peer IDs read from the file into the cache. Returns true if the change to
+// the off-chain address of any peer with open channels was not applied.
+//
+// Caller should hold the mutex on the cache for writing.
+func (c *IDProvider) mergeFilePeerIDs(filePeerIDs map[string]perun.PeerID, inUse map[string]bool,
+	r perun.Registerer, logger log.Logger,
+) (retained bool) {
+	var err error
+	for alias, p := range filePeerIDs {
+		if alias == perun.OwnAlias {
+			logger.Warnf("Ignoring entry for own alias (%s) in ID provider file", perun.OwnAlias)
+			continue
+		}
+		oldPeerID, isPresent := c.peerIDsByAlias[alias]
+		if isPresent && PeerIDEqual(oldPeerID, p) {
+			continue
+		}
+		if p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString); err != nil {
+			logger.Errorf("Ignoring peer ID %s in ID provider file with invalid off-chain address: %v", alias, err)
+			continue
+		}
+		if isPresent && oldPeerID.OffChainAddrString != p.OffChainAddrString && inUse[alias] {
+			logger.Warnf("Peer ID %s has open channels, retaining its off-chain address in ID provider file", alias)
+			retained = true
+			continue
+		}
+		if _, isRuntime := c.runtimeAliases[alias]; isRuntime && isPresent {
+			logger.Warnf("Conflict: peer ID %s added at runtime differs from ID provider file, using the file: %+v",
+				alias, p)
+			delete(c.runtimeAliases, alias)
+		}
+
+		c.set(alias, p)
+		if !isPresent {
+			logger.Infof("Peer ID %s added from ID provider file", alias)
+			r.Register(p.OffChainAddr, p.CommAddr)
+			continue
+		}
+		logger.Infof("Peer ID %s updated from ID provider file", alias)
+		if oldPeerID.CommAddr != p.CommAddr || oldPeerID.OffChainAddrString != p.OffChainAddrString {
+			r.Register(p.OffChainAddr, p.CommAddr)
+		}
+	}
+	return retained
+}
+
+// removeMissingPeerIDs removes the peer IDs that are missing in the file from the cache, except the ones with open
+// channels. Returns true if any peer ID was retained.
+//
+// Caller should hold the mutex on the cache for writing.
+func (c *IDProvider) removeMissingPeerIDs(filePeerIDs map[string]perun.PeerID, inUse map[string]bool,
+	logger log.Logger,
+) (retained bool) {
+	for alias := range c.peerIDsByAlias {
+		if _, isPresent := filePeerIDs[alias]; isPresent || alias == perun.OwnAlias {
+			continue
+		}
+		if inUse[alias] {
+			logger.Warnf("Peer ID %s has open channels and is missing in ID provider file, retaining it", alias)
+			retained = true
+			continue
+		}
+		if _, isRuntime := c.runtimeAliases[alias]; isRuntime {
+			logger.Warnf("Conflict: peer ID %s added at runtime is missing in ID provider file, removing it", alias)
+			delete(c.runtimeAliases, alias)
+		}
+		c.delete(alias) //nolint:errcheck,gosec // Peer is present in the cache, so delete cannot fail.
+		logger.Infof("Peer ID %s removed as it is missing in ID provider file", alias)
+	}
+	return retained
+}
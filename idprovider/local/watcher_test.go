@@ -0,0 +1,192 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider/idprovidertest"
+	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	reloadTimeout = 5 * time.Second
+	reloadTick    = 20 * time.Millisecond
+)
+
+func Test_YAML_Watch(t *testing.T) {
+	logger := log.NewLoggerWithField("test", "idprovider-watcher")
+
+	t.Run("happy_add_update_remove", func(t *testing.T) {
+		c, idProviderFile := newWatchedIDProvider(t, peer1, peer2)
+		updatedPeer1 := peer1
+		updatedPeer1.CommAddr = "127.0.0.1:5761"
+		registerer := mocks.NewRegisterer(t)
+		registerer.On("Register", mock.Anything, updatedPeer1.CommAddr).Once()
+		registerer.On("Register", peer3.OffChainAddr, peer3.CommAddr).Once()
+		require.NoError(t, c.Watch(registerer, noOpenChs, logger))
+
+		rewriteIDProviderFile(t, idProviderFile, updatedPeer1, peer3)
+		assert.Eventually(t, func() bool {
+			_, isPeer2Present := c.ReadByAlias(peer2.Alias)
+			_, isPeer3Present := c.ReadByAlias(peer3.Alias)
+			gotPeer1, _ := c.ReadByOffChainAddr(peer1.OffChainAddr)
+			return !isPeer2Present && isPeer3Present && gotPeer1.CommAddr == updatedPeer1.CommAddr
+		}, reloadTimeout, reloadTick)
+		require.NoError(t, c.Close())
+	})
+
+	t.Run("happy_runtime_peer_removed", func(t *testing.T) {
+		c, idProviderFile := newWatchedIDProvider(t, peer1)
+		registerer := mocks.NewRegisterer(t)
+		registerer.On("Register", peer3.OffChainAddr, peer3.CommAddr).Once()
+		require.NoError(t, c.Watch(registerer, noOpenChs, logger))
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+
+		// File is modified by another process that does not know of peer2.
+		rewriteIDProviderFile(t, idProviderFile, peer1, peer3)
+		assert.Eventually(t, func() bool {
+			_, isPresent := c.ReadByAlias(peer3.Alias)
+			return isPresent
+		}, reloadTimeout, reloadTick)
+		_, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.False(t, isPresent)
+		require.NoError(t, c.Close())
+
+		reloaded, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+		assert.Equal(t, []perun.PeerID{peer1, peer3}, reloaded.ReadAll())
+	})
+
+	t.Run("happy_peer_with_open_chs_retained", func(t *testing.T) {
+		c, idProviderFile := newWatchedIDProvider(t, peer1, peer2)
+		hasOpenChs := func(_ context.Context, alias string) bool {
+			return alias == peer1.Alias || alias == peer2.Alias
+		}
+		registerer := mocks.NewRegisterer(t)
+		registerer.On("Register", peer3.OffChainAddr, peer3.CommAddr).Once()
+		require.NoError(t, c.Watch(registerer, hasOpenChs, logger))
+
+		// File is modified to change the off-chain address of peer1 and remove peer2, both have open channels.
+		updatedPeer1 := peer1
+		updatedPeer1.OffChainAddrString = "0x1111111111111111111111111111111111111111"
+		rewriteIDProviderFile(t, idProviderFile, updatedPeer1, peer3)
+		assert.Eventually(t, func() bool {
+			_, isPresent := c.ReadByAlias(peer3.Alias)
+			return isPresent
+		}, reloadTimeout, reloadTick)
+		assert.Equal(t, []perun.PeerID{peer1, peer2, peer3}, c.ReadAll())
+		require.NoError(t, c.Close())
+
+		reloaded, err := local.NewIDprovider(idProviderFile, walletBackend)
+		require.NoError(t, err)
+		assert.Equal(t, []perun.PeerID{peer1, peer2, peer3}, reloaded.ReadAll())
+	})
+
+	t.Run("happy_close_during_reload", func(t *testing.T) {
+		c, idProviderFile := newWatchedIDProvider(t, peer1, peer2)
+		checking := make(chan struct{})
+		hasOpenChs := func(ctx context.Context, _ string) bool {
+			close(checking)
+			<-ctx.Done()
+			return true
+		}
+		require.NoError(t, c.Watch(mocks.NewRegisterer(t), hasOpenChs, logger))
+
+		rewriteIDProviderFile(t, idProviderFile, peer1)
+		<-checking
+		require.NoError(t, c.Close())
+		_, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+	})
+
+	t.Run("invalid_file_retains_cache", func(t *testing.T) {
+		c, idProviderFile := newWatchedIDProvider(t, peer1)
+		registerer := mocks.NewRegisterer(t)
+		registerer.On("Register", mock.Anything, mock.Anything).Maybe()
+		require.NoError(t, c.Watch(registerer, noOpenChs, logger))
+
+		require.NoError(t, os.WriteFile(idProviderFile, []byte("invalid: : yaml"), 0o600))
+		time.Sleep(5 * reloadTick)
+		_, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+
+		// Watcher continues to work after the file is fixed.
+		rewriteIDProviderFile(t, idProviderFile, peer1, peer2)
+		assert.Eventually(t, func() bool {
+			_, isPresent := c.ReadByAlias(peer2.Alias)
+			return isPresent
+		}, reloadTimeout, reloadTick)
+		require.NoError(t, c.Close())
+	})
+
+	t.Run("close_stops_watching", func(t *testing.T) {
+		c, idProviderFile := newWatchedIDProvider(t, peer1)
+		require.NoError(t, c.Watch(mocks.NewRegisterer(t), noOpenChs, logger))
+		require.NoError(t, c.Close())
+		require.NoError(t, c.Close())
+
+		rewriteIDProviderFile(t, idProviderFile, peer1, peer2)
+		time.Sleep(10 * reloadTick)
+		_, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.False(t, isPresent)
+	})
+
+	t.Run("already_watching", func(t *testing.T) {
+		c, _ := newWatchedIDProvider(t, peer1)
+		require.NoError(t, c.Watch(mocks.NewRegisterer(t), noOpenChs, logger))
+		err := c.Watch(mocks.NewRegisterer(t), noOpenChs, logger)
+		assert.Error(t, err)
+		t.Log(err)
+		require.NoError(t, c.Close())
+	})
+}
+
+// noOpenChs is used as the open channels checker, when none of the peers have open channels.
+func noOpenChs(context.Context, string) bool {
+	return false
+}
+
+// newWatchedIDProvider initializes a local ID provider with the given peers, using a file in a new temp directory,
+// so that the watcher does not receive events for unrelated files.
+func newWatchedIDProvider(t *testing.T, peerIDs ...perun.PeerID) (*local.IDProvider, string) {
+	t.Helper()
+	idProviderFile := filepath.Join(t.TempDir(), "idprovider.yaml")
+	rewriteIDProviderFile(t, idProviderFile, peerIDs...)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend)
+	require.NoError(t, err)
+	return c, idProviderFile
+}
+
+// rewriteIDProviderFile overwrites the ID provider file with the given peers, as would be done by another process.
+func rewriteIDProviderFile(t *testing.T, idProviderFile string, peerIDs ...perun.PeerID) {
+	t.Helper()
+	data, err := os.ReadFile(idprovidertest.NewIDProviderT(t, peerIDs...))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(idProviderFile, data, 0o600))
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Path of the peers resource and the query parameter for looking up peers by
+// off-chain address in the directory API.
+const (
+	peersPath            = "/peers"
+	offChainAddrQueryKey = "offchain_address"
+)
+
+// Error codes that are sent by the directory in the error responses.
+const (
+	codePeerIDNotFound          = "peer_id_not_found"
+	codePeerAliasAlreadyUsed    = "peer_alias_already_used"
+	codePeerIDAlreadyRegistered = "peer_id_already_registered"
+	codeInvalidRequest          = "invalid_request"
+)
+
+type (
+	// peerIDJSON is the representation of peer ID used in the directory API.
+	peerIDJSON struct {
+		Alias               string `json:"alias"`
+		OffChainAddrString  string `json:"offchain_address"`
+		CommAddr            string `json:"comm_address"`
+		CommType            string `json:"comm_type"`
+		CommCertFingerprint string `json:"comm_cert_fingerprint,omitempty"`
+	}
+
+	// errorJSON is the representation of error responses in the directory API.
+	errorJSON struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+func fromPeerID(p perun.PeerID) peerIDJSON {
+	return peerIDJSON{
+		Alias:               p.Alias,
+		OffChainAddrString:  p.OffChainAddrString,
+		CommAddr:            p.CommAddr,
+		CommType:            p.CommType,
+		CommCertFingerprint: p.CommCertFingerprint,
+	}
+}
+
+func (p peerIDJSON) toPeerID() perun.PeerID {
+	return perun.PeerID{
+		Alias:               p.Alias,
+		OffChainAddrString:  p.OffChainAddrString,
+		CommAddr:            p.CommAddr,
+		CommType:            p.CommType,
+		CommCertFingerprint: p.CommCertFingerprint,
+	}
+}
+
+// Directory is an in-memory implementation of the directory service used by
+// the remote ID provider. It implements http.Handler and can be served using
+// any HTTP server, for example:
+//
+//	http.ListenAndServe("127.0.0.1:8080", remote.NewDirectory())
+//
+// Off-chain addresses are stored as strings and are not validated by the
+// directory. Since the requests are not authenticated, the directory only
+// allows adding new entries; existing entries cannot be changed or removed
+// via the API. The methods on it are safe for concurrent use.
+type Directory struct {
+	mutex          sync.RWMutex
+	peerIDsByAlias map[string]peerIDJSON
+}
+
+// NewDirectory returns an instance of directory, initialized with the given
+// peer IDs.
+func NewDirectory(peerIDs ...perun.PeerID) *Directory {
+	d := &Directory{
+		peerIDsByAlias: make(map[string]peerIDJSON, len(peerIDs)),
+	}
+	for _, p := range peerIDs {
+		d.peerIDsByAlias[p.Alias] = fromPeerID(p)
+	}
+	return d
+}
+
+// ServeHTTP implements http.Handler interface.
+func (d *Directory) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	isPeerPath := strings.HasPrefix(r.URL.Path, peersPath+"/")
+	alias := strings.TrimPrefix(r.URL.Path, peersPath+"/")
+	switch {
+	case r.URL.Path == peersPath && r.Method == http.MethodGet:
+		d.list(w, r.URL.Query().Get(offChainAddrQueryKey))
+	case r.URL.Path == peersPath && r.Method == http.MethodPost:
+		d.add(w, r)
+	case isPeerPath && alias != "" && r.Method == http.MethodGet:
+		d.get(w, alias)
+	default:
+		writeError(w, http.StatusNotFound, codeInvalidRequest, "unknown endpoint")
+	}
+}
+
+func (d *Directory) list(w http.ResponseWriter, offChainAddr string) {
+	d.mutex.RLock()
+	peerIDs := make([]peerIDJSON, 0, len(d.peerIDsByAlias))
+	for _, p := range d.peerIDsByAlias {
+		if offChainAddr == "" || strings.EqualFold(p.OffChainAddrString, offChainAddr) {
+			peerIDs = append(peerIDs, p)
+		}
+	}
+	d.mutex.RUnlock()
+
+	sort.Slice(peerIDs, func(i, j int) bool {
+		return peerIDs[i].Alias < peerIDs[j].Alias
+	})
+	writeJSON(w, http.StatusOK, peerIDs)
+}
+
+func (d *Directory) get(w http.ResponseWriter, alias string) {
+	d.mutex.RLock()
+	p, ok := d.peerIDsByAlias[alias]
+	d.mutex.RUnlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, codePeerIDNotFound, "peer id not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (d *Directory) add(w http.ResponseWriter, r *http.Request) {
+	var p peerIDJSON
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil || p.Alias == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidRequest, "invalid peer id in request body")
+		return
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if oldPeerID, ok := d.peerIDsByAlias[p.Alias]; ok {
+		if oldPeerID == p {
+			writeError(w, http.StatusConflict, codePeerIDAlreadyRegistered, "peer id already registered")
+			return
+		}
+		writeError(w, http.StatusConflict, codePeerAliasAlreadyUsed, "peer alias is already used for another peer id")
+		return
+	}
+	d.peerIDsByAlias[p.Alias] = p
+	writeJSON(w, http.StatusCreated, p)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) //nolint:errcheck,gosec // Nothing can be done if writing the response fails.
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorJSON{Code: code, Message: message})
+}
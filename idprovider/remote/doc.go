@@ -0,0 +1,47 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remote contains an implementation of ID provider, where the peer
+// IDs are stored in a directory service shared by many nodes and accessed
+// over HTTP.
+//
+// The peer IDs are cached locally and the cache is refreshed from the
+// directory when it is older than the configured TTL. When a peer ID is not
+// found in the cache, it is looked up in the directory before reporting it as
+// missing. Write operations are sent to the directory and the cache is updated
+// only when the directory accepts the change.
+//
+// The directory does not authenticate the requests. So, to prevent a node from
+// rewriting or removing the entries used by every other node, the existing
+// entries cannot be changed via the directory API. Update and Delete
+// operations are applied only to the ID provider instance of the node and are
+// retained across refreshes of the cache.
+//
+// The peer ID of the user registered with the alias perun.OwnAlias is
+// specific to each session. Hence it is stored only in the local cache and is
+// never sent to the directory.
+//
+// The package also includes Directory, an in-memory implementation of the
+// directory service. It serves as the reference for the HTTP API and can be
+// used for running the ID provider without any external services.
+//
+// The directory API uses JSON encoding and consists of the following
+// endpoints:
+//   - GET    /peers                    list all peer IDs.
+//   - GET    /peers?offchain_address=X list peer IDs with the off-chain address.
+//   - GET    /peers/{alias}            get the peer ID for the alias.
+//   - POST   /peers                    add a peer ID.
+package remote
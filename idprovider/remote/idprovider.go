@@ -0,0 +1,464 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+)
+
+const (
+	// DefaultCacheTTL is the duration for which the cached peer IDs are used,
+	// when no TTL is specified.
+	DefaultCacheTTL = time.Minute
+
+	// requestTimeout is the timeout for each request sent to the directory.
+	requestTimeout = 10 * time.Second
+
+	// missCacheTTL is the duration for which a peer ID that was not found in the directory is not looked up again.
+	// If the cache TTL is shorter, it is used instead.
+	missCacheTTL = 5 * time.Second
+
+	// Keys for the requests to the directory, used for merging concurrent requests and for caching misses.
+	refreshKey     = "refresh"
+	aliasKeyPrefix = "alias/"
+	addrKeyPrefix  = "addr/"
+)
+
+// IDProvider represents an ID provider that provides access to peer IDs stored in a remote directory service.
+//
+// The peer IDs fetched from the directory are cached and the cache is refreshed when it is older than the TTL. If
+// the refresh fails, the stale cache is used. Peer IDs not found in the cache are looked up in the directory and
+// misses are cached for a short duration. Concurrent refreshes and lookups for the same peer are merged into a
+// single request. Write operations are sent to the directory and the cache is updated only when the directory accepts
+// the change. The mutex is not held while waiting for the directory.
+//
+// Update and Delete operations are applied only to this instance and are never sent to the directory, because the
+// directory does not authenticate the requests and the entries in it are shared by all the nodes. They are stored as
+// overrides and applied over the entries fetched from the directory.
+//
+// The peer ID registered with the alias perun.OwnAlias is stored only in the cache and is never sent to the
+// directory. Entries with this alias in the directory are ignored.
+//
+// It also stores an instance of wallet backend that will be used for decoding address strings.
+type IDProvider struct {
+	mutex          sync.RWMutex
+	peerIDsByAlias map[string]perun.PeerID  // Stores a list of peer IDs indexed by Alias.
+	aliasByAddr    map[string]string        // Stores a list of alias, indexed by off-chain address string.
+	misses         map[string]time.Time     // Stores the expiry of cached misses, indexed by request key.
+	overrides      map[string]*perun.PeerID // Peer IDs updated (or deleted, if nil) locally, indexed by alias.
+	refreshedAt    time.Time
+	inflight       singleflight.Group
+
+	directoryURL  string
+	cacheTTL      time.Duration
+	missTTL       time.Duration
+	httpClient    *http.Client
+	walletBackend perun.WalletBackend
+}
+
+// NewIDProvider returns an instance of ID provider to access the peer IDs in the directory at the given URL.
+//
+// All the peer IDs in the directory are fetched and cached during initialization and an error is returned if the
+// directory cannot be reached. If the cacheTTL is zero, DefaultCacheTTL is used.
+//
+// Backend is used for decoding the address strings.
+func NewIDProvider(directoryURL string, cacheTTL time.Duration, backend perun.WalletBackend) (*IDProvider, error) {
+	if _, err := url.ParseRequestURI(directoryURL); err != nil {
+		return nil, errors.Wrap(err, "parsing directory url")
+	}
+	if cacheTTL == 0 {
+		cacheTTL = DefaultCacheTTL
+	}
+	missTTL := missCacheTTL
+	if cacheTTL < missTTL {
+		missTTL = cacheTTL
+	}
+	c := &IDProvider{
+		peerIDsByAlias: make(map[string]perun.PeerID),
+		aliasByAddr:    make(map[string]string),
+		misses:         make(map[string]time.Time),
+		overrides:      make(map[string]*perun.PeerID),
+		directoryURL:   strings.TrimSuffix(directoryURL, "/"),
+		cacheTTL:       cacheTTL,
+		missTTL:        missTTL,
+		httpClient:     &http.Client{Timeout: requestTimeout},
+		walletBackend:  backend,
+	}
+	if err := c.refresh(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ReadByAlias returns the peer ID corresponding to given alias. If the peer ID is not present in the cache, it is
+// looked up in the directory.
+func (c *IDProvider) ReadByAlias(alias string) (_ perun.PeerID, isPresent bool) {
+	return c.read(aliasKeyPrefix+alias, func() (perun.PeerID, bool) {
+		p, ok := c.peerIDsByAlias[alias]
+		return p, ok
+	}, func() ([]peerIDJSON, error) {
+		var p peerIDJSON
+		err := c.send(http.MethodGet, peersPath+"/"+url.PathEscape(alias), nil, &p)
+		return []peerIDJSON{p}, err
+	})
+}
+
+// ReadByOffChainAddr returns the peer ID corresponding to given off-chain address. If the peer ID is not present in
+// the cache, it is looked up in the directory.
+func (c *IDProvider) ReadByOffChainAddr(offChainAddr pwire.Address) (_ perun.PeerID, isPresent bool) {
+	if offChainAddr == nil {
+		return perun.PeerID{}, false
+	}
+	offChainAddrString := offChainAddr.String()
+	return c.read(addrKeyPrefix+offChainAddrString, func() (perun.PeerID, bool) {
+		alias, ok := c.aliasByAddr[offChainAddrString]
+		if !ok {
+			return perun.PeerID{}, false
+		}
+		p, ok := c.peerIDsByAlias[alias]
+		return p, ok
+	}, func() ([]peerIDJSON, error) {
+		var peerIDs []peerIDJSON
+		query := url.Values{offChainAddrQueryKey: []string{offChainAddrString}}
+		err := c.send(http.MethodGet, peersPath+"?"+query.Encode(), nil, &peerIDs)
+		return peerIDs, err
+	})
+}
+
+// read looks up the peer ID in the cache using readCache, after refreshing the cache if it is stale. If the peer ID
+// is not found, it is looked up in the directory using fetch and added to the cache, unless a miss for the key was
+// cached recently.
+func (c *IDProvider) read(key string, readCache func() (perun.PeerID, bool), fetch func() ([]peerIDJSON, error)) (
+	perun.PeerID, bool,
+) {
+	c.mutex.RLock()
+	isStale := c.isStale()
+	isMissCached := time.Now().Before(c.misses[key])
+	p, ok := readCache()
+	c.mutex.RUnlock()
+	if ok && !isStale {
+		return p, true
+	}
+
+	if isStale {
+		c.refresh() //nolint:errcheck,gosec // On error, the stale cache is used.
+	} else if !isMissCached {
+		c.lookup(key, readCache, fetch)
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return readCache()
+}
+
+// ReadAll returns all the peer IDs, sorted by their aliases. The cache is refreshed before, if it is stale.
+func (c *IDProvider) ReadAll() []perun.PeerID {
+	c.mutex.RLock()
+	isStale := c.isStale()
+	c.mutex.RUnlock()
+	if isStale {
+		c.refresh() //nolint:errcheck,gosec // On error, the stale cache is used.
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	peerIDs := make([]perun.PeerID, 0, len(c.peerIDsByAlias))
+	for _, p := range c.peerIDsByAlias {
+		peerIDs = append(peerIDs, p)
+	}
+	sort.Slice(peerIDs, func(i, j int) bool {
+		return peerIDs[i].Alias < peerIDs[j].Alias
+	})
+	return peerIDs
+}
+
+// lookup fetches the peer ID from the directory and adds it to the cache. If it is not found or if the directory
+// cannot be reached, a miss is cached for the key. Concurrent lookups for the same key are merged.
+func (c *IDProvider) lookup(key string, readCache func() (perun.PeerID, bool), fetch func() ([]peerIDJSON, error)) {
+	c.inflight.Do(key, func() (interface{}, error) { //nolint:errcheck,gosec // Errors are cached as misses.
+		peerIDs, err := fetch()
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		if err == nil {
+			for i := range peerIDs {
+				c.cache(peerIDs[i]) //nolint:errcheck,gosec // Entries with invalid addresses are not cached.
+			}
+		}
+		if _, ok := readCache(); !ok {
+			c.cacheMiss(key)
+		}
+		return nil, err
+	})
+}
+
+// Write adds the peer ID to the directory and to the cache. Returns an error if the alias is already used by same
+// or different peer ID, if the off-chain address of the peer ID cannot be parsed using the wallet backend of this ID
+// Provider or if the directory cannot be reached.
+//
+// If the alias was deleted locally, the peer ID is added only to this instance, as the entry in the directory is
+// not changed.
+func (c *IDProvider) Write(alias string, p perun.PeerID) error {
+	var err error
+	if p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString); err != nil {
+		return errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error())
+	}
+	p.Alias = alias
+
+	if alias == perun.OwnAlias {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		if oldPeerID, ok := c.peerIDsByAlias[alias]; ok {
+			if fromPeerID(oldPeerID) == fromPeerID(p) {
+				return idprovider.ErrPeerIDAlreadyRegistered
+			}
+			return idprovider.ErrPeerAliasAlreadyUsed
+		}
+		c.set(p)
+		return nil
+	}
+
+	c.mutex.Lock()
+	if override, ok := c.overrides[alias]; ok && override == nil {
+		c.overrides[alias] = &p
+		c.set(p)
+		c.mutex.Unlock()
+		return nil
+	}
+	c.mutex.Unlock()
+
+	if err = c.send(http.MethodPost, peersPath, fromPeerID(p), nil); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	c.set(p)
+	c.mutex.Unlock()
+	return nil
+}
+
+// Update replaces the peer ID for the given alias in this instance. The entry in the directory is not changed.
+// Returns an error if peer corresponding to given alias is not found, if the off-chain address is already used by
+// another alias or if the off-chain address of the peer ID cannot be parsed using the wallet backend of this ID
+// Provider.
+func (c *IDProvider) Update(alias string, p perun.PeerID) error {
+	var err error
+	if p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString); err != nil {
+		return errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error())
+	}
+	p.Alias = alias
+	if _, ok := c.ReadByAlias(alias); !ok {
+		return idprovider.ErrPeerIDNotFound
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.peerIDsByAlias[alias]; !ok {
+		return idprovider.ErrPeerIDNotFound
+	}
+	if otherAlias, ok := c.aliasByAddr[p.OffChainAddrString]; ok && otherAlias != alias {
+		return idprovider.ErrOffChainAddrAlreadyUsed
+	}
+	if alias != perun.OwnAlias {
+		c.overrides[alias] = &p
+	}
+	c.set(p)
+	return nil
+}
+
+// Delete deletes the peer from this instance. The entry in the directory is not changed. Returns an error if peer
+// corresponding to given alias is not found.
+func (c *IDProvider) Delete(alias string) error {
+	if _, ok := c.ReadByAlias(alias); !ok {
+		return idprovider.ErrPeerIDNotFound
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.peerIDsByAlias[alias]; !ok {
+		return idprovider.ErrPeerIDNotFound
+	}
+	if alias != perun.OwnAlias {
+		c.overrides[alias] = nil
+	}
+	c.remove(alias)
+	return nil
+}
+
+// UpdateStorage is a no-op, because the changes are sent to the directory in each Write operation and the local
+// overrides are not persisted.
+func (c *IDProvider) UpdateStorage() error {
+	return nil
+}
+
+// isStale returns true if the cache is older than the TTL.
+//
+// Caller should hold the mutex, either for reading or writing.
+func (c *IDProvider) isStale() bool {
+	return time.Since(c.refreshedAt) > c.cacheTTL
+}
+
+// refresh replaces the cache with the list of peer IDs fetched from the directory. The own peer ID is retained, the
+// local overrides are applied and the cached misses are cleared. Concurrent refreshes are merged.
+func (c *IDProvider) refresh() error {
+	_, err, _ := c.inflight.Do(refreshKey, func() (interface{}, error) {
+		var peerIDs []peerIDJSON
+		if err := c.send(http.MethodGet, peersPath, nil, &peerIDs); err != nil {
+			return nil, err
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		ownPeerID, isOwnPresent := c.peerIDsByAlias[perun.OwnAlias]
+		c.peerIDsByAlias = make(map[string]perun.PeerID, len(peerIDs))
+		c.aliasByAddr = make(map[string]string, len(peerIDs))
+		c.misses = make(map[string]time.Time)
+		for i := range peerIDs {
+			c.cache(peerIDs[i]) //nolint:errcheck,gosec // Entries with invalid addresses are not cached.
+		}
+		for _, p := range c.overrides {
+			if p != nil {
+				c.set(*p)
+			}
+		}
+		if isOwnPresent {
+			c.set(ownPeerID)
+		}
+		c.refreshedAt = time.Now()
+		return nil, nil
+	})
+	return err
+}
+
+// cacheMiss records that the peer ID for the key was not found, so that it is not looked up again until the miss
+// expires. Expired misses are removed, so that the number of cached misses stays bounded.
+//
+// Caller should hold the mutex for writing.
+func (c *IDProvider) cacheMiss(key string) {
+	now := time.Now()
+	for k, expiry := range c.misses {
+		if !now.Before(expiry) {
+			delete(c.misses, k)
+		}
+	}
+	c.misses[key] = now.Add(c.missTTL)
+}
+
+// cache parses the off-chain address of the peer ID received from the directory and adds it to the cache. Entries
+// with the alias perun.OwnAlias are ignored and, for entries with a local override, the override is used instead.
+//
+// Caller should hold the mutex for writing.
+func (c *IDProvider) cache(src peerIDJSON) error {
+	if src.Alias == perun.OwnAlias {
+		return nil
+	}
+	if override, ok := c.overrides[src.Alias]; ok {
+		if override != nil {
+			c.set(*override)
+		}
+		return nil
+	}
+	p := src.toPeerID()
+	var err error
+	if p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString); err != nil {
+		return errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error())
+	}
+	c.set(p)
+	return nil
+}
+
+// set adds the peer ID to the cache, replacing the existing entry for the alias if any, and clears the misses cached
+// for it.
+//
+// Caller should hold the mutex for writing.
+func (c *IDProvider) set(p perun.PeerID) {
+	c.remove(p.Alias)
+	c.peerIDsByAlias[p.Alias] = p
+	c.aliasByAddr[p.OffChainAddrString] = p.Alias
+	delete(c.misses, aliasKeyPrefix+p.Alias)
+	delete(c.misses, addrKeyPrefix+p.OffChainAddrString)
+}
+
+// remove deletes the peer ID for the alias from the cache.
+//
+// Caller should hold the mutex for writing.
+func (c *IDProvider) remove(alias string) {
+	if p, ok := c.peerIDsByAlias[alias]; ok {
+		delete(c.aliasByAddr, p.OffChainAddrString)
+		delete(c.peerIDsByAlias, alias)
+	}
+}
+
+// send sends a request to the directory with reqBody encoded as JSON (if not nil) and decodes the response body
+// into respBody (if not nil). The error codes in the response are translated into the errors in idprovider package.
+func (c *IDProvider) send(method, path string, reqBody, respBody interface{}) error {
+	var body io.Reader
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return errors.Wrap(err, "encoding request")
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.directoryURL+path, body) //nolint:noctx // Client timeout is used.
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "sending request to directory")
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var errResp errorJSON
+		if err = json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+			return fmt.Errorf("directory responded with status %s", resp.Status)
+		}
+		switch errResp.Code {
+		case codePeerIDNotFound:
+			return idprovider.ErrPeerIDNotFound
+		case codePeerAliasAlreadyUsed:
+			return idprovider.ErrPeerAliasAlreadyUsed
+		case codePeerIDAlreadyRegistered:
+			return idprovider.ErrPeerIDAlreadyRegistered
+		default:
+			return fmt.Errorf("directory responded with status %s: %s", resp.Status, errResp.Message)
+		}
+	}
+	if respBody == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(respBody), "decoding response")
+}
@@ -0,0 +1,420 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+	"github.com/hyperledger-labs/perun-node/idprovider/remote"
+)
+
+var (
+	peer1 = perun.PeerID{
+		Alias:              "Alice",
+		OffChainAddrString: "0x9282681723920798983380581376586951466585",
+		CommType:           "tcpip",
+		CommAddr:           "127.0.0.1:5751",
+	}
+	peer2 = perun.PeerID{
+		Alias:              "Bob",
+		OffChainAddrString: "0x3369783337071807248093730889602727505701",
+		CommType:           "tcpip",
+		CommAddr:           "127.0.0.1:5750",
+	}
+	peer3 = perun.PeerID{
+		Alias:              "Tom",
+		OffChainAddrString: "0x7187308896023072480933697833370727318468",
+		CommType:           "tcpip",
+		CommAddr:           "127.0.0.1:5753",
+	}
+
+	walletBackend = ethereum.NewWalletBackend()
+)
+
+func init() {
+	for _, p := range []*perun.PeerID{&peer1, &peer2, &peer3} {
+		var err error
+		if p.OffChainAddr, err = walletBackend.ParseAddr(p.OffChainAddrString); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// newDirectoryServerT starts a directory server with the given peer IDs and
+// returns its URL. The server is closed when the test completes.
+func newDirectoryServerT(t *testing.T, peerIDs ...perun.PeerID) (*httptest.Server, string) {
+	t.Helper()
+	server := httptest.NewServer(remote.NewDirectory(peerIDs...))
+	t.Cleanup(server.Close)
+	return server, server.URL
+}
+
+// newCountingDirectoryServerT is like newDirectoryServerT, but it also counts
+// the requests received by the server. Each request waits for the gate
+// function (if not nil) to return before it is handled.
+func newCountingDirectoryServerT(t *testing.T, gate func(*http.Request), peerIDs ...perun.PeerID) (
+	*int32, string,
+) {
+	t.Helper()
+	directory := remote.NewDirectory(peerIDs...)
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		if gate != nil {
+			gate(r)
+		}
+		directory.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return &count, server.URL
+}
+
+func Test_IDProvider_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.IDProvider)(nil), new(remote.IDProvider))
+}
+
+func Test_NewIDProvider(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		_, directoryURL := newDirectoryServerT(t, peer1, peer2)
+		c, err := remote.NewIDProvider(directoryURL, 0, walletBackend)
+		require.NoError(t, err)
+		assert.Equal(t, []perun.PeerID{peer1, peer2}, c.ReadAll())
+	})
+
+	t.Run("invalid_url", func(t *testing.T) {
+		_, err := remote.NewIDProvider("invalid-url", 0, walletBackend)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("directory_unreachable", func(t *testing.T) {
+		server, directoryURL := newDirectoryServerT(t)
+		server.Close()
+		_, err := remote.NewIDProvider(directoryURL, 0, walletBackend)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func Test_IDProvider_Read(t *testing.T) {
+	_, directoryURL := newDirectoryServerT(t, peer1)
+	c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+	require.NoError(t, err)
+	other, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+	require.NoError(t, err)
+
+	t.Run("happy_cached", func(t *testing.T) {
+		gotPeer, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeer)
+		gotPeer, isPresent = c.ReadByOffChainAddr(peer1.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeer)
+	})
+
+	t.Run("happy_lookup_on_miss", func(t *testing.T) {
+		// Peers added by other nodes are looked up, even if the cache is not stale.
+		require.NoError(t, other.Write(peer2.Alias, peer2))
+		require.NoError(t, other.Write(peer3.Alias, peer3))
+
+		gotPeer, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeer)
+		gotPeer, isPresent = c.ReadByOffChainAddr(peer3.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer3, gotPeer)
+	})
+
+	t.Run("missing_peer", func(t *testing.T) {
+		_, isPresent := c.ReadByAlias("unknown-alias")
+		assert.False(t, isPresent)
+		_, isPresent = c.ReadByOffChainAddr(nil)
+		assert.False(t, isPresent)
+	})
+}
+
+func Test_IDProvider_CacheTTL(t *testing.T) {
+	t.Run("happy_refresh_after_ttl", func(t *testing.T) {
+		_, directoryURL := newDirectoryServerT(t, peer1)
+		cacheTTL := 50 * time.Millisecond
+		c, err := remote.NewIDProvider(directoryURL, cacheTTL, walletBackend)
+		require.NoError(t, err)
+		other, err := remote.NewIDProvider(directoryURL, cacheTTL, walletBackend)
+		require.NoError(t, err)
+
+		require.NoError(t, other.Write(peer2.Alias, peer2))
+
+		assert.Equal(t, []perun.PeerID{peer1}, c.ReadAll(), "cached value should be used before ttl expires")
+		time.Sleep(2 * cacheTTL)
+		assert.Equal(t, []perun.PeerID{peer1, peer2}, c.ReadAll(), "value should be refreshed after ttl expires")
+	})
+
+	t.Run("happy_stale_cache_when_unreachable", func(t *testing.T) {
+		server, directoryURL := newDirectoryServerT(t, peer1)
+		cacheTTL := 50 * time.Millisecond
+		c, err := remote.NewIDProvider(directoryURL, cacheTTL, walletBackend)
+		require.NoError(t, err)
+
+		server.Close()
+		time.Sleep(2 * cacheTTL)
+		gotPeer, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeer)
+	})
+}
+
+func Test_IDProvider_Write(t *testing.T) {
+	_, directoryURL := newDirectoryServerT(t, peer1)
+	c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+	require.NoError(t, err)
+
+	t.Run("happy", func(t *testing.T) {
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+
+		other, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+		gotPeer, isPresent := other.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeer)
+	})
+
+	t.Run("happy_own_alias_not_sent", func(t *testing.T) {
+		ownPeer := peer3
+		ownPeer.Alias = perun.OwnAlias
+		require.NoError(t, c.Write(perun.OwnAlias, ownPeer))
+		gotPeer, isPresent := c.ReadByAlias(perun.OwnAlias)
+		assert.True(t, isPresent)
+		assert.Equal(t, ownPeer, gotPeer)
+
+		other, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+		_, isPresent = other.ReadByAlias(perun.OwnAlias)
+		assert.False(t, isPresent)
+	})
+
+	t.Run("peer_already_present", func(t *testing.T) {
+		err := c.Write(peer1.Alias, peer1)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDAlreadyRegistered))
+	})
+
+	t.Run("alias_used_by_diff_peer", func(t *testing.T) {
+		err := c.Write(peer1.Alias, peer3)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerAliasAlreadyUsed))
+	})
+
+	t.Run("invalid_offchain_addr", func(t *testing.T) {
+		peer3Copy := peer3
+		peer3Copy.OffChainAddrString = "invalid-addr"
+		err := c.Write(peer3Copy.Alias, peer3Copy)
+		assert.True(t, errors.Is(err, idprovider.ErrParsingOffChainAddress))
+	})
+}
+
+func Test_IDProvider_Update_Delete(t *testing.T) {
+	_, directoryURL := newDirectoryServerT(t, peer1, peer2)
+	cacheTTL := 50 * time.Millisecond
+	c, err := remote.NewIDProvider(directoryURL, cacheTTL, walletBackend)
+	require.NoError(t, err)
+	updatedPeer1 := peer3
+	updatedPeer1.Alias = peer1.Alias
+
+	t.Run("happy_update_local_only", func(t *testing.T) {
+		require.NoError(t, c.Update(peer1.Alias, updatedPeer1))
+
+		gotPeer, isPresent := c.ReadByOffChainAddr(updatedPeer1.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, updatedPeer1, gotPeer)
+
+		other, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+		gotPeer, _ = other.ReadByAlias(peer1.Alias)
+		assert.Equal(t, peer1, gotPeer)
+	})
+
+	t.Run("happy_delete_local_only", func(t *testing.T) {
+		require.NoError(t, c.Delete(peer2.Alias))
+		_, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.False(t, isPresent)
+
+		other, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+		_, isPresent = other.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+	})
+
+	t.Run("happy_overrides_retained_on_refresh", func(t *testing.T) {
+		time.Sleep(2 * cacheTTL)
+		assert.Equal(t, []perun.PeerID{updatedPeer1}, c.ReadAll())
+		_, isPresent := c.ReadByOffChainAddr(peer2.OffChainAddr)
+		assert.False(t, isPresent)
+	})
+
+	t.Run("happy_write_after_delete", func(t *testing.T) {
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+		gotPeer, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeer)
+		require.NoError(t, c.Delete(peer2.Alias))
+	})
+
+	t.Run("offchain_addr_used_by_another_peer", func(t *testing.T) {
+		_, directoryURL := newDirectoryServerT(t, peer1, peer2)
+		c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+		peer1Copy := peer1
+		peer1Copy.OffChainAddrString = peer2.OffChainAddrString
+		err = c.Update(peer1.Alias, peer1Copy)
+		assert.True(t, errors.Is(err, idprovider.ErrOffChainAddrAlreadyUsed))
+	})
+
+	t.Run("missing_peer", func(t *testing.T) {
+		err := c.Update(peer2.Alias, peer2)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDNotFound))
+		err = c.Delete(peer2.Alias)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDNotFound))
+	})
+}
+
+func Test_Directory_Unauthenticated_Changes_Rejected(t *testing.T) {
+	_, directoryURL := newDirectoryServerT(t, peer1)
+	for _, method := range []string{http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequest(method, directoryURL+"/peers/"+peer1.Alias, nil) //nolint:noctx
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close() //nolint:errcheck,gosec
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, method)
+	}
+
+	c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+	require.NoError(t, err)
+	gotPeer, isPresent := c.ReadByAlias(peer1.Alias)
+	assert.True(t, isPresent)
+	assert.Equal(t, peer1, gotPeer)
+}
+
+func Test_IDProvider_MissCache(t *testing.T) {
+	t.Run("happy_miss_cached", func(t *testing.T) {
+		count, directoryURL := newCountingDirectoryServerT(t, nil, peer1)
+		c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+
+		atomic.StoreInt32(count, 0)
+		for i := 0; i < 3; i++ {
+			_, isPresent := c.ReadByAlias("unknown-alias")
+			assert.False(t, isPresent)
+		}
+		assert.EqualValues(t, 1, atomic.LoadInt32(count), "misses should be cached")
+	})
+
+	t.Run("happy_refresh_clears_miss", func(t *testing.T) {
+		cacheTTL := 50 * time.Millisecond
+		count, directoryURL := newCountingDirectoryServerT(t, nil, peer1)
+		c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+		other, err := remote.NewIDProvider(directoryURL, cacheTTL, walletBackend)
+		require.NoError(t, err)
+
+		_, isPresent := other.ReadByAlias(peer2.Alias)
+		assert.False(t, isPresent)
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+		time.Sleep(2 * cacheTTL)
+
+		atomic.StoreInt32(count, 0)
+		gotPeer, isPresent := other.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeer)
+		assert.EqualValues(t, 1, atomic.LoadInt32(count))
+	})
+
+	t.Run("happy_write_clears_miss", func(t *testing.T) {
+		_, directoryURL := newCountingDirectoryServerT(t, nil, peer1)
+		c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+
+		_, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.False(t, isPresent)
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+		gotPeer, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeer)
+	})
+}
+
+func Test_IDProvider_Concurrency(t *testing.T) {
+	t.Run("happy_concurrent_lookups_merged", func(t *testing.T) {
+		release := make(chan struct{})
+		gate := func(r *http.Request) {
+			if r.URL.Path != "/peers" {
+				<-release
+			}
+		}
+		count, directoryURL := newCountingDirectoryServerT(t, gate, peer1)
+		c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+
+		atomic.StoreInt32(count, 0)
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.ReadByAlias("unknown-alias")
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		assert.EqualValues(t, 1, atomic.LoadInt32(count))
+	})
+
+	t.Run("happy_reads_not_blocked_by_write", func(t *testing.T) {
+		release := make(chan struct{})
+		gate := func(r *http.Request) {
+			if r.Method == http.MethodPost {
+				<-release
+			}
+		}
+		_, directoryURL := newCountingDirectoryServerT(t, gate, peer1)
+		c, err := remote.NewIDProvider(directoryURL, time.Hour, walletBackend)
+		require.NoError(t, err)
+
+		writeErr := make(chan error, 1)
+		go func() {
+			writeErr <- c.Write(peer2.Alias, peer2)
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		gotPeer, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeer)
+		close(release)
+		require.NoError(t, <-writeErr)
+	})
+}
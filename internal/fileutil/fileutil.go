@@ -0,0 +1,57 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fileutil contains helper functions for working with files on the
+// disk, that are shared across the packages in perun-node.
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// WriteFileAtomic writes the data to a temporary file in the same directory
+// and renames it to the given file path, so that the file is either fully
+// updated or left untouched, even if the process dies while writing.
+func WriteFileAtomic(filePath string, data []byte, perm os.FileMode) (err error) {
+	tempFile, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".tmp-*")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary file")
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tempFile.Name())
+		}
+	}()
+
+	if _, err = tempFile.Write(data); err != nil {
+		_ = tempFile.Close()
+		return errors.Wrap(err, "writing to temporary file")
+	}
+	if err = tempFile.Sync(); err != nil {
+		_ = tempFile.Close()
+		return errors.Wrap(err, "syncing temporary file")
+	}
+	if err = tempFile.Close(); err != nil {
+		return errors.Wrap(err, "closing temporary file")
+	}
+	if err = os.Chmod(tempFile.Name(), perm); err != nil {
+		return errors.Wrap(err, "setting file permissions")
+	}
+	return errors.Wrap(os.Rename(tempFile.Name(), filePath), "renaming temporary file")
+}
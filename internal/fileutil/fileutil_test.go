@@ -0,0 +1,56 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileutil_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/internal/fileutil"
+)
+
+func Test_WriteFileAtomic(t *testing.T) {
+	t.Run("happy_create_overwrite", func(t *testing.T) {
+		dir := t.TempDir()
+		filePath := filepath.Join(dir, "file.yaml")
+
+		require.NoError(t, fileutil.WriteFileAtomic(filePath, []byte("first"), 0o600))
+		require.NoError(t, fileutil.WriteFileAtomic(filePath, []byte("second"), 0o640))
+
+		data, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(data))
+		fileInfo, err := os.Stat(filePath)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o640), fileInfo.Mode().Perm())
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "temporary file should not be left behind")
+	})
+
+	t.Run("missing_dir", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "missing-dir", "file.yaml")
+		err := fileutil.WriteFileAtomic(filePath, []byte("data"), 0o600)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+}
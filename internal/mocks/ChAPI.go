@@ -95,6 +95,31 @@ func (_m *ChAPI) GetChInfo() perun.ChInfo {
 	return r0
 }
 
+// GetHistory provides a mock function with given fields: fromVersion, limit
+func (_m *ChAPI) GetHistory(fromVersion uint64, limit uint64) ([]perun.ChHistoryEntry, perun.APIError) {
+	ret := _m.Called(fromVersion, limit)
+
+	var r0 []perun.ChHistoryEntry
+	if rf, ok := ret.Get(0).(func(uint64, uint64) []perun.ChHistoryEntry); ok {
+		r0 = rf(fromVersion, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.ChHistoryEntry)
+		}
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(uint64, uint64) perun.APIError); ok {
+		r1 = rf(fromVersion, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ID provides a mock function with given fields:
 func (_m *ChAPI) ID() string {
 	ret := _m.Called()
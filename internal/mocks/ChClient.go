@@ -72,6 +72,11 @@ func (_m *ChClient) Handle(_a0 client.ProposalHandler, _a1 client.UpdateHandler)
 	_m.Called(_a0, _a1)
 }
 
+// HandleAppMsgs provides a mock function with given fields: handler
+func (_m *ChClient) HandleAppMsgs(handler func(wire.Address, string, []byte)) {
+	_m.Called(handler)
+}
+
 // Log provides a mock function with given fields:
 func (_m *ChClient) Log() log.Logger {
 	ret := _m.Called()
@@ -149,6 +154,20 @@ func (_m *ChClient) RestoreChs(databaseDir string, timeout time.Duration, handle
 	return r0
 }
 
+// SendAppMsg provides a mock function with given fields: ctx, to, appID, data
+func (_m *ChClient) SendAppMsg(ctx context.Context, to wire.Address, appID string, data []byte) error {
+	ret := _m.Called(ctx, to, appID, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, wire.Address, string, []byte) error); ok {
+		r0 = rf(ctx, to, appID, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewChClient interface {
 	mock.TestingT
 	Cleanup(func())
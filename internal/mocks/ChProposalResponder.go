@@ -18,11 +18,11 @@ type ChProposalResponder struct {
 }
 
 // Accept provides a mock function with given fields: _a0, _a1
-func (_m *ChProposalResponder) Accept(_a0 context.Context, _a1 *client.LedgerChannelProposalAccMsg) (session.PChannel, error) {
+func (_m *ChProposalResponder) Accept(_a0 context.Context, _a1 client.ChannelProposalAccept) (session.PChannel, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 session.PChannel
-	if rf, ok := ret.Get(0).(func(context.Context, *client.LedgerChannelProposalAccMsg) session.PChannel); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, client.ChannelProposalAccept) session.PChannel); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -31,7 +31,7 @@ func (_m *ChProposalResponder) Accept(_a0 context.Context, _a1 *client.LedgerCha
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *client.LedgerChannelProposalAccMsg) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, client.ChannelProposalAccept) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
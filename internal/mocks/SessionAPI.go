@@ -37,6 +37,29 @@ func (_m *SessionAPI) AddPeerID(_a0 perun.PeerID) perun.APIError {
 	return r0
 }
 
+// CancelPaymentSchedule provides a mock function with given fields: scheduleID
+func (_m *SessionAPI) CancelPaymentSchedule(scheduleID string) (perun.PaymentSchedule, perun.APIError) {
+	ret := _m.Called(scheduleID)
+
+	var r0 perun.PaymentSchedule
+	if rf, ok := ret.Get(0).(func(string) perun.PaymentSchedule); ok {
+		r0 = rf(scheduleID)
+	} else {
+		r0 = ret.Get(0).(perun.PaymentSchedule)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string) perun.APIError); ok {
+		r1 = rf(scheduleID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields: force
 func (_m *SessionAPI) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 	ret := _m.Called(force)
@@ -62,6 +85,45 @@ func (_m *SessionAPI) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 	return r0, r1
 }
 
+// CreatePaymentSchedule provides a mock function with given fields: _a0
+func (_m *SessionAPI) CreatePaymentSchedule(_a0 perun.PaymentSchedule) (perun.PaymentSchedule, perun.APIError) {
+	ret := _m.Called(_a0)
+
+	var r0 perun.PaymentSchedule
+	if rf, ok := ret.Get(0).(func(perun.PaymentSchedule) perun.PaymentSchedule); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(perun.PaymentSchedule)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(perun.PaymentSchedule) perun.APIError); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeletePeerID provides a mock function with given fields: alias
+func (_m *SessionAPI) DeletePeerID(alias string) perun.APIError {
+	ret := _m.Called(alias)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(string) perun.APIError); ok {
+		r0 = rf(alias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
 // DeployAssetERC20 provides a mock function with given fields: tokenERC20
 func (_m *SessionAPI) DeployAssetERC20(tokenERC20 string) (string, perun.APIError) {
 	ret := _m.Called(tokenERC20)
@@ -163,6 +225,29 @@ func (_m *SessionAPI) GetPeerID(alias string) (perun.PeerID, perun.APIError) {
 	return r0, r1
 }
 
+// GetPeerStatus provides a mock function with given fields: ctx, alias
+func (_m *SessionAPI) GetPeerStatus(ctx context.Context, alias string) (perun.PeerStatus, perun.APIError) {
+	ret := _m.Called(ctx, alias)
+
+	var r0 perun.PeerStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) perun.PeerStatus); ok {
+		r0 = rf(ctx, alias)
+	} else {
+		r0 = ret.Get(0).(perun.PeerStatus)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(context.Context, string) perun.APIError); ok {
+		r1 = rf(ctx, alias)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ID provides a mock function with given fields:
 func (_m *SessionAPI) ID() string {
 	ret := _m.Called()
@@ -191,6 +276,56 @@ func (_m *SessionAPI) IsAssetRegistered(asset channel.Asset) bool {
 	return r0
 }
 
+// ListPaymentSchedules provides a mock function with given fields:
+func (_m *SessionAPI) ListPaymentSchedules() ([]perun.PaymentSchedule, perun.APIError) {
+	ret := _m.Called()
+
+	var r0 []perun.PaymentSchedule
+	if rf, ok := ret.Get(0).(func() []perun.PaymentSchedule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.PaymentSchedule)
+		}
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func() perun.APIError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListPeerIDs provides a mock function with given fields:
+func (_m *SessionAPI) ListPeerIDs() ([]perun.PeerID, perun.APIError) {
+	ret := _m.Called()
+
+	var r0 []perun.PeerID
+	if rf, ok := ret.Get(0).(func() []perun.PeerID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.PeerID)
+		}
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func() perun.APIError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // OpenCh provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *SessionAPI) OpenCh(_a0 context.Context, _a1 perun.BalInfo, _a2 perun.App, _a3 uint64) (perun.ChInfo, perun.APIError) {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
@@ -214,6 +349,52 @@ func (_m *SessionAPI) OpenCh(_a0 context.Context, _a1 perun.BalInfo, _a2 perun.A
 	return r0, r1
 }
 
+// OpenVirtualCh provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *SessionAPI) OpenVirtualCh(_a0 context.Context, _a1 perun.VirtualChParents, _a2 perun.BalInfo, _a3 perun.App, _a4 uint64) (perun.ChInfo, perun.APIError) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 perun.ChInfo
+	if rf, ok := ret.Get(0).(func(context.Context, perun.VirtualChParents, perun.BalInfo, perun.App, uint64) perun.ChInfo); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Get(0).(perun.ChInfo)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(context.Context, perun.VirtualChParents, perun.BalInfo, perun.App, uint64) perun.APIError); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// Pay provides a mock function with given fields: ctx, targetAlias, currency, amount
+func (_m *SessionAPI) Pay(ctx context.Context, targetAlias string, currency string, amount string) (perun.RoutedPayment, perun.APIError) {
+	ret := _m.Called(ctx, targetAlias, currency, amount)
+
+	var r0 perun.RoutedPayment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) perun.RoutedPayment); ok {
+		r0 = rf(ctx, targetAlias, currency, amount)
+	} else {
+		r0 = ret.Get(0).(perun.RoutedPayment)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) perun.APIError); ok {
+		r1 = rf(ctx, targetAlias, currency, amount)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // Progress provides a mock function with given fields: _a0, _a1
 func (_m *SessionAPI) Progress(_a0 context.Context, _a1 perun.ProgressReq) perun.APIError {
 	ret := _m.Called(_a0, _a1)
@@ -283,6 +464,55 @@ func (_m *SessionAPI) RespondChProposal(_a0 context.Context, _a1 string, _a2 boo
 	return r0, r1
 }
 
+// RespondVirtualChProposal provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SessionAPI) RespondVirtualChProposal(_a0 context.Context, _a1 string, _a2 bool) (perun.ChInfo, perun.APIError) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 perun.ChInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) perun.ChInfo); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(perun.ChInfo)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) perun.APIError); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// SendAppMsg provides a mock function with given fields: ctx, peerAlias, appID, data
+func (_m *SessionAPI) SendAppMsg(ctx context.Context, peerAlias string, appID string, data []byte) perun.APIError {
+	ret := _m.Called(ctx, peerAlias, appID, data)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) perun.APIError); ok {
+		r0 = rf(ctx, peerAlias, appID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
+// SetChUpdateInterceptor provides a mock function with given fields: appID, interceptor
+func (_m *SessionAPI) SetChUpdateInterceptor(appID string, interceptor perun.ChUpdateInterceptor) {
+	_m.Called(appID, interceptor)
+}
+
+// SetCloseHandler provides a mock function with given fields: id, handler
+func (_m *SessionAPI) SetCloseHandler(id string, handler perun.SessionCloseHandler) {
+	_m.Called(id, handler)
+}
+
 // StartWatchingLedgerChannel provides a mock function with given fields: _a0, _a1
 func (_m *SessionAPI) StartWatchingLedgerChannel(_a0 context.Context, _a1 channel.SignedState) (watcher.StatesPub, watcher.AdjudicatorSub, perun.APIError) {
 	ret := _m.Called(_a0, _a1)
@@ -367,6 +597,22 @@ func (_m *SessionAPI) StopWatching(_a0 context.Context, _a1 [32]byte) perun.APIE
 	return r0
 }
 
+// SubAppMsgs provides a mock function with given fields: appID, notifier
+func (_m *SessionAPI) SubAppMsgs(appID string, notifier perun.AppMsgNotifier) perun.APIError {
+	ret := _m.Called(appID, notifier)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(string, perun.AppMsgNotifier) perun.APIError); ok {
+		r0 = rf(appID, notifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
 // SubChProposals provides a mock function with given fields: _a0
 func (_m *SessionAPI) SubChProposals(_a0 perun.ChProposalNotifier) perun.APIError {
 	ret := _m.Called(_a0)
@@ -383,6 +629,22 @@ func (_m *SessionAPI) SubChProposals(_a0 perun.ChProposalNotifier) perun.APIErro
 	return r0
 }
 
+// SubPeerStatus provides a mock function with given fields: alias, notifier
+func (_m *SessionAPI) SubPeerStatus(alias string, notifier perun.PeerStatusNotifier) perun.APIError {
+	ret := _m.Called(alias, notifier)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(string, perun.PeerStatusNotifier) perun.APIError); ok {
+		r0 = rf(alias, notifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
 // Subscribe provides a mock function with given fields: _a0, _a1
 func (_m *SessionAPI) Subscribe(_a0 context.Context, _a1 [32]byte) (channel.AdjudicatorSubscription, perun.APIError) {
 	ret := _m.Called(_a0, _a1)
@@ -408,6 +670,22 @@ func (_m *SessionAPI) Subscribe(_a0 context.Context, _a1 [32]byte) (channel.Adju
 	return r0, r1
 }
 
+// UnsubAppMsgs provides a mock function with given fields: appID
+func (_m *SessionAPI) UnsubAppMsgs(appID string) perun.APIError {
+	ret := _m.Called(appID)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(string) perun.APIError); ok {
+		r0 = rf(appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
 // UnsubChProposals provides a mock function with given fields:
 func (_m *SessionAPI) UnsubChProposals() perun.APIError {
 	ret := _m.Called()
@@ -424,6 +702,38 @@ func (_m *SessionAPI) UnsubChProposals() perun.APIError {
 	return r0
 }
 
+// UnsubPeerStatus provides a mock function with given fields: alias
+func (_m *SessionAPI) UnsubPeerStatus(alias string) perun.APIError {
+	ret := _m.Called(alias)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(string) perun.APIError); ok {
+		r0 = rf(alias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
+// UpdatePeerID provides a mock function with given fields: _a0
+func (_m *SessionAPI) UpdatePeerID(_a0 perun.PeerID) perun.APIError {
+	ret := _m.Called(_a0)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(perun.PeerID) perun.APIError); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
 // Withdraw provides a mock function with given fields: _a0, _a1, _a2
 func (_m *SessionAPI) Withdraw(_a0 context.Context, _a1 perun.AdjudicatorReq, _a2 channel.StateMap) perun.APIError {
 	ret := _m.Called(_a0, _a1, _a2)
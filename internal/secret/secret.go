@@ -0,0 +1,105 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secret resolves the secrets, such as keystore passwords, from the
+// sources specified in the configuration, so that they need not be written
+// in clear text in the config files.
+//
+// The errors returned by this package never include the value of the secret.
+package secret
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+)
+
+// Prefixes and keywords for the supported secret sources.
+const (
+	// EnvPrefix is used to read the secret from an environment variable.
+	// E.g. "env:PERUN_PASSWORD".
+	EnvPrefix = "env:"
+
+	// FilePrefix is used to read the secret from a file. The trailing
+	// newline, if any, is trimmed. E.g. "file:/run/secrets/password".
+	FilePrefix = "file:"
+
+	// Prompt is used to read the secret from the terminal, without echoing
+	// it. It is supported only by the command line tools.
+	Prompt = "prompt"
+)
+
+// Resolve returns the secret from the given source. The source can be an
+// environment variable (env:VAR) or a file (file:PATH). Any other value is
+// returned as such and is treated as the secret itself, for backward
+// compatibility with the configs having secrets in clear text.
+//
+// Prompt source is not supported, as it cannot be used by a node running in
+// the background.
+func Resolve(source string) (string, error) {
+	if source == Prompt {
+		return "", errors.New("prompt is not supported as secret source here, use env or file")
+	}
+	return resolve(source)
+}
+
+// ResolveWithPrompt is same as Resolve, but additionally supports the prompt
+// source. For the prompt source, the message is printed on stderr and the
+// secret is read from the terminal.
+func ResolveWithPrompt(source, message string) (string, error) {
+	if source == Prompt {
+		return readFromTerminal(message)
+	}
+	return resolve(source)
+}
+
+func resolve(source string) (string, error) {
+	switch {
+	case strings.HasPrefix(source, EnvPrefix):
+		name := strings.TrimPrefix(source, EnvPrefix)
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return "", errors.Errorf("environment variable %s is not set", name)
+		}
+		return value, nil
+	case strings.HasPrefix(source, FilePrefix):
+		data, err := os.ReadFile(filepath.Clean(strings.TrimPrefix(source, FilePrefix)))
+		if err != nil {
+			return "", errors.Wrap(err, "reading secret file")
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	default:
+		return source, nil
+	}
+}
+
+func readFromTerminal(message string) (string, error) {
+	fd := int(os.Stdin.Fd()) //nolint:gosec // File descriptors fit in int.
+	if !term.IsTerminal(fd) {
+		return "", errors.New("prompt as secret source requires a terminal")
+	}
+	fmt.Fprint(os.Stderr, message) //nolint:errcheck // Best effort.
+	data, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr) //nolint:errcheck // Best effort.
+	if err != nil {
+		return "", errors.Wrap(err, "reading secret from terminal")
+	}
+	return string(data), nil
+}
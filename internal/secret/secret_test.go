@@ -0,0 +1,90 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/internal/secret"
+)
+
+func Test_Resolve(t *testing.T) {
+	t.Run("happy_literal", func(t *testing.T) {
+		got, err := secret.Resolve("some-password")
+		require.NoError(t, err)
+		assert.Equal(t, "some-password", got)
+	})
+	t.Run("happy_empty", func(t *testing.T) {
+		got, err := secret.Resolve("")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("happy_env", func(t *testing.T) {
+		t.Setenv("PERUN_TEST_SECRET", "some-password")
+		got, err := secret.Resolve("env:PERUN_TEST_SECRET")
+		require.NoError(t, err)
+		assert.Equal(t, "some-password", got)
+	})
+	t.Run("happy_file", func(t *testing.T) {
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		require.NoError(t, os.WriteFile(secretFile, []byte("some-password\n"), 0o600))
+		got, err := secret.Resolve("file:" + secretFile)
+		require.NoError(t, err)
+		assert.Equal(t, "some-password", got)
+	})
+	t.Run("missing_env", func(t *testing.T) {
+		_, err := secret.Resolve("env:PERUN_TEST_MISSING_SECRET")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "PERUN_TEST_MISSING_SECRET")
+	})
+	t.Run("missing_file", func(t *testing.T) {
+		_, err := secret.Resolve("file:" + filepath.Join(t.TempDir(), "missing"))
+		require.Error(t, err)
+	})
+	t.Run("prompt", func(t *testing.T) {
+		_, err := secret.Resolve(secret.Prompt)
+		require.Error(t, err)
+	})
+}
+
+func Test_ResolveWithPrompt(t *testing.T) {
+	t.Run("happy_env", func(t *testing.T) {
+		t.Setenv("PERUN_TEST_SECRET", "some-password")
+		got, err := secret.ResolveWithPrompt("env:PERUN_TEST_SECRET", "Password: ")
+		require.NoError(t, err)
+		assert.Equal(t, "some-password", got)
+	})
+	t.Run("prompt_without_terminal", func(t *testing.T) {
+		stdin := os.Stdin
+		t.Cleanup(func() { os.Stdin = stdin })
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			r.Close() //nolint:errcheck,gosec
+			w.Close() //nolint:errcheck,gosec
+		})
+		os.Stdin = r
+
+		_, err = secret.ResolveWithPrompt(secret.Prompt, "Password: ")
+		require.Error(t, err)
+	})
+}
@@ -17,15 +17,19 @@
 package node
 
 import (
+	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
 	psync "polycry.pt/poly-go/sync"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app"
 	"github.com/hyperledger-labs/perun-node/blockchain"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
 	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/secret"
 	"github.com/hyperledger-labs/perun-node/log"
 	"github.com/hyperledger-labs/perun-node/session"
 )
@@ -33,9 +37,11 @@ import (
 type node struct {
 	log.Logger
 	cfg              perun.NodeConfig
-	sessions         map[string]perun.SessionAPI
+	sessions         map[string]*storedSession
+	sessionStore     *sessionStore
 	contractRegistry perun.ContractRegistry
 	currencyRegistry perun.CurrencyRegistry
+	appRegistry      perun.AppRegistry
 	psync.Mutex
 }
 
@@ -50,7 +56,19 @@ func (e Error) Error() string {
 // New returns a perun NodeAPI instance initialized using the given config.
 // This should be called only once, subsequent calls after the first non error
 // response will return an error.
-func New(cfg perun.NodeConfig) (perun.NodeAPI, error) {
+//
+// The given apps are registered on the node, so that channels using these apps
+// can be opened in any of the sessions. They are registered before the
+// sessions are re-opened, so that persisted channels using these apps can be
+// restored.
+//
+// If a sessions file is configured, the sessions that were open when the node
+// was last running will be re-opened with the same session IDs and their
+// persisted channels will be restored.
+func New(cfg perun.NodeConfig, apps ...perun.AppDef) (perun.NodeAPI, error) {
+	if err := ethereum.ValidateGasConfig(cfg.Gas, cfg.OnChainTxTimeout); err != nil {
+		return nil, errors.WithMessage(err, "validating gas config")
+	}
 	chain, err := ethereum.NewROChainBackend(cfg.ChainURL, cfg.ChainConnTimeout)
 	if err != nil {
 		return nil, errors.WithMessage(err, "connecting to blockchain")
@@ -70,18 +88,57 @@ func New(cfg perun.NodeConfig) (perun.NodeAPI, error) {
 		return nil, err
 	}
 
+	appRegistry := app.NewRegistry()
+	for i := range apps {
+		if err = appRegistry.Register(apps[i]); err != nil {
+			return nil, errors.WithMessagef(err, "registering app %s", apps[i].Name())
+		}
+	}
+
 	err = log.InitLogger(cfg.LogLevel, cfg.LogFile)
 	if err != nil {
 		return nil, errors.WithMessage(err, "initializing logger for node")
 	}
 
-	return &node{
+	sessionStore, err := newSessionStore(cfg.SessionsFile)
+	if err != nil {
+		return nil, errors.WithMessage(err, "initializing sessions store")
+	}
+
+	n := &node{
 		Logger:           log.NewLoggerWithField("node", 1), // ID of the node is always 1.
 		cfg:              cfg,
-		sessions:         make(map[string]perun.SessionAPI),
+		sessions:         make(map[string]*storedSession),
+		sessionStore:     sessionStore,
 		contractRegistry: contractRegistry,
 		currencyRegistry: currencyRegistry,
-	}, nil
+		appRegistry:      appRegistry,
+	}
+	n.reopenSessions()
+	return n, nil
+}
+
+// reopenSessions re-opens each of the sessions in the session store. The
+// channels persisted by each session are restored when it is opened.
+//
+// If a session cannot be re-opened, the error is logged and the record is
+// retained, so that it will be retried when the node is restarted again.
+// Sessions having the wallet passwords configured as prompt cannot be
+// re-opened, as there is no user to enter them.
+func (n *node) reopenSessions() {
+	n.Lock()
+	defer n.Unlock()
+
+	for _, r := range n.sessionStore.list() {
+		sess, err := n.openSession(r.ID, r.ConfigFile, perun.WalletPasswords{})
+		if err != nil {
+			n.WithFields(log.Fields{"sessionID": r.ID, "configFile": r.ConfigFile}).
+				Errorf("Re-opening persisted session: %v", err)
+			continue
+		}
+		n.WithFields(log.Fields{"sessionID": sess.ID(), "restoredChs": len(sess.GetChsInfo())}).
+			Info("Re-opened persisted session")
+	}
 }
 
 func initContractRegistry(chain perun.ROChainBackend, adjudicator, assetETH string) (
@@ -153,11 +210,16 @@ func (n *node) GetConfig() perun.NodeConfig {
 // channels were persisted during the previous instance of the session, they
 // will be restored and their last known info will be returned.
 //
+// The passwords are used for the wallets whose password is configured as
+// prompt in the config file and are ignored for the others.
+//
 // If there is an error, it will be one of the following codes:
 // - ErrInvalidArgument with Name:"configFile" when config file cannot be accessed.
 // - ErrInvalidConfig when any of the configuration is invalid.
 // - ErrUnknownInternal.
-func (n *node) OpenSession(configFile string) (string, []perun.ChInfo, perun.APIError) {
+func (n *node) OpenSession(configFile string, passwords perun.WalletPasswords) (
+	string, []perun.ChInfo, perun.APIError,
+) {
 	n.WithField("method", "OpenSession").Infof("\nReceived request with params %+v", configFile)
 	n.Lock()
 	defer n.Unlock()
@@ -169,11 +231,43 @@ func (n *node) OpenSession(configFile string) (string, []perun.ChInfo, perun.API
 		}
 	}()
 
+	// Absolute path is recorded, so that the session can be re-opened even if
+	// the node is restarted from a different working directory.
+	absConfigFile, err := filepath.Abs(configFile)
+	if err != nil {
+		err = errors.Wrap(err, "resolving absolute path")
+		apiErr = perun.NewAPIErrInvalidArgument(err, perun.ArgNameConfigFile, configFile)
+		return "", nil, apiErr
+	}
+
+	var sess *storedSession
+	sess, apiErr = n.openSession("", absConfigFile, passwords)
+	if apiErr != nil {
+		return "", nil, apiErr
+	}
+
+	n.WithFields(log.Fields{"method": "OpenSession", "sessionID": sess.ID()}).Info("Session opened successfully")
+	return sess.ID(), sess.GetChsInfo(), nil
+}
+
+// openSession opens a session with the configuration in the given file and
+// adds it to the node. If the session ID is empty, a new one is computed.
+//
+// The session is recorded in the session store, so that it can be re-opened
+// when the node is restarted. It is removed from the store when the session
+// is closed.
+//
+// The node mutex should be held when calling this function.
+func (n *node) openSession(sessionID, configFile string, passwords perun.WalletPasswords) (
+	*storedSession, perun.APIError,
+) {
 	sessionConfig, err := session.ParseConfig(configFile)
 	if err != nil {
 		err = errors.WithMessage(err, "parsing config")
-		return "", nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNameConfigFile, configFile)
+		return nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNameConfigFile, configFile)
 	}
+	setPromptedPassword(&sessionConfig.User.OnChainWallet, passwords.OnChain)
+	setPromptedPassword(&sessionConfig.User.OffChainWallet, passwords.OffChain)
 
 	if sessionConfig.FundingType == "local" {
 		// AssetETH is set during contract registry init and will always be
@@ -182,14 +276,79 @@ func (n *node) OpenSession(configFile string) (string, []perun.ChInfo, perun.API
 	}
 	// Set adjudicator anyways until remote adjudicator is implemented.
 	sessionConfig.Adjudicator = n.contractRegistry.Adjudicator()
-	sess, apiErr := session.New(sessionConfig, n.currencyRegistry, n.contractRegistry)
+	if sessionConfig.Gas == (perun.GasConfig{}) {
+		sessionConfig.Gas = n.cfg.Gas
+	}
+	sess, apiErr := session.NewWithID(sessionID, sessionConfig, n.currencyRegistry, n.contractRegistry)
 	if apiErr != nil {
-		return "", nil, apiErr
+		return nil, apiErr
 	}
-	n.sessions[sess.ID()] = sess
 
-	n.WithFields(log.Fields{"method": "OpenSession", "sessionID": sess.ID()}).Info("Session opened successfully")
-	return sess.ID(), sess.GetChsInfo(), nil
+	record, ok := n.sessionStore.get(sess.ID())
+	if !ok {
+		record = sessionRecord{
+			ID:         sess.ID(),
+			UserAlias:  sessionConfig.User.Alias,
+			ConfigFile: configFile,
+			OpenedAt:   time.Now().UTC(),
+		}
+	}
+	if err = n.sessionStore.put(record); err != nil {
+		// Session is still usable, it just won't be re-opened after a restart.
+		n.WithField("sessionID", sess.ID()).Errorf("Persisting session record: %v", err)
+	}
+
+	wrapped := &storedSession{Session: sess, record: record, onClose: n.forgetSession}
+	n.sessions[sess.ID()] = wrapped
+	return wrapped, nil
+}
+
+// setPromptedPassword sets the password entered by the user, if the wallet
+// password is configured as prompt. If no password was entered, the config
+// is retained as such and opening the session fails when resolving it.
+func setPromptedPassword(cfg *session.WalletConfig, password string) {
+	if cfg.Password == secret.Prompt && password != "" {
+		cfg.Password = password
+	}
+}
+
+// forgetSession removes the session from the node and its record from the
+// session store, so that it is not re-opened when the node is restarted.
+func (n *node) forgetSession(sessionID string) {
+	n.Lock()
+	defer n.Unlock()
+	delete(n.sessions, sessionID)
+	if err := n.sessionStore.delete(sessionID); err != nil {
+		n.WithField("sessionID", sessionID).Errorf("Removing session record: %v", err)
+	}
+}
+
+// storedSession wraps a session to remove it from the node and its record
+// from the session store when it is closed.
+type storedSession struct {
+	*session.Session
+	record  sessionRecord
+	onClose func(sessionID string)
+}
+
+// info returns the session info for the administration APIs.
+func (s *storedSession) info() perun.SessionInfo {
+	return perun.SessionInfo{
+		ID:         s.record.ID,
+		UserAlias:  s.record.UserAlias,
+		ConfigFile: s.record.ConfigFile,
+		OpenedAt:   s.record.OpenedAt.Unix(),
+		OpenChs:    s.OpenChsCount(),
+	}
+}
+
+// Close closes the session and removes its record from the session store.
+func (s *storedSession) Close(force bool) ([]perun.ChInfo, perun.APIError) {
+	openChsInfo, apiErr := s.Session.Close(force)
+	if apiErr == nil {
+		s.onClose(s.ID())
+	}
+	return openChsInfo, apiErr
 }
 
 // RegisterCurrency registers the currency for the specified token address in
@@ -259,10 +418,90 @@ func (n *node) RegisterCurrency(tokenERC20Addr, assetERC20Addr string) (symbol s
 	return symbol, nil
 }
 
-// Help returns the list of user APIs served by the node.
+// Help returns the list of user APIs served by the node. It includes the
+// payment API and the names of the apps registered on the node, which can be
+// used via the app API.
 func (n *node) Help() []string {
 	n.Debug("Received request: node.Help")
-	return []string{"payment"}
+	return append([]string{"payment"}, n.appRegistry.Names()...)
+}
+
+// ListSessions returns the info of all the sessions open on the node, in the
+// order in which they were opened.
+func (n *node) ListSessions() []perun.SessionInfo {
+	n.WithField("method", "ListSessions").Info("Received request")
+	n.Lock()
+	sessions := make([]*storedSession, 0, len(n.sessions))
+	for _, sess := range n.sessions {
+		sessions = append(sessions, sess)
+	}
+	n.Unlock()
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].record.OpenedAt.Before(sessions[j].record.OpenedAt)
+	})
+	sessionsInfo := make([]perun.SessionInfo, len(sessions))
+	for i := range sessions {
+		sessionsInfo[i] = sessions[i].info()
+	}
+	return sessionsInfo
+}
+
+// GetSessionInfo returns the info of the session corresponding to the given
+// session ID.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound when the session ID is not known.
+func (n *node) GetSessionInfo(sessionID string) (perun.SessionInfo, perun.APIError) {
+	n.WithField("method", "GetSessionInfo").Info("Received request with params:", sessionID)
+
+	sess, apiErr := n.getStoredSession(sessionID)
+	if apiErr != nil {
+		n.WithFields(perun.APIErrAsMap("GetSessionInfo", apiErr)).Error(apiErr.Message())
+		return perun.SessionInfo{}, apiErr
+	}
+	return sess.info(), nil
+}
+
+// CloseSession closes the session corresponding to the given session ID and
+// removes it from the node. See SessionAPI.Close for the semantics of the force
+// option.
+//
+// Once closed, the session will not be re-opened when the node is restarted.
+// But it can be opened again using the OpenSession API.
+//
+// If there is an error, it will be one of the following codes:
+//   - ErrResourceNotFound when the session ID is not known.
+//   - ErrFailedPreCondition when force=false and unclosed channels exists.
+//     Additional Info will contain an extra field: OpenChannelsInfo that
+//     contains a list of Channel Info.
+//   - ErrUnknownInternal.
+func (n *node) CloseSession(sessionID string, force bool) ([]perun.ChInfo, perun.APIError) {
+	n.WithField("method", "CloseSession").Infof("\nReceived request with params %+v,%+v", sessionID, force)
+
+	// Node mutex is not held when closing the session, because the session
+	// acquires it for removing itself from the node once it is closed.
+	sess, apiErr := n.getStoredSession(sessionID)
+	if apiErr != nil {
+		n.WithFields(perun.APIErrAsMap("CloseSession", apiErr)).Error(apiErr.Message())
+		return nil, apiErr
+	}
+	openChsInfo, apiErr := sess.Close(force)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	n.WithFields(log.Fields{"method": "CloseSession", "sessionID": sessionID}).Info("Session closed successfully")
+	return openChsInfo, nil
+}
+
+func (n *node) getStoredSession(sessionID string) (*storedSession, perun.APIError) {
+	n.Lock()
+	sess, ok := n.sessions[sessionID]
+	n.Unlock()
+	if !ok {
+		return nil, perun.NewAPIErrResourceNotFound(perun.ResTypeSession, sessionID)
+	}
+	return sess, nil
 }
 
 // GetSession is an internal API that retreives the session API instance
@@ -286,3 +525,20 @@ func (n *node) GetSession(sessionID string) (perun.SessionAPI, perun.APIError) {
 	n.WithField("method", "GetSession").Info("Session retrieved:")
 	return sess, nil
 }
+
+// GetApp internally returns the app registered on the node with the given
+// name.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound when no app is registered with the given name.
+func (n *node) GetApp(name string) (perun.AppDef, perun.APIError) {
+	n.WithField("method", "GetApp").Info("Received request with params:", name)
+
+	appDef, ok := n.appRegistry.App(name)
+	if !ok {
+		apiErr := perun.NewAPIErrResourceNotFound(perun.ResTypeApp, name)
+		n.WithFields(perun.APIErrAsMap("GetApp (internal)", apiErr)).Error(apiErr.Message())
+		return nil, apiErr
+	}
+	return appDef, nil
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/metered"
+	"github.com/hyperledger-labs/perun-node/app/tictactoe"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
 	"github.com/hyperledger-labs/perun-node/node"
 	"github.com/hyperledger-labs/perun-node/node/nodetest"
@@ -55,6 +57,14 @@ func Test_Integ_New(t *testing.T) {
 		t.Log(err)
 	})
 
+	t.Run("err_invalid_gas_config", func(t *testing.T) {
+		cfg := nodetest.NewConfig(true)
+		cfg.Gas = perun.GasConfig{GasPrice: 1, MaxFeePerGas: 1}
+		_, err := node.New(cfg)
+		require.Error(t, err)
+		t.Log(err)
+	})
+
 	t.Run("err_invalid_adjudicator_address", func(t *testing.T) {
 		cfg := nodetest.NewConfig(true)
 		cfg.Adjudicator = "invalid-addr"
@@ -123,6 +133,37 @@ func Test_Integ_Node_Help(t *testing.T) {
 	assert.Equal(t, []string{"payment"}, apis)
 }
 
+func Test_Integ_Node_Apps(t *testing.T) {
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	game := tictactoe.New(ethereumtest.NewRandomAddress(prng))
+	service := metered.New(ethereumtest.NewRandomAddress(prng))
+
+	t.Run("happy", func(t *testing.T) {
+		n, err := node.New(nodetest.NewConfig(true), game, service)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+
+		assert.Equal(t, []string{"payment", tictactoe.Name, metered.Name}, n.Help())
+		gotApp, err := n.GetApp(tictactoe.Name)
+		require.NoError(t, err)
+		assert.Equal(t, game, gotApp)
+	})
+
+	t.Run("error_app_not_found", func(t *testing.T) {
+		n, err := node.New(nodetest.NewConfig(true), game)
+		require.NoError(t, err)
+
+		_, apiErr := n.GetApp(metered.Name)
+		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, apiErr.AddInfo(), perun.ResTypeApp, metered.Name)
+	})
+
+	t.Run("error_duplicate_app", func(t *testing.T) {
+		_, err := node.New(nodetest.NewConfig(true), game, tictactoe.New(ethereumtest.NewRandomAddress(prng)))
+		require.Error(t, err)
+	})
+}
+
 func Test_Integ_Node_OpenSession_GetSession(t *testing.T) {
 	t.Run("happy", func(t *testing.T) {
 		n, err := node.New(nodetest.NewConfig(true))
@@ -132,7 +173,7 @@ func Test_Integ_Node_OpenSession_GetSession(t *testing.T) {
 		sessionCfg := sessiontest.NewConfigT(t, prng)
 		sessionCfgFile := sessiontest.NewConfigFileT(t, sessionCfg)
 
-		sessionID, _, err := n.OpenSession(sessionCfgFile)
+		sessionID, _, err := n.OpenSession(sessionCfgFile, perun.WalletPasswords{})
 		require.NoError(t, err)
 		assert.NotZero(t, sessionID)
 
@@ -153,7 +194,7 @@ func Test_Integ_Node_OpenSession_GetSession(t *testing.T) {
 		sessionCfg.ChainURL = invalidChainURL
 		sessionCfgFile := sessiontest.NewConfigFileT(t, sessionCfg)
 
-		_, _, err = n.OpenSession(sessionCfgFile)
+		_, _, err = n.OpenSession(sessionCfgFile, perun.WalletPasswords{})
 		require.Error(t, err)
 		t.Log(err)
 	})
@@ -201,3 +242,50 @@ func Test_Integ_Node_RegisterCurrency(t *testing.T) {
 		}
 	})
 }
+
+func Test_Integ_Node_ListSessions_GetSessionInfo_CloseSession(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		n, err := node.New(nodetest.NewConfig(true))
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+		sessionCfg := sessiontest.NewConfigT(t, prng)
+		sessionCfgFile := sessiontest.NewConfigFileT(t, sessionCfg)
+
+		sessionID, _, err := n.OpenSession(sessionCfgFile, perun.WalletPasswords{})
+		require.NoError(t, err)
+
+		sessionsInfo := n.ListSessions()
+		require.Len(t, sessionsInfo, 1)
+		assert.Equal(t, sessionID, sessionsInfo[0].ID)
+		assert.Equal(t, sessionCfgFile, sessionsInfo[0].ConfigFile)
+		assert.Zero(t, sessionsInfo[0].OpenChs)
+
+		sessionInfo, apiErr := n.GetSessionInfo(sessionID)
+		require.NoError(t, apiErr)
+		assert.Equal(t, sessionsInfo[0], sessionInfo)
+
+		openChsInfo, apiErr := n.CloseSession(sessionID, false)
+		require.NoError(t, apiErr)
+		assert.Len(t, openChsInfo, 0)
+		assert.Len(t, n.ListSessions(), 0)
+
+		_, apiErr = n.GetSession(sessionID)
+		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrResourceNotFound)
+	})
+
+	t.Run("unknown_session", func(t *testing.T) {
+		n, err := node.New(nodetest.NewConfig(true))
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		unknownSessID := "unknown session id"
+
+		_, apiErr := n.GetSessionInfo(unknownSessID)
+		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, apiErr.AddInfo(), perun.ResTypeSession, unknownSessID)
+
+		_, apiErr = n.CloseSession(unknownSessID, false)
+		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, apiErr.AddInfo(), perun.ResTypeSession, unknownSessID)
+	})
+}
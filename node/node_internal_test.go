@@ -22,8 +22,28 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/internal/secret"
+	"github.com/hyperledger-labs/perun-node/session"
 )
 
 func Test_NodeAPI_Interface(t *testing.T) {
 	assert.Implements(t, (*perun.NodeAPI)(nil), new(node))
 }
+
+func Test_setPromptedPassword(t *testing.T) {
+	t.Run("prompt", func(t *testing.T) {
+		cfg := session.WalletConfig{Password: secret.Prompt}
+		setPromptedPassword(&cfg, "entered")
+		assert.Equal(t, "entered", cfg.Password)
+	})
+	t.Run("prompt_not_entered", func(t *testing.T) {
+		cfg := session.WalletConfig{Password: secret.Prompt}
+		setPromptedPassword(&cfg, "")
+		assert.Equal(t, secret.Prompt, cfg.Password)
+	})
+	t.Run("not_prompt", func(t *testing.T) {
+		cfg := session.WalletConfig{Password: "env:PERUN_PASSWORD"}
+		setPromptedPassword(&cfg, "entered")
+		assert.Equal(t, "env:PERUN_PASSWORD", cfg.Password)
+	})
+}
@@ -44,8 +44,8 @@ func NewConfig(incERC20Assets bool) perun.NodeConfig {
 		Adjudicator:          adjudicator.String(),
 		AssetETH:             assetETH.String(),
 		AssetERC20s:          assetERC20sString,
-		CommTypes:            []string{"tcp"},
-		IDProviderTypes:      []string{"local"},
+		CommTypes:            []string{"tcp", "websocket", "tls", "relay"},
+		IDProviderTypes:      []string{"local", "remote"},
 		CurrencyInterpreters: []string{"ETH"},
 
 		ChainConnTimeout: ethereumtest.ChainConnTimeout,
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger-labs/perun-node/internal/fileutil"
+)
+
+// sessionsFileMode is the file mode used for creating the sessions file.
+const sessionsFileMode = os.FileMode(0o600)
+
+type (
+	// sessionRecord holds the data required to re-open a session when the
+	// node is restarted.
+	sessionRecord struct {
+		ID         string    `yaml:"id"`
+		UserAlias  string    `yaml:"user_alias"`
+		ConfigFile string    `yaml:"config_file"`
+		OpenedAt   time.Time `yaml:"opened_at"`
+	}
+
+	// sessionStore persists the list of open sessions in a YAML file on the
+	// disk, so that they can be re-opened when the node is restarted.
+	//
+	// Each change is written to the file immediately. The file is updated by
+	// writing to a temporary file and renaming it, so that the file is never
+	// left partially written.
+	//
+	// If the file path is empty, the store does not persist anything. The
+	// methods on it are not safe for concurrent use, the node mutex should be
+	// held when accessing it.
+	sessionStore struct {
+		filePath string
+		records  map[string]sessionRecord
+	}
+)
+
+// newSessionStore loads the session records from the given file. If the file
+// does not exist, an empty store is returned and the file will be created on
+// the first write.
+func newSessionStore(filePath string) (*sessionStore, error) {
+	s := &sessionStore{
+		filePath: filePath,
+		records:  make(map[string]sessionRecord),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+
+	f, err := os.Open(filepath.Clean(filePath))
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "opening sessions file")
+	}
+	defer f.Close() //nolint:errcheck
+
+	var records []sessionRecord
+	if err = yaml.NewDecoder(f).Decode(&records); err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "decoding sessions file")
+	}
+	for _, r := range records {
+		s.records[r.ID] = r
+	}
+	return s, nil
+}
+
+// list returns the session records ordered by the time they were opened.
+func (s *sessionStore) list() []sessionRecord {
+	records := make([]sessionRecord, 0, len(s.records))
+	for _, r := range s.records {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].OpenedAt.Before(records[j].OpenedAt)
+	})
+	return records
+}
+
+// get returns the record for the given session ID.
+func (s *sessionStore) get(sessionID string) (_ sessionRecord, found bool) {
+	r, found := s.records[sessionID]
+	return r, found
+}
+
+// put adds the record to the store and writes the store to the disk.
+func (s *sessionStore) put(r sessionRecord) error {
+	s.records[r.ID] = r
+	return s.persist()
+}
+
+// delete removes the record for the session ID from the store and writes the
+// store to the disk. It is a no-op if the session ID is not known.
+func (s *sessionStore) delete(sessionID string) error {
+	if _, ok := s.records[sessionID]; !ok {
+		return nil
+	}
+	delete(s.records, sessionID)
+	return s.persist()
+}
+
+func (s *sessionStore) persist() error {
+	if s.filePath == "" {
+		return nil
+	}
+	data, err := yaml.Marshal(s.list())
+	if err != nil {
+		return errors.Wrap(err, "encoding data as yaml")
+	}
+	return fileutil.WriteFileAtomic(s.filePath, data, sessionsFileMode)
+}
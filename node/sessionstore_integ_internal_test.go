@@ -0,0 +1,85 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+// +build integration
+
+package node
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	copyutil "github.com/otiai10/copy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/node/nodetest"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Integ_ReopenSessions(t *testing.T) {
+	ethereumtest.SetupContractsT(t, ethereumtest.ChainURL, ethereumtest.ChainID, ethereumtest.OnChainTxTimeout, false)
+	cfg := nodetest.NewConfig(true)
+	cfg.SessionsFile = filepath.Join(t.TempDir(), "sessions.yaml")
+
+	// Use the database and ID provider of a session with two persisted channels.
+	prng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	sessionCfg := sessiontest.NewConfigT(t, prng)
+	sessionCfg.DatabaseDir = t.TempDir()
+	require.NoError(t, copyutil.Copy("../testdata/session/persistence/alice-database", sessionCfg.DatabaseDir))
+	sessionCfg.IDProviderURL = filepath.Join(t.TempDir(), "idprovider.yaml")
+	require.NoError(t, copyutil.Copy("../testdata/session/persistence/alice-idprovider.yaml", sessionCfg.IDProviderURL))
+	sessionCfgFile := sessiontest.NewConfigFileT(t, sessionCfg)
+
+	// Open the session using a relative path to the config file.
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	relSessionCfgFile, err := filepath.Rel(wd, sessionCfgFile)
+	require.NoError(t, err)
+
+	n1, err := New(cfg)
+	require.NoError(t, err)
+	sessionID, chsInfo, apiErr := n1.OpenSession(relSessionCfgFile, perun.WalletPasswords{})
+	require.NoError(t, apiErr)
+	require.Len(t, chsInfo, 2)
+	sessionInfo, apiErr := n1.GetSessionInfo(sessionID)
+	require.NoError(t, apiErr)
+	assert.Equal(t, sessionCfgFile, sessionInfo.ConfigFile)
+
+	// Simulate a restart: close the session without removing its record
+	// from the store and initialize a new node using the same store.
+	stored, apiErr := n1.(*node).getStoredSession(sessionID)
+	require.NoError(t, apiErr)
+	_, apiErr = stored.Session.Close(true)
+	require.NoError(t, apiErr)
+
+	n2, err := New(cfg)
+	require.NoError(t, err)
+	sessionsInfo := n2.ListSessions()
+	require.Len(t, sessionsInfo, 1)
+	assert.Equal(t, sessionID, sessionsInfo[0].ID)
+	assert.Equal(t, sessionCfgFile, sessionsInfo[0].ConfigFile)
+	assert.Equal(t, 2, sessionsInfo[0].OpenChs)
+
+	sess, apiErr := n2.GetSession(sessionID)
+	require.NoError(t, apiErr)
+	assert.Len(t, sess.GetChsInfo(), 2)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SessionStore(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	record1 := sessionRecord{ID: "session-1", ConfigFile: "alice/session.yaml", OpenedAt: now}
+	record2 := sessionRecord{ID: "session-2", ConfigFile: "bob/session.yaml", OpenedAt: now.Add(time.Second)}
+
+	t.Run("happy_put_reload_delete", func(t *testing.T) {
+		sessionsFile := filepath.Join(t.TempDir(), "sessions.yaml")
+		s, err := newSessionStore(sessionsFile)
+		require.NoError(t, err)
+		assert.Empty(t, s.list())
+
+		require.NoError(t, s.put(record2))
+		require.NoError(t, s.put(record1))
+
+		reloaded, err := newSessionStore(sessionsFile)
+		require.NoError(t, err)
+		assert.Equal(t, []sessionRecord{record1, record2}, reloaded.list())
+
+		require.NoError(t, reloaded.delete(record1.ID))
+		require.NoError(t, reloaded.delete("unknown-session"))
+		reloaded, err = newSessionStore(sessionsFile)
+		require.NoError(t, err)
+		assert.Equal(t, []sessionRecord{record2}, reloaded.list())
+	})
+
+	t.Run("happy_persistence_disabled", func(t *testing.T) {
+		s, err := newSessionStore("")
+		require.NoError(t, err)
+		require.NoError(t, s.put(record1))
+		got, found := s.get(record1.ID)
+		assert.True(t, found)
+		assert.Equal(t, record1, got)
+	})
+
+	t.Run("no_temp_files_left", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := newSessionStore(filepath.Join(dir, "sessions.yaml"))
+		require.NoError(t, err)
+		require.NoError(t, s.put(record1))
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "sessions.yaml", entries[0].Name())
+	})
+
+	t.Run("corrupted_file", func(t *testing.T) {
+		sessionsFile := filepath.Join(t.TempDir(), "sessions.yaml")
+		require.NoError(t, os.WriteFile(sessionsFile, []byte("id: : invalid"), 0o600))
+		_, err := newSessionStore(sessionsFile)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+}
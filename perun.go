@@ -43,6 +43,9 @@ type PeerID struct {
 	CommAddr string `yaml:"comm_address"`
 	// Type of off-chain communication protocol.
 	CommType string `yaml:"comm_type"`
+	// Fingerprint (hex encoded SHA-256 hash) of the certificate used by the peer for off-chain communication.
+	// It is optional and is used only with tls comm type, for pinning the certificate of the peer.
+	CommCertFingerprint string `yaml:"comm_cert_fingerprint,omitempty"`
 }
 
 // OwnAlias is the alias for the entry of the user's own PeerID details.
@@ -53,6 +56,7 @@ const OwnAlias = "self"
 type IDReader interface {
 	ReadByAlias(alias string) (p PeerID, contains bool)
 	ReadByOffChainAddr(offChainAddr pwire.Address) (p PeerID, contains bool)
+	ReadAll() []PeerID
 }
 
 // IDProvider represents the functions to read, write peer IDs from and to the local cache connected to a
@@ -60,6 +64,7 @@ type IDReader interface {
 type IDProvider interface {
 	IDReader
 	Write(alias string, p PeerID) error
+	Update(alias string, p PeerID) error
 	Delete(alias string) error
 	UpdateStorage() error
 }
@@ -202,6 +207,40 @@ type ROContractRegistry interface {
 	Assets() map[string]string
 }
 
+// AppDef represents a channel app that can be registered on the node. It
+// consists of the app definition address (usually the address of the on-chain
+// contract defining the valid transitions of the app) and a Go implementation
+// of the data encoding and valid transitions, which is used for validating the
+// off-chain updates.
+//
+// Channels with an app are advanced by app specific actions. Both the init
+// params and the actions are opaque to the node and are interpreted only by
+// the app.
+type AppDef interface {
+	pchannel.StateApp
+
+	// Name returns the name used to identify the app in the user API.
+	Name() string
+
+	// InitData returns the initial app data constructed from the app specific
+	// init params.
+	InitData(params []byte) (pchannel.Data, error)
+
+	// ApplyAction applies the app specific action, taken by the participant
+	// at the given index, to the state. The resulting state should be a valid
+	// transition, as checked by ValidTransition. It must not change the
+	// version, assets or the locked allocation.
+	ApplyAction(state *pchannel.State, actor pchannel.Index, action []byte) error
+}
+
+// AppRegistry provides an interface to register and retrieve the apps that can
+// be used in channels.
+type AppRegistry interface {
+	Register(AppDef) error
+	App(name string) (AppDef, bool)
+	Names() []string
+}
+
 // NodeConfig represents the configurable parameters of a perun node.
 type NodeConfig struct {
 	// User configurable values.
@@ -215,6 +254,14 @@ type NodeConfig struct {
 	ChainConnTimeout time.Duration     // Timeout for connecting to blockchain node.
 	OnChainTxTimeout time.Duration     // Timeout to wait for confirmation of on-chain tx.
 	ResponseTimeout  time.Duration     // Timeout to wait for a response from the peer / user.
+	// SessionsFile is the file for persisting the list of open sessions, so
+	// that they are re-opened when the node restarts. Empty string disables it.
+	SessionsFile string
+	// Apps is the list of built-in apps to enable on the node, as a map of
+	// app name to the address of its app definition.
+	Apps map[string]string
+	// Gas is the gas strategy used by the sessions that do not configure one.
+	Gas GasConfig
 
 	// Hard coded values. See cmd/perunnode/run.go.
 	CommTypes            []string // Communication protocols supported by the node for off-chain communication.
@@ -222,6 +269,35 @@ type NodeConfig struct {
 	CurrencyInterpreters []string // Currencies Interpreters supported by the node.
 }
 
+// GasConfig represents the strategy for pricing the on-chain transactions. All
+// amounts are in wei. Fields with zero values are not used, in which case the
+// values suggested by the blockchain node are used.
+//
+// Either the legacy gas price or the EIP-1559 fees can be configured, but not
+// both.
+type GasConfig struct {
+	GasPrice             uint64 // Fixed gas price for legacy transactions.
+	MaxFeePerGas         uint64 // Max fee per gas for EIP-1559 transactions.
+	MaxPriorityFeePerGas uint64 // Max priority fee (tip) per gas for EIP-1559 transactions.
+
+	// MaxTxFee is the cap on the total fee (gas limit x fee per gas) that
+	// can be paid for a single transaction. Transactions exceeding it are not
+	// sent.
+	MaxTxFee uint64
+
+	// FeeBumpPercent is the percentage by which the fees of a transaction
+	// are increased, when it is not mined within the fee bump interval. The
+	// transaction is then replaced by the one with increased fees. It should
+	// be at least 10, as required by the blockchain nodes for replacing a
+	// pending transaction.
+	FeeBumpPercent uint64
+	// FeeBumpInterval is the time to wait for a transaction to be mined,
+	// before its fees are bumped. It is required when fee bump percent is
+	// set and should be shorter than the on-chain tx timeout, so that the
+	// fees can be bumped before waiting for the transaction times out.
+	FeeBumpInterval time.Duration
+}
+
 // APIError represents the newer version of error returned by node, session
 // and channel APIs.
 //
@@ -301,6 +377,7 @@ const (
 	ErrInvalidContracts     ErrorCode = 206
 	ErrTxTimedOut           ErrorCode = 301
 	ErrChainNotReachable    ErrorCode = 302
+	ErrTxReorged            ErrorCode = 303
 	ErrUnknownInternal      ErrorCode = 401
 )
 
@@ -397,6 +474,13 @@ type (
 	ErrInfoChainNotReachable struct {
 		ChainURL string
 	}
+
+	// ErrInfoTxReorged represents the fields in the additional info
+	// for ErrTxReorged.
+	ErrInfoTxReorged struct {
+		TxType string
+		TxID   string
+	}
 )
 
 // NodeAPI represents the APIs that can be accessed in the context of a perun node.
@@ -406,13 +490,41 @@ type NodeAPI interface {
 	Time() int64
 	GetConfig() NodeConfig
 	Help() []string
-	OpenSession(configFile string) (string, []ChInfo, APIError)
+	OpenSession(configFile string, passwords WalletPasswords) (string, []ChInfo, APIError)
 
 	RegisterCurrency(tokenAddr, assetAddr string) (symbol string, _ APIError)
 
+	// Methods for administering the sessions on the node.
+	ListSessions() []SessionInfo
+	GetSessionInfo(sessionID string) (SessionInfo, APIError)
+	CloseSession(sessionID string, force bool) ([]ChInfo, APIError)
+
 	// This function is used internally to get a SessionAPI instance.
 	// Should not be exposed via user API.
 	GetSession(string) (SessionAPI, APIError)
+
+	// This function is used internally to get an app registered on the node.
+	// Should not be exposed via user API.
+	GetApp(name string) (AppDef, APIError)
+}
+
+// WalletPasswords are the passwords entered by the user for the wallets whose
+// password is configured as "prompt" in the session config file. As the node
+// runs in the background, these passwords are read by the client and sent
+// along with the request to open the session.
+type WalletPasswords struct {
+	OnChain  string
+	OffChain string
+}
+
+// SessionInfo represents the info regarding a session that will be sent to
+// the node administrator.
+type SessionInfo struct {
+	ID         string
+	UserAlias  string // Alias of the user as specified in the session config file.
+	ConfigFile string
+	OpenedAt   int64 // Time (in unix timestamp) at which the session was first opened.
+	OpenChs    int   // Number of channels in the session that are open for off-chain transactions.
 }
 
 //go:generate mockery --name SessionAPI --output ./internal/mocks
@@ -424,11 +536,28 @@ type SessionAPI interface {
 	ID() string
 	AddPeerID(PeerID) APIError
 	GetPeerID(alias string) (PeerID, APIError)
+	ListPeerIDs() ([]PeerID, APIError)
+	UpdatePeerID(PeerID) APIError
+	DeletePeerID(alias string) APIError
+	GetPeerStatus(ctx context.Context, alias string) (PeerStatus, APIError)
+	SubPeerStatus(alias string, notifier PeerStatusNotifier) APIError
+	UnsubPeerStatus(alias string) APIError
 	OpenCh(context.Context, BalInfo, App, uint64) (ChInfo, APIError)
+	OpenVirtualCh(context.Context, VirtualChParents, BalInfo, App, uint64) (ChInfo, APIError)
 	GetChsInfo() []ChInfo
 	SubChProposals(ChProposalNotifier) APIError
 	UnsubChProposals() APIError
 	RespondChProposal(context.Context, string, bool) (ChInfo, APIError)
+	RespondVirtualChProposal(context.Context, string, bool) (ChInfo, APIError)
+	SendAppMsg(ctx context.Context, peerAlias, appID string, data []byte) APIError
+	SubAppMsgs(appID string, notifier AppMsgNotifier) APIError
+	UnsubAppMsgs(appID string) APIError
+	SetChUpdateInterceptor(appID string, interceptor ChUpdateInterceptor)
+	CreatePaymentSchedule(PaymentSchedule) (PaymentSchedule, APIError)
+	ListPaymentSchedules() ([]PaymentSchedule, APIError)
+	CancelPaymentSchedule(scheduleID string) (PaymentSchedule, APIError)
+	SetCloseHandler(id string, handler SessionCloseHandler)
+	Pay(ctx context.Context, targetAlias, currency, amount string) (RoutedPayment, APIError)
 	Close(force bool) ([]ChInfo, APIError)
 
 	DeployAssetERC20(tokenERC20 string) (asset string, _ APIError)
@@ -464,6 +593,69 @@ type (
 		App              App
 		ChallengeDurSecs uint64
 		Expiry           int64
+		// ID of the user's ledger channel that will fund the proposed channel.
+		// It is set only for virtual channel proposals, which should be
+		// responded to using RespondVirtualChProposal.
+		ParentChID string
+		// AutoResponse is set when the proposal was responded to
+		// automatically as per the session policy. Expiry will be zero and
+		// no response is expected for such notifications.
+		AutoResponse AutoResponse
+	}
+
+	// VirtualChParents identifies the ledger channels that fund a virtual
+	// channel. Each of them is between one of the participants and the
+	// intermediary common to both.
+	VirtualChParents struct {
+		// ID of the user's ledger channel with the intermediary.
+		ChID string
+		// ID of the peer's ledger channel with the intermediary.
+		PeerChID string
+		// Index of the peer in its ledger channel with the intermediary. It
+		// is 0, if the peer had proposed that channel using a perun node.
+		PeerIdx uint16
+	}
+
+	// AppMsgNotifier is the notifier function that is used for sending app message notifications.
+	AppMsgNotifier func(AppMsg)
+
+	// AppMsg represents an off-chain message received from a peer for an
+	// application built on top of the session. The content of the message is
+	// opaque to the node and is interpreted only by the application.
+	AppMsg struct {
+		PeerAlias string
+		Data      []byte
+	}
+
+	// ChUpdateInterceptor is invoked for each incoming channel update before
+	// it is notified to the user. If it returns true, the update is
+	// considered to be handled by the interceptor, which should respond to it
+	// using RespondChUpdate; and the update is not notified to the user.
+	//
+	// It is invoked while holding the lock on the channel. Hence, it should
+	// not call any method on the channel before returning.
+	ChUpdateInterceptor func(ChUpdateNotif) bool
+
+	// SessionCloseHandler is invoked once the session is closed, for
+	// releasing the resources that are tied to the session.
+	//
+	// It is invoked after the lock on the session is released. Hence, it can
+	// call the session APIs, though most of them will return an error as the
+	// session is closed.
+	SessionCloseHandler func()
+
+	// PeerStatusNotifier is the notifier function that is used for sending peer status notifications.
+	PeerStatusNotifier func(PeerStatus)
+
+	// PeerStatus represents the connectivity status of a peer, as observed in
+	// the last attempt to reach it.
+	PeerStatus struct {
+		Alias     string
+		Reachable bool
+		// Time when the peer was last reachable. Zero value if it was never reachable.
+		LastSeen time.Time
+		// Round-trip latency measured when the peer was last reachable.
+		Latency time.Duration
 	}
 )
 
@@ -487,6 +679,7 @@ type ChAPI interface {
 	UnsubChUpdates() APIError
 	RespondChUpdate(context.Context, string, bool) (ChInfo, APIError)
 	GetChInfo() ChInfo
+	GetHistory(fromVersion uint64, limit uint64) ([]ChHistoryEntry, APIError)
 	Close(context.Context) (ChInfo, APIError)
 }
 
@@ -500,6 +693,80 @@ const (
 	ChUpdateTypeClosed
 )
 
+// Enumeration of values for AutoResponse:
+// None: Proposal or update is to be responded to by the user.
+// Accepted: Proposal or update was accepted automatically as per the session policy.
+// Rejected: Proposal or update was rejected automatically as per the session policy.
+const (
+	AutoResponseNone AutoResponse = iota
+	AutoResponseAccepted
+	AutoResponseRejected
+)
+
+// Enumeration of values for PaymentDirection:
+// None: Balance of the user did not change.
+// Incoming: Balance of the user increased in atleast one currency and did not decrease in any.
+// Outgoing: Balance of the user decreased in atleast one currency and did not increase in any.
+// Mixed: Balance of the user increased in some currencies and decreased in others.
+const (
+	PaymentDirectionNone PaymentDirection = iota
+	PaymentDirectionIncoming
+	PaymentDirectionOutgoing
+	PaymentDirectionMixed
+)
+
+// PaymentDirection is the direction of the amounts exchanged in a channel
+// update, as seen by the user. It can have four values: "none", "incoming",
+// "outgoing" and "mixed".
+type PaymentDirection uint8
+
+// Enumeration of values for ScheduleUnit.
+const (
+	ScheduleUnitMinute ScheduleUnit = iota
+	ScheduleUnitHour
+	ScheduleUnitDay
+	ScheduleUnitWeek
+	ScheduleUnitMonth
+)
+
+// ScheduleUnit is the unit of the interval between the payments of a payment
+// schedule. It can have five values: "minute", "hour", "day", "week" and
+// "month".
+type ScheduleUnit uint8
+
+// Enumeration of values for PaymentScheduleStatus:
+// Active: Payments will be made as per the schedule.
+// Cancelled: Schedule was cancelled by the user.
+// Failed: Schedule was stopped, because a payment failed.
+const (
+	PaymentScheduleActive PaymentScheduleStatus = iota
+	PaymentScheduleCancelled
+	PaymentScheduleFailed
+)
+
+// PaymentScheduleStatus is the status of a payment schedule. It can have
+// three values: "active", "cancelled" and "failed".
+type PaymentScheduleStatus uint8
+
+// Enumeration of values for InvoiceStatus:
+// Open: Invoice can be paid.
+// Paid: Payment for the invoice was accepted.
+// Expired: Invoice was not paid before its expiry.
+const (
+	InvoiceOpen InvoiceStatus = iota
+	InvoicePaid
+	InvoiceExpired
+)
+
+// InvoiceStatus is the status of an invoice. It can have three values:
+// "open", "paid" and "expired".
+type InvoiceStatus uint8
+
+// AutoResponse is the response sent automatically to an incoming proposal or
+// update, as per the session policy. It can have three values: "none",
+// "accepted" and "rejected".
+type AutoResponse uint8
+
 type (
 	// ChUpdateType is the type of channel update. It can have three values: "open", "final" and "closed".
 	ChUpdateType uint8
@@ -534,6 +801,11 @@ type (
 		// while a channel is closed by the watcher.
 		// When this is non empty, expiry will also be zero and no response is expected
 		Error APIError
+
+		// AutoResponse is set when the update was responded to automatically
+		// as per the session policy. Expiry will be zero and no response is
+		// expected for such notifications.
+		AutoResponse AutoResponse
 	}
 
 	// App represents the app definition and the corresponding app data for a channel.
@@ -555,6 +827,94 @@ type (
 		Version string
 	}
 
+	// ChHistoryEntry represents an update on the channel that was accepted by
+	// all the participants, as recorded in the channel history. It captures
+	// the change in the balance of the user due to the update.
+	ChHistoryEntry struct {
+		ChID string
+		// Version of the channel after the update.
+		Version uint64
+		// Time at which the update was accepted, in unix seconds.
+		Timestamp int64
+		Direction PaymentDirection
+		// Alias of the participant with whom the amounts were exchanged. It is
+		// the first participant, other than the user, whose balance changed in
+		// the direction opposite to that of the user. It is empty if the
+		// balance of the user did not change.
+		Counterparty string
+		// Change in the balance of the user for each currency, in the standard
+		// representation of the currency. Amounts paid by the user are negative.
+		Currencies []string
+		Deltas     []string
+	}
+
+	// PaymentSchedule represents a recurring payment from the user to a peer
+	// on a channel. The payments are made until the schedule is cancelled or a
+	// payment fails.
+	PaymentSchedule struct {
+		// ID is set by the session when the schedule is created.
+		ID       string
+		ChID     string
+		Payee    string
+		Currency string
+		Amount   string
+		// A payment is made once every "Every" units.
+		Every uint64
+		Unit  ScheduleUnit
+		// Time of the next payment, in unix seconds. When creating a schedule,
+		// it is the time of the first payment. If zero, the first payment is
+		// made immediately.
+		NextAt int64
+
+		// These fields are set by the session.
+		//
+		// Time of the first payment, in unix seconds. The due dates of
+		// monthly schedules are computed from it.
+		StartAt int64
+		// Number of payments made so far and the time of the latest one.
+		Payments   uint64
+		LastPaidAt int64
+		Status     PaymentScheduleStatus
+		// Message of the error due to which the schedule failed.
+		LastError string
+	}
+
+	// RoutedPayment represents a payment made to a peer through a path of
+	// channels, where each hop is paid using a hash time locked transfer.
+	RoutedPayment struct {
+		Hash     string   // Payment hash (hex encoded).
+		Preimage string   // Preimage of the payment hash (hex encoded), revealed by the target.
+		Route    []string // Off-chain addresses of the hops, excluding the payer.
+		Amount   string   // Amount paid by the payer, including the fee.
+		Fee      string   // Total fee paid to the intermediaries.
+	}
+
+	// Invoice represents a payment request created by the payee, which can
+	// be paid by a peer using the encoded invoice.
+	Invoice struct {
+		ID       string
+		Payee    string // Off-chain address of the payee.
+		Currency string
+		Amount   string
+		Memo     string
+		// Time when the invoice was created and the time after which it
+		// cannot be paid, in unix seconds. Zero expiry means the invoice
+		// does not expire.
+		CreatedAt int64
+		Expiry    int64
+		// Encoded is the invoice encoded for sharing with the payer. It is
+		// set only for the invoices created by the user.
+		Encoded string
+
+		Status InvoiceStatus
+		// ID of the channel, version of the update and the time (in unix
+		// seconds) at which the payment was accepted. Set only when the
+		// invoice is paid.
+		ChID    string
+		Version uint64
+		PaidAt  int64
+	}
+
 	// BalInfo represents the Balance information of the channel participants.
 	// Bal[0] represents the balance of the channel for asset Currency[0] for
 	// the all the channel participants as mentioned in Parts; Bal[1] specifies
@@ -167,3 +167,14 @@ func AssertErrInfoChainNotReachable(t *testing.T, info interface{}, chainURL str
 	require.True(t, ok)
 	assert.Equal(t, chainURL, addInfo.ChainURL)
 }
+
+// AssertErrInfoTxReorged tests if additional info field is of
+// correct type and has expected values.
+func AssertErrInfoTxReorged(t *testing.T, info interface{}, txType, txID string) {
+	t.Helper()
+
+	addInfo, ok := info.(perun.ErrInfoTxReorged)
+	require.True(t, ok)
+	assert.Equal(t, txType, addInfo.TxType)
+	assert.Equal(t, txID, addInfo.TxID)
+}
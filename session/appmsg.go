@@ -0,0 +1,130 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+	pwire "perun.network/go-perun/wire"
+	"perun.network/go-perun/wire/perunio"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// appMsgType is the wire message type for app messages. It is the first
+// type after those of the perun wire protocol.
+const appMsgType = pwire.LastType
+
+// maxAppMsgDataLen is the maximum length of the data in an app message.
+const maxAppMsgDataLen = 1 << 20
+
+func init() {
+	pwire.RegisterExternalDecoder(appMsgType, decodeAppMsg, "AppMsg")
+}
+
+type (
+	// appMsg is the wire message for exchanging the messages of the
+	// applications built on top of the session, over the same connections
+	// used by the channel client.
+	appMsg struct {
+		AppID string
+		Data  []byte
+	}
+
+	// appMsgHandler is the function that is used for handling incoming app messages.
+	appMsgHandler func(from pwire.Address, appID string, data []byte)
+
+	// appMsgBus wraps the message bus used by the channel client, so that
+	// the incoming app messages are passed to the handler, instead of to the
+	// channel client.
+	appMsgBus struct {
+		perun.WireBus
+
+		mutex   sync.RWMutex
+		handler appMsgHandler
+	}
+
+	// appMsgConsumer wraps the consumer subscribed to the bus by the channel
+	// client and intercepts the app messages.
+	appMsgConsumer struct {
+		pwire.Consumer
+		bus *appMsgBus
+	}
+)
+
+// Type returns the wire message type of the app message.
+func (appMsg) Type() pwire.Type {
+	return appMsgType
+}
+
+// Encode encodes the app message into the writer. The data is prefixed with
+// its length, as byte slices are encoded without length by perunio.
+func (m appMsg) Encode(w io.Writer) error {
+	if len(m.Data) > maxAppMsgDataLen {
+		return errors.Errorf("data length %d exceeds the limit %d", len(m.Data), maxAppMsgDataLen)
+	}
+	return perunio.Encode(w, m.AppID, uint32(len(m.Data)), m.Data)
+}
+
+func decodeAppMsg(r io.Reader) (pwire.Msg, error) {
+	var m appMsg
+	var dataLen uint32
+	if err := perunio.Decode(r, &m.AppID, &dataLen); err != nil {
+		return nil, errors.WithMessage(err, "decoding app message")
+	}
+	if dataLen > maxAppMsgDataLen {
+		return nil, errors.Errorf("data length %d exceeds the limit %d", dataLen, maxAppMsgDataLen)
+	}
+	m.Data = make([]byte, dataLen)
+	return &m, errors.WithMessage(perunio.Decode(r, &m.Data), "decoding app message data")
+}
+
+func newAppMsgBus(bus perun.WireBus) *appMsgBus {
+	return &appMsgBus{WireBus: bus}
+}
+
+// SubscribeClient subscribes the consumer wrapped in an appMsgConsumer.
+func (b *appMsgBus) SubscribeClient(c pwire.Consumer, addr pwire.Address) error {
+	return b.WireBus.SubscribeClient(&appMsgConsumer{Consumer: c, bus: b}, addr)
+}
+
+func (b *appMsgBus) setHandler(handler appMsgHandler) {
+	b.mutex.Lock()
+	b.handler = handler
+	b.mutex.Unlock()
+}
+
+func (b *appMsgBus) handle(from pwire.Address, m *appMsg) {
+	b.mutex.RLock()
+	handler := b.handler
+	b.mutex.RUnlock()
+	if handler != nil {
+		handler(from, m.AppID, m.Data)
+	}
+}
+
+// Put passes the app messages to the handler on the bus and all other
+// messages to the wrapped consumer.
+func (c *appMsgConsumer) Put(e *pwire.Envelope) {
+	if m, ok := e.Msg.(*appMsg); ok {
+		c.bus.handle(e.Sender, m)
+		return
+	}
+	c.Consumer.Put(e)
+}
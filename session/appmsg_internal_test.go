@@ -0,0 +1,77 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+func Test_AppMsg_EncodeDecode(t *testing.T) {
+	msg := &appMsg{AppID: "app", Data: []byte("data")}
+	var buf bytes.Buffer
+	require.NoError(t, pwire.EncodeMsg(msg, &buf))
+
+	got, err := pwire.DecodeMsg(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, msg, got)
+}
+
+// subscribeCapturer is a wire bus that captures the consumer that is subscribed.
+type subscribeCapturer struct {
+	perun.WireBus
+	consumer pwire.Consumer
+}
+
+func (b *subscribeCapturer) SubscribeClient(c pwire.Consumer, _ pwire.Address) error {
+	b.consumer = c
+	return nil
+}
+
+func Test_AppMsgBus(t *testing.T) {
+	wireBus := &subscribeCapturer{}
+	bus := newAppMsgBus(wireBus)
+
+	receiver := pwire.NewReceiver()
+	require.NoError(t, bus.SubscribeClient(receiver, nil))
+	consumer := wireBus.consumer
+	require.NotNil(t, consumer)
+
+	var handled []string
+	bus.setHandler(func(from pwire.Address, appID string, data []byte) {
+		handled = append(handled, appID)
+	})
+
+	consumer.Put(&pwire.Envelope{Msg: &appMsg{AppID: "app"}})
+	assert.Equal(t, []string{"app"}, handled)
+
+	ping := pwire.NewPingMsg()
+	consumer.Put(&pwire.Envelope{Msg: ping})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	env, err := receiver.Next(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ping, env.Msg)
+}
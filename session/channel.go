@@ -56,6 +56,10 @@ type (
 		chUpdateNotifCache []perun.ChUpdateNotif
 		chUpdateResponders map[string]chUpdateResponderEntry
 
+		// Store for recording the accepted updates. It is set when the
+		// channel is added to the session and is nil until then.
+		history *historyStore
+
 		watcherWg *sync.WaitGroup
 		psync.Mutex
 	}
@@ -320,8 +324,8 @@ func (ch *Channel) SendChUpdate(pctx context.Context, updater perun.StateUpdater
 // handleSendChUpdateError inspects the passed error, constructs an
 // appropriate APIError and returns it.
 func (ch *Channel) handleSendChUpdateError(err error) perun.APIError {
-	peerAlias := ch.parts[ch.pch.Idx()^1] // Logic works only for a two party channel.
-	if apiErr := handleProposalError(peerAlias, ch.timeoutCfg.response.String(), err); apiErr != nil {
+	peerAliases := joinPeerAliases(ch.parts, int(ch.pch.Idx()))
+	if apiErr := handleProposalError(peerAliases, ch.timeoutCfg.response.String(), err); apiErr != nil {
 		return apiErr
 	}
 	return perun.NewAPIErrUnknownInternal(err)
@@ -333,6 +337,17 @@ func (ch *Channel) handleSendChUpdateError(err error) perun.APIError {
 // process the update.
 func (ch *Channel) HandleUpdate(
 	currState *pchannel.State, chUpdate pclient.ChannelUpdate, responder ChUpdateResponder,
+) {
+	ch.handleUpdate(currState, chUpdate, responder, nil, nil)
+}
+
+// handleUpdate is the implementation of HandleUpdate. If the interceptor is
+// not nil, it is invoked before notifying the update and the notification is
+// not sent if the interceptor handles the update. Otherwise, the update is
+// evaluated against the policy and responded to automatically if the policy
+// decides so.
+func (ch *Channel) handleUpdate(currState *pchannel.State, chUpdate pclient.ChannelUpdate,
+	responder ChUpdateResponder, interceptor perun.ChUpdateInterceptor, pol *policy,
 ) {
 	ch.Lock()
 	defer ch.Unlock()
@@ -356,9 +371,29 @@ func (ch *Channel) HandleUpdate(
 	if expiry != 0 {
 		ch.chUpdateResponders[notif.UpdateID] = entry
 	}
+	if interceptor != nil && interceptor(notif) {
+		ch.Debug("HandleUpdate: Notification intercepted")
+		return
+	}
+	if pol != nil {
+		notif.AutoResponse = pol.paymentResponse(ch.parts, ch.currencies, ch.pch.Idx(), chUpdate.ActorIdx,
+			currState, chUpdate.State)
+	}
+	if notif.AutoResponse != perun.AutoResponseNone {
+		notif.Expiry = 0
+		// Response is sent in a go-routine, as it requires the channel lock held by this function.
+		go ch.autoRespondChUpdate(notif.UpdateID, notif.AutoResponse == perun.AutoResponseAccepted)
+	}
 	ch.sendChUpdateNotif(notif)
 }
 
+// autoRespondChUpdate sends the response to the channel update, as decided by
+// the session policy.
+func (ch *Channel) autoRespondChUpdate(updateID string, accept bool) {
+	//nolint:errcheck // It is sufficient to just log this error, which is done by respondChUpdate.
+	ch.respondChUpdate(context.Background(), "AutoRespondChUpdate", updateID, accept, "rejected by policy")
+}
+
 func (ch *Channel) sendChUpdateNotif(notif perun.ChUpdateNotif) {
 	if ch.chUpdateNotifier == nil {
 		ch.chUpdateNotifCache = append(ch.chUpdateNotifCache, notif)
@@ -476,13 +511,21 @@ func (ch *Channel) RespondChUpdate(pctx context.Context, updateID string, accept
 	perun.ChInfo, perun.APIError,
 ) {
 	ch.WithField("method", "RespondChUpdate").Infof("\nReceived request with params %+v,%+v", updateID, accept)
+	return ch.respondChUpdate(pctx, "RespondChUpdate", updateID, accept, "rejected by user")
+}
+
+// respondChUpdate is the implementation of RespondChUpdate. The method is
+// used for logging and the reason is sent to the peer when rejecting the update.
+func (ch *Channel) respondChUpdate(pctx context.Context, method, updateID string, accept bool, rejectReason string) (
+	perun.ChInfo, perun.APIError,
+) {
 	ch.Lock()
 	defer ch.Unlock()
 
 	var apiErr perun.APIError
 	defer func() {
 		if apiErr != nil {
-			ch.WithFields(perun.APIErrAsMap("RespondChUpdate", apiErr)).Error(apiErr.Message())
+			ch.WithFields(perun.APIErrAsMap(method, apiErr)).Error(apiErr.Message())
 		}
 	}()
 
@@ -508,7 +551,7 @@ func (ch *Channel) RespondChUpdate(pctx context.Context, updateID string, accept
 	case true:
 		apiErr = ch.acceptChUpdate(pctx, entry)
 		if apiErr == nil {
-			ch.WithField("method", "RespondChUpdate").Info("Channel update accepted successfully")
+			ch.WithField("method", method).Info("Channel update accepted successfully")
 		}
 		if apiErr == nil && entry.notif.Type == perun.ChUpdateTypeFinal {
 			apiErr = ch.settle(pctx)
@@ -520,9 +563,9 @@ func (ch *Channel) RespondChUpdate(pctx context.Context, updateID string, accept
 			ch.closeAndNotify(apiErr)
 		}
 	case false:
-		apiErr = ch.rejectChUpdate(pctx, entry, "rejected by user")
+		apiErr = ch.rejectChUpdate(pctx, entry, rejectReason)
 		if apiErr == nil {
-			ch.WithField("method", "RespondChUpdate").Info("Channel update rejected successfully")
+			ch.WithField("method", method).Info("Channel update rejected successfully")
 		}
 	}
 	return ch.getChInfo(), apiErr
@@ -559,6 +602,58 @@ func (ch *Channel) GetChInfo() perun.ChInfo {
 	return chInfo
 }
 
+// GetHistory retrieves the history of accepted updates on the channel in the
+// order of versions, starting from the given version. At most limit entries
+// are returned and, if limit is zero, the maximum of 1000 entries are
+// returned. To retrieve the next page, use the version following that of the
+// last returned entry.
+//
+// History is retained even after the channel is closed.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"limit" when limit exceeds the maximum.
+// - ErrUnknownInternal.
+func (ch *Channel) GetHistory(fromVersion, limit uint64) ([]perun.ChHistoryEntry, perun.APIError) {
+	ch.WithField("method", "GetHistory").Infof("\nReceived request with params %+v,%+v", fromVersion, limit)
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			ch.WithFields(perun.APIErrAsMap("GetHistory", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if limit > maxChHistoryPageSize {
+		err := errors.Errorf("should not exceed %d", maxChHistoryPageSize)
+		apiErr = perun.NewAPIErrInvalidArgument(err, perun.ArgNameLimit, fmt.Sprintf("%d", limit))
+		return nil, apiErr
+	}
+	if limit == 0 {
+		limit = maxChHistoryPageSize
+	}
+	if ch.history == nil {
+		return []perun.ChHistoryEntry{}, nil
+	}
+
+	entries, err := ch.history.get(ch.ID(), fromVersion, limit)
+	if err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return nil, apiErr
+	}
+	return entries, nil
+}
+
+// recordUpdate records the update in the channel history. It is registered
+// as the callback for the updates accepted on the channel and hence can be
+// invoked while the channel lock is held by the goroutine that is updating it.
+// So, it should not acquire the channel lock.
+func (ch *Channel) recordUpdate(from, to *pchannel.State) {
+	entry := makeChHistoryEntry(ch.ID(), ch.parts, ch.currencies, ch.pch.Idx(), from, to)
+	if err := ch.history.put(entry); err != nil {
+		ch.Errorf("Recording update with version %d in history: %v", to.Version, err)
+	}
+}
+
 // This function assumes that caller has already locked the channel.
 func (ch *Channel) getChInfo() perun.ChInfo {
 	return ch.makeChInfo(ch.pch.State().Clone())
@@ -621,11 +716,14 @@ func makeBalInfoFromRawBal(parts []string, currencies []perun.Currency, rawBal [
 // If there is an error in the closing update, it will be one of the following codes:
 // - ErrTxTimedOut with TxType: "Conclude" or "ConcludeFinal" when on-chain finalizing tx times out.
 // - ErrTxTimedOut with TxType: "Withdraw"  when withdrawing tx times out.
+// - ErrTxReorged with TxType: "Conclude", "ConcludeFinal" or "Withdraw" when the tx is
+// removed from the chain in a reorg.
 // - ErrChainNotReachable when connection to blockchain drops while finalizing on-chain or withdrawing.
 // - ErrUnknownInternal
 //
 // If there is an error returned by this API, it will be one of the following codes:
 // - ErrTxTimedOut with TxType: "Register" when register tx times out.
+// - ErrTxReorged with TxType: "Register" when register tx is removed from the chain in a reorg.
 // - ErrChainNotReachable when connection to blockchain drops while register.
 // - ErrUnknownInternal.
 func (ch *Channel) Close(pctx context.Context) (perun.ChInfo, perun.APIError) {
@@ -30,6 +30,7 @@ import (
 	pclient "perun.network/go-perun/client"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
 	"github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/internal/mocks"
@@ -798,6 +799,31 @@ func Test_Close(t *testing.T) {
 		peruntest.AssertErrInfoTxTimedOut(t, err.AddInfo(), txType, txID, txTimeout)
 	})
 
+	t.Run("forInitiator_finalized_settle_TxReorgedError", func(t *testing.T) {
+		txType := pethchannel.Register.String()
+		txID := "0xabcd"
+		txReorgedError := blockchain.NewTxReorgedError(txType, txID, pclient.TxTimedoutError{
+			TxType: txType,
+			TxID:   txID,
+		})
+		pch, watcherSignal := newMockPCh()
+		ch := session.NewChForTest(
+			pch, currency.ETHSymbol, validOpeningBalInfo.Parts, responseTimeout, challengeDurSecs, true)
+
+		pch.On("Idx").Return(pchannel.Index(peerIdx))
+		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
+		pch.On("Update", mock.Anything, mock.Anything).Return(nil)
+		pch.On("Settle", mock.Anything, mock.Anything).Return(txReorgedError)
+		pch.On("Close").Return(nil).Run(func(args mock.Arguments) {
+			watcherSignal <- time.Now() // Signal the watcher to return when pch is closed.
+		})
+
+		_, err := ch.Close(context.Background())
+
+		peruntest.AssertAPIError(t, err, perun.ProtocolFatalError, perun.ErrTxReorged)
+		peruntest.AssertErrInfoTxReorged(t, err.AddInfo(), txType, txID)
+	})
+
 	t.Run("forInitiator_finalized_settle_ChainNotReachable", func(t *testing.T) {
 		chainURL := ethereumtest.ChainURL
 		chainNotReachableError := pclient.ChainNotReachableError{}
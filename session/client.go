@@ -59,6 +59,9 @@ type (
 		Restore(context.Context) error
 		RestoreChs(databaseDir string, timeout time.Duration, handler func(PChannel)) error
 
+		SendAppMsg(ctx context.Context, to pwire.Address, appID string, data []byte) error
+		HandleAppMsgs(handler func(from pwire.Address, appID string, data []byte))
+
 		Log() plog.Logger
 	}
 
@@ -73,7 +76,8 @@ type (
 	// It implements ChClient interface.
 	client struct {
 		pClient
-		msgBus perun.WireBus
+		msgBus       perun.WireBus
+		offChainAddr pwire.Address
 
 		// Registry that is used by the channel client for resolving off-chain address to comm address.
 		msgBusRegistry perun.Registerer
@@ -144,7 +148,8 @@ func newChClient(
 	}
 
 	dialer := comm.NewDialer()
-	msgBus := pnet.NewBus(offChainAcc, dialer, pperunioserializer.Serializer())
+	netBus := pnet.NewBus(offChainAcc, dialer, pperunioserializer.Serializer())
+	msgBus := newAppMsgBus(netBus)
 
 	pcClient, err := pclient.New(offChainAcc.Address(), msgBus, funder, adjudicator, offChainCred.Wallet, watcher)
 	if err != nil {
@@ -154,15 +159,18 @@ func newChClient(
 	c := &client{
 		pClient:        &pclientWrapped{pcClient},
 		msgBus:         msgBus,
+		offChainAddr:   offChainAcc.Address(),
 		msgBusRegistry: dialer,
 		wg:             &sync.WaitGroup{},
 	}
 
 	listener, err := comm.NewListener(commAddr)
 	if err != nil {
+		pcClient.Close() //nolint: errcheck,gosec // Error in creating the listener is returned.
+		msgBus.Close()   //nolint: errcheck,gosec // Error in creating the listener is returned.
 		return nil, perun.NewAPIErrInvalidConfig(err, "commAddr", commAddr)
 	}
-	c.runAsGoRoutine(func() { msgBus.Listen(listener) })
+	c.runAsGoRoutine(func() { netBus.Listen(listener) })
 
 	return c, nil
 }
@@ -178,6 +186,24 @@ func (c *client) Handle(ph pclient.ProposalHandler, ch pclient.UpdateHandler) {
 	c.runAsGoRoutine(func() { c.pClient.Handle(ph, ch) })
 }
 
+// SendAppMsg sends the data for the given app to the given off-chain address.
+func (c *client) SendAppMsg(ctx context.Context, to pwire.Address, appID string, data []byte) error {
+	env := &pwire.Envelope{
+		Sender:    c.offChainAddr,
+		Recipient: to,
+		Msg:       &appMsg{AppID: appID, Data: data},
+	}
+	return errors.WithMessage(c.msgBus.Publish(ctx, env), "sending app message")
+}
+
+// HandleAppMsgs registers the handler for the incoming app messages. Messages
+// received when there is no handler are dropped.
+func (c *client) HandleAppMsgs(handler func(from pwire.Address, appID string, data []byte)) {
+	if bus, ok := c.msgBus.(*appMsgBus); ok {
+		bus.setHandler(handler)
+	}
+}
+
 // RestoreChs will restore the persisted channels. Register OnNewChannel Callback
 // before calling this function.
 func (c *client) RestoreChs(databaseDir string, timeout time.Duration, handler func(PChannel)) error {
@@ -214,6 +240,10 @@ func (c *client) Close() error {
 		return errors.Wrap(busErr, "closing message bus")
 	}
 	c.wg.Wait()
+	// Database connection is not set, if the client is closed before restoring the channels.
+	if c.dbConn == nil {
+		return nil
+	}
 	return errors.Wrap(c.dbConn.Close(), "closing persistence database")
 }
 
@@ -23,6 +23,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment/routing"
 )
 
 type (
@@ -30,7 +33,7 @@ type (
 	Config struct {
 		User UserConfig
 
-		IDProviderType   string        // Type of ID provider.
+		IDProviderType   string        // Type of ID provider. Can take two values: local, remote.
 		IDProviderURL    string        // URL for accessing the ID provider.
 		ChainURL         string        // URL of the blockchain node.
 		ChainID          int           // See chainconfig.
@@ -38,11 +41,46 @@ type (
 		OnChainTxTimeout time.Duration // Timeout to wait for confirmation of on-chain tx.
 		ResponseTimeout  time.Duration // Timeout to wait for a response from the peer / user.
 
+		// Number of blocks, including the one containing it, after which an
+		// on-chain tx is considered final. Funding, register and withdraw wait
+		// for the txs to reach this depth within the OnChainTxTimeout. If zero,
+		// a default value suitable only for local test chains is used.
+		TxFinalityDepth uint64
+
+		// Strategy for pricing the on-chain txs. If not specified, the one
+		// configured for the node is used.
+		Gas perun.GasConfig
+
+		// If ID provider type is remote, this parameter is used. It is the
+		// duration for which the peer IDs are cached. If zero, a default value
+		// is used.
+		IDProviderCacheTTL time.Duration
+
 		DatabaseDir string // Path to directory containing persistence database.
 		// Timeout for re-establishing all open channels (if any) that was persisted during the
 		// previous running instance of the node.
 		PeerReconnTimeout time.Duration
 
+		// Parameters for off-chain communication with the peers. If zero,
+		// default values are used.
+		//
+		// Keep-alive and reconnect backoff for the comm backends are not
+		// configurable: the tcp based backends use the default TCP
+		// keep-alive of the Go runtime and connections to the peers are
+		// dialed on demand, when a message is sent, without any backoff.
+		// The probe interval and retry backoff below apply only to probing
+		// the status of the peers.
+		//
+		// Timeout for dialing a connection to a peer. It is also the timeout
+		// for receiving a response when probing the status of a peer.
+		CommDialTimeout time.Duration
+		// Interval at which the peers with an active status subscription are
+		// probed for connectivity.
+		PeerStatusProbeInterval time.Duration
+		// Delay before probing a peer again after it was unreachable. It is
+		// doubled after each failed attempt, up to the probe interval.
+		PeerStatusRetryBackoff time.Duration
+
 		FundingType string // Can take two values: local, remote
 
 		// If funding type is local, these two parameters are needed.
@@ -61,6 +99,94 @@ type (
 		// If watcher type is grpc, these two parameters are needed.
 		WatcherURL    string
 		WatcherAPIKey string
+
+		// Rules for automatically responding to incoming channel proposals
+		// and payments. If not specified, all of them are notified to the
+		// user for a manual response.
+		Policy PolicyConfig
+
+		// Parameters for making and forwarding multi-hop payments over the
+		// channels using the htlc app.
+		Routing RoutingConfig
+	}
+
+	// RoutingConfig defines the parameters for making and forwarding
+	// multi-hop payments. Routing is enabled only if Enable is set.
+	RoutingConfig struct {
+		Enable bool
+
+		// See routing.Config for the documentation on these fields. If zero,
+		// default values are used for the durations and no fee is charged.
+		HopTimeout    time.Duration
+		Fee           string
+		InvoiceExpiry time.Duration
+
+		// Channels of the other participants in the network, over which the
+		// payments can be routed. Channels of the user need not be added.
+		Links []routing.Link
+	}
+
+	// PolicyConfig defines the rules for automatically responding to
+	// incoming channel proposals and payments.
+	PolicyConfig struct {
+		Proposals ProposalPolicyConfig
+		Payments  PaymentPolicyConfig
+	}
+
+	// ProposalPolicyConfig defines the rules for automatically responding to
+	// proposals for payment channels, i.e., channels without an app. Proposals
+	// for channels with an app are always notified for a manual response.
+	ProposalPolicyConfig struct {
+		// Accept the proposals that match all the rules below.
+		Accept bool
+		// Reject the proposals that do not match the rules below, instead of
+		// notifying them for a manual response.
+		RejectOthers bool
+
+		// Aliases of the peers whose proposals match. If empty, proposals
+		// from any known peer match.
+		Peers []string
+		// Currencies that can be used in the channel. If empty, any currency
+		// can be used.
+		Currencies []string
+		// Minimum challenge duration for the channel.
+		MinChallengeDurSecs uint64
+		// Maximum amount the user can contribute to the channel, for each
+		// currency. The user should not contribute any amount of a currency
+		// without a limit.
+		MaxOwnBal []PolicyLimit
+	}
+
+	// PaymentPolicyConfig defines the rules for automatically responding to
+	// incoming payments. An incoming payment is an update on a payment
+	// channel, that does not finalize the channel and does not decrease the
+	// balance of the user in any currency. Other updates are always notified
+	// for a manual response.
+	PaymentPolicyConfig struct {
+		// Accept the payments that match all the rules below.
+		Accept bool
+		// Reject the payments that do not match the rules below, instead of
+		// notifying them for a manual response.
+		RejectOthers bool
+
+		// Aliases of the peers whose payments match. If empty, payments from
+		// any known peer match.
+		Peers []string
+		// Currencies that can be received. If empty, any currency can be
+		// received.
+		Currencies []string
+		// Maximum amount that can be received in a single payment, for each
+		// currency. There is no limit for currencies without a limit.
+		MaxAmount []PolicyLimit
+	}
+
+	// PolicyLimit defines the limit on amount for a currency. If the peer is
+	// specified, it applies only to that peer and takes precedence over the
+	// limit without a peer.
+	PolicyLimit struct {
+		Peer     string
+		Currency string
+		Amount   string
 	}
 
 	// UserConfig defines the parameters required to configure a user.
@@ -77,12 +203,43 @@ type (
 
 		CommAddr string
 		CommType string
+
+		// If comm type is tls, this parameter is needed.
+		CommTLS CommTLSConfig
+	}
+
+	// CommTLSConfig defines the paths to the PEM encoded files required for
+	// off-chain communication using tls comm type.
+	CommTLSConfig struct {
+		CertFile   string // Certificate presented to the peers.
+		KeyFile    string // Private key corresponding to the certificate.
+		CACertFile string // Certificates of the CAs used for verifying the certificates presented by the peers.
 	}
 
 	// WalletConfig defines the parameters required to configure a wallet.
 	WalletConfig struct {
 		KeystorePath string
-		Password     string
+
+		// Password for unlocking the keys in the keystore. To avoid writing
+		// it in clear text, it can be read from an environment variable
+		// (env:VAR) or a file (file:PATH). It can also be set to "prompt",
+		// when the session is opened using perunnodecli, which then reads
+		// the password from the terminal and sends it to the node.
+		Password string
+
+		// URL of an external signer (such as clef) holding the keys, e.g.
+		// path to its IPC socket. If specified, all the signatures are made
+		// by the signer and keystore path and password are not used.
+		SignerURL string
+
+		// BIP-39 mnemonic from which the keys are derived using BIP-44
+		// paths. Like password, it can be read from an environment variable
+		// or a file. If specified, keystore path is not used and password is
+		// used as the BIP-39 passphrase. On-chain and off-chain addresses
+		// should be of the accounts at m/44'/60'/0'/0/0 and m/44'/60'/0'/0/1
+		// respectively. A fresh participant account is derived for each
+		// ledger channel, so participant addresses need not be configured.
+		Mnemonic string
 	}
 
 	// ChainConfig represents the configuration parameters for connecting to blockchain.
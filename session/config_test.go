@@ -19,11 +19,13 @@ package session_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gotest.tools/assert"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment/routing"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
 	"github.com/hyperledger-labs/perun-node/session"
 	"github.com/hyperledger-labs/perun-node/session/sessiontest"
@@ -61,6 +63,49 @@ var (
 		OnChainTxTimeout:  ethereumtest.OnChainTxTimeout,
 		ResponseTimeout:   sessiontest.ResponseTimeout,
 		PeerReconnTimeout: sessiontest.PeerReconnTimeout,
+		TxFinalityDepth:   12,
+		Gas: perun.GasConfig{
+			MaxFeePerGas:         30000000000,
+			MaxPriorityFeePerGas: 2000000000,
+			MaxTxFee:             10000000000000000,
+			FeeBumpPercent:       20,
+			FeeBumpInterval:      15 * time.Second,
+		},
+
+		CommDialTimeout:         10 * time.Second,
+		PeerStatusProbeInterval: 15 * time.Second,
+		PeerStatusRetryBackoff:  1 * time.Second,
+
+		Routing: session.RoutingConfig{
+			Enable:        true,
+			HopTimeout:    5 * time.Second,
+			Fee:           "0.001",
+			InvoiceExpiry: 10 * time.Minute,
+			Links: []routing.Link{{
+				From:     "0x7b7E212652b9C3755C4A1f1718a142ABE38Ce2aB",
+				To:       "0x2EE38A1D2b6A9d0E2C4E1d1A9A3A2DD5cBbF6C6c",
+				Currency: "ETH",
+				Fee:      "0.001",
+			}},
+		},
+
+		Policy: session.PolicyConfig{
+			Proposals: session.ProposalPolicyConfig{
+				Accept:              true,
+				Peers:               []string{"bob"},
+				Currencies:          []string{"ETH"},
+				MinChallengeDurSecs: 10,
+				MaxOwnBal:           []session.PolicyLimit{{Currency: "ETH", Amount: "0"}},
+			},
+			Payments: session.PaymentPolicyConfig{
+				Accept:       true,
+				RejectOthers: true,
+				MaxAmount: []session.PolicyLimit{
+					{Currency: "ETH", Amount: "1"},
+					{Peer: "bob", Currency: "ETH", Amount: "5"},
+				},
+			},
+		},
 	}
 )
 
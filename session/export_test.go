@@ -20,12 +20,14 @@ import (
 	"time"
 
 	pchannel "perun.network/go-perun/channel"
+	pmemorydb "polycry.pt/poly-go/sortedkv/memorydb"
 
 	"github.com/pkg/errors"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/memory"
 	"github.com/hyperledger-labs/perun-node/currency/currencytest"
 	"github.com/hyperledger-labs/perun-node/log"
 )
@@ -35,6 +37,12 @@ func SetWalletBackend(wb perun.WalletBackend) {
 	walletBackend = wb
 }
 
+// SetMemoryCommHub enables the comm type "memory" using the given hub during
+// tests. Passing nil disables it.
+func SetMemoryCommHub(hub *memory.Hub) {
+	memoryCommHub = hub
+}
+
 func NewClientForTest(pClient pClient,
 	bus perun.WireBus, msgBusRegistry perun.Registerer, dbConn Closer,
 ) ChClient {
@@ -50,12 +58,18 @@ func NewSessionForTest(cfg Config, isOpen bool, chClient ChClient, chainSetup *e
 	*Session, error,
 ) {
 	_ = chainSetup
+	policy, err := newPolicy(cfg.Policy)
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "policy", "")
+	}
+
 	user, apiErr := NewUnlockedUser(walletBackend, cfg.User)
 	if apiErr != nil {
 		return nil, apiErr
 	}
 
-	idProvider, apiErr := initIDProvider(cfg.IDProviderType, cfg.IDProviderURL, walletBackend, user.PeerID)
+	idProvider, apiErr := initIDProvider(cfg.IDProviderType, cfg.IDProviderURL, cfg.IDProviderCacheTTL,
+		walletBackend, user.PeerID)
 	if apiErr != nil {
 		return nil, apiErr
 	}
@@ -78,7 +92,9 @@ func NewSessionForTest(cfg Config, isOpen bool, chClient ChClient, chainSetup *e
 	funder := chainSetup.ChainBackend.NewFunder(contracts.AssetETH(), user.OnChain.Addr)
 	adjudicator := chainSetup.ChainBackend.NewAdjudicator(cfg.Adjudicator, user.OnChain.Addr)
 
-	return &Session{
+	commCfg := newCommConfig(cfg)
+
+	sess := &Session{
 		Logger:               log.NewLoggerWithField("session-id", sessionID),
 		id:                   sessionID,
 		isOpen:               isOpen,
@@ -87,6 +103,7 @@ func NewSessionForTest(cfg Config, isOpen bool, chClient ChClient, chainSetup *e
 		user:                 user,
 		chClient:             chClient,
 		idProvider:           idProvider,
+		peers:                newPeerMonitor(chClient, commCfg),
 		chain:                chainSetup.ChainBackend,
 		funder:               funder,
 		adjudicator:          adjudicator,
@@ -94,7 +111,19 @@ func NewSessionForTest(cfg Config, isOpen bool, chClient ChClient, chainSetup *e
 		contractRegistry:     contracts,
 		currencyRegistry:     currencytest.Registry(),
 		chProposalResponders: make(map[string]chProposalResponderEntry),
-	}, nil
+		appMsgNotifiers:      make(map[string]perun.AppMsgNotifier),
+		chUpdateInterceptors: make(map[string]perun.ChUpdateInterceptor),
+		closeHandlers:        make(map[string]perun.SessionCloseHandler),
+		policy:               policy,
+		history:              newHistoryStore(pmemorydb.NewDatabase()),
+	}
+	sess.scheduler = newScheduler(pmemorydb.NewDatabase(), sess.GetCh, sess.Logger)
+	if deriver, ok := user.OffChain.Wallet.(partAccDeriver); ok {
+		if sess.partAccs, err = newPartAccAllocator(deriver, pmemorydb.NewDatabase()); err != nil {
+			return nil, err
+		}
+	}
+	return sess, nil
 }
 
 func NewChForTest(pch PChannel,
@@ -124,3 +153,10 @@ func MakeAllocation(openingBalInfo perun.BalInfo,
 	_, allocation, err := makeAllocation(openingBalInfo, contractRegistry, currencyRegistry)
 	return allocation, err
 }
+
+// SetScheduleRetry sets the interval and the maximum number of retries for
+// scheduled payments that time out.
+func SetScheduleRetry(s *Session, interval time.Duration, maxRetries int) {
+	s.scheduler.retryInterval = interval
+	s.scheduler.maxRetries = maxRetries
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+	"polycry.pt/poly-go/sortedkv"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+const (
+	// historyTablePrefix is the prefix for the keys of history entries in the database.
+	historyTablePrefix = "ChHistory:"
+
+	// historyDirName is the name of the directory within the database
+	// directory, where the history of channels in a session is persisted.
+	historyDirName = "history"
+
+	// maxChHistoryPageSize is the maximum number of entries that can be
+	// retrieved in one call to GetHistory.
+	maxChHistoryPageSize = 1000
+)
+
+// historyStore persists the history of updates on the channels in a session.
+//
+// Entries are stored with the channel ID and the version as key, so that
+// entries of a channel can be iterated in the order of versions. The methods
+// on it are safe for concurrent use.
+type historyStore struct {
+	db sortedkv.Database
+}
+
+// newHistoryStore initializes a history store using the given database.
+func newHistoryStore(db sortedkv.Database) *historyStore {
+	return &historyStore{
+		db: sortedkv.NewTable(db, historyTablePrefix),
+	}
+}
+
+// historyKey returns the key for the history entry. The version is zero
+// padded, so that the lexical order of the keys is the same as the order of
+// the versions.
+func historyKey(chID string, version uint64) string {
+	return fmt.Sprintf("%s:%020d", chID, version)
+}
+
+// put persists the history entry, overwriting any existing entry for the
+// same channel and version.
+func (h *historyStore) put(entry perun.ChHistoryEntry) error {
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "encoding history entry")
+	}
+	return errors.Wrap(h.db.PutBytes(historyKey(entry.ChID, entry.Version), value), "writing history entry")
+}
+
+// get retrieves upto limit entries for the channel, starting from the given
+// version, in the order of versions.
+func (h *historyStore) get(chID string, fromVersion, limit uint64) ([]perun.ChHistoryEntry, error) {
+	// ";" is the character following ":" and hence the range covers all the keys of the channel.
+	it := h.db.NewIteratorWithRange(historyKey(chID, fromVersion), chID+";")
+	defer it.Close() //nolint:errcheck
+
+	entries := []perun.ChHistoryEntry{}
+	for uint64(len(entries)) < limit && it.Next() {
+		var entry perun.ChHistoryEntry
+		if err := json.Unmarshal(it.ValueBytes(), &entry); err != nil {
+			return nil, errors.Wrapf(err, "decoding history entry %s", it.Key())
+		}
+		entries = append(entries, entry)
+	}
+	return entries, errors.Wrap(it.Close(), "reading history entries")
+}
+
+// close closes the underlying database.
+func (h *historyStore) close() error {
+	return errors.Wrap(h.db.Close(), "closing history database")
+}
+
+// makeChHistoryEntry constructs the history entry for an update on the
+// channel from the given state to the next state.
+func makeChHistoryEntry(chID string, parts []string, currencies []perun.Currency, ownIdx pchannel.Index,
+	from, to *pchannel.State,
+) perun.ChHistoryEntry {
+	entry := perun.ChHistoryEntry{
+		ChID:       chID,
+		Version:    to.Version,
+		Timestamp:  time.Now().UTC().Unix(),
+		Currencies: make([]string, len(currencies)),
+		Deltas:     make([]string, len(currencies)),
+	}
+	var received, paid bool
+	ownDeltas := make([]*big.Int, len(currencies))
+	for i := range currencies {
+		ownDeltas[i] = balDelta(from, to, i, int(ownIdx))
+		received = received || ownDeltas[i].Sign() > 0
+		paid = paid || ownDeltas[i].Sign() < 0
+		entry.Currencies[i] = currencies[i].Symbol()
+		entry.Deltas[i] = currencies[i].Print(ownDeltas[i])
+	}
+	switch {
+	case received && paid:
+		entry.Direction = perun.PaymentDirectionMixed
+	case received:
+		entry.Direction = perun.PaymentDirectionIncoming
+	case paid:
+		entry.Direction = perun.PaymentDirectionOutgoing
+	}
+	entry.Counterparty = counterparty(parts, ownIdx, ownDeltas, from, to)
+	return entry
+}
+
+// counterparty returns the alias of the first participant, other than the
+// user, whose balance changed in the direction opposite to that of the user
+// in any currency.
+func counterparty(parts []string, ownIdx pchannel.Index, ownDeltas []*big.Int, from, to *pchannel.State) string {
+	for partIdx := range parts {
+		if partIdx == int(ownIdx) {
+			continue
+		}
+		for i := range ownDeltas {
+			if ownDeltas[i].Sign()*balDelta(from, to, i, partIdx).Sign() < 0 {
+				return parts[partIdx]
+			}
+		}
+	}
+	return ""
+}
+
+// balDelta returns the change in the balance of the participant for the
+// asset, between the two states.
+func balDelta(from, to *pchannel.State, assetIdx, partIdx int) *big.Int {
+	if assetIdx >= len(from.Balances) || assetIdx >= len(to.Balances) ||
+		partIdx >= len(from.Balances[assetIdx]) || partIdx >= len(to.Balances[assetIdx]) {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Sub(to.Balances[assetIdx][partIdx], from.Balances[assetIdx][partIdx])
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pmemorydb "polycry.pt/poly-go/sortedkv/memorydb"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+func Test_HistoryStore(t *testing.T) {
+	h := newHistoryStore(pmemorydb.NewDatabase())
+	for _, chID := range []string{"ch1", "ch2"} {
+		// Versions are put out of order and beyond 10, to check the ordering is numeric.
+		for _, version := range []uint64{11, 2, 1, 10, 3} {
+			require.NoError(t, h.put(perun.ChHistoryEntry{ChID: chID, Version: version}))
+		}
+	}
+	versions := func(entries []perun.ChHistoryEntry) []uint64 {
+		got := make([]uint64, len(entries))
+		for i := range entries {
+			assert.Equal(t, "ch1", entries[i].ChID)
+			got[i] = entries[i].Version
+		}
+		return got
+	}
+
+	t.Run("all", func(t *testing.T) {
+		entries, err := h.get("ch1", 0, maxChHistoryPageSize)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1, 2, 3, 10, 11}, versions(entries))
+	})
+	t.Run("paged", func(t *testing.T) {
+		entries, err := h.get("ch1", 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1, 2}, versions(entries))
+
+		entries, err = h.get("ch1", 3, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{3, 10}, versions(entries))
+
+		entries, err = h.get("ch1", 11, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{11}, versions(entries))
+	})
+	t.Run("unknown_channel", func(t *testing.T) {
+		entries, err := h.get("ch3", 0, maxChHistoryPageSize)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func Test_MakeChHistoryEntry(t *testing.T) {
+	currencies := []perun.Currency{currencytest.Registry().Currency(currency.ETHSymbol)}
+	parts := []string{perun.OwnAlias, "bob"}
+	state := func(version uint64, ownBal, peerBal int64) *pchannel.State {
+		return &pchannel.State{
+			Version: version,
+			Allocation: pchannel.Allocation{
+				Balances: pchannel.Balances{{big.NewInt(ownBal), big.NewInt(peerBal)}},
+			},
+		}
+	}
+	from := state(1, 1e18, 1e18)
+
+	tests := []struct {
+		name             string
+		to               *pchannel.State
+		wantDirection    perun.PaymentDirection
+		wantCounterparty string
+		wantDelta        string
+	}{
+		{"incoming", state(2, 1.5e18, 0.5e18), perun.PaymentDirectionIncoming, "bob", "0.5"},
+		{"outgoing", state(2, 0.5e18, 1.5e18), perun.PaymentDirectionOutgoing, "bob", "-0.5"},
+		{"none", state(2, 1e18, 1e18), perun.PaymentDirectionNone, "", "0"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := makeChHistoryEntry("ch1", parts, currencies, 0, from, tc.to)
+			assert.Equal(t, "ch1", entry.ChID)
+			assert.Equal(t, uint64(2), entry.Version)
+			assert.NotZero(t, entry.Timestamp)
+			assert.Equal(t, tc.wantDirection, entry.Direction)
+			assert.Equal(t, tc.wantCounterparty, entry.Counterparty)
+			assert.Equal(t, []string{currency.ETHSymbol}, entry.Currencies)
+			assert.Equal(t, []string{tc.wantDelta}, entry.Deltas)
+		})
+	}
+}
+
+func Test_Channel_GetHistory(t *testing.T) {
+	ch := &Channel{
+		Logger:  log.NewLoggerWithField("channel-id", "ch1"),
+		params:  params{id: "ch1"},
+		history: newHistoryStore(pmemorydb.NewDatabase()),
+	}
+	for version := uint64(1); version <= 3; version++ {
+		require.NoError(t, ch.history.put(perun.ChHistoryEntry{ChID: "ch1", Version: version}))
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		entries, apiErr := ch.GetHistory(2, 0)
+		require.NoError(t, apiErr)
+		require.Len(t, entries, 2)
+		assert.Equal(t, uint64(2), entries[0].Version)
+	})
+	t.Run("no_history_store", func(t *testing.T) {
+		chWoHistory := &Channel{Logger: ch.Logger, params: ch.params}
+		entries, apiErr := chWoHistory.GetHistory(0, 0)
+		require.NoError(t, apiErr)
+		assert.Empty(t, entries)
+	})
+	t.Run("error_limit", func(t *testing.T) {
+		_, apiErr := ch.GetHistory(0, maxChHistoryPageSize+1)
+		require.Error(t, apiErr)
+		assert.Equal(t, perun.ErrInvalidArgument, apiErr.Code())
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	"polycry.pt/poly-go/sortedkv"
+	pleveldb "polycry.pt/poly-go/sortedkv/leveldb"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+const (
+	// partAccsDirName is the name of the directory within the database
+	// directory, where the number of participant accounts derived in a
+	// session is persisted.
+	partAccsDirName = "partaccs"
+
+	// numPartAccsKey is the key for the number of participant accounts in
+	// the database.
+	numPartAccsKey = "NumPartAccs"
+)
+
+// partAccDeriver is implemented by the off-chain wallets that can derive a
+// fresh participant account for each channel, such as the HD wallet.
+type partAccDeriver interface {
+	NewPartAccount() (pwallet.Account, error)
+	NumPartAccounts() uint
+	DerivePartAccounts(n uint) error
+}
+
+// partAccAllocator allocates a fresh participant account for each ledger
+// channel, using the off-chain wallet.
+//
+// The number of derived accounts is persisted, so that the accounts used in
+// the channels can be derived again when the session is re-opened, and are
+// not reused for the new channels. The methods on it are safe for concurrent
+// use.
+type partAccAllocator struct {
+	mutex  sync.Mutex
+	wallet partAccDeriver
+	db     sortedkv.Database
+}
+
+// initPartAccAllocator initializes a participant account allocator, if the
+// off-chain wallet can derive participant accounts. Else, it returns nil and
+// the off-chain account is used as participant in all the channels.
+func initPartAccAllocator(w pwallet.Wallet, databaseDir string) (*partAccAllocator, perun.APIError) {
+	deriver, ok := w.(partAccDeriver)
+	if !ok {
+		return nil, nil
+	}
+	db, err := pleveldb.LoadDatabase(filepath.Join(databaseDir, partAccsDirName))
+	if err != nil {
+		err = errors.Wrap(err, "initializing participant accounts database")
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", databaseDir)
+	}
+	a, err := newPartAccAllocator(deriver, db)
+	if err != nil {
+		db.Close() //nolint:errcheck,gosec // Error while initializing is returned.
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", databaseDir)
+	}
+	return a, nil
+}
+
+// newPartAccAllocator initializes a participant account allocator and derives
+// the accounts that were allocated before the session was re-opened.
+func newPartAccAllocator(w partAccDeriver, db sortedkv.Database) (*partAccAllocator, error) {
+	a := &partAccAllocator{wallet: w, db: db}
+	has, err := db.Has(numPartAccsKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading number of participant accounts")
+	}
+	if !has {
+		return a, nil
+	}
+	value, err := db.Get(numPartAccsKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading number of participant accounts")
+	}
+	numPartAccs, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing number of participant accounts")
+	}
+	if err = w.DerivePartAccounts(uint(numPartAccs)); err != nil {
+		return nil, errors.WithMessage(err, "deriving participant accounts")
+	}
+	return a, nil
+}
+
+// newPartAddr derives a fresh participant account and returns its address.
+// The number of derived accounts is persisted before the account is returned.
+func (a *partAccAllocator) newPartAddr() (pwallet.Address, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	acc, err := a.wallet.NewPartAccount()
+	if err != nil {
+		return nil, errors.WithMessage(err, "deriving participant account")
+	}
+	numPartAccs := strconv.FormatUint(uint64(a.wallet.NumPartAccounts()), 10)
+	if err = a.db.Put(numPartAccsKey, numPartAccs); err != nil {
+		return nil, errors.Wrap(err, "writing number of participant accounts")
+	}
+	return acc.Address(), nil
+}
+
+// close closes the underlying database.
+func (a *partAccAllocator) close() error {
+	return errors.Wrap(a.db.Close(), "closing participant accounts database")
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwallet "perun.network/go-perun/wallet"
+	pmemorydb "polycry.pt/poly-go/sortedkv/memorydb"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+)
+
+func newHDWallet(t *testing.T) partAccDeriver {
+	t.Helper()
+	w, err := ethereum.NewHDWalletBackend().NewWallet(ethereumtest.HDMnemonic, "")
+	require.NoError(t, err)
+	deriver, ok := w.(partAccDeriver)
+	require.True(t, ok)
+	return deriver
+}
+
+func Test_PartAccAllocator(t *testing.T) {
+	db := pmemorydb.NewDatabase()
+	a, err := newPartAccAllocator(newHDWallet(t), db)
+	require.NoError(t, err)
+
+	addrs := make([]pwallet.Address, 3)
+	for i := range addrs {
+		addrs[i], err = a.newPartAddr()
+		require.NoError(t, err)
+	}
+	assert.False(t, addrs[0].Equal(addrs[1]))
+	assert.False(t, addrs[1].Equal(addrs[2]))
+	numPartAccs, err := db.Get(numPartAccsKey)
+	require.NoError(t, err)
+	assert.Equal(t, "3", numPartAccs)
+
+	t.Run("restore", func(t *testing.T) {
+		w := newHDWallet(t)
+		restored, err := newPartAccAllocator(w, db)
+		require.NoError(t, err)
+		assert.Equal(t, uint(3), w.NumPartAccounts())
+		for i := range addrs {
+			_, err = w.(pwallet.Wallet).Unlock(addrs[i])
+			assert.NoError(t, err)
+		}
+
+		// New accounts should not reuse the ones allocated before restoring.
+		addr, err := restored.newPartAddr()
+		require.NoError(t, err)
+		for i := range addrs {
+			assert.False(t, addr.Equal(addrs[i]))
+		}
+	})
+	t.Run("invalid_number_in_db", func(t *testing.T) {
+		invalidDB := pmemorydb.NewDatabase()
+		require.NoError(t, invalidDB.Put(numPartAccsKey, "invalid"))
+		_, err := newPartAccAllocator(newHDWallet(t), invalidDB)
+		assert.Error(t, err)
+	})
+}
+
+func Test_InitPartAccAllocator(t *testing.T) {
+	t.Run("hd_wallet", func(t *testing.T) {
+		a, apiErr := initPartAccAllocator(newHDWallet(t).(pwallet.Wallet), t.TempDir())
+		require.NoError(t, apiErr)
+		require.NotNil(t, a)
+		assert.NoError(t, a.close())
+	})
+	t.Run("keystore_wallet", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+		ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+		a, apiErr := initPartAccAllocator(ws.Wallet, t.TempDir())
+		require.NoError(t, apiErr)
+		assert.Nil(t, a)
+	})
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Default values for the off-chain communication and peer status parameters,
+// used when they are not set in the session config.
+const (
+	defaultCommDialTimeout         = 10 * time.Second
+	defaultPeerStatusProbeInterval = 15 * time.Second
+	defaultPeerStatusRetryBackoff  = 1 * time.Second
+)
+
+// App IDs of the app messages used for probing the peers. They are handled
+// by the peer monitor and are not passed to the app message subscriptions.
+const (
+	peerStatusPingAppID = "perun-node/peer-status/ping"
+	peerStatusPongAppID = "perun-node/peer-status/pong"
+
+	// pingNonceLen is the length of the nonce in ping and pong messages.
+	pingNonceLen = 8
+)
+
+type commConfig struct {
+	dialTimeout   time.Duration
+	probeInterval time.Duration
+	retryBackoff  time.Duration
+}
+
+// newCommConfig returns the off-chain communication and peer status
+// parameters in the session config, replacing the values that are not set
+// with the defaults.
+func newCommConfig(cfg Config) commConfig {
+	c := commConfig{
+		dialTimeout:   cfg.CommDialTimeout,
+		probeInterval: cfg.PeerStatusProbeInterval,
+		retryBackoff:  cfg.PeerStatusRetryBackoff,
+	}
+	if c.dialTimeout <= 0 {
+		c.dialTimeout = defaultCommDialTimeout
+	}
+	if c.probeInterval <= 0 {
+		c.probeInterval = defaultPeerStatusProbeInterval
+	}
+	if c.retryBackoff <= 0 {
+		c.retryBackoff = defaultPeerStatusRetryBackoff
+	}
+	return c
+}
+
+// peerMessenger sends app messages to the peers over the connections of the
+// channel client. It is implemented by ChClient.
+type peerMessenger interface {
+	perun.Registerer
+	SendAppMsg(ctx context.Context, to pwire.Address, appID string, data []byte) error
+}
+
+// peerMonitor tracks the connectivity status of the peers.
+//
+// A peer is probed by sending a ping app message to it over the connection
+// used by the channel client and waiting for the pong. If there is no
+// connection to the peer, one is dialed and authenticated by the message bus
+// and retained for later use, as would be done when sending any other message.
+// The round-trip time of the ping is reported as the latency. It includes the
+// time for establishing the connection, if one was dialed.
+type peerMonitor struct {
+	mutex     sync.Mutex
+	messenger peerMessenger
+	cfg       commConfig
+	statuses  map[string]perun.PeerStatus // Last known status of each peer, by alias.
+	subs      map[string]chan struct{}    // Signal for stopping each subscription, by alias.
+	pings     map[uint64]pendingPing      // Pings waiting for a pong, by nonce.
+	nonce     uint64
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+// pendingPing is a ping sent to a peer, that is waiting for a pong.
+type pendingPing struct {
+	to   pwire.Address
+	pong chan struct{}
+}
+
+func newPeerMonitor(messenger peerMessenger, cfg commConfig) *peerMonitor {
+	return &peerMonitor{
+		messenger: messenger,
+		cfg:       cfg,
+		statuses:  make(map[string]perun.PeerStatus),
+		subs:      make(map[string]chan struct{}),
+		pings:     make(map[uint64]pendingPing),
+	}
+}
+
+// probe pings the peer, updates its status and returns the updated status.
+// The peer is not reachable, if the pong is not received within the dial
+// timeout.
+func (m *peerMonitor) probe(ctx context.Context, peerID perun.PeerID) perun.PeerStatus {
+	m.messenger.Register(peerID.OffChainAddr, peerID.CommAddr)
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.dialTimeout)
+	defer cancel()
+
+	start := time.Now()
+	reachable := m.ping(ctx, peerID.OffChainAddr)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	status := m.statuses[peerID.Alias]
+	status.Alias = peerID.Alias
+	status.Reachable = reachable
+	if status.Reachable {
+		status.Latency = time.Since(start)
+		status.LastSeen = time.Now()
+	}
+	m.statuses[peerID.Alias] = status
+	return status
+}
+
+// ping sends a ping to the peer and returns true if the pong is received
+// before the context expires.
+func (m *peerMonitor) ping(ctx context.Context, to pwire.Address) bool {
+	m.mutex.Lock()
+	m.nonce++
+	nonce := m.nonce
+	pong := make(chan struct{})
+	m.pings[nonce] = pendingPing{to: to, pong: pong}
+	m.mutex.Unlock()
+	defer func() {
+		m.mutex.Lock()
+		delete(m.pings, nonce)
+		m.mutex.Unlock()
+	}()
+
+	data := make([]byte, pingNonceLen)
+	binary.BigEndian.PutUint64(data, nonce)
+	if err := m.messenger.SendAppMsg(ctx, to, peerStatusPingAppID, data); err != nil {
+		return false
+	}
+	select {
+	case <-pong:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// handleAppMsg handles the ping and pong app messages. A pong is sent in
+// response to each ping and the pending ping is completed for each pong
+// from the pinged peer. It returns false, if the message is not a ping or a
+// pong.
+func (m *peerMonitor) handleAppMsg(from pwire.Address, appID string, data []byte) bool {
+	switch appID {
+	case peerStatusPingAppID:
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		if m.closed {
+			return true
+		}
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), m.cfg.dialTimeout)
+			defer cancel()
+			// If the pong cannot be sent, the peer will find this node unreachable.
+			m.messenger.SendAppMsg(ctx, from, peerStatusPongAppID, data) //nolint:errcheck,gosec
+		}()
+		return true
+	case peerStatusPongAppID:
+		if len(data) != pingNonceLen {
+			return true
+		}
+		nonce := binary.BigEndian.Uint64(data)
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		if ping, ok := m.pings[nonce]; ok && ping.to.Equal(from) {
+			close(ping.pong)
+			delete(m.pings, nonce)
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// subscribe starts probing the peer in the background and sends its status
+// to the notifier after each probe. The peer ID is read before each probe, so
+// that the changes made to it are picked up.
+//
+// It returns false if a subscription already exists for the alias.
+func (m *peerMonitor) subscribe(alias string, idReader perun.IDReader, notifier perun.PeerStatusNotifier) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.subs[alias]; ok {
+		return false
+	}
+	stop := make(chan struct{})
+	m.subs[alias] = stop
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run(alias, idReader, notifier, stop)
+	}()
+	return true
+}
+
+// run probes the peer at the probe interval when it is reachable. When it is
+// not, the peer is probed again after the retry backoff, which is doubled
+// after each failed attempt up to the probe interval.
+func (m *peerMonitor) run(alias string, idReader perun.IDReader, notifier perun.PeerStatusNotifier,
+	stop chan struct{},
+) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	backoff := m.cfg.retryBackoff
+	for {
+		status := perun.PeerStatus{Alias: alias}
+		if peerID, found := idReader.ReadByAlias(alias); found {
+			status = m.probe(ctx, peerID)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		notifier(status)
+
+		interval := m.cfg.probeInterval
+		if status.Reachable {
+			backoff = m.cfg.retryBackoff
+		} else {
+			interval = backoff
+			if backoff *= 2; backoff > m.cfg.probeInterval {
+				backoff = m.cfg.probeInterval
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// unsubscribe stops the subscription for the alias. It returns false if there
+// is no subscription for the alias.
+func (m *peerMonitor) unsubscribe(alias string) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	stop, ok := m.subs[alias]
+	if !ok {
+		return false
+	}
+	close(stop)
+	delete(m.subs, alias)
+	return true
+}
+
+// forget removes the last known status of the peer.
+func (m *peerMonitor) forget(alias string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.statuses, alias)
+}
+
+// close stops all the subscriptions and waits for them and for the pongs
+// being sent to return. Pings received after closing are not responded to.
+func (m *peerMonitor) close() error {
+	m.mutex.Lock()
+	m.closed = true
+	for alias, stop := range m.subs {
+		close(stop)
+		delete(m.subs, alias)
+	}
+	m.mutex.Unlock()
+
+	m.wg.Wait()
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+)
+
+func Test_NewCommConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		want := commConfig{
+			dialTimeout:   defaultCommDialTimeout,
+			probeInterval: defaultPeerStatusProbeInterval,
+			retryBackoff:  defaultPeerStatusRetryBackoff,
+		}
+		assert.Equal(t, want, newCommConfig(Config{}))
+	})
+	t.Run("configured", func(t *testing.T) {
+		cfg := Config{
+			CommDialTimeout:         time.Second,
+			PeerStatusProbeInterval: time.Minute,
+			PeerStatusRetryBackoff:  time.Millisecond,
+		}
+		want := commConfig{dialTimeout: time.Second, probeInterval: time.Minute, retryBackoff: time.Millisecond}
+		assert.Equal(t, want, newCommConfig(cfg))
+	})
+}
+
+func Test_PeerMonitor(t *testing.T) {
+	aliceID := newTestPeerID(t, "alice", "alice-comm-addr", "0x3369783337071807248093730889602727505701")
+	bobID := newTestPeerID(t, "bob", "bob-comm-addr", "0x9282681723920798983380581376586951466585")
+	cfg := commConfig{dialTimeout: time.Second, probeInterval: 20 * time.Millisecond, retryBackoff: 5 * time.Millisecond}
+	aliceMessenger := &testMessenger{own: aliceID.OffChainAddr, reachable: true}
+	bobMessenger := &testMessenger{own: bobID.OffChainAddr, reachable: true}
+	alice := newPeerMonitor(aliceMessenger, cfg)
+	bob := newPeerMonitor(bobMessenger, cfg)
+	aliceMessenger.peer, bobMessenger.peer = bob, alice
+	t.Cleanup(func() {
+		assert.NoError(t, alice.close())
+		assert.NoError(t, bob.close())
+	})
+
+	reachable := alice.probe(context.Background(), bobID)
+	assert.Equal(t, bobID.Alias, reachable.Alias)
+	assert.True(t, reachable.Reachable)
+	assert.False(t, reachable.LastSeen.IsZero())
+	assert.Equal(t, bobID.CommAddr, aliceMessenger.registered(bobID.OffChainAddr))
+
+	t.Run("subscribe_unsubscribe", func(t *testing.T) {
+		notifs := make(chan perun.PeerStatus, 10)
+		require.True(t, alice.subscribe(bobID.Alias, idReader{bobID}, func(s perun.PeerStatus) { notifs <- s }))
+		assert.False(t, alice.subscribe(bobID.Alias, idReader{bobID}, func(perun.PeerStatus) {}))
+		for i := 0; i < 2; i++ {
+			select {
+			case notif := <-notifs:
+				assert.True(t, notif.Reachable)
+			case <-time.After(time.Second):
+				t.Fatal("no notification received")
+			}
+		}
+		assert.True(t, alice.unsubscribe(bobID.Alias))
+		assert.False(t, alice.unsubscribe(bobID.Alias))
+	})
+
+	t.Run("unreachable_retains_last_seen", func(t *testing.T) {
+		lastReachable := alice.probe(context.Background(), bobID)
+		require.True(t, lastReachable.Reachable)
+		aliceMessenger.setReachable(false)
+		defer aliceMessenger.setReachable(true)
+		unreachable := alice.probe(context.Background(), bobID)
+		assert.False(t, unreachable.Reachable)
+		assert.Equal(t, lastReachable.LastSeen, unreachable.LastSeen)
+		assert.Equal(t, lastReachable.Latency, unreachable.Latency)
+
+		alice.forget(bobID.Alias)
+		assert.Equal(t, perun.PeerStatus{Alias: bobID.Alias}, alice.probe(context.Background(), bobID))
+	})
+
+	t.Run("no_pong", func(t *testing.T) {
+		// Bob receives the ping, but the pong is lost.
+		bobMessenger.setReachable(false)
+		defer bobMessenger.setReachable(true)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		assert.False(t, alice.probe(ctx, bobID).Reachable)
+	})
+
+	t.Run("pong_from_other_peer", func(t *testing.T) {
+		// A pong is accepted only from the pinged peer.
+		assert.True(t, alice.handleAppMsg(aliceID.OffChainAddr, peerStatusPongAppID, make([]byte, pingNonceLen)))
+		assert.True(t, alice.handleAppMsg(aliceID.OffChainAddr, peerStatusPongAppID, []byte{1}))
+		assert.False(t, alice.handleAppMsg(bobID.OffChainAddr, "other-app", nil))
+	})
+
+	t.Run("unknown_peer", func(t *testing.T) {
+		notifs := make(chan perun.PeerStatus, 10)
+		require.True(t, alice.subscribe("unknown", idReader{}, func(s perun.PeerStatus) { notifs <- s }))
+		select {
+		case notif := <-notifs:
+			assert.Equal(t, perun.PeerStatus{Alias: "unknown"}, notif)
+		case <-time.After(time.Second):
+			t.Fatal("no notification received")
+		}
+		// Subscription is stopped when closing the monitor.
+	})
+}
+
+// testMessenger is a peerMessenger that delivers the app messages to the
+// peer monitor of the peer, when it is reachable.
+type testMessenger struct {
+	own  pwire.Address
+	peer *peerMonitor
+
+	mutex     sync.Mutex
+	reachable bool
+	commAddrs map[string]string
+}
+
+func (m *testMessenger) Register(offChainAddr pwire.Address, commAddr string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.commAddrs == nil {
+		m.commAddrs = make(map[string]string)
+	}
+	m.commAddrs[offChainAddr.String()] = commAddr
+}
+
+func (m *testMessenger) SendAppMsg(_ context.Context, _ pwire.Address, appID string, data []byte) error {
+	m.mutex.Lock()
+	reachable := m.reachable
+	m.mutex.Unlock()
+	if !reachable {
+		return errors.New("peer not reachable")
+	}
+	go m.peer.handleAppMsg(m.own, appID, data)
+	return nil
+}
+
+func (m *testMessenger) setReachable(reachable bool) {
+	m.mutex.Lock()
+	m.reachable = reachable
+	m.mutex.Unlock()
+}
+
+func (m *testMessenger) registered(offChainAddr pwire.Address) string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.commAddrs[offChainAddr.String()]
+}
+
+func newTestPeerID(t *testing.T, alias, commAddr, offChainAddrString string) perun.PeerID {
+	t.Helper()
+	offChainAddr, err := ethereum.NewWalletBackend().ParseAddr(offChainAddrString)
+	require.NoError(t, err)
+	return perun.PeerID{
+		Alias:              alias,
+		OffChainAddr:       offChainAddr,
+		OffChainAddrString: offChainAddrString,
+		CommAddr:           commAddr,
+		CommType:           "memory",
+	}
+}
+
+// idReader is a perun.IDReader that returns the peer IDs in it.
+type idReader []perun.PeerID
+
+func (r idReader) ReadByAlias(alias string) (perun.PeerID, bool) {
+	for _, peerID := range r {
+		if peerID.Alias == alias {
+			return peerID, true
+		}
+	}
+	return perun.PeerID{}, false
+}
+
+func (r idReader) ReadByOffChainAddr(offChainAddr pwire.Address) (perun.PeerID, bool) {
+	for _, peerID := range r {
+		if peerID.OffChainAddr.Equal(offChainAddr) {
+			return peerID, true
+		}
+	}
+	return perun.PeerID{}, false
+}
+
+func (r idReader) ReadAll() []perun.PeerID {
+	return r
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	pchannel "perun.network/go-perun/channel"
+	pclient "perun.network/go-perun/client"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// policy evaluates the incoming channel proposals and payments against the
+// rules configured for the session, to decide if they should be responded to
+// automatically.
+//
+// A nil policy does not respond to anything automatically.
+type policy struct {
+	proposals ProposalPolicyConfig
+	payments  PaymentPolicyConfig
+}
+
+// newPolicy validates the policy configuration and initializes a policy. It
+// returns nil, if the configuration does not respond to anything automatically.
+func newPolicy(cfg PolicyConfig) (*policy, error) {
+	limits := append(append([]PolicyLimit{}, cfg.Proposals.MaxOwnBal...), cfg.Payments.MaxAmount...)
+	for i := range limits {
+		amount, err := decimal.NewFromString(limits[i].Amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "limit for currency %s", limits[i].Currency)
+		}
+		if amount.IsNegative() {
+			return nil, errors.Errorf("limit for currency %s should not be negative", limits[i].Currency)
+		}
+	}
+	if !cfg.Proposals.Accept && !cfg.Proposals.RejectOthers && !cfg.Payments.Accept && !cfg.Payments.RejectOthers {
+		return nil, nil
+	}
+	return &policy{
+		proposals: cfg.Proposals,
+		payments:  cfg.Payments,
+	}, nil
+}
+
+// proposalResponse returns the automatic response for the channel proposal
+// from the peers with given aliases. The currencies correspond to the assets
+// in the proposal.
+func (p *policy) proposalResponse(parts []string, currencies []perun.Currency,
+	chProposal *pclient.BaseChannelProposal,
+) perun.AutoResponse {
+	if p == nil || !pchannel.IsNoApp(chProposal.App) {
+		return perun.AutoResponseNone
+	}
+	ownIdx, ok := aliasIdx(parts, perun.OwnAlias)
+	if !ok {
+		return perun.AutoResponseNone
+	}
+	proposer := parts[pclient.ProposerIdx]
+	return decide(p.proposals.Accept, p.proposals.RejectOthers,
+		p.matchProposal(proposer, ownIdx, currencies, chProposal))
+}
+
+func (p *policy) matchProposal(proposer string, ownIdx pchannel.Index, currencies []perun.Currency,
+	chProposal *pclient.BaseChannelProposal,
+) bool {
+	cfg := p.proposals
+	if !containsFold(cfg.Peers, proposer) || chProposal.ChallengeDuration < cfg.MinChallengeDurSecs {
+		return false
+	}
+	for i := range currencies {
+		if !containsFold(cfg.Currencies, currencies[i].Symbol()) {
+			return false
+		}
+		limit, ok := findLimit(cfg.MaxOwnBal, proposer, currencies[i])
+		if !ok {
+			limit = big.NewInt(0)
+		}
+		if chProposal.InitBals.Balances[i][ownIdx].Cmp(limit) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// paymentResponse returns the automatic response for the channel update from
+// the participant at actorIdx. It is evaluated only for incoming payments and
+// the response is none for all other updates.
+func (p *policy) paymentResponse(parts []string, currencies []perun.Currency, ownIdx, actorIdx pchannel.Index,
+	currState, proposedState *pchannel.State,
+) perun.AutoResponse {
+	if p == nil || int(actorIdx) >= len(parts) {
+		return perun.AutoResponseNone
+	}
+	received, ok := incomingPayment(ownIdx, currState, proposedState)
+	if !ok {
+		return perun.AutoResponseNone
+	}
+	return decide(p.payments.Accept, p.payments.RejectOthers,
+		p.matchPayment(parts[actorIdx], currencies, received))
+}
+
+func (p *policy) matchPayment(peer string, currencies []perun.Currency, received []*big.Int) bool {
+	cfg := p.payments
+	if !containsFold(cfg.Peers, peer) {
+		return false
+	}
+	for i := range currencies {
+		if received[i].Sign() == 0 {
+			continue
+		}
+		if !containsFold(cfg.Currencies, currencies[i].Symbol()) {
+			return false
+		}
+		if limit, ok := findLimit(cfg.MaxAmount, peer, currencies[i]); ok && received[i].Cmp(limit) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// incomingPayment returns the amount received by the user in each currency,
+// if the update is an incoming payment. That is, an update on a payment
+// channel that does not finalize it, increases the balance of the user in at
+// least one currency and does not decrease it in any.
+func incomingPayment(ownIdx pchannel.Index, currState, proposedState *pchannel.State) ([]*big.Int, bool) {
+	if proposedState.IsFinal || !pchannel.IsNoApp(proposedState.App) ||
+		len(currState.Balances) != len(proposedState.Balances) {
+		return nil, false
+	}
+	received := make([]*big.Int, len(proposedState.Balances))
+	isIncoming := false
+	for i := range proposedState.Balances {
+		received[i] = new(big.Int).Sub(proposedState.Balances[i][ownIdx], currState.Balances[i][ownIdx])
+		switch received[i].Sign() {
+		case -1:
+			return nil, false
+		case 1:
+			isIncoming = true
+		}
+	}
+	return received, isIncoming
+}
+
+// decide returns the automatic response, depending upon if the request
+// matched the rules and the actions configured for either case.
+func decide(accept, rejectOthers, matched bool) perun.AutoResponse {
+	switch {
+	case matched && accept:
+		return perun.AutoResponseAccepted
+	case !matched && rejectOthers:
+		return perun.AutoResponseRejected
+	default:
+		return perun.AutoResponseNone
+	}
+}
+
+// findLimit returns the limit for the currency that applies to the peer. A
+// limit specific to the peer takes precedence over the one without a peer.
+func findLimit(limits []PolicyLimit, peer string, c perun.Currency) (*big.Int, bool) {
+	var found *PolicyLimit
+	for i := range limits {
+		if !strings.EqualFold(limits[i].Currency, c.Symbol()) {
+			continue
+		}
+		if strings.EqualFold(limits[i].Peer, peer) {
+			found = &limits[i]
+			break
+		}
+		if limits[i].Peer == "" {
+			found = &limits[i]
+		}
+	}
+	if found == nil {
+		return nil, false
+	}
+	return parseLimit(c, found.Amount), true
+}
+
+// parseLimit converts the limit into base unit of the currency. Amounts that
+// are smaller than the base unit are treated as zero. The amount should have
+// been validated when initializing the policy.
+func parseLimit(c perun.Currency, amount string) *big.Int {
+	limit, err := c.Parse(amount)
+	if err != nil {
+		return big.NewInt(0)
+	}
+	return limit
+}
+
+// aliasIdx returns the index of the alias in the list of aliases.
+func aliasIdx(aliases []string, alias string) (pchannel.Index, bool) {
+	for i := range aliases {
+		if aliases[i] == alias {
+			return pchannel.Index(i), true
+		}
+	}
+	return 0, false
+}
+
+// containsFold reports if the list contains the item, ignoring the case. An
+// empty list contains every item.
+func containsFold(list []string, item string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for i := range list {
+		if strings.EqualFold(list[i], item) {
+			return true
+		}
+	}
+	return false
+}
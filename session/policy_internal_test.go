@@ -0,0 +1,182 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pclient "perun.network/go-perun/client"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+)
+
+func eth(t *testing.T, amount string) *big.Int {
+	t.Helper()
+	if amount == "0" {
+		return big.NewInt(0)
+	}
+	value, err := currencytest.Registry().Currency(currency.ETHSymbol).Parse(amount)
+	require.NoError(t, err)
+	return value
+}
+
+func Test_NewPolicy(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		_, err := newPolicy(PolicyConfig{Payments: PaymentPolicyConfig{
+			MaxAmount: []PolicyLimit{{Currency: currency.ETHSymbol, Amount: "0.5"}},
+		}})
+		require.NoError(t, err)
+	})
+	t.Run("happy_no_rules", func(t *testing.T) {
+		pol, err := newPolicy(PolicyConfig{})
+		require.NoError(t, err)
+		assert.Nil(t, pol)
+	})
+	t.Run("err_invalid_amount", func(t *testing.T) {
+		_, err := newPolicy(PolicyConfig{Proposals: ProposalPolicyConfig{
+			MaxOwnBal: []PolicyLimit{{Currency: currency.ETHSymbol, Amount: "abc"}},
+		}})
+		require.Error(t, err)
+	})
+	t.Run("err_negative_amount", func(t *testing.T) {
+		_, err := newPolicy(PolicyConfig{Payments: PaymentPolicyConfig{
+			MaxAmount: []PolicyLimit{{Currency: currency.ETHSymbol, Amount: "-1"}},
+		}})
+		require.Error(t, err)
+	})
+}
+
+func Test_Policy_ProposalResponse(t *testing.T) {
+	currencies := []perun.Currency{currencytest.Registry().Currency(currency.ETHSymbol)}
+	parts := []string{"bob", perun.OwnAlias}
+	proposal := func(ownBal string, challengeDurSecs uint64) *pclient.BaseChannelProposal {
+		return &pclient.BaseChannelProposal{
+			ChallengeDuration: challengeDurSecs,
+			App:               pchannel.NoApp(),
+			InitBals: &pchannel.Allocation{
+				Balances: pchannel.Balances{{eth(t, "1"), eth(t, ownBal)}},
+			},
+		}
+	}
+	cfg := PolicyConfig{Proposals: ProposalPolicyConfig{
+		Accept:              true,
+		Peers:               []string{"Bob"},
+		Currencies:          []string{"eth"},
+		MinChallengeDurSecs: 10,
+	}}
+	p, err := newPolicy(cfg)
+	require.NoError(t, err)
+
+	t.Run("accepted", func(t *testing.T) {
+		got := p.proposalResponse(parts, currencies, proposal("0", 10))
+		assert.Equal(t, perun.AutoResponseAccepted, got)
+	})
+	t.Run("unmatched_own_bal", func(t *testing.T) {
+		got := p.proposalResponse(parts, currencies, proposal("1", 10))
+		assert.Equal(t, perun.AutoResponseNone, got)
+	})
+	t.Run("unmatched_challenge_dur", func(t *testing.T) {
+		got := p.proposalResponse(parts, currencies, proposal("0", 5))
+		assert.Equal(t, perun.AutoResponseNone, got)
+	})
+	t.Run("unmatched_peer", func(t *testing.T) {
+		got := p.proposalResponse([]string{"alice", perun.OwnAlias}, currencies, proposal("0", 10))
+		assert.Equal(t, perun.AutoResponseNone, got)
+	})
+	t.Run("app_channel", func(t *testing.T) {
+		chProposal := proposal("0", 10)
+		chProposal.App = nil
+		got := p.proposalResponse(parts, currencies, chProposal)
+		assert.Equal(t, perun.AutoResponseNone, got)
+	})
+	t.Run("accepted_within_limit", func(t *testing.T) {
+		limitCfg := cfg
+		limitCfg.Proposals.MaxOwnBal = []PolicyLimit{{Currency: currency.ETHSymbol, Amount: "2"}}
+		pLimit, err := newPolicy(limitCfg)
+		require.NoError(t, err)
+		got := pLimit.proposalResponse(parts, currencies, proposal("1", 10))
+		assert.Equal(t, perun.AutoResponseAccepted, got)
+	})
+	t.Run("rejected_others", func(t *testing.T) {
+		rejectCfg := cfg
+		rejectCfg.Proposals.RejectOthers = true
+		pReject, err := newPolicy(rejectCfg)
+		require.NoError(t, err)
+		got := pReject.proposalResponse(parts, currencies, proposal("1", 10))
+		assert.Equal(t, perun.AutoResponseRejected, got)
+	})
+	t.Run("nil_policy", func(t *testing.T) {
+		var pNil *policy
+		got := pNil.proposalResponse(parts, currencies, proposal("0", 10))
+		assert.Equal(t, perun.AutoResponseNone, got)
+	})
+}
+
+func Test_Policy_PaymentResponse(t *testing.T) {
+	currencies := []perun.Currency{currencytest.Registry().Currency(currency.ETHSymbol)}
+	parts := []string{perun.OwnAlias, "bob"}
+	ownIdx, peerIdx := pchannel.Index(0), pchannel.Index(1)
+	state := func(ownBal, peerBal string) *pchannel.State {
+		return &pchannel.State{
+			App: pchannel.NoApp(),
+			Allocation: pchannel.Allocation{
+				Balances: pchannel.Balances{{eth(t, ownBal), eth(t, peerBal)}},
+			},
+		}
+	}
+	currState := state("1", "10")
+	p, err := newPolicy(PolicyConfig{Payments: PaymentPolicyConfig{
+		Accept:       true,
+		RejectOthers: true,
+		Peers:        []string{"bob"},
+		MaxAmount: []PolicyLimit{
+			{Currency: currency.ETHSymbol, Amount: "1"},
+			{Peer: "bob", Currency: currency.ETHSymbol, Amount: "3"},
+		},
+	}})
+	require.NoError(t, err)
+
+	t.Run("accepted_within_peer_limit", func(t *testing.T) {
+		got := p.paymentResponse(parts, currencies, ownIdx, peerIdx, currState, state("3", "8"))
+		assert.Equal(t, perun.AutoResponseAccepted, got)
+	})
+	t.Run("rejected_above_peer_limit", func(t *testing.T) {
+		got := p.paymentResponse(parts, currencies, ownIdx, peerIdx, currState, state("5", "6"))
+		assert.Equal(t, perun.AutoResponseRejected, got)
+	})
+	t.Run("rejected_unknown_peer", func(t *testing.T) {
+		got := p.paymentResponse([]string{perun.OwnAlias, "alice"}, currencies, ownIdx, peerIdx,
+			currState, state("2", "9"))
+		assert.Equal(t, perun.AutoResponseRejected, got)
+	})
+	t.Run("outgoing_payment", func(t *testing.T) {
+		got := p.paymentResponse(parts, currencies, ownIdx, peerIdx, currState, state("0.5", "10.5"))
+		assert.Equal(t, perun.AutoResponseNone, got)
+	})
+	t.Run("final_update", func(t *testing.T) {
+		proposedState := state("2", "9")
+		proposedState.IsFinal = true
+		got := p.paymentResponse(parts, currencies, ownIdx, peerIdx, currState, proposedState)
+		assert.Equal(t, perun.AutoResponseNone, got)
+	})
+}
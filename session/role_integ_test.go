@@ -33,6 +33,7 @@ import (
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/memory"
 	"github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/session"
 	"github.com/hyperledger-labs/perun-node/session/sessiontest"
@@ -53,6 +54,12 @@ func Test_Integ_Role(t *testing.T) {
 	aliceCfg := sessiontest.NewConfigT(t, prng)
 	bobCfg := sessiontest.NewConfigT(t, prng)
 
+	// Connect the sessions through an in-memory hub, so that the test does not use the network for off-chain comm.
+	session.SetMemoryCommHub(memory.NewHub())
+	t.Cleanup(func() { session.SetMemoryCommHub(nil) })
+	aliceCfg.User.CommType = "memory"
+	bobCfg.User.CommType = "memory"
+
 	alice, err := session.New(aliceCfg, currencies, contracts)
 	require.NoErrorf(t, err, "initializing alice session")
 	t.Logf("alice session id: %s\n", alice.ID())
@@ -295,6 +302,22 @@ func Test_Integ_Role(t *testing.T) {
 	})
 	require.True(t, passed)
 
+	passed = t.Run("GetHistory", func(t *testing.T) {
+		// Only the update sent by bob was accepted.
+		aliceHistory, apiErr := aliceChs[0].GetHistory(0, 0)
+		require.NoError(t, apiErr, "alice getting channel history")
+		require.Len(t, aliceHistory, 1)
+		assert.Equal(t, perun.PaymentDirectionIncoming, aliceHistory[0].Direction)
+		assert.Equal(t, []string{"0.5"}, aliceHistory[0].Deltas)
+
+		bobHistory, apiErr := bobChs[0].GetHistory(0, 0)
+		require.NoError(t, apiErr, "bob getting channel history")
+		require.Len(t, bobHistory, 1)
+		assert.Equal(t, perun.PaymentDirectionOutgoing, bobHistory[0].Direction)
+		assert.Equal(t, []string{"-0.5"}, bobHistory[0].Deltas)
+	})
+	require.True(t, passed)
+
 	passed = t.Run("Session_Close_NoForce_Error", func(t *testing.T) {
 		var openChsInfo []perun.ChInfo
 		openChsInfo, err = alice.Close(false)
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment/routing"
+)
+
+// newRouter initializes a router for the session, with the links in the
+// config added to its graph.
+func newRouter(s *Session, cfg RoutingConfig) (*routing.Router, perun.APIError) {
+	graph := routing.NewGraph()
+	for i := range cfg.Links {
+		if err := graph.Add(cfg.Links[i]); err != nil {
+			return nil, perun.NewAPIErrInvalidConfig(err, "routing.links", fmt.Sprintf("%+v", cfg.Links[i]))
+		}
+	}
+	return routing.New(s, graph, routing.Config{
+		HopTimeout:    cfg.HopTimeout,
+		Fee:           cfg.Fee,
+		InvoiceExpiry: cfg.InvoiceExpiry,
+	})
+}
+
+// Pay pays the amount to the peer corresponding to the given alias, through
+// a path of channels using the htlc app. The payer pays the fee for each
+// intermediary in addition to the amount.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when the session is closed or routing is not enabled for the session.
+// or any of the errors returned by the routing.Router.Pay API.
+func (s *Session) Pay(pctx context.Context, targetAlias, currency, amount string) (
+	perun.RoutedPayment, perun.APIError,
+) {
+	s.WithField("method", "Pay").Infof("\nReceived request with params %+v,%+v,%+v", targetAlias, currency, amount)
+	s.Lock()
+	var apiErr perun.APIError
+	switch {
+	case !s.isOpen:
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+	case s.router == nil:
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrNoRouting)
+	}
+	s.Unlock()
+	if apiErr != nil {
+		s.WithFields(perun.APIErrAsMap("Pay", apiErr)).Error(apiErr.Message())
+		return perun.RoutedPayment{}, apiErr
+	}
+
+	// Session lock is not held when paying, as the router uses the session
+	// APIs and the payment can take up to the hop timeout for each hop.
+	return s.router.Pay(pctx, targetAlias, currency, amount)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+)
+
+func Test_Session_Pay(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openSession, _, _ := newSessionWMockChClient(t, true, peerIDs[0])
+	closedSession, _, _ := newSessionWMockChClient(t, false, peerIDs[0])
+
+	t.Run("routing_not_enabled", func(t *testing.T) {
+		_, err := openSession.Pay(context.Background(), peerIDs[0].Alias, currency.ETHSymbol, "1")
+		require.Error(t, err)
+
+		wantMessage := perun.ErrNoRouting.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+		assert.Nil(t, err.AddInfo())
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSession.Pay(context.Background(), peerIDs[0].Alias, currency.ETHSymbol, "1")
+		require.Error(t, err)
+
+		wantMessage := perun.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+		assert.Nil(t, err.AddInfo())
+	})
+}
@@ -0,0 +1,647 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"polycry.pt/poly-go/sortedkv"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// scheduleTablePrefix is the prefix for the keys of payment schedules in the database.
+	scheduleTablePrefix = "PaymentSchedule:"
+
+	// inFlightTablePrefix is the prefix for the keys of in-flight markers in
+	// the database. A marker is stored, with the schedule ID as key and the
+	// channel version as value, before sending a scheduled payment and is
+	// deleted once its result is recorded.
+	inFlightTablePrefix = "InFlightPayment:"
+
+	// scheduleDirName is the name of the directory within the database
+	// directory, where the payment schedules of a session are persisted.
+	scheduleDirName = "schedules"
+
+	// scheduleRetryInterval is the duration to wait before retrying a
+	// scheduled payment that timed out waiting for the peer's response.
+	scheduleRetryInterval = 30 * time.Second
+
+	// scheduleMaxRetries is the maximum number of times a scheduled payment
+	// is retried, before the schedule is marked as failed.
+	scheduleMaxRetries = 5
+
+	// scheduleIDLen is the length of the random schedule IDs in bytes.
+	scheduleIDLen = 8
+)
+
+// scheduleStore persists the payment schedules of a session and the markers
+// for their in-flight payments, with the schedule ID as key. The methods on it
+// are safe for concurrent use.
+type scheduleStore struct {
+	db       sortedkv.Database
+	inFlight sortedkv.Database
+}
+
+// newScheduleStore initializes a schedule store using the given database.
+func newScheduleStore(db sortedkv.Database) *scheduleStore {
+	return &scheduleStore{
+		db:       sortedkv.NewTable(db, scheduleTablePrefix),
+		inFlight: sortedkv.NewTable(db, inFlightTablePrefix),
+	}
+}
+
+// put persists the schedule, overwriting any existing schedule with the same ID.
+func (st *scheduleStore) put(sch perun.PaymentSchedule) error {
+	value, err := json.Marshal(sch)
+	if err != nil {
+		return errors.Wrap(err, "encoding payment schedule")
+	}
+	return errors.Wrap(st.db.PutBytes(sch.ID, value), "writing payment schedule")
+}
+
+// get retrieves the schedule with the given ID. The boolean is false, if no
+// schedule is found.
+func (st *scheduleStore) get(id string) (perun.PaymentSchedule, bool, error) {
+	has, err := st.db.Has(id)
+	if err != nil || !has {
+		return perun.PaymentSchedule{}, false, errors.Wrap(err, "reading payment schedule")
+	}
+	value, err := st.db.GetBytes(id)
+	if err != nil {
+		return perun.PaymentSchedule{}, false, errors.Wrap(err, "reading payment schedule")
+	}
+	var sch perun.PaymentSchedule
+	if err = json.Unmarshal(value, &sch); err != nil {
+		return perun.PaymentSchedule{}, false, errors.Wrapf(err, "decoding payment schedule %s", id)
+	}
+	return sch, true, nil
+}
+
+// list retrieves all the schedules, in the order of their IDs.
+func (st *scheduleStore) list() ([]perun.PaymentSchedule, error) {
+	it := st.db.NewIterator()
+	defer it.Close() //nolint:errcheck
+
+	schedules := []perun.PaymentSchedule{}
+	for it.Next() {
+		var sch perun.PaymentSchedule
+		if err := json.Unmarshal(it.ValueBytes(), &sch); err != nil {
+			return nil, errors.Wrapf(err, "decoding payment schedule %s", it.Key())
+		}
+		schedules = append(schedules, sch)
+	}
+	return schedules, errors.Wrap(it.Close(), "reading payment schedules")
+}
+
+// putInFlight persists a marker for an in-flight payment of the schedule,
+// along with the version of the channel before the payment.
+func (st *scheduleStore) putInFlight(id, version string) error {
+	return errors.Wrap(st.inFlight.Put(id, version), "writing in-flight payment marker")
+}
+
+// getInFlight retrieves the channel version in the marker for the in-flight
+// payment of the schedule. The boolean is false, if no marker is found.
+func (st *scheduleStore) getInFlight(id string) (string, bool, error) {
+	has, err := st.inFlight.Has(id)
+	if err != nil || !has {
+		return "", false, errors.Wrap(err, "reading in-flight payment marker")
+	}
+	version, err := st.inFlight.Get(id)
+	if err != nil {
+		return "", false, errors.Wrap(err, "reading in-flight payment marker")
+	}
+	return version, true, nil
+}
+
+// deleteInFlight deletes the marker for the in-flight payment of the
+// schedule, if there is one.
+func (st *scheduleStore) deleteInFlight(id string) error {
+	has, err := st.inFlight.Has(id)
+	if err != nil || !has {
+		return errors.Wrap(err, "reading in-flight payment marker")
+	}
+	return errors.Wrap(st.inFlight.Delete(id), "deleting in-flight payment marker")
+}
+
+// close closes the underlying database.
+func (st *scheduleStore) close() error {
+	return errors.Wrap(st.db.Close(), "closing schedule database")
+}
+
+// scheduler makes the payments for the active payment schedules in a
+// session, each in its own go-routine. The methods on it are safe for
+// concurrent use.
+type scheduler struct {
+	log.Logger
+
+	getCh         func(chID string) (perun.ChAPI, perun.APIError)
+	retryInterval time.Duration
+	maxRetries    int
+
+	mtx     sync.Mutex
+	store   *scheduleStore
+	cancels map[string]context.CancelFunc // Cancel funcs of running schedules, by schedule ID.
+	closed  bool
+}
+
+// newScheduler initializes a scheduler that persists the schedules in the
+// given database and retrieves the channels for making payments using getCh.
+// Schedules are not run until start is called.
+func newScheduler(db sortedkv.Database, getCh func(string) (perun.ChAPI, perun.APIError),
+	logger log.Logger,
+) *scheduler {
+	return &scheduler{
+		Logger:        logger,
+		getCh:         getCh,
+		retryInterval: scheduleRetryInterval,
+		maxRetries:    scheduleMaxRetries,
+		store:         newScheduleStore(db),
+		cancels:       make(map[string]context.CancelFunc),
+	}
+}
+
+// start runs all the active schedules persisted in the store.
+//
+// A schedule with an in-flight marker was paying when the node stopped. If
+// the channel version has changed since the marker was stored, the payment
+// is counted as made and is not repeated. This could skip a payment, if the
+// version was changed by another update, but never pays twice.
+func (sc *scheduler) start() error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	schedules, err := sc.store.list()
+	if err != nil {
+		return err
+	}
+	for i := range schedules {
+		if schedules[i].Status != perun.PaymentScheduleActive {
+			continue
+		}
+		sch, resolveErr := sc.resolveInFlightLocked(schedules[i])
+		if resolveErr != nil {
+			return resolveErr
+		}
+		sc.runLocked(sch)
+	}
+	return nil
+}
+
+// resolveInFlightLocked resolves the in-flight marker of the schedule, if
+// there is one, and returns the updated schedule. Caller must hold the mutex.
+func (sc *scheduler) resolveInFlightLocked(sch perun.PaymentSchedule) (perun.PaymentSchedule, error) {
+	version, found, err := sc.store.getInFlight(sch.ID)
+	if err != nil || !found {
+		return sch, err
+	}
+	ch, apiErr := sc.getCh(sch.ChID)
+	if apiErr == nil && ch.GetChInfo().Version != version {
+		sc.WithField("schedule-id", sch.ID).Warn("Counting interrupted scheduled payment as made,",
+			"channel version changed from", version)
+		now := time.Now()
+		sch.Payments++
+		sch.LastPaidAt = now.Unix()
+		sch.NextAt = nextPaymentTime(sch, now)
+		if err = sc.store.put(sch); err != nil {
+			return perun.PaymentSchedule{}, err
+		}
+	}
+	return sch, sc.store.deleteInFlight(sch.ID)
+}
+
+// add persists the schedule and starts running it.
+func (sc *scheduler) add(sch perun.PaymentSchedule) error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if sc.closed {
+		return errors.New("scheduler closed")
+	}
+	if err := sc.store.put(sch); err != nil {
+		return err
+	}
+	sc.runLocked(sch)
+	return nil
+}
+
+// list returns all the schedules, including the ones that are cancelled or
+// have failed.
+func (sc *scheduler) list() ([]perun.PaymentSchedule, error) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	return sc.store.list()
+}
+
+// cancel marks the schedule as cancelled and stops running it. Cancelling a
+// schedule that is not active does not change it. The boolean is false, if
+// no schedule is found.
+func (sc *scheduler) cancel(id string) (perun.PaymentSchedule, bool, error) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	sch, found, err := sc.store.get(id)
+	if err != nil || !found {
+		return perun.PaymentSchedule{}, found, err
+	}
+	if sch.Status == perun.PaymentScheduleActive {
+		sch.Status = perun.PaymentScheduleCancelled
+		if err = sc.store.put(sch); err != nil {
+			return perun.PaymentSchedule{}, true, err
+		}
+	}
+	if cancel, ok := sc.cancels[id]; ok {
+		cancel()
+		delete(sc.cancels, id)
+	}
+	return sch, true, nil
+}
+
+// close stops running all the schedules and closes the store.
+//
+// It does not wait for the go-routines to return, as they could be waiting on
+// the session lock held by the caller. Any go-routine that returns after close
+// does not update the store.
+func (sc *scheduler) close() error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	sc.closed = true
+	for id, cancel := range sc.cancels {
+		cancel()
+		delete(sc.cancels, id)
+	}
+	return sc.store.close()
+}
+
+// runLocked starts a go-routine to run the schedule. Caller must hold the mutex.
+func (sc *scheduler) runLocked(sch perun.PaymentSchedule) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sc.cancels[sch.ID] = cancel
+	go sc.run(ctx, sch)
+}
+
+// run makes the payments for the schedule at the scheduled times, until the
+// schedule is cancelled or a payment fails.
+func (sc *scheduler) run(ctx context.Context, sch perun.PaymentSchedule) {
+	for {
+		timer := time.NewTimer(time.Until(time.Unix(sch.NextAt, 0)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		var active bool
+		sch, active = sc.record(sch.ID, sc.pay(ctx, sch))
+		if !active {
+			return
+		}
+	}
+}
+
+// pay makes one payment for the schedule. If the peer does not respond in
+// time, the payment is retried upto maxRetries times. Other errors are not
+// retried, as the update could have been applied even though an error was
+// reported.
+//
+// Before sending the payment, an in-flight marker is persisted, so that the
+// payment is not repeated if the node stops before the result is recorded.
+// Before each retry, the payment is counted as made and is not sent again, if
+// the channel version has changed since the marker was stored.
+func (sc *scheduler) pay(ctx context.Context, sch perun.PaymentSchedule) perun.APIError {
+	ch, apiErr := sc.getCh(sch.ChID)
+	if apiErr != nil {
+		return apiErr
+	}
+	version := ch.GetChInfo().Version
+	if err := sc.putInFlight(sch.ID, version); err != nil {
+		return perun.NewAPIErrUnknownInternal(err)
+	}
+	payments := []payment.Payment{{
+		Currency: sch.Currency,
+		Payer:    perun.OwnAlias,
+		Payee:    sch.Payee,
+		Amount:   sch.Amount,
+	}}
+	for attempt := 0; ; attempt++ {
+		_, apiErr = payment.SendPayChUpdate(ctx, ch, payments)
+		if apiErr == nil || apiErr.Code() != perun.ErrPeerRequestTimedOut || attempt >= sc.maxRetries {
+			return apiErr
+		}
+		sc.WithField("schedule-id", sch.ID).Warn("Retrying scheduled payment:", apiErr.Message())
+		select {
+		case <-ctx.Done():
+			return apiErr
+		case <-time.After(sc.retryInterval):
+		}
+		if current := ch.GetChInfo().Version; current != version {
+			sc.WithField("schedule-id", sch.ID).Warn("Not retrying scheduled payment, counting it as made,",
+				"channel version changed from", version, "to", current)
+			return nil
+		}
+	}
+}
+
+// putInFlight persists the in-flight marker for the schedule, unless the
+// scheduler is closed.
+func (sc *scheduler) putInFlight(id, version string) error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if sc.closed {
+		return errors.New("scheduler closed")
+	}
+	return sc.store.putInFlight(id, version)
+}
+
+// record updates the stored schedule with the result of a payment, deletes
+// its in-flight marker and returns the updated schedule. The boolean is
+// false, if the schedule is no longer active or the scheduler is closed.
+func (sc *scheduler) record(id string, payErr perun.APIError) (perun.PaymentSchedule, bool) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if sc.closed {
+		return perun.PaymentSchedule{}, false
+	}
+	if err := sc.store.deleteInFlight(id); err != nil {
+		sc.WithField("schedule-id", id).Error("Deleting in-flight payment marker:", err)
+	}
+	sch, found, err := sc.store.get(id)
+	if err != nil || !found {
+		sc.WithField("schedule-id", id).Error("Reading payment schedule:", err)
+		return perun.PaymentSchedule{}, false
+	}
+	if payErr == nil {
+		now := time.Now()
+		sch.Payments++
+		sch.LastPaidAt = now.Unix()
+		sch.NextAt = nextPaymentTime(sch, now)
+	} else if sch.Status == perun.PaymentScheduleActive {
+		sc.WithField("schedule-id", id).Error("Scheduled payment failed:", payErr.Message())
+		sch.Status = perun.PaymentScheduleFailed
+		sch.LastError = payErr.Message()
+	}
+	if err = sc.store.put(sch); err != nil {
+		sc.WithField("schedule-id", id).Error("Updating payment schedule:", err)
+	}
+	if sch.Status != perun.PaymentScheduleActive {
+		delete(sc.cancels, id)
+		return sch, false
+	}
+	return sch, true
+}
+
+// nextPaymentTime returns the time of the payment following the one
+// scheduled at sch.NextAt. If that time has already passed, for example when
+// the node was not running, the missed payments are skipped and the
+// next payment is scheduled one interval after now. For monthly schedules, see
+// nextMonthlyPaymentTime.
+func nextPaymentTime(sch perun.PaymentSchedule, now time.Time) int64 {
+	if sch.Unit == perun.ScheduleUnitMonth {
+		return nextMonthlyPaymentTime(sch, now)
+	}
+	next := addInterval(time.Unix(sch.NextAt, 0), sch.Every, sch.Unit)
+	if next.Before(now) {
+		next = addInterval(now, sch.Every, sch.Unit)
+	}
+	return next.Unix()
+}
+
+// nextMonthlyPaymentTime returns the time of the payment following the one
+// scheduled at sch.NextAt, for a monthly schedule. The due dates are computed
+// from the time of the first payment, so that they do not drift when a month
+// is shorter than the day of the first payment. Missed payments are skipped
+// and the next payment is scheduled on the first due date after now.
+func nextMonthlyPaymentTime(sch perun.PaymentSchedule, now time.Time) int64 {
+	start := sch.StartAt
+	if start == 0 {
+		start = sch.NextAt // Schedules persisted before the start time was recorded.
+	}
+	anchor := time.Unix(start, 0).UTC()
+	current := time.Unix(sch.NextAt, 0)
+	for months := int(sch.Every); ; months += int(sch.Every) {
+		next := addMonths(anchor, months)
+		if next.After(current) && !next.Before(now) {
+			return next.Unix()
+		}
+	}
+}
+
+// addInterval adds "every" units to the given time. Days, weeks and months
+// are added in calendar terms.
+func addInterval(t time.Time, every uint64, unit perun.ScheduleUnit) time.Time {
+	n := int(every)
+	switch unit {
+	case perun.ScheduleUnitMinute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case perun.ScheduleUnitHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case perun.ScheduleUnitDay:
+		return t.AddDate(0, 0, n)
+	case perun.ScheduleUnitWeek:
+		return t.AddDate(0, 0, 7*n)
+	default:
+		return addMonths(t, n)
+	}
+}
+
+// addMonths adds n months to the given time. If the day of the month does
+// not exist in the resulting month, the last day of that month is used.
+func addMonths(t time.Time, n int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	// Day zero of the following month normalizes to the last day of this month.
+	lastDay := time.Date(year, month+time.Month(n)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month+time.Month(n), day, hour, minute, sec, t.Nanosecond(), t.Location())
+}
+
+// randomScheduleID returns a random hex encoded schedule ID.
+func randomScheduleID() (string, error) {
+	id := make([]byte, scheduleIDLen)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrap(err, "generating schedule ID")
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// CreatePaymentSchedule creates a schedule for making recurring payments from
+// the user to the payee on the channel and starts running it. The schedule
+// is persisted and is resumed when the session is opened again. The first
+// payment is made at sch.NextAt, or immediately if it is zero.
+//
+// A payment that times out waiting for the peer's response is retried a few
+// times, unless the channel has been updated in the meanwhile; any other
+// error, such as the peer not being reachable or rejecting the payment, stops
+// the schedule and marks it as failed. If the node stops while a payment is in
+// progress, the payment is not repeated when the schedule is resumed, if the
+// channel has been updated in the meanwhile.
+//
+// The payments of monthly schedules are due on the day of the month of the
+// first payment (in UTC), or on the last day of the month if it is shorter.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound when the channel ID or the currency is not known.
+// - ErrInvalidArgument with Name:"payee" when the payee is not a peer in the channel.
+// - ErrInvalidArgument with Name:"amount" when the amount is invalid.
+// - ErrInvalidArgument with Name:"interval" when the interval is invalid.
+// - ErrUnknownInternal.
+func (s *Session) CreatePaymentSchedule(sch perun.PaymentSchedule) (perun.PaymentSchedule, perun.APIError) {
+	s.WithField("method", "CreatePaymentSchedule").Info("Received request with params:", sch)
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.isOpen {
+		apiErr := perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		s.WithFields(perun.APIErrAsMap("CreatePaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+	ch := s.chs.get(sch.ChID)
+	if ch == nil {
+		apiErr := perun.NewAPIErrResourceNotFound(perun.ResTypeChannel, sch.ChID)
+		s.WithFields(perun.APIErrAsMap("CreatePaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+	if apiErr := validatePaymentSchedule(ch, sch); apiErr != nil {
+		s.WithFields(perun.APIErrAsMap("CreatePaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+
+	id, err := randomScheduleID()
+	if err != nil {
+		apiErr := perun.NewAPIErrUnknownInternal(err)
+		s.WithFields(perun.APIErrAsMap("CreatePaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+	sch.ID = id
+	if sch.NextAt == 0 {
+		sch.NextAt = time.Now().Unix()
+	}
+	sch.StartAt = sch.NextAt
+	sch.Payments, sch.LastPaidAt = 0, 0
+	sch.Status, sch.LastError = perun.PaymentScheduleActive, ""
+
+	if err = s.scheduler.add(sch); err != nil {
+		apiErr := perun.NewAPIErrUnknownInternal(err)
+		s.WithFields(perun.APIErrAsMap("CreatePaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+	s.WithField("method", "CreatePaymentSchedule").Info("Payment schedule created:", sch.ID)
+	return sch, nil
+}
+
+// validatePaymentSchedule checks if the payments in the schedule can be made
+// on the channel.
+func validatePaymentSchedule(ch *Channel, sch perun.PaymentSchedule) perun.APIError {
+	_, currency, found := ch.Currency(sch.Currency)
+	if !found {
+		return perun.NewAPIErrResourceNotFound(perun.ResTypeCurrency, sch.Currency)
+	}
+	isPart := false
+	for _, part := range ch.Parts() {
+		isPart = isPart || part == sch.Payee
+	}
+	if !isPart || sch.Payee == perun.OwnAlias {
+		return perun.NewAPIErrInvalidArgument(payment.ErrInvalidPayee, perun.ArgNamePayee, sch.Payee)
+	}
+	if _, err := currency.Parse(sch.Amount); err != nil {
+		err = errors.WithMessage(err, payment.ErrInvalidAmount.Error())
+		return perun.NewAPIErrInvalidArgument(err, perun.ArgNameAmount, sch.Amount)
+	}
+	if sch.Every == 0 || sch.Unit > perun.ScheduleUnitMonth {
+		err := errors.New("interval must be a positive number of minutes, hours, days, weeks or months")
+		return perun.NewAPIErrInvalidArgument(err, perun.ArgNameInterval, fmt.Sprintf("%d %d", sch.Every, sch.Unit))
+	}
+	return nil
+}
+
+// ListPaymentSchedules returns all the payment schedules in the session,
+// including the ones that are cancelled or have failed, in the order of their
+// IDs.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrUnknownInternal.
+func (s *Session) ListPaymentSchedules() ([]perun.PaymentSchedule, perun.APIError) {
+	s.WithField("method", "ListPaymentSchedules").Info("Received request")
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.isOpen {
+		apiErr := perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		s.WithFields(perun.APIErrAsMap("ListPaymentSchedules", apiErr)).Error(apiErr.Message())
+		return nil, apiErr
+	}
+	schedules, err := s.scheduler.list()
+	if err != nil {
+		apiErr := perun.NewAPIErrUnknownInternal(err)
+		s.WithFields(perun.APIErrAsMap("ListPaymentSchedules", apiErr)).Error(apiErr.Message())
+		return nil, apiErr
+	}
+	s.WithField("method", "ListPaymentSchedules").Info("Payment schedules retrieved successfully")
+	return schedules, nil
+}
+
+// CancelPaymentSchedule cancels the payment schedule and returns it. A
+// payment that is in progress is not aborted. Cancelling a schedule that is
+// not active does not change it.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound when the schedule ID is not known.
+// - ErrUnknownInternal.
+func (s *Session) CancelPaymentSchedule(scheduleID string) (perun.PaymentSchedule, perun.APIError) {
+	s.WithField("method", "CancelPaymentSchedule").Info("Received request with params:", scheduleID)
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.isOpen {
+		apiErr := perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		s.WithFields(perun.APIErrAsMap("CancelPaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+	sch, found, err := s.scheduler.cancel(scheduleID)
+	if err != nil {
+		apiErr := perun.NewAPIErrUnknownInternal(err)
+		s.WithFields(perun.APIErrAsMap("CancelPaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+	if !found {
+		apiErr := perun.NewAPIErrResourceNotFound(perun.ResTypeSchedule, scheduleID)
+		s.WithFields(perun.APIErrAsMap("CancelPaymentSchedule", apiErr)).Error(apiErr.Message())
+		return perun.PaymentSchedule{}, apiErr
+	}
+	s.WithField("method", "CancelPaymentSchedule").Info("Payment schedule cancelled:", scheduleID)
+	return sch, nil
+}
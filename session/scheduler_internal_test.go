@@ -0,0 +1,330 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pmemorydb "polycry.pt/poly-go/sortedkv/memorydb"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+func Test_ScheduleStore(t *testing.T) {
+	st := newScheduleStore(pmemorydb.NewDatabase())
+	for _, id := range []string{"b", "a", "c"} {
+		require.NoError(t, st.put(perun.PaymentSchedule{ID: id, Amount: "1"}))
+	}
+	require.NoError(t, st.put(perun.PaymentSchedule{ID: "a", Amount: "2"}))
+
+	sch, found, err := st.get("a")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "2", sch.Amount)
+
+	_, found, err = st.get("d")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	schedules, err := st.list()
+	require.NoError(t, err)
+	require.Len(t, schedules, 3)
+	for i, id := range []string{"a", "b", "c"} {
+		assert.Equal(t, id, schedules[i].ID)
+	}
+
+	t.Run("in_flight", func(t *testing.T) {
+		require.NoError(t, st.putInFlight("a", "3"))
+		version, found, err := st.getInFlight("a")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, "3", version)
+
+		// Markers are not listed as schedules.
+		schedules, err := st.list()
+		require.NoError(t, err)
+		assert.Len(t, schedules, 3)
+
+		require.NoError(t, st.deleteInFlight("a"))
+		_, found, err = st.getInFlight("a")
+		require.NoError(t, err)
+		assert.False(t, found)
+		require.NoError(t, st.deleteInFlight("a"))
+	})
+	require.NoError(t, st.close())
+}
+
+func Test_NextPaymentTime(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		every uint64
+		unit  perun.ScheduleUnit
+		want  time.Time
+	}{
+		{30, perun.ScheduleUnitMinute, start.Add(30 * time.Minute)},
+		{2, perun.ScheduleUnitHour, start.Add(2 * time.Hour)},
+		{1, perun.ScheduleUnitDay, time.Date(2026, time.February, 1, 10, 0, 0, 0, time.UTC)},
+		{2, perun.ScheduleUnitWeek, time.Date(2026, time.February, 14, 10, 0, 0, 0, time.UTC)},
+		// February 31 is clamped to the last day of February.
+		{1, perun.ScheduleUnitMonth, time.Date(2026, time.February, 28, 10, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		sch := perun.PaymentSchedule{NextAt: start.Unix(), Every: tc.every, Unit: tc.unit}
+		assert.Equal(t, tc.want.Unix(), nextPaymentTime(sch, start), "unit %d", tc.unit)
+	}
+
+	t.Run("monthly_anchored_to_start", func(t *testing.T) {
+		sch := perun.PaymentSchedule{StartAt: start.Unix(), NextAt: start.Unix(), Every: 1, Unit: perun.ScheduleUnitMonth}
+		for _, want := range []time.Time{
+			time.Date(2026, time.February, 28, 10, 0, 0, 0, time.UTC),
+			time.Date(2026, time.March, 31, 10, 0, 0, 0, time.UTC),
+			time.Date(2026, time.April, 30, 10, 0, 0, 0, time.UTC),
+			time.Date(2026, time.May, 31, 10, 0, 0, 0, time.UTC),
+		} {
+			sch.NextAt = nextPaymentTime(sch, time.Unix(sch.NextAt, 0))
+			assert.Equal(t, want.Unix(), sch.NextAt)
+		}
+	})
+
+	t.Run("monthly_missed_payments_skipped", func(t *testing.T) {
+		now := time.Date(2026, time.April, 15, 0, 0, 0, 0, time.UTC)
+		sch := perun.PaymentSchedule{StartAt: start.Unix(), NextAt: start.Unix(), Every: 1, Unit: perun.ScheduleUnitMonth}
+		assert.Equal(t, time.Date(2026, time.April, 30, 10, 0, 0, 0, time.UTC).Unix(), nextPaymentTime(sch, now))
+	})
+
+	t.Run("missed_payments_skipped", func(t *testing.T) {
+		now := start.Add(5 * time.Hour)
+		sch := perun.PaymentSchedule{NextAt: start.Unix(), Every: 1, Unit: perun.ScheduleUnitHour}
+		assert.Equal(t, now.Add(time.Hour).Unix(), nextPaymentTime(sch, now))
+	})
+}
+
+// versionedCh is a channel that only returns the given version in its info.
+type versionedCh struct {
+	perun.ChAPI
+	version string
+}
+
+func (ch versionedCh) GetChInfo() perun.ChInfo {
+	return perun.ChInfo{Version: ch.version}
+}
+
+// payingCh is a channel, on which the updates return the given errors in that
+// order and succeed after that. Each successful update increments the
+// version, as does each failed update if updatedOnErr is true.
+type payingCh struct {
+	perun.ChAPI
+	currency     perun.Currency
+	updatedOnErr bool
+
+	mtx     sync.Mutex
+	errs    []perun.APIError
+	version int
+	sent    int
+}
+
+func (ch *payingCh) Currency(string) (int, perun.Currency, bool) {
+	return 0, ch.currency, true
+}
+
+func (ch *payingCh) Parts() []string {
+	return []string{perun.OwnAlias, "bob"}
+}
+
+func (ch *payingCh) GetChInfo() perun.ChInfo {
+	ch.mtx.Lock()
+	defer ch.mtx.Unlock()
+	return perun.ChInfo{Version: fmt.Sprint(ch.version)}
+}
+
+func (ch *payingCh) SendChUpdate(context.Context, perun.StateUpdater) (perun.ChInfo, perun.APIError) {
+	ch.mtx.Lock()
+	defer ch.mtx.Unlock()
+	ch.sent++
+	if len(ch.errs) == 0 {
+		ch.version++
+		return perun.ChInfo{}, nil
+	}
+	err := ch.errs[0]
+	ch.errs = ch.errs[1:]
+	if ch.updatedOnErr {
+		ch.version++
+	}
+	return perun.ChInfo{}, err
+}
+
+func Test_Scheduler_Pay(t *testing.T) {
+	eth, err := currency.NewRegistry().Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+	require.NoError(t, err)
+	timedOut := perun.NewAPIErrPeerRequestTimedOut(assert.AnError, "bob", "1s")
+	sch := perun.PaymentSchedule{ID: "a", Payee: "bob", Currency: currency.ETHSymbol, Amount: "1"}
+
+	tests := []struct {
+		name         string
+		errs         []perun.APIError
+		updatedOnErr bool
+		wantErr      bool
+		wantSent     int
+	}{
+		{"happy_timed_out_retried", []perun.APIError{timedOut}, false, false, 2},
+		{"happy_version_changed_not_retried", []perun.APIError{timedOut}, true, false, 1},
+		{"unknown_internal_not_retried", []perun.APIError{perun.NewAPIErrUnknownInternal(assert.AnError)}, false, true, 1},
+		{"retries_exhausted", []perun.APIError{timedOut, timedOut}, false, true, 2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := &payingCh{currency: eth, updatedOnErr: tc.updatedOnErr, errs: tc.errs}
+			getCh := func(string) (perun.ChAPI, perun.APIError) { return ch, nil }
+			sc := newScheduler(pmemorydb.NewDatabase(), getCh, log.NewLoggerWithField("test", "scheduler"))
+			sc.retryInterval, sc.maxRetries = time.Millisecond, 1
+
+			apiErr := sc.pay(context.Background(), sch)
+			if tc.wantErr {
+				assert.Error(t, apiErr)
+			} else {
+				assert.NoError(t, apiErr)
+			}
+			assert.Equal(t, tc.wantSent, ch.sent)
+			version, found, err := sc.store.getInFlight(sch.ID)
+			require.NoError(t, err)
+			require.True(t, found)
+			assert.Equal(t, "0", version)
+			require.NoError(t, sc.close())
+		})
+	}
+}
+
+func Test_Scheduler(t *testing.T) {
+	chNotFound := func(chID string) (perun.ChAPI, perun.APIError) {
+		return nil, perun.NewAPIErrResourceNotFound(perun.ResTypeChannel, chID)
+	}
+	// status returns the status of the stored schedule.
+	status := func(sc *scheduler, id string) func() perun.PaymentScheduleStatus {
+		return func() perun.PaymentScheduleStatus {
+			sch, found, err := sc.store.get(id)
+			require.NoError(t, err)
+			require.True(t, found)
+			return sch.Status
+		}
+	}
+
+	t.Run("payment_fails", func(t *testing.T) {
+		sc := newScheduler(pmemorydb.NewDatabase(), chNotFound, log.NewLoggerWithField("test", "scheduler"))
+		require.NoError(t, sc.start())
+		require.NoError(t, sc.add(perun.PaymentSchedule{ID: "a", ChID: "ch", NextAt: time.Now().Unix()}))
+
+		assert.Eventually(t, func() bool {
+			return status(sc, "a")() == perun.PaymentScheduleFailed
+		}, 2*time.Second, 10*time.Millisecond)
+		schedules, err := sc.list()
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+		assert.Contains(t, schedules[0].LastError, "ch")
+		assert.Zero(t, schedules[0].Payments)
+		require.NoError(t, sc.close())
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		sc := newScheduler(pmemorydb.NewDatabase(), chNotFound, log.NewLoggerWithField("test", "scheduler"))
+		nextAt := time.Now().Add(time.Hour).Unix()
+		require.NoError(t, sc.add(perun.PaymentSchedule{ID: "a", NextAt: nextAt}))
+
+		sch, found, err := sc.cancel("a")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, perun.PaymentScheduleCancelled, sch.Status)
+		assert.Empty(t, sc.cancels)
+
+		// Cancelling again does not change the schedule.
+		sch, found, err = sc.cancel("a")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, perun.PaymentScheduleCancelled, sch.Status)
+
+		_, found, err = sc.cancel("b")
+		require.NoError(t, err)
+		assert.False(t, found)
+		require.NoError(t, sc.close())
+	})
+
+	t.Run("start_resumes_active", func(t *testing.T) {
+		db := pmemorydb.NewDatabase()
+		st := newScheduleStore(db)
+		nextAt := time.Now().Add(time.Hour).Unix()
+		require.NoError(t, st.put(perun.PaymentSchedule{ID: "a", NextAt: nextAt}))
+		require.NoError(t, st.put(perun.PaymentSchedule{ID: "b", Status: perun.PaymentScheduleCancelled}))
+		require.NoError(t, st.put(perun.PaymentSchedule{ID: "c", Status: perun.PaymentScheduleFailed}))
+
+		sc := newScheduler(db, chNotFound, log.NewLoggerWithField("test", "scheduler"))
+		require.NoError(t, sc.start())
+		assert.Len(t, sc.cancels, 1)
+		assert.Contains(t, sc.cancels, "a")
+		require.NoError(t, sc.close())
+		assert.Empty(t, sc.cancels)
+	})
+
+	t.Run("start_resolves_in_flight", func(t *testing.T) {
+		chWVersion := func(version string) func(string) (perun.ChAPI, perun.APIError) {
+			return func(string) (perun.ChAPI, perun.APIError) {
+				return versionedCh{version: version}, nil
+			}
+		}
+		nextAt := time.Now().Add(time.Hour).Unix()
+		sch := perun.PaymentSchedule{ID: "a", NextAt: nextAt, Every: 1, Unit: perun.ScheduleUnitDay}
+
+		for _, tc := range []struct {
+			name     string
+			version  string
+			payments uint64
+		}{
+			{"version_changed", "4", 1},
+			{"version_unchanged", "3", 0},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				db := pmemorydb.NewDatabase()
+				st := newScheduleStore(db)
+				require.NoError(t, st.put(sch))
+				require.NoError(t, st.putInFlight(sch.ID, "3"))
+
+				sc := newScheduler(db, chWVersion(tc.version), log.NewLoggerWithField("test", "scheduler"))
+				require.NoError(t, sc.start())
+				got, found, err := sc.store.get(sch.ID)
+				require.NoError(t, err)
+				require.True(t, found)
+				assert.Equal(t, tc.payments, got.Payments)
+				_, found, err = sc.store.getInFlight(sch.ID)
+				require.NoError(t, err)
+				assert.False(t, found)
+				require.NoError(t, sc.close())
+			})
+		}
+	})
+
+	t.Run("add_after_close", func(t *testing.T) {
+		sc := newScheduler(pmemorydb.NewDatabase(), chNotFound, log.NewLoggerWithField("test", "scheduler"))
+		require.NoError(t, sc.close())
+		assert.Error(t, sc.add(perun.PaymentSchedule{ID: "a"}))
+	})
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2026 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pclient "perun.network/go-perun/client"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+func Test_Session_CreatePaymentSchedule(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+
+	// Returns a session with a channel, on which the updates return the given
+	// errors in that order and succeed after that.
+	newSessionWSchCh := func(t *testing.T, updateErrs ...error) (*session.Session, perun.PaymentSchedule) {
+		pch, _ := newMockPCh()
+		pch.On("Idx").Return(pchannel.Index(0))
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		for _, err := range updateErrs {
+			pch.On("Update", mock.Anything, mock.Anything).Return(err).Once()
+		}
+		pch.On("Update", mock.Anything, mock.Anything).Return(nil)
+		sess := newSessionWCh(t, peerIDs, copyBalInfo(openingBalInfo), pch)
+		session.SetScheduleRetry(sess, 10*time.Millisecond, 1)
+		return sess, perun.PaymentSchedule{
+			ChID:     fmt.Sprintf("%x", pch.ID()),
+			Payee:    peerIDs[0].Alias,
+			Currency: currency.ETHSymbol,
+			Amount:   "0.1",
+			Every:    1,
+			Unit:     perun.ScheduleUnitDay,
+		}
+	}
+	// Waits until the schedule has the given number of payments or is not active.
+	waitForSchedule := func(t *testing.T, sess *session.Session, payments uint64) perun.PaymentSchedule {
+		var sch perun.PaymentSchedule
+		require.Eventually(t, func() bool {
+			schedules, err := sess.ListPaymentSchedules()
+			require.NoError(t, err)
+			require.Len(t, schedules, 1)
+			sch = schedules[0]
+			return sch.Payments == payments || sch.Status != perun.PaymentScheduleActive
+		}, 2*time.Second, 10*time.Millisecond)
+		return sch
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		sess, sch := newSessionWSchCh(t)
+		created, err := sess.CreatePaymentSchedule(sch)
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.Equal(t, perun.PaymentScheduleActive, created.Status)
+		assert.NotZero(t, created.NextAt)
+
+		got := waitForSchedule(t, sess, 1)
+		assert.Equal(t, perun.PaymentScheduleActive, got.Status)
+		assert.EqualValues(t, 1, got.Payments)
+		assert.NotZero(t, got.LastPaidAt)
+		assert.Equal(t, time.Unix(created.NextAt, 0).AddDate(0, 0, 1).Unix(), got.NextAt)
+
+		cancelled, err := sess.CancelPaymentSchedule(created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, perun.PaymentScheduleCancelled, cancelled.Status)
+		assert.EqualValues(t, 1, cancelled.Payments)
+	})
+
+	t.Run("happy_first_payment_later", func(t *testing.T) {
+		sess, sch := newSessionWSchCh(t)
+		sch.NextAt = time.Now().Add(time.Hour).Unix()
+		created, err := sess.CreatePaymentSchedule(sch)
+		require.NoError(t, err)
+		assert.Equal(t, sch.NextAt, created.NextAt)
+
+		time.Sleep(50 * time.Millisecond)
+		schedules, err := sess.ListPaymentSchedules()
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+		assert.Zero(t, schedules[0].Payments)
+	})
+
+	t.Run("happy_retry_timed_out", func(t *testing.T) {
+		sess, sch := newSessionWSchCh(t, pclient.RequestTimedOutError("timed out"))
+		_, err := sess.CreatePaymentSchedule(sch)
+		require.NoError(t, err)
+
+		got := waitForSchedule(t, sess, 1)
+		assert.Equal(t, perun.PaymentScheduleActive, got.Status)
+		assert.EqualValues(t, 1, got.Payments)
+	})
+
+	t.Run("unknown_internal_not_retried", func(t *testing.T) {
+		sess, sch := newSessionWSchCh(t, errors.New("peer not reachable"))
+		_, err := sess.CreatePaymentSchedule(sch)
+		require.NoError(t, err)
+
+		got := waitForSchedule(t, sess, 1)
+		assert.Equal(t, perun.PaymentScheduleFailed, got.Status)
+		assert.Zero(t, got.Payments)
+	})
+
+	t.Run("retries_exhausted", func(t *testing.T) {
+		timedOut := pclient.RequestTimedOutError("timed out")
+		sess, sch := newSessionWSchCh(t, timedOut, timedOut)
+		_, err := sess.CreatePaymentSchedule(sch)
+		require.NoError(t, err)
+
+		got := waitForSchedule(t, sess, 1)
+		assert.Equal(t, perun.PaymentScheduleFailed, got.Status)
+		assert.Zero(t, got.Payments)
+		assert.NotEmpty(t, got.LastError)
+	})
+
+	t.Run("payment_rejected", func(t *testing.T) {
+		sess, sch := newSessionWSchCh(t, pclient.PeerRejectedError{ItemType: "channel update", Reason: "no"})
+		_, err := sess.CreatePaymentSchedule(sch)
+		require.NoError(t, err)
+
+		got := waitForSchedule(t, sess, 1)
+		assert.Equal(t, perun.PaymentScheduleFailed, got.Status)
+		assert.Zero(t, got.Payments)
+		assert.NotEmpty(t, got.LastError)
+	})
+
+	t.Run("invalid_args", func(t *testing.T) {
+		sess, sch := newSessionWSchCh(t)
+
+		unknownCh := sch
+		unknownCh.ChID = "unknown-ch"
+		_, err := sess.CreatePaymentSchedule(unknownCh)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeChannel, unknownCh.ChID)
+
+		unknownCurrency := sch
+		unknownCurrency.Currency = "unknown-currency"
+		_, err = sess.CreatePaymentSchedule(unknownCurrency)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeCurrency, unknownCurrency.Currency)
+
+		for _, payee := range []string{perun.OwnAlias, "unknown-peer"} {
+			invalidPayee := sch
+			invalidPayee.Payee = payee
+			_, err = sess.CreatePaymentSchedule(invalidPayee)
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument, payment.ErrInvalidPayee.Error())
+			peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNamePayee, payee)
+		}
+
+		for _, amount := range []string{"abc", "0", "-1"} {
+			invalidAmount := sch
+			invalidAmount.Amount = amount
+			_, err = sess.CreatePaymentSchedule(invalidAmount)
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+			peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameAmount, amount)
+		}
+
+		zeroInterval := sch
+		zeroInterval.Every = 0
+		_, err = sess.CreatePaymentSchedule(zeroInterval)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+
+		invalidUnit := sch
+		invalidUnit.Unit = perun.ScheduleUnitMonth + 1
+		_, err = sess.CreatePaymentSchedule(invalidUnit)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+
+		schedules, err := sess.ListPaymentSchedules()
+		require.NoError(t, err)
+		assert.Empty(t, schedules)
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, false)
+		_, err := sess.CreatePaymentSchedule(perun.PaymentSchedule{})
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		_, err = sess.ListPaymentSchedules()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		_, err = sess.CancelPaymentSchedule("some-id")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
+
+func Test_Session_CancelPaymentSchedule(t *testing.T) {
+	sess, _, _ := newSessionWMockChClient(t, true)
+	_, err := sess.CancelPaymentSchedule("unknown-id")
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeSchedule, "unknown-id")
+}
@@ -19,8 +19,12 @@ package session
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"math/big"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -33,16 +37,23 @@ import (
 	pwatcher "perun.network/go-perun/watcher"
 	plocal "perun.network/go-perun/watcher/local"
 	pwire "perun.network/go-perun/wire"
+	pleveldb "polycry.pt/poly-go/sortedkv/leveldb"
 	psync "polycry.pt/poly-go/sync"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/app/payment/routing"
+	"github.com/hyperledger-labs/perun-node/blockchain"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/comm/memory"
+	"github.com/hyperledger-labs/perun-node/comm/relay"
 	"github.com/hyperledger-labs/perun-node/comm/tcp"
-	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/comm/tls"
+	"github.com/hyperledger-labs/perun-node/comm/websocket"
 	"github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/idprovider"
 	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/idprovider/remote"
 	"github.com/hyperledger-labs/perun-node/log"
 )
 
@@ -61,6 +72,12 @@ func init() {
 	walletBackend = ethereum.NewWalletBackend()
 }
 
+// memoryCommHub is the hub used by the sessions with comm type "memory". This
+// comm type connects only to the sessions in the same process and is
+// supported only when the hub is set using a function defined in
+// export_test.go, so that it is available only in tests.
+var memoryCommHub *memory.Hub
+
 type (
 	// Session provides a context for the user to interact with a node. It manages
 	// user data (such as keys, peer IDs), and channel client.
@@ -76,11 +93,13 @@ type (
 		user       User
 		chClient   ChClient
 		idProvider perun.IDProvider
+		peers      *peerMonitor
 
 		timeoutCfg timeoutConfig
 		chainURL   string // used for annotating error messages.
 
 		chain       perun.ChainBackend
+		grpcConns   []io.Closer // Connections to the remote funding and watching services.
 		funder      perun.Funder
 		adjudicator pchannel.Adjudicator
 		watcher     pwatcher.Watcher
@@ -92,10 +111,19 @@ type (
 		chProposalNotifier    perun.ChProposalNotifier
 		chProposalNotifsCache []perun.ChProposalNotif
 		chProposalResponders  map[string]chProposalResponderEntry
+
+		appMsgNotifiers      map[string]perun.AppMsgNotifier      // Subscriptions for app messages, by app ID.
+		chUpdateInterceptors map[string]perun.ChUpdateInterceptor // Interceptors for channel updates, by app ID.
+		closeHandlers        map[string]perun.SessionCloseHandler // Handlers invoked when the session is closed, by ID.
+		policy               *policy
+		history              *historyStore
+		scheduler            *scheduler
+		router               *routing.Router   // nil, if routing is not enabled.
+		partAccs             *partAccAllocator // nil, if the off-chain wallet cannot derive participant accounts.
 	}
 
 	chProposalResponderEntry struct {
-		proposal   pclient.LedgerChannelProposalMsg
+		proposal   pclient.ChannelProposal
 		notif      perun.ChProposalNotif
 		responder  ChProposalResponder
 		currencies []perun.Currency
@@ -103,7 +131,7 @@ type (
 
 	// ChProposalResponder defines the methods on proposal responder that will be used by the perun node.
 	ChProposalResponder interface {
-		Accept(context.Context, *pclient.LedgerChannelProposalAccMsg) (PChannel, error)
+		Accept(context.Context, pclient.ChannelProposalAccept) (PChannel, error)
 		Reject(ctx context.Context, reason string) error
 	}
 )
@@ -117,7 +145,7 @@ type chProposalResponderWrapped struct {
 }
 
 // Accept is a wrapper around the original function, that returns a channel of interface type instead of struct type.
-func (r *chProposalResponderWrapped) Accept(ctx context.Context, proposalAcc *pclient.LedgerChannelProposalAccMsg) (
+func (r *chProposalResponderWrapped) Accept(ctx context.Context, proposalAcc pclient.ChannelProposalAccept) (
 	PChannel, error,
 ) {
 	return r.ProposalResponder.Accept(ctx, proposalAcc)
@@ -126,32 +154,74 @@ func (r *chProposalResponderWrapped) Accept(ctx context.Context, proposalAcc *pc
 // New initializes a SessionAPI instance for the given configuration, read-only
 // currency registry and returns an instance of it. All methods on it are safe
 // for concurrent use.
-func New( //nolint: funlen
+func New(
+	cfg Config,
+	currencyRegistry perun.ROCurrencyRegistry,
+	contractRegistry perun.ContractRegistry) (
+	*Session, perun.APIError,
+) {
+	return NewWithID("", cfg, currencyRegistry, contractRegistry)
+}
+
+// NewWithID is same as New, except that the session will be assigned the
+// given session ID instead of a newly computed one. If the session ID is
+// empty, a new one is computed.
+//
+// This is used by the node for re-opening the sessions that were open before
+// the node was restarted, so that the session IDs remain the same.
+func NewWithID( //nolint: funlen
+	sessionID string,
 	cfg Config,
 	currencyRegistry perun.ROCurrencyRegistry,
 	contractRegistry perun.ContractRegistry) (
 	*Session, perun.APIError,
 ) {
+	policy, err := newPolicy(cfg.Policy)
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "policy", "")
+	}
+
+	// Resources acquired during initialization are added to closers, so that
+	// they are released in the reverse order if a later step fails.
+	closers := []func() error{}
+	fail := func(apiErr perun.APIError) (*Session, perun.APIError) {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]() //nolint: errcheck,gosec // Initialization error is returned, errors from closing are not.
+		}
+		return nil, apiErr
+	}
+
 	user, apiErr := NewUnlockedUser(walletBackend, cfg.User)
 	if apiErr != nil {
 		return nil, apiErr
 	}
 
-	if cfg.User.CommType != "tcp" {
-		return nil, perun.NewAPIErrInvalidConfig(perun.ErrUnsupportedType, "commType", cfg.User.CommType)
+	idProvider, apiErr := initIDProvider(cfg.IDProviderType, cfg.IDProviderURL, cfg.IDProviderCacheTTL,
+		walletBackend, user.PeerID)
+	if apiErr != nil {
+		return nil, apiErr
 	}
-	commBackend := tcp.NewTCPBackend(tcptest.DialerTimeout)
-	idProvider, apiErr := initIDProvider(cfg.IDProviderType, cfg.IDProviderURL, walletBackend, user.PeerID)
+	commCfg := newCommConfig(cfg)
+	commBackend, apiErr := initCommBackend(cfg.User, commCfg.dialTimeout, user.OffChain, idProvider)
 	if apiErr != nil {
 		return nil, apiErr
 	}
 
-	chain, err := ethereum.NewChainBackend(
-		cfg.ChainURL, cfg.ChainID, cfg.ChainConnTimeout, cfg.OnChainTxTimeout, user.OnChain)
+	if err = ethereum.ValidateGasConfig(cfg.Gas, cfg.OnChainTxTimeout); err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "gas", fmt.Sprintf("%+v", cfg.Gas))
+	}
+	chain, err := ethereum.NewChainBackend(cfg.ChainURL, cfg.ChainID, cfg.ChainConnTimeout, cfg.OnChainTxTimeout,
+		cfg.TxFinalityDepth, cfg.Gas, user.OnChain)
 	if err != nil {
 		err = errors.WithMessage(err, "connecting to blockchain")
 		return nil, perun.NewAPIErrInvalidConfig(err, "chainURL", cfg.ChainURL)
 	}
+	if closer, ok := chain.(io.Closer); ok {
+		closers = append(closers, closer.Close)
+	}
+	// Connections to the remote funding and watching services, these are
+	// closed when the session is closed.
+	var grpcConns []io.Closer
 
 	var funder perun.Funder
 	var adjudicator pchannel.Adjudicator
@@ -165,6 +235,8 @@ func New( //nolint: funlen
 			grpcErr = errors.WithMessage(grpcErr, "connecting to funding api")
 			return nil, perun.NewAPIErrUnknownInternal(grpcErr)
 		}
+		closers = append(closers, conn.Close)
+		grpcConns = append(grpcConns, conn)
 		funderClient := pb.NewFunding_APIClient(conn)
 		funder = &grpcFunder{
 			apiKey: cfg.FundingAPIKey,
@@ -177,7 +249,7 @@ func New( //nolint: funlen
 
 	default:
 		err = errors.New("should be local or remote")
-		return nil, perun.NewAPIErrInvalidConfig(err, "fundingType", cfg.FundingAPIKey)
+		return fail(perun.NewAPIErrInvalidConfig(err, "fundingType", cfg.FundingAPIKey))
 	}
 
 	var watcher pwatcher.Watcher
@@ -185,14 +257,16 @@ func New( //nolint: funlen
 	case "local":
 		watcher, err = plocal.NewWatcher(adjudicator)
 		if err != nil {
-			return nil, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "initializing watcher"))
+			return fail(perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "initializing watcher")))
 		}
 	case "grpc":
 		conn, grpcErr := grpclib.Dial(cfg.WatcherURL, grpclib.WithTransportCredentials(insecure.NewCredentials()))
 		if grpcErr != nil {
 			grpcErr = errors.WithMessage(grpcErr, "connecting to watching api")
-			return nil, perun.NewAPIErrUnknownInternal(grpcErr)
+			return fail(perun.NewAPIErrUnknownInternal(grpcErr))
 		}
+		closers = append(closers, conn.Close)
+		grpcConns = append(grpcConns, conn)
 		watcherClient := pb.NewWatching_APIClient(conn)
 		watcher = &grpcWatcher{
 			apiKey: cfg.WatcherAPIKey,
@@ -202,15 +276,34 @@ func New( //nolint: funlen
 
 	chClient, apiErr := newChClient(funder, adjudicator, watcher, commBackend, cfg.User.CommAddr, user.OffChain)
 	if apiErr != nil {
-		return nil, apiErr
+		return fail(apiErr)
 	}
+	closers = append(closers, chClient.Close)
 
-	offChainAddr, err := user.OffChainAddr.MarshalBinary()
-	if err != nil {
-		return nil, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "off-chain address"))
+	if sessionID == "" {
+		offChainAddr, err := user.OffChainAddr.MarshalBinary()
+		if err != nil {
+			return fail(perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "off-chain address")))
+		}
+		sessionID = calcSessionID(offChainAddr)
 	}
-	sessionID := calcSessionID(offChainAddr)
 	timeoutCfg := timeoutConfig{onChainTx: cfg.OnChainTxTimeout, response: cfg.ResponseTimeout}
+	historyDB, err := pleveldb.LoadDatabase(filepath.Join(cfg.DatabaseDir, historyDirName))
+	if err != nil {
+		err = errors.Wrap(err, "initializing history database")
+		return fail(perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir))
+	}
+	closers = append(closers, historyDB.Close)
+	scheduleDB, err := pleveldb.LoadDatabase(filepath.Join(cfg.DatabaseDir, scheduleDirName))
+	if err != nil {
+		err = errors.Wrap(err, "initializing schedule database")
+		return fail(perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir))
+	}
+	closers = append(closers, scheduleDB.Close)
+	partAccs, apiErr := initPartAccAllocator(user.OffChain.Wallet, cfg.DatabaseDir)
+	if apiErr != nil {
+		return fail(apiErr)
+	}
 	sess := &Session{
 		Logger:               log.NewLoggerWithField("session-id", sessionID),
 		id:                   sessionID,
@@ -220,7 +313,9 @@ func New( //nolint: funlen
 		user:                 user,
 		chClient:             chClient,
 		idProvider:           idProvider,
+		peers:                newPeerMonitor(chClient, commCfg),
 		chain:                chain,
+		grpcConns:            grpcConns,
 		funder:               funder,
 		adjudicator:          adjudicator,
 		watcher:              watcher,
@@ -228,24 +323,91 @@ func New( //nolint: funlen
 		contractRegistry:     contractRegistry,
 		currencyRegistry:     currencyRegistry,
 		chProposalResponders: make(map[string]chProposalResponderEntry),
+		appMsgNotifiers:      make(map[string]perun.AppMsgNotifier),
+		chUpdateInterceptors: make(map[string]perun.ChUpdateInterceptor),
+		closeHandlers:        make(map[string]perun.SessionCloseHandler),
+		policy:               policy,
+		history:              newHistoryStore(historyDB),
+		partAccs:             partAccs,
 	}
+	sess.scheduler = newScheduler(scheduleDB, sess.GetCh, sess.Logger)
+	// From here on, the session owns all the resources and closing it releases them.
+	closers = []func() error{func() error { return sess.close() }}
 
 	err = sess.chClient.RestoreChs(cfg.DatabaseDir, cfg.PeerReconnTimeout, sess.handleRestoredCh)
 	if err != nil {
 		err = errors.WithMessage(err, "restoring channels")
-		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
+		return fail(perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir))
+	}
+	// Merge changes made to the ID provider file by other processes, when using a local ID provider.
+	if localIDProvider, ok := idProvider.(*local.IDProvider); ok {
+		if err = localIDProvider.Watch(chClient, sess.hasOpenChsWithPeer, sess.Logger); err != nil {
+			return fail(perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "watching ID provider file")))
+		}
 	}
 	chClient.Handle(sess, sess) // Init handlers
+	chClient.HandleAppMsgs(sess.HandleAppMsg)
+	// Resume the payment schedules only after the channels are restored.
+	if err = sess.scheduler.start(); err != nil {
+		return fail(perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "resuming payment schedules")))
+	}
+	if cfg.Routing.Enable {
+		if sess.router, apiErr = newRouter(sess, cfg.Routing); apiErr != nil {
+			return fail(apiErr)
+		}
+	}
 	return sess, nil
 }
 
-func initIDProvider(idProviderType, idProviderURL string, wb perun.WalletBackend, own perun.PeerID) (
-	perun.IDProvider, perun.APIError,
-) {
-	if idProviderType != "local" {
+func initCommBackend(cfg UserConfig, dialTimeout time.Duration, offChainCred perun.Credential,
+	idReader perun.IDReader,
+) (perun.CommBackend, perun.APIError) {
+	switch cfg.CommType {
+	case "tcp":
+		return tcp.NewTCPBackend(dialTimeout), nil
+	case "websocket":
+		return websocket.NewWebSocketBackend(dialTimeout), nil
+	case "tls":
+		tlsCfg := tls.Config{
+			CertFile:   cfg.CommTLS.CertFile,
+			KeyFile:    cfg.CommTLS.KeyFile,
+			CACertFile: cfg.CommTLS.CACertFile,
+		}
+		commBackend, err := tls.NewTLSBackend(tlsCfg, dialTimeout, idReader)
+		if err != nil {
+			value := fmt.Sprintf("%s, %s, %s", cfg.CommTLS.CertFile, cfg.CommTLS.KeyFile, cfg.CommTLS.CACertFile)
+			return nil, perun.NewAPIErrInvalidConfig(err, "commTLS", value)
+		}
+		return commBackend, nil
+	case "relay":
+		offChainAcc, err := offChainCred.Wallet.Unlock(offChainCred.Addr)
+		if err != nil {
+			return nil, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "unlocking off-chain account"))
+		}
+		return relay.NewRelayBackend(offChainAcc, dialTimeout), nil
+	case "memory":
+		if memoryCommHub == nil {
+			return nil, perun.NewAPIErrInvalidConfig(perun.ErrUnsupportedType, "commType", cfg.CommType)
+		}
+		return memory.NewMemoryBackend(memoryCommHub), nil
+	default:
+		return nil, perun.NewAPIErrInvalidConfig(perun.ErrUnsupportedType, "commType", cfg.CommType)
+	}
+}
+
+func initIDProvider(idProviderType, idProviderURL string, cacheTTL time.Duration, wb perun.WalletBackend,
+	own perun.PeerID,
+) (perun.IDProvider, perun.APIError) {
+	var idProvider perun.IDProvider
+	var err error
+	switch idProviderType {
+	case "local":
+		idProvider, err = local.NewIDprovider(idProviderURL, wb)
+	case "remote":
+		idProvider, err = remote.NewIDProvider(idProviderURL, cacheTTL, wb)
+	default:
 		return nil, perun.NewAPIErrInvalidConfig(perun.ErrUnsupportedType, "idProviderType", idProviderType)
 	}
-	idProvider, err := local.NewIDprovider(idProviderURL, wb)
 	if err != nil {
 		return nil, perun.NewAPIErrInvalidConfig(err, "idProviderURL", idProviderURL)
 	}
@@ -310,14 +472,16 @@ func (s *Session) handleRestoredCh(pch PChannel) {
 	s.Debugf("restored channel from persistence: %v", ch.getChInfo())
 }
 
-// AddPeerID adds the peer ID to the ID provider instance of the session.
+// AddPeerID adds the peer ID to the ID provider instance of the session. The
+// ID provider persists the peer ID, so that it is available when the session
+// is re-opened.
 //
 // If there is an error, it will be one of the following codes:
 // - ErrFailedPrecondition when the session is closed.
 // - ErrResourceExists with ResourceType: "peerID" when peer ID is already registered
 // - ErrInvalidArgument with Name:"peerAlias" when peer alias is used for another peer,
 // - ErrInvalidArgument with Name:"offChainAddress" when off-chain address is invalid.
-// - ErrUnknownInternal.
+// - ErrUnknownInternal when the peer ID cannot be persisted.
 func (s *Session) AddPeerID(peerID perun.PeerID) perun.APIError {
 	s.WithField("method", "AddPeerID").Info("Received request with params:", peerID)
 	s.Lock()
@@ -377,11 +541,497 @@ func (s *Session) GetPeerID(alias string) (perun.PeerID, perun.APIError) {
 	return peerID, nil
 }
 
+// ListPeerIDs returns the list of all peer IDs in the ID provider instance of
+// the session, sorted by their aliases. It includes the user's own peer ID
+// registered with the alias perun.OwnAlias.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+func (s *Session) ListPeerIDs() ([]perun.PeerID, perun.APIError) {
+	s.WithField("method", "ListPeerIDs").Info("Received request")
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.isOpen {
+		apiErr := perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		s.WithFields(perun.APIErrAsMap("ListPeerIDs", apiErr)).Error(apiErr.Message())
+		return nil, apiErr
+	}
+
+	peerIDs := s.idProvider.ReadAll()
+	s.WithField("method", "ListPeerIDs").Info("Peer IDs retreived successfully")
+	return peerIDs, nil
+}
+
+// UpdatePeerID updates the peer ID for the alias in the ID provider instance
+// of the session and registers the new comm address of the peer with the
+// wire bus, so that new connections to the peer use the updated address.
+//
+// The off-chain address of a peer cannot be changed when there are open
+// channels with the peer.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrFailedPreCondition when off-chain address is changed and there are open
+// channels with the peer. Additional Info will contain an extra field:
+// OpenChannelsInfo that contains a list of Channel Info.
+// - ErrResourceNotFound with ResourceType: "peerID" when peer alias is not known.
+// - ErrInvalidArgument with Name:"peerAlias" when peer alias is that of own peer ID (self).
+// - ErrInvalidArgument with Name:"offChainAddress" when off-chain address is invalid
+// or is already used by another peer.
+// - ErrUnknownInternal when the peer ID cannot be persisted.
+func (s *Session) UpdatePeerID(peerID perun.PeerID) perun.APIError {
+	s.WithField("method", "UpdatePeerID").Info("Received request with params:", peerID)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("UpdatePeerID", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		return apiErr
+	}
+	if peerID.Alias == perun.OwnAlias {
+		apiErr = perun.NewAPIErrInvalidArgument(perun.ErrOwnPeerIDNotModifiable, perun.ArgNamePeerAlias, peerID.Alias)
+		return apiErr
+	}
+	oldPeerID, isPresent := s.idProvider.ReadByAlias(peerID.Alias)
+	if !isPresent {
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypePeerID, peerID.Alias)
+		return apiErr
+	}
+	if oldPeerID.OffChainAddrString != peerID.OffChainAddrString {
+		if openChsInfo := s.openChsWithPeer(peerID.Alias); len(openChsInfo) != 0 {
+			err := errors.New("off-chain address cannot be updated when there are open channels with the peer")
+			apiErr = perun.NewAPIErrFailedPreConditionUnclosedChs(err, openChsInfo)
+			return apiErr
+		}
+	}
+
+	err := s.idProvider.Update(peerID.Alias, peerID)
+	if err != nil {
+		// The error should be one of these following errors.
+		switch {
+		case errors.Is(err, idprovider.ErrParsingOffChainAddress),
+			errors.Is(err, idprovider.ErrOffChainAddrAlreadyUsed):
+			apiErr = perun.NewAPIErrInvalidArgument(err, perun.ArgNameOffChainAddr, peerID.OffChainAddrString)
+		default:
+			apiErr = perun.NewAPIErrUnknownInternal(err)
+		}
+		return apiErr
+	}
+	// Read the updated peer ID, as it will contain the parsed off-chain address.
+	peerID, _ = s.idProvider.ReadByAlias(peerID.Alias)
+	s.chClient.Register(peerID.OffChainAddr, peerID.CommAddr)
+	s.WithField("method", "UpdatePeerID").Info("Peer ID updated successfully")
+	return nil
+}
+
+// DeletePeerID deletes the peer ID for the alias from the ID provider instance
+// of the session. A peer ID cannot be deleted when there are open channels
+// with the peer.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrFailedPreCondition when there are open channels with the peer.
+// Additional Info will contain an extra field: OpenChannelsInfo that contains
+// a list of Channel Info.
+// - ErrResourceNotFound with ResourceType: "peerID" when peer alias is not known.
+// - ErrInvalidArgument with Name:"peerAlias" when peer alias is that of own peer ID (self).
+// - ErrUnknownInternal when the peer ID cannot be deleted from the persistence.
+func (s *Session) DeletePeerID(alias string) perun.APIError {
+	s.WithField("method", "DeletePeerID").Info("Received request with params:", alias)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("DeletePeerID", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		return apiErr
+	}
+	if alias == perun.OwnAlias {
+		apiErr = perun.NewAPIErrInvalidArgument(perun.ErrOwnPeerIDNotModifiable, perun.ArgNamePeerAlias, alias)
+		return apiErr
+	}
+	if _, isPresent := s.idProvider.ReadByAlias(alias); !isPresent {
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypePeerID, alias)
+		return apiErr
+	}
+	if openChsInfo := s.openChsWithPeer(alias); len(openChsInfo) != 0 {
+		err := errors.New("peer ID cannot be deleted when there are open channels with the peer")
+		apiErr = perun.NewAPIErrFailedPreConditionUnclosedChs(err, openChsInfo)
+		return apiErr
+	}
+
+	if err := s.idProvider.Delete(alias); err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return apiErr
+	}
+	s.peers.forget(alias)
+	s.WithField("method", "DeletePeerID").Info("Peer ID deleted successfully")
+	return nil
+}
+
+// hasOpenChsWithPeer returns true if there are open channels with the given
+// peer. It is used by the ID provider file watcher, which cancels the context
+// when it is stopped. If the context is done before the session lock is
+// acquired, it returns true.
+func (s *Session) hasOpenChsWithPeer(ctx context.Context, alias string) bool {
+	if !s.TryLockCtx(ctx) {
+		return true
+	}
+	defer s.Unlock()
+	return len(s.openChsWithPeer(alias)) != 0
+}
+
+// GetPeerStatus probes the peer corresponding to the given alias and returns
+// its connectivity status.
+//
+// The peer is probed by sending a ping message over the connection used by
+// the channel client (dialing one if required) and waiting for the response,
+// until the dial timeout in the session config. If the peer is not reachable,
+// the status will contain the time when it was last reachable and the latency
+// measured at that time.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "peerID" when peer alias is not known.
+func (s *Session) GetPeerStatus(ctx context.Context, alias string) (perun.PeerStatus, perun.APIError) {
+	s.WithField("method", "GetPeerStatus").Info("Received request with params:", alias)
+	s.Lock()
+	var apiErr perun.APIError
+	peerID, isPresent := s.idProvider.ReadByAlias(alias)
+	switch {
+	case !s.isOpen:
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+	case !isPresent:
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypePeerID, alias)
+	}
+	s.Unlock()
+	if apiErr != nil {
+		s.WithFields(perun.APIErrAsMap("GetPeerStatus", apiErr)).Error(apiErr.Message())
+		return perun.PeerStatus{}, apiErr
+	}
+
+	// Session lock is not held when probing, as it can take up to the dial timeout.
+	status := s.peers.probe(ctx, peerID)
+	s.WithField("method", "GetPeerStatus").Info("Peer status retrieved successfully")
+	return status, nil
+}
+
+// SubPeerStatus subscribes to notifications on the connectivity status of the
+// peer corresponding to the given alias. Only one subscription can be made for
+// a peer at a time. Making a new subscription without canceling the previous
+// one will return an error.
+//
+// Once subscribed, the peer is probed periodically at the peer status probe
+// interval in the session config and a notification is sent after each probe.
+// When the peer is not reachable, it is probed again after the retry backoff,
+// which is doubled after each failed attempt up to the probe interval.
+//
+// If the peer ID is deleted when the subscription is active, notifications
+// will be sent with only the alias set, until the subscription is canceled.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "peerID" when peer alias is not known.
+// - ErrResourceExists with ResourceType: "peerStatusSub" when a subscription already exists.
+func (s *Session) SubPeerStatus(alias string, notifier perun.PeerStatusNotifier) perun.APIError {
+	s.WithField("method", "SubPeerStatus").Info("Received request with params:", alias)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("SubPeerStatus", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		return apiErr
+	}
+	if _, isPresent := s.idProvider.ReadByAlias(alias); !isPresent {
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypePeerID, alias)
+		return apiErr
+	}
+	if !s.peers.subscribe(alias, s.idProvider, notifier) {
+		apiErr = perun.NewAPIErrResourceExists(perun.ResTypePeerStatusSub, alias)
+		return apiErr
+	}
+	s.WithField("method", "SubPeerStatus").Info("Subscribed successfully")
+	return nil
+}
+
+// UnsubPeerStatus unsubscribes from notifications on the connectivity status
+// of the peer corresponding to the given alias.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "peerStatusSub" when there is no active subscription.
+func (s *Session) UnsubPeerStatus(alias string) perun.APIError {
+	s.WithField("method", "UnsubPeerStatus").Info("Received request with params:", alias)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		s.WithFields(perun.APIErrAsMap("UnsubPeerStatus", apiErr)).Error(apiErr.Message())
+		return apiErr
+	}
+
+	if !s.peers.unsubscribe(alias) {
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypePeerStatusSub, alias)
+		s.WithFields(perun.APIErrAsMap("UnsubPeerStatus", apiErr)).Error(apiErr.Message())
+		return apiErr
+	}
+	s.WithField("method", "UnsubPeerStatus").Info("Unsubscribed successfully")
+	return nil
+}
+
+// SendAppMsg sends the data as a message for the given app to the peer
+// corresponding to the given alias. The message is sent over the same
+// off-chain connection used for the channels with the peer. The content of the
+// message is opaque to the node.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "peerID" when peer alias is not known.
+// - ErrPeerRequestTimedOut when the message could not be sent before time out.
+func (s *Session) SendAppMsg(pctx context.Context, peerAlias, appID string, data []byte) perun.APIError {
+	s.WithField("method", "SendAppMsg").Infof("\nReceived request with params %+v,%+v", peerAlias, appID)
+	s.Lock()
+	var apiErr perun.APIError
+	peerID, isPresent := s.idProvider.ReadByAlias(peerAlias)
+	switch {
+	case !s.isOpen:
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+	case !isPresent:
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypePeerID, peerAlias)
+	}
+	s.Unlock()
+	if apiErr != nil {
+		s.WithFields(perun.APIErrAsMap("SendAppMsg", apiErr)).Error(apiErr.Message())
+		return apiErr
+	}
+
+	// Session lock is not held when sending, as it can take up to the response timeout.
+	s.chClient.Register(peerID.OffChainAddr, peerID.CommAddr)
+	ctx, cancel := context.WithTimeout(pctx, s.timeoutCfg.response)
+	defer cancel()
+	if err := s.chClient.SendAppMsg(ctx, peerID.OffChainAddr, appID, data); err != nil {
+		apiErr = perun.NewAPIErrPeerRequestTimedOut(err, peerAlias, s.timeoutCfg.response.String())
+		s.WithFields(perun.APIErrAsMap("SendAppMsg", apiErr)).Error(apiErr.Message())
+		return apiErr
+	}
+	s.WithField("method", "SendAppMsg").Info("App message sent successfully")
+	return nil
+}
+
+// SubAppMsgs subscribes to the messages for the given app received from the
+// peers. Only one subscription can be made for an app at a time. Making a new
+// subscription without canceling the previous one will return an error.
+//
+// Messages received from senders that are not in the ID provider and those
+// received when there is no subscription for the app are dropped.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceExists with ResourceType: "appMsgSub" when a subscription already exists.
+func (s *Session) SubAppMsgs(appID string, notifier perun.AppMsgNotifier) perun.APIError {
+	s.WithField("method", "SubAppMsgs").Info("Received request with params:", appID)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("SubAppMsgs", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		return apiErr
+	}
+	if _, ok := s.appMsgNotifiers[appID]; ok {
+		apiErr = perun.NewAPIErrResourceExists(perun.ResTypeAppMsgSub, appID)
+		return apiErr
+	}
+	s.appMsgNotifiers[appID] = notifier
+	s.WithField("method", "SubAppMsgs").Info("Subscribed successfully")
+	return nil
+}
+
+// UnsubAppMsgs unsubscribes from the messages for the given app.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "appMsgSub" when there is no active subscription.
+func (s *Session) UnsubAppMsgs(appID string) perun.APIError {
+	s.WithField("method", "UnsubAppMsgs").Info("Received request with params:", appID)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("UnsubAppMsgs", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		return apiErr
+	}
+	if _, ok := s.appMsgNotifiers[appID]; !ok {
+		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypeAppMsgSub, appID)
+		return apiErr
+	}
+	delete(s.appMsgNotifiers, appID)
+	s.WithField("method", "UnsubAppMsgs").Info("Unsubscribed successfully")
+	return nil
+}
+
+// HandleAppMsg is a handler to be registered on the channel client for
+// processing incoming app messages. It identifies the alias of the sender and
+// sends the message to the subscriber for the app.
+func (s *Session) HandleAppMsg(from pwire.Address, appID string, data []byte) {
+	if s.peers.handleAppMsg(from, appID, data) {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.isOpen {
+		return
+	}
+	notifier, ok := s.appMsgNotifiers[appID]
+	if !ok {
+		s.Debugf("Dropped app message for %s as there is no active subscription", appID)
+		return
+	}
+	peerID, isPresent := s.idProvider.ReadByOffChainAddr(from)
+	if !isPresent {
+		s.Infof("Dropped app message for %s from unknown peer %v", appID, from)
+		return
+	}
+	go notifier(perun.AppMsg{PeerAlias: peerID.Alias, Data: data})
+}
+
+// SetChUpdateInterceptor sets the interceptor for the app, that is invoked
+// for each incoming channel update before it is notified to the user. This is
+// intended for the applications built on top of the session that handle some
+// of the updates by themselves. Setting it to nil removes the interceptor.
+//
+// When interceptors are set for multiple apps, they are invoked in the order
+// of app IDs, until one of them handles the update.
+func (s *Session) SetChUpdateInterceptor(appID string, interceptor perun.ChUpdateInterceptor) {
+	s.WithField("method", "SetChUpdateInterceptor").Infof("Received request with params %+v", appID)
+	s.Lock()
+	defer s.Unlock()
+	if interceptor == nil {
+		delete(s.chUpdateInterceptors, appID)
+		return
+	}
+	s.chUpdateInterceptors[appID] = interceptor
+}
+
+// SetCloseHandler sets the handler to be invoked when the session is closed,
+// replacing the handler previously set for the ID, if any. Passing a nil
+// handler removes the handler for the ID.
+//
+// If the session is already closed, the handler is not set and is invoked
+// right away.
+func (s *Session) SetCloseHandler(id string, handler perun.SessionCloseHandler) {
+	s.WithField("method", "SetCloseHandler").Infof("Received request with params %+v", id)
+	s.Lock()
+	if handler == nil {
+		delete(s.closeHandlers, id)
+		s.Unlock()
+		return
+	}
+	if !s.isOpen {
+		s.Unlock()
+		handler()
+		return
+	}
+	s.closeHandlers[id] = handler
+	s.Unlock()
+}
+
+// chUpdateInterceptor returns an interceptor that invokes the interceptors
+// set for each app in the order of app IDs, until one of them handles the
+// update. It returns nil if no interceptors are set.
+//
+// This function assumes that caller has already locked the session.
+func (s *Session) chUpdateInterceptor() perun.ChUpdateInterceptor {
+	if len(s.chUpdateInterceptors) == 0 {
+		return nil
+	}
+	appIDs := make([]string, 0, len(s.chUpdateInterceptors))
+	for appID := range s.chUpdateInterceptors {
+		appIDs = append(appIDs, appID)
+	}
+	sort.Strings(appIDs)
+	interceptors := make([]perun.ChUpdateInterceptor, len(appIDs))
+	for i := range appIDs {
+		interceptors[i] = s.chUpdateInterceptors[appIDs[i]]
+	}
+	return func(notif perun.ChUpdateNotif) bool {
+		for i := range interceptors {
+			if interceptors[i](notif) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// openChsWithPeer returns the info of channels that are open and have the
+// given peer as one of the participants.
+//
+// Caller should hold the session lock.
+func (s *Session) openChsWithPeer(alias string) []perun.ChInfo {
+	openChsInfo := []perun.ChInfo{}
+	s.chs.forEach(func(_ int, ch *Channel) {
+		ch.Lock()
+		defer ch.Unlock()
+		if ch.status != open {
+			return
+		}
+		for _, part := range ch.parts {
+			if part == alias {
+				openChsInfo = append(openChsInfo, ch.getChInfo())
+				return
+			}
+		}
+	})
+	return openChsInfo
+}
+
 // OpenCh proposes a channel to the participants with the specified opening
 // balance and app, funds it on the blockchain when the proposal is accepted
 // and sets it up for off-chain transactions when all the participants have
 // funded the channel on the blockchain.
 //
+// The channel can have two or more participants, one of which should be the
+// user (own alias). The opening balance should have an entry for each
+// participant in each currency.
+//
 // `Challenge duration` is the time available for the node to refute in case of
 // disputes when a state is registered on the blockchain.
 //
@@ -389,11 +1039,15 @@ func (s *Session) GetPeerID(alias string) (perun.PeerID, perun.APIError) {
 // - ErrFailedPrecondition when the session is closed.
 // - ErrResourceNotFound with ResourceType: "peerID" when any of the peer aliases are not known.
 // - ErrResourceNotFound with ResourceType: "currency" when the currency is not known.
-// - ErrInvalidArgument with Name:"amount" when any of the amounts is invalid.
-// - ErrPeerRequestTimedOut when peer request times out.
-// - ErrPeerRejected when peer rejects the request.
-// - ErrPeerNotFunded when peer did not fund the channel in time.
+// - ErrInvalidArgument with Name:"peerAlias" when there are less than two participants.
+// - ErrInvalidArgument with Name:"amount" when any of the amounts is invalid or missing.
+// - ErrPeerRequestTimedOut when peer request times out. With more than one peer,
+// aliases of all the peers are included in the error, as the peer is not known.
+// - ErrPeerRejected when peer rejects the request. Aliases are included as above.
+// - ErrPeerNotFunded when peers did not fund the channel in time. With more than one
+// peer, aliases of all the peers that did not fund are included, separated by commas.
 // - ErrTxTimedOut with TxType: "Fund" when funding tx times out.
+// - ErrTxReorged with TxType: "Fund" when funding tx is removed from the chain in a reorg.
 // - ErrChainNotReachable when connection to blockchain drops while funding.
 // - ErrUnknownInternal.
 func (s *Session) OpenCh(pctx context.Context, openingBalInfo perun.BalInfo, app perun.App, challengeDurSecs uint64) (
@@ -415,6 +1069,9 @@ func (s *Session) OpenCh(pctx context.Context, openingBalInfo perun.BalInfo, app
 		return perun.ChInfo{}, apiErr
 	}
 
+	if apiErr = validateBalInfo(openingBalInfo); apiErr != nil {
+		return perun.ChInfo{}, apiErr
+	}
 	sanitizeBalInfo(openingBalInfo)
 
 	var parts []perun.PeerID
@@ -432,7 +1089,12 @@ func (s *Session) OpenCh(pctx context.Context, openingBalInfo perun.BalInfo, app
 	}
 	updateAssetsInFunder(currencies, s.contractRegistry, s.funder, s.user.OnChain.Addr)
 
-	proposal, err := pclient.NewLedgerChannelProposal(challengeDurSecs, s.user.OffChainAddr, allocation,
+	partAddr, err := s.newPartAddr()
+	if err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "allocating participant account"))
+		return perun.ChInfo{}, apiErr
+	}
+	proposal, err := pclient.NewLedgerChannelProposal(challengeDurSecs, partAddr, allocation,
 		makeOffChainAddrs(parts), pclient.WithApp(app.Def, app.Data), pclient.WithRandomNonce())
 	if err != nil {
 		apiErr = perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "constructing channel proposal"))
@@ -452,24 +1114,233 @@ func (s *Session) OpenCh(pctx context.Context, openingBalInfo perun.BalInfo, app
 	return ch.GetChInfo(), nil
 }
 
+// OpenVirtualCh proposes a virtual channel to the peer with the specified
+// opening balance and app. The channel is funded off-chain from the ledger
+// channels that the user and the peer each have with a common intermediary.
+// So, no on-chain transactions are required for opening it. The intermediary
+// should also be running a perun node, which will fund the virtual channel
+// without any interaction from its user.
+//
+// The channel should have exactly two participants, one of which should be
+// the user (own alias). The currencies in the opening balance should be the
+// same (in the same order) as in the user's parent channel, and both the
+// parent channels should hold enough balance to fund the virtual channel.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session or the user's parent channel is closed.
+// - ErrResourceNotFound with ResourceType: "channel" when the user's parent channel is not known.
+// - ErrResourceNotFound with ResourceType: "peerID" when the peer alias is not known.
+// - ErrResourceNotFound with ResourceType: "currency" when the currency is not known.
+// - ErrInvalidArgument with Name:"peerAlias" when there are not exactly two participants.
+// - ErrInvalidArgument with Name:"currency" when the currencies do not match the parent channel.
+// - ErrInvalidArgument with Name:"amount" when any of the amounts is invalid or missing.
+// - ErrInvalidArgument with Name:"peerChID" when the ID of the peer's parent channel is invalid.
+// - ErrInvalidArgument with Name:"peerIdx" when the index of the peer in its parent channel is invalid.
+// - ErrPeerRequestTimedOut when peer request times out.
+// - ErrPeerRejected when peer rejects the request.
+// - ErrUnknownInternal.
+func (s *Session) OpenVirtualCh(pctx context.Context, parents perun.VirtualChParents, openingBalInfo perun.BalInfo,
+	app perun.App, challengeDurSecs uint64,
+) (perun.ChInfo, perun.APIError) {
+	s.WithField("method", "OpenVirtualCh").Infof(
+		"\nReceived request with params %+v,%+v,%+v,%+v", parents, openingBalInfo, app, challengeDurSecs)
+	// Session lock is not held for the whole call, but only when checking if
+	// the session is open, retrieving the parent channel and adding the
+	// channel to session, as proposing the channel can take long.
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("OpenVirtualCh", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	s.Lock()
+	isOpen := s.isOpen
+	s.Unlock()
+	if !isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(perun.ErrSessionClosed)
+		return perun.ChInfo{}, apiErr
+	}
+
+	if apiErr = validateVirtualChBalInfo(openingBalInfo); apiErr != nil {
+		return perun.ChInfo{}, apiErr
+	}
+	sanitizeBalInfo(openingBalInfo)
+
+	var parentIDs []pchannel.ID
+	var indexMaps [][]pchannel.Index
+	parentIDs, indexMaps, apiErr = s.virtualChParents(parents, openingBalInfo.Currencies)
+	if apiErr != nil {
+		return perun.ChInfo{}, apiErr
+	}
+
+	var parts []perun.PeerID
+	parts, apiErr = retrievePartIDs(openingBalInfo.Parts, s.idProvider)
+	if apiErr != nil {
+		return perun.ChInfo{}, apiErr
+	}
+	registerParts(parts, s.chClient)
+
+	var allocation *pchannel.Allocation
+	var currencies []perun.Currency
+	currencies, allocation, apiErr = makeAllocation(openingBalInfo, s.contractRegistry, s.currencyRegistry)
+	if apiErr != nil {
+		return perun.ChInfo{}, apiErr
+	}
+
+	proposal, err := pclient.NewVirtualChannelProposal(challengeDurSecs, s.user.OffChainAddr, allocation,
+		makeOffChainAddrs(parts), parentIDs, indexMaps, pclient.WithApp(app.Def, app.Data), pclient.WithRandomNonce())
+	if err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "constructing virtual channel proposal"))
+		return perun.ChInfo{}, apiErr
+	}
+	ctx, cancel := context.WithTimeout(pctx, s.timeoutCfg.proposeCh(challengeDurSecs))
+	defer cancel()
+	pch, err := s.chClient.ProposeChannel(ctx, proposal)
+	if err != nil {
+		apiErr = s.handleProposeChError(openingBalInfo.Parts, errors.WithMessage(err, "proposing virtual channel"))
+		return perun.ChInfo{}, apiErr
+	}
+
+	ch := newCh(pch, s.chainURL, currencies, openingBalInfo.Parts, s.timeoutCfg, challengeDurSecs)
+	s.addCh(ch)
+	s.WithFields(log.Fields{"method": "OpenVirtualCh", "channelID": ch.ID()}).Info("Channel opened successfully")
+	return ch.GetChInfo(), nil
+}
+
+// validateVirtualChBalInfo checks if the balance info is valid and has
+// exactly two participants, as virtual channels are always two party
+// channels.
+func validateVirtualChBalInfo(balInfo perun.BalInfo) perun.APIError {
+	if apiErr := validateBalInfo(balInfo); apiErr != nil {
+		return apiErr
+	}
+	if len(balInfo.Parts) != 2 {
+		err := errors.New("virtual channel should have exactly two participants")
+		return perun.NewAPIErrInvalidArgument(err, perun.ArgNamePeerAlias, strings.Join(balInfo.Parts, ","))
+	}
+	return nil
+}
+
+// virtualChParents returns the IDs of the parent channels and the index maps
+// for a virtual channel between the user (at index 0) and the peer (at index
+// 1). The index map for each parent channel maps each participant of the
+// virtual channel to its index in that parent channel, where the
+// intermediary stands in for the participant that is not in it.
+//
+// Currencies of the virtual channel should be the same as in the user's
+// parent channel.
+func (s *Session) virtualChParents(parents perun.VirtualChParents, currencies []string) (
+	[]pchannel.ID, [][]pchannel.Index, perun.APIError,
+) {
+	s.Lock()
+	parent := s.chs.get(parents.ChID)
+	s.Unlock()
+	if parent == nil {
+		return nil, nil, perun.NewAPIErrResourceNotFound(perun.ResTypeChannel, parents.ChID)
+	}
+
+	parent.Lock()
+	defer parent.Unlock()
+	if parent.status == closed {
+		return nil, nil, perun.NewAPIErrFailedPreCondition(perun.ErrChClosed)
+	}
+	if len(parent.parts) != 2 {
+		return nil, nil, perun.NewAPIErrFailedPreCondition(errors.New("parent channel should have two participants"))
+	}
+	if !hasSymbols(parent.currencies, currencies) {
+		err := errors.New("currencies should be same as in the parent channel")
+		return nil, nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNameCurrency, strings.Join(currencies, ","))
+	}
+
+	peerParentID, err := parseChID(parents.PeerChID)
+	if err != nil {
+		return nil, nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNamePeerChID, parents.PeerChID)
+	}
+	if parents.PeerIdx > 1 {
+		err = errors.New("should be 0 or 1, as the parent channel has two participants")
+		return nil, nil, perun.NewAPIErrInvalidArgument(err, perun.ArgNamePeerIdx, fmt.Sprint(parents.PeerIdx))
+	}
+
+	ownIdx := parent.pch.Idx()
+	peerIdx := pchannel.Index(parents.PeerIdx)
+	return []pchannel.ID{parent.pch.ID(), peerParentID}, [][]pchannel.Index{
+		{ownIdx, counterpartIdx(ownIdx)},
+		{counterpartIdx(peerIdx), peerIdx},
+	}, nil
+}
+
+// counterpartIdx returns the index of the other participant in a two party
+// channel, given the index of one participant.
+func counterpartIdx(idx pchannel.Index) pchannel.Index {
+	if idx == 0 {
+		return 1
+	}
+	return 0
+}
+
+// hasSymbols checks if the symbols of the currencies match the given
+// symbols in the same order.
+func hasSymbols(currencies []perun.Currency, symbols []string) bool {
+	if len(currencies) != len(symbols) {
+		return false
+	}
+	for i := range currencies {
+		if currencies[i].Symbol() != symbols[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseChID parses the hex representation of a channel ID, as used in the
+// APIs, into a channel ID.
+func parseChID(chIDHex string) (pchannel.ID, error) {
+	var chID pchannel.ID
+	chIDBytes, err := hex.DecodeString(chIDHex)
+	if err != nil {
+		return chID, errors.Wrap(err, "decoding channel ID")
+	}
+	if len(chIDBytes) != len(chID) {
+		return chID, errors.Errorf("channel ID should be %d bytes long", len(chID))
+	}
+	copy(chID[:], chIDBytes)
+	return chID, nil
+}
+
 // handleProposeChError inspects the passed error, constructs an
 // appropriate APIError and returns it.
 //
 // Passed error must be non-nil.
 func (s *Session) handleProposeChError(parts []string, err error) perun.APIError {
-	var peerIdx pchannel.Index = 1 // In a sanitized openingBalInfo, peer (proposee) is at index 1.
+	// In a sanitized openingBalInfo, own alias is at index 0 and the peers (proposees) follow it.
+	peerAliases := joinPeerAliases(parts, 0)
 
 	var apiErr perun.APIError
 	if apiErr = handleChainError(s.chainURL, s.timeoutCfg.onChainTx.String(), err); apiErr != nil {
 		return apiErr
-	} else if apiErr = handleFundingTimeoutError(parts[peerIdx], peerIdx, err); apiErr != nil {
+	} else if apiErr = handleFundingTimeoutError(parts, err); apiErr != nil {
 		return apiErr
-	} else if apiErr = handleProposalError(parts[peerIdx], s.timeoutCfg.response.String(), err); apiErr != nil {
+	} else if apiErr = handleProposalError(peerAliases, s.timeoutCfg.response.String(), err); apiErr != nil {
 		return apiErr
 	}
 	return perun.NewAPIErrUnknownInternal(err)
 }
 
+// joinPeerAliases returns the aliases of all the participants except the one
+// at ownIdx, separated by commas. In a two party channel, it is the alias of
+// the peer.
+func joinPeerAliases(parts []string, ownIdx int) string {
+	peerAliases := make([]string, 0, len(parts))
+	for i := range parts {
+		if i != ownIdx {
+			peerAliases = append(peerAliases, parts[i])
+		}
+	}
+	return strings.Join(peerAliases, ",")
+}
+
 // handleProposalError inspects if the passed error is a proposal error.
 // If yes, it constructs & returns an APIError. If not, returns nil
 //
@@ -490,6 +1361,22 @@ func handleProposalError(peerAlias, responseTimeout string, err error) perun.API
 	}
 }
 
+// validateBalInfo checks if the balance info has at least two participants and
+// if there is a balance for each participant in each currency.
+func validateBalInfo(balInfo perun.BalInfo) perun.APIError {
+	if len(balInfo.Parts) < 2 {
+		err := errors.New("channel should have at least two participants")
+		return perun.NewAPIErrInvalidArgument(err, perun.ArgNamePeerAlias, strings.Join(balInfo.Parts, ","))
+	}
+	for i := range balInfo.Bals {
+		if len(balInfo.Bals[i]) != len(balInfo.Parts) {
+			err := errors.New("length of each bal should match the length of parts")
+			return perun.NewAPIErrInvalidArgument(err, perun.ArgNameAmount, strings.Join(balInfo.Bals[i], ","))
+		}
+	}
+	return nil
+}
+
 // sanitizeBalInfo checks if the entry for ownAlias is at index 0,
 // if not it rearranges the Aliases & Balance lists to make the index of ownAlias 0.
 //
@@ -630,6 +1517,8 @@ func updateAssetsInFunder(currs []perun.Currency, contractRegistry perun.ROContr
 // addCh adds the channel to session. It locks the session mutex during the operation.
 func (s *Session) addCh(ch *Channel) {
 	ch.Logger = log.NewDerivedLoggerWithField(s.Logger, "channel-id", ch.id)
+	ch.history = s.history
+	ch.pch.OnUpdate(ch.recordUpdate)
 	s.Lock()
 	s.chs.put(ch)
 	s.Unlock()
@@ -646,14 +1535,14 @@ func (s *Session) HandleProposal(chProposal pclient.ChannelProposal, responder *
 //
 // It is implemented this way to enable easier testing.
 func (s *Session) HandleProposalWInterface(chProposal pclient.ChannelProposal, responder ChProposalResponder) {
-	ledgerChProposal, ok := chProposal.(*pclient.LedgerChannelProposalMsg)
+	peers, parentChID, ok := proposalPeers(chProposal)
 	if !ok {
-		// Our handler is expected to handle only ledger channel proposals,
+		// Our handler is expected to handle only ledger and virtual channel proposals,
 		// if it is anything else (sub-channel proposals), simply drop it.
 		return
 	}
 
-	s.Debugf("SDK Callback: HandleProposal. Params: %+v", ledgerChProposal)
+	s.Debugf("SDK Callback: HandleProposal. Params: %+v", chProposal)
 	expiry := time.Now().UTC().Add(s.timeoutCfg.response).Unix()
 
 	if !s.isOpen {
@@ -662,39 +1551,58 @@ func (s *Session) HandleProposalWInterface(chProposal pclient.ChannelProposal, r
 		return
 	}
 
-	parts := make([]string, len(ledgerChProposal.Peers))
-	for i := range ledgerChProposal.Peers {
-		p, ok := s.idProvider.ReadByOffChainAddr(ledgerChProposal.Peers[i])
+	parts := make([]string, len(peers))
+	for i := range peers {
+		p, ok := s.idProvider.ReadByOffChainAddr(peers[i])
 		if !ok {
-			s.Infof("Rejecting channel proposal with unknown peer ID: %v", ledgerChProposal.Peers[i])
-			//nolint:errcheck              // It is sufficient to just log this error.
-			s.rejectChProposal(context.Background(), responder, "unrecogonized peer ID")
+			s.Infof("Unknown peer ID in channel proposal: %v", peers[i])
+			parts[i] = peers[i].String()
 			expiry = 0
-			break
+			continue
 		}
 		parts[i] = p.Alias
 	}
+	if expiry == 0 {
+		s.Info("Rejecting channel proposal with unknown peer IDs")
+		//nolint:errcheck              // It is sufficient to just log this error.
+		s.rejectChProposal(context.Background(), responder, "unrecogonized peer ID")
+	}
 
-	currencies, err := getCurrencies(ledgerChProposal.InitBals.Assets, s.contractRegistry, s.currencyRegistry)
+	currencies, err := getCurrencies(chProposal.Base().InitBals.Assets, s.contractRegistry, s.currencyRegistry)
 	if err != nil {
 		s.Infof("Rejecting channel proposal due to %v", err)
 		//nolint:errcheck              // It is sufficient to just log this error.
 		s.rejectChProposal(context.Background(), responder, "unrecogonized currency")
 	}
 
-	notif := chProposalNotif(parts, currencies, ledgerChProposal, expiry)
-	entry := chProposalResponderEntry{
-		proposal:   *ledgerChProposal,
+	notif := chProposalNotif(parts, currencies, chProposal.Base(), parentChID, expiry)
+	if expiry != 0 && err == nil {
+		notif.AutoResponse = s.policy.proposalResponse(parts, currencies, chProposal.Base())
+	}
+	s.handleChProposalNotif(chProposalResponderEntry{
+		proposal:   chProposal,
 		notif:      notif,
 		responder:  responder,
 		currencies: currencies,
+	})
+}
+
+// handleChProposalNotif stores the responder entry and sends the notification
+// for the channel proposal. If the proposal is to be responded to
+// automatically as per the session policy, the response is sent instead of
+// storing the entry.
+func (s *Session) handleChProposalNotif(entry chProposalResponderEntry) {
+	notif := entry.notif
+	if notif.AutoResponse != perun.AutoResponseNone {
+		notif.Expiry = 0
+		go s.autoRespondChProposal(entry)
 	}
 
 	s.Lock()
 	defer s.Unlock()
 	// Need not store entries for notification with expiry = 0, as these update requests have
-	// already been rejected by the perun node. Hence no response is expected for these notifications.
-	if expiry != 0 {
+	// already been rejected or responded to by the perun node. Hence no response is expected for these notifications.
+	if notif.Expiry != 0 {
 		s.chProposalResponders[notif.ProposalID] = entry
 	}
 
@@ -707,6 +1615,42 @@ func (s *Session) HandleProposalWInterface(chProposal pclient.ChannelProposal, r
 	}
 }
 
+// autoRespondChProposal sends the response to the channel proposal, as
+// decided by the session policy.
+func (s *Session) autoRespondChProposal(entry chProposalResponderEntry) {
+	method := "AutoRespondChProposal"
+	logger := s.WithFields(log.Fields{"method": method, "proposalID": entry.notif.ProposalID})
+
+	var apiErr perun.APIError
+	if entry.notif.AutoResponse == perun.AutoResponseAccepted {
+		_, apiErr = s.acceptChProposal(context.Background(), method, entry)
+	} else {
+		apiErr = s.rejectChProposal(context.Background(), entry.responder, "rejected by policy")
+	}
+	if apiErr != nil {
+		logger.WithFields(perun.APIErrAsMap(method, apiErr)).Error(apiErr.Message())
+		return
+	}
+	logger.Info("Channel proposal responded to as per the policy")
+}
+
+// proposalPeers returns the off-chain addresses of the participants in the
+// channel proposal. For virtual channel proposals, it also returns the ID of
+// the user's parent channel.
+//
+// It returns false for any other type of proposal.
+func proposalPeers(chProposal pclient.ChannelProposal) ([]pwire.Address, string, bool) {
+	switch p := chProposal.(type) {
+	case *pclient.LedgerChannelProposalMsg:
+		return p.Peers, "", true
+	case *pclient.VirtualChannelProposalMsg:
+		// Number of parents is validated by the go-perun client before invoking the proposal handler.
+		return p.Peers, fmt.Sprintf("%x", p.Parents[pclient.ProposeeIdx]), true
+	default:
+		return nil, "", false
+	}
+}
+
 // TODO: Here, assets are received as io.Encoder. But since we are working with only one type of client,
 // we know, the underlying type is pwallet.Address and hence we do type extraction without assertion.
 //
@@ -727,8 +1671,8 @@ func getCurrencies(assets []pchannel.Asset,
 	return currencies, nil
 }
 
-func chProposalNotif(parts []string, currencies []perun.Currency, chProposal *pclient.LedgerChannelProposalMsg,
-	expiry int64,
+func chProposalNotif(parts []string, currencies []perun.Currency, chProposal *pclient.BaseChannelProposal,
+	parentChID string, expiry int64,
 ) perun.ChProposalNotif {
 	return perun.ChProposalNotif{
 		ProposalID:       fmt.Sprintf("%x", chProposal.ProposalID),
@@ -736,6 +1680,7 @@ func chProposalNotif(parts []string, currencies []perun.Currency, chProposal *pc
 		App:              makeApp(chProposal.App, chProposal.InitData),
 		ChallengeDurSecs: chProposal.ChallengeDuration,
 		Expiry:           expiry,
+		ParentChID:       parentChID,
 	}
 }
 
@@ -825,25 +1770,57 @@ func (s *Session) UnsubChProposals() perun.APIError {
 // notification expires. Use the `Time` API to fetch current time of the perun
 // node as as reference for checking notification expiry.
 //
+// Virtual channel proposals should be responded to using the
+// RespondVirtualChProposal API.
+//
 // If there is an error, it will be one of the following codes:
 // - ErrFailedPrecondition when the session is closed.
 // - ErrResourceNotFound with ResourceType: "proposal" when proposal ID is not known.
 // - ErrPeerNotFunded when peer did not fund the channel in time.
 // - ErrUserResponseTimedOut when user responded after time out expired.
 // - ErrTxTimedOut with TxType: "Fund" when there is tx timed error while funding.
+// - ErrTxReorged with TxType: "Fund" when funding tx is removed from the chain in a reorg.
 // - ErrChainNotReachable when connection to blockchain drops while funding.
 // - ErrUnknownInternal.
 func (s *Session) RespondChProposal(pctx context.Context, chProposalID string, accept bool) (
 	perun.ChInfo, perun.APIError,
 ) {
-	s.WithField("method", "RespondChProposal").Infof("\nReceived request with Params %+v,%+v", chProposalID, accept)
+	return s.respondChProposal(pctx, "RespondChProposal", chProposalID, accept, false)
+}
+
+// RespondVirtualChProposal responds to the specified virtual channel proposal
+// for which a notification had been received. It is the counterpart of
+// RespondChProposal for proposals with the ParentChID set in the
+// notification.
+//
+// When accepted, the channel is funded off-chain from the parent channel and
+// no on-chain transactions are required.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "proposal" when proposal ID is not known.
+// - ErrUserResponseTimedOut when user responded after time out expired.
+// - ErrUnknownInternal.
+func (s *Session) RespondVirtualChProposal(pctx context.Context, chProposalID string, accept bool) (
+	perun.ChInfo, perun.APIError,
+) {
+	return s.respondChProposal(pctx, "RespondVirtualChProposal", chProposalID, accept, true)
+}
+
+// respondChProposal is the common implementation of RespondChProposal and
+// RespondVirtualChProposal. Proposals of the other kind are treated as not
+// found.
+func (s *Session) respondChProposal(pctx context.Context, method, chProposalID string, accept, isVirtual bool) (
+	perun.ChInfo, perun.APIError,
+) {
+	s.WithField("method", method).Infof("\nReceived request with Params %+v,%+v", chProposalID, accept)
 	// Session lock is not acquired at the beginning, but only when retrieving
 	// the proposal and when adding the opened channel to session.
 
 	var apiErr perun.APIError
 	defer func() {
 		if apiErr != nil {
-			s.WithFields(perun.APIErrAsMap("RespondChProposal", apiErr)).Error(apiErr.Message())
+			s.WithFields(perun.APIErrAsMap(method, apiErr)).Error(apiErr.Message())
 		}
 	}()
 
@@ -856,7 +1833,7 @@ func (s *Session) RespondChProposal(pctx context.Context, chProposalID string, a
 	// It will again be locked when adding the channel to the session.
 	s.Lock()
 	entry, ok := s.chProposalResponders[chProposalID]
-	if !ok {
+	if !ok || (entry.notif.ParentChID != "") != isVirtual {
 		s.Unlock()
 		apiErr = perun.NewAPIErrResourceNotFound(perun.ResTypeProposal, chProposalID)
 		return perun.ChInfo{}, apiErr
@@ -873,21 +1850,28 @@ func (s *Session) RespondChProposal(pctx context.Context, chProposalID string, a
 	var openedChInfo perun.ChInfo
 	switch accept {
 	case true:
-		openedChInfo, apiErr = s.acceptChProposal(pctx, entry)
+		openedChInfo, apiErr = s.acceptChProposal(pctx, method, entry)
 	case false:
 		apiErr = s.rejectChProposal(pctx, entry.responder, "rejected by user")
 	}
 	return openedChInfo, apiErr
 }
 
-func (s *Session) acceptChProposal(pctx context.Context, entry chProposalResponderEntry) (
+func (s *Session) acceptChProposal(pctx context.Context, method string, entry chProposalResponderEntry) (
 	perun.ChInfo, perun.APIError,
 ) {
 	ctx, cancel := context.WithTimeout(pctx, s.timeoutCfg.respChProposalAccept(entry.notif.ChallengeDurSecs))
 	defer cancel()
 
-	proposal := entry.proposal
-	resp := proposal.Accept(s.user.OffChainAddr, pclient.WithRandomNonce())
+	partAddr := s.user.OffChainAddr
+	if _, ok := entry.proposal.(*pclient.LedgerChannelProposalMsg); ok {
+		var err error
+		if partAddr, err = s.newPartAddr(); err != nil {
+			err = errors.WithMessage(err, "allocating participant account")
+			return perun.ChInfo{}, perun.NewAPIErrUnknownInternal(err)
+		}
+	}
+	resp := makeChProposalAcc(entry.proposal, partAddr)
 
 	updateAssetsInFunder(entry.currencies, s.contractRegistry, s.funder, s.user.OnChain.Addr)
 	pch, err := entry.responder.Accept(ctx, resp)
@@ -899,21 +1883,41 @@ func (s *Session) acceptChProposal(pctx context.Context, entry chProposalRespond
 	parts := entry.notif.OpeningBalInfo.Parts
 	ch := newCh(pch, s.chainURL, entry.currencies, parts, s.timeoutCfg, entry.notif.ChallengeDurSecs)
 	s.addCh(ch)
-	s.WithFields(log.Fields{"method": "RespondChProposal", "channelID": ch.ID()}).Info("Channel opened successfully")
+	s.WithFields(log.Fields{"method": method, "channelID": ch.ID()}).Info("Channel opened successfully")
 	return ch.getChInfo(), nil
 }
 
+// newPartAddr returns the address to be used as participant in a new ledger
+// channel. If the off-chain wallet can derive participant accounts, a fresh
+// one is derived for each channel. Else, the off-chain address is used.
+func (s *Session) newPartAddr() (pwallet.Address, error) {
+	if s.partAccs == nil {
+		return s.user.OffChainAddr, nil
+	}
+	return s.partAccs.newPartAddr()
+}
+
+// makeChProposalAcc constructs the accept message for the channel proposal.
+// The proposal should either be a ledger or a virtual channel proposal.
+func makeChProposalAcc(chProposal pclient.ChannelProposal, offChainAddr pwire.Address) pclient.ChannelProposalAccept {
+	switch p := chProposal.(type) {
+	case *pclient.VirtualChannelProposalMsg:
+		return p.Accept(offChainAddr)
+	default:
+		// Entries are added to the responders map only for ledger and virtual channel proposals.
+		return chProposal.(*pclient.LedgerChannelProposalMsg).Accept(offChainAddr, pclient.WithRandomNonce())
+	}
+}
+
 // handleProposeChannelError inspects the passed error, constructs an
 // appropriate APIError and returns it.
 //
 // Passed error must be non-nil.
 func (s *Session) handleChProposalAcceptError(parts []string, err error) perun.APIError {
-	var peerIdx pchannel.Index // In a sanitized openingBalInfo, peer (proposer) is at index 0.
-
 	var apiErr perun.APIError
 	if apiErr = handleChainError(s.chainURL, s.timeoutCfg.onChainTx.String(), err); apiErr != nil {
 		return apiErr
-	} else if apiErr = handleFundingTimeoutError(parts[peerIdx], peerIdx, err); apiErr != nil {
+	} else if apiErr = handleFundingTimeoutError(parts, err); apiErr != nil {
 		return apiErr
 	}
 	return perun.NewAPIErrUnknownInternal(err)
@@ -946,6 +1950,23 @@ func (s *Session) GetChsInfo() []perun.ChInfo {
 	return chsInfo
 }
 
+// OpenChsCount returns the number of channels in the session that are open
+// for off-chain transactions.
+func (s *Session) OpenChsCount() int {
+	s.Lock()
+	defer s.Unlock()
+
+	count := 0
+	s.chs.forEach(func(_ int, ch *Channel) {
+		ch.Lock()
+		if ch.status == open {
+			count++
+		}
+		ch.Unlock()
+	})
+	return count
+}
+
 // GetCh is an internal API that retreives the channel API instance
 // corresponding to the given channel ID.
 //
@@ -1006,7 +2027,7 @@ func (s *Session) HandleUpdateWInterface(
 		s.Info("Error rejecting incoming update for unknown channel with id %s: %v", chID, err)
 		return
 	}
-	go ch.HandleUpdate(currState, chUpdate, responder)
+	go ch.handleUpdate(currState, chUpdate, responder, s.chUpdateInterceptor(), s.policy)
 }
 
 // Close closes the specified session. All session data will be persisted to
@@ -1031,6 +2052,13 @@ func (s *Session) HandleUpdateWInterface(
 //   - ErrUnknownInternal.
 func (s *Session) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 	s.WithField("method", "Close").Infof("\nReceived request with params %+v", force)
+	// Close handlers are invoked after the session lock is released, so that they can use the session APIs.
+	var closeHandlers map[string]perun.SessionCloseHandler
+	defer func() {
+		for _, handler := range closeHandlers {
+			handler()
+		}
+	}()
 	s.Lock()
 	defer s.Unlock()
 
@@ -1085,6 +2113,7 @@ func (s *Session) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 	}
 
 	s.isOpen = false
+	closeHandlers, s.closeHandlers = s.closeHandlers, make(map[string]perun.SessionCloseHandler)
 	apiErr = s.close()
 	return openChsInfo, apiErr
 }
@@ -1095,12 +2124,40 @@ func (s *Session) unlockAllChs() {
 	})
 }
 
+// close releases all the resources held by the session. Every resource is
+// closed even if closing one of them fails and the errors are combined.
 func (s *Session) close() perun.APIError {
 	s.user.OnChain.Wallet.LockAll()
 	s.user.OffChain.Wallet.LockAll()
-	err := errors.WithMessage(s.chClient.Close(), "closing session")
-	if err != nil {
-		return perun.NewAPIErrUnknownInternal(err)
+
+	errs := []string{}
+	collect := func(err error, msg string) {
+		if err != nil {
+			errs = append(errs, errors.WithMessage(err, msg).Error())
+		}
+	}
+	collect(s.peers.close(), "closing peer monitor")
+	collect(s.chClient.Close(), "closing channel client")
+	for _, conn := range s.grpcConns {
+		collect(conn.Close(), "closing connection to funding or watching service")
+	}
+	if closer, ok := s.chain.(io.Closer); ok {
+		collect(closer.Close(), "closing connection to blockchain")
+	}
+	collect(s.history.close(), "closing channel history")
+	collect(s.scheduler.close(), "closing payment scheduler")
+	if s.partAccs != nil {
+		collect(s.partAccs.close(), "closing participant accounts")
+	}
+	// Peer IDs are persisted when they are added, flush once more to ensure
+	// the storage is up to date before the ID provider is closed.
+	collect(s.idProvider.UpdateStorage(), "updating ID provider storage")
+	if closer, ok := s.idProvider.(io.Closer); ok {
+		collect(closer.Close(), "closing ID provider")
+	}
+
+	if len(errs) != 0 {
+		return perun.NewAPIErrUnknownInternal(errors.Errorf("closing session: %s", strings.Join(errs, "; ")))
 	}
 	return nil
 }
@@ -1148,26 +2205,34 @@ func (s *Session) DeployAssetERC20(tokenAddr string) (assetAddr string, _ perun.
 // handleFundingTimeoutError inspects if the passed error is an funding timeout error.
 // If yes, it constructs & returns an APIError. If not, returns nil
 //
+// The aliases of all the peers that did not fund (in any of the assets) are
+// included in the error, separated by commas.
+//
 // Passed error must be non-nil.
-func handleFundingTimeoutError(peerAlias string, peerIdx pchannel.Index, err error) perun.APIError {
+func handleFundingTimeoutError(parts []string, err error) perun.APIError {
 	fundingTimeoutError := pchannel.FundingTimeoutError{}
 	ok := errors.As(err, &fundingTimeoutError)
 	if !ok {
 		return nil
 	}
-	if len(fundingTimeoutError.Errors) != 1 {
-		err = errors.WithMessage(err, "channel can contain only one asset")
-		return perun.NewAPIErrUnknownInternal(err)
-	}
-	if len(fundingTimeoutError.Errors[0].TimedOutPeers) != 1 {
-		err = errors.WithMessage(err, "channel can contain only one participant other than self")
-		return perun.NewAPIErrUnknownInternal(err)
+
+	timedOut := make([]bool, len(parts))
+	for _, assetErr := range fundingTimeoutError.Errors {
+		for _, peerIdx := range assetErr.TimedOutPeers {
+			if int(peerIdx) >= len(parts) {
+				err = errors.WithMessage(err, fmt.Sprintf("index of peer must be less than %d", len(parts)))
+				return perun.NewAPIErrUnknownInternal(err)
+			}
+			timedOut[peerIdx] = true
+		}
 	}
-	if fundingTimeoutError.Errors[0].TimedOutPeers[0] != peerIdx {
-		err = errors.WithMessage(err, fmt.Sprintf("index of peer must be %d", peerIdx))
-		return perun.NewAPIErrUnknownInternal(err)
+	peerAliases := make([]string, 0, len(parts))
+	for i := range parts {
+		if timedOut[i] {
+			peerAliases = append(peerAliases, parts[i])
+		}
 	}
-	return perun.NewAPIErrPeerNotFunded(err, peerAlias)
+	return perun.NewAPIErrPeerNotFunded(err, strings.Join(peerAliases, ","))
 }
 
 // handleChainError inspects if the passed error is an on-chain error.
@@ -1175,10 +2240,15 @@ func handleFundingTimeoutError(peerAlias string, peerIdx pchannel.Index, err err
 //
 // Passed error must be non-nil.
 func handleChainError(chainURL, onChainTxTimeout string, err error) perun.APIError {
+	txReorgedError := blockchain.TxReorgedError{}
 	txTimedOutError := pclient.TxTimedoutError{}
 	chainNotReachableError := pclient.ChainNotReachableError{}
 
 	switch {
+	// TxReorgedError wraps the TxTimedoutError, hence check it first.
+	case errors.As(err, &txReorgedError):
+		return perun.NewAPIErrTxReorged(err, txReorgedError.TxType, txReorgedError.TxID)
+
 	case errors.As(err, &txTimedOutError):
 		txType := txTimedOutError.TxType
 		txID := txTimedOutError.TxID
@@ -22,6 +22,7 @@ package session_test
 import (
 	"fmt"
 	"math/rand"
+	"net"
 	"os"
 	"testing"
 	"time"
@@ -34,10 +35,14 @@ import (
 	pperunioserializer "perun.network/go-perun/wire/perunio/serializer"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/relay"
 	"github.com/hyperledger-labs/perun-node/comm/tcp"
+	"github.com/hyperledger-labs/perun-node/comm/tls/tlstest"
 	"github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/idprovider/idprovidertest"
+	"github.com/hyperledger-labs/perun-node/log"
 	"github.com/hyperledger-labs/perun-node/peruntest"
 	"github.com/hyperledger-labs/perun-node/session"
 	"github.com/hyperledger-labs/perun-node/session/sessiontest"
@@ -69,6 +74,57 @@ func Test_Integ_New(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, sess)
 	})
+	t.Run("happy_commType_websocket", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+
+		// Listener will start listening on this port.
+		// Use a different port number to not affect other tests.
+		port, err := freeport.GetFreePort()
+		require.NoError(t, err)
+		cfgCopy.User.CommAddr = fmt.Sprintf("127.0.0.1:%d", port)
+		cfgCopy.User.CommType = "websocket"
+
+		sess, err := session.New(cfgCopy, currencies, contracts)
+		require.NoError(t, err)
+		assert.NotNil(t, sess)
+	})
+	t.Run("happy_commType_tls", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+
+		// Listener will start listening on this port.
+		// Use a different port number to not affect other tests.
+		port, err := freeport.GetFreePort()
+		require.NoError(t, err)
+		cfgCopy.User.CommAddr = fmt.Sprintf("127.0.0.1:%d", port)
+		cfgCopy.User.CommType = "tls"
+		tlsCfg, _ := tlstest.NewCAT(t).NewConfigT(t, "alice")
+		cfgCopy.User.CommTLS = session.CommTLSConfig(tlsCfg)
+
+		sess, err := session.New(cfgCopy, currencies, contracts)
+		require.NoError(t, err)
+		assert.NotNil(t, sess)
+	})
+	t.Run("happy_commType_relay", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+
+		// Start a relay server, session will register at the relay.
+		port, err := freeport.GetFreePort()
+		require.NoError(t, err)
+		cfgCopy.User.CommAddr = fmt.Sprintf("127.0.0.1:%d", port)
+		cfgCopy.User.CommType = "relay"
+		relayServer := relay.NewServer(ethereum.NewWalletBackend(), log.NewLoggerWithField("relay", port))
+		netListener, err := net.Listen("tcp", cfgCopy.User.CommAddr)
+		require.NoError(t, err)
+		go relayServer.Serve(netListener) //nolint:errcheck
+		defer relayServer.Close()         //nolint:errcheck
+
+		sess, err := session.New(cfgCopy, currencies, contracts)
+		require.NoError(t, err)
+		assert.NotNil(t, sess)
+	})
 	t.Run("invalidConfig_databaseDir_alreadyInUse", func(t *testing.T) {
 		cfgCopy := cfg
 		cfgCopy.DatabaseDir = newDatabaseDir(t)
@@ -92,6 +148,12 @@ func Test_Integ_New(t *testing.T) {
 		require.Error(t, apiErr)
 		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrInvalidConfig, "")
 		peruntest.AssertErrInfoInvalidConfig(t, apiErr.AddInfo(), "databaseDir", cfgCopy.DatabaseDir)
+
+		// Resources acquired by the failed session should have been released,
+		// so that the same listener address can be used again.
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+		_, apiErr = session.New(cfgCopy, currencies, contracts)
+		require.NoError(t, apiErr)
 	})
 	t.Run("invalidConfig_chainURL", func(t *testing.T) {
 		cfgCopy := cfg
@@ -112,6 +174,16 @@ func Test_Integ_New(t *testing.T) {
 		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "chainURL", cfgCopy.ChainURL)
 	})
 
+	t.Run("invalidConfig_gas", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+		cfgCopy.Gas = perun.GasConfig{MaxFeePerGas: 10, MaxPriorityFeePerGas: 20}
+		_, err := session.New(cfgCopy, currencies, contracts)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "gas", fmt.Sprintf("%+v", cfgCopy.Gas))
+	})
+
 	t.Run("invalidConfig_onChainAddr", func(t *testing.T) {
 		cfgCopy := cfg
 		cfgCopy.DatabaseDir = newDatabaseDir(t)
@@ -134,21 +206,23 @@ func Test_Integ_New(t *testing.T) {
 		cfgCopy := cfg
 		cfgCopy.DatabaseDir = newDatabaseDir(t)
 		cfgCopy.User.OnChainWallet.Password = "invalid-password"
-		wantValue := fmt.Sprintf("%s, %s", cfgCopy.User.OnChainWallet.KeystorePath, cfgCopy.User.OnChainWallet.Password)
+		wantValue := cfgCopy.User.OnChainWallet.KeystorePath
 		_, err := session.New(cfgCopy, currencies, contracts)
 		require.Error(t, err)
 		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
 		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "onChainWallet", wantValue)
+		assert.NotContains(t, err.Error(), cfgCopy.User.OnChainWallet.Password)
 	})
 	t.Run("invalidConfig_offChainWallet_password", func(t *testing.T) {
 		cfgCopy := cfg
 		cfgCopy.DatabaseDir = newDatabaseDir(t)
 		cfgCopy.User.OffChainWallet.Password = "invalid-password"
-		wantValue := fmt.Sprintf("%s, %s", cfgCopy.User.OffChainWallet.KeystorePath, cfgCopy.User.OffChainWallet.Password)
+		wantValue := cfgCopy.User.OffChainWallet.KeystorePath
 		_, err := session.New(cfgCopy, currencies, contracts)
 		require.Error(t, err)
 		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
 		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "offChainWallet", wantValue)
+		assert.NotContains(t, err.Error(), cfgCopy.User.OffChainWallet.Password)
 	})
 
 	t.Run("invalidConfig_commType", func(t *testing.T) {
@@ -160,6 +234,31 @@ func Test_Integ_New(t *testing.T) {
 		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
 		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "commType", cfgCopy.User.CommType)
 	})
+	t.Run("invalidConfig_commType_memory_not_enabled", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+		cfgCopy.User.CommType = "memory"
+		_, err := session.New(cfgCopy, currencies, contracts)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "commType", cfgCopy.User.CommType)
+	})
+	t.Run("invalidConfig_commTLS", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+		cfgCopy.User.CommType = "tls"
+		cfgCopy.User.CommTLS = session.CommTLSConfig{
+			CertFile:   "missing.crt",
+			KeyFile:    "missing.key",
+			CACertFile: "missing-ca.crt",
+		}
+		wantValue := fmt.Sprintf("%s, %s, %s", cfgCopy.User.CommTLS.CertFile, cfgCopy.User.CommTLS.KeyFile,
+			cfgCopy.User.CommTLS.CACertFile)
+		_, err := session.New(cfgCopy, currencies, contracts)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "commTLS", wantValue)
+	})
 	t.Run("invalidConfig_commAddr", func(t *testing.T) {
 		cfgCopy := cfg
 		cfgCopy.DatabaseDir = newDatabaseDir(t)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -37,10 +38,12 @@ import (
 	pwire "perun.network/go-perun/wire"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
 	"github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/currency/currencytest"
 	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/idprovider/remote"
 	"github.com/hyperledger-labs/perun-node/internal/mocks"
 	"github.com/hyperledger-labs/perun-node/peruntest"
 	"github.com/hyperledger-labs/perun-node/session"
@@ -73,6 +76,25 @@ func newSessionWMockChClient(t *testing.T, isOpen bool, peerIDs ...perun.PeerID)
 	return s, chClient, chainSetup
 }
 
+// newSessionWHDWallet returns a session with a mock channel client, where
+// the user accounts are derived from a mnemonic by the HD wallet.
+func newSessionWHDWallet(t *testing.T, peerIDs ...perun.PeerID) (*session.Session, *mocks.ChClient) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+	onChainAddr, offChainAddr := ethereumtest.NewHDUserAddrsT(t, ethereumtest.HDMnemonic)
+	cfg.User.OnChainAddr = onChainAddr.String()
+	cfg.User.OffChainAddr = offChainAddr.String()
+	cfg.User.OnChainWallet = session.WalletConfig{Mnemonic: ethereumtest.HDMnemonic}
+	cfg.User.OffChainWallet = session.WalletConfig{Mnemonic: ethereumtest.HDMnemonic}
+	chClient := &mocks.ChClient{}
+
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	s, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+	require.NoError(t, err)
+	return s, chClient
+}
+
 func Test_Session_AddPeerID(t *testing.T) {
 	peerIDs := newPeerIDs(t, uint(3))
 	// In openSession, peer0 is already present, peer1 can be added.
@@ -148,6 +170,416 @@ func Test_Session_GetPeerID(t *testing.T) {
 	})
 }
 
+func Test_Session_RemoteIDProvider(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	directoryServer := httptest.NewServer(remote.NewDirectory(peerIDs[0]))
+	t.Cleanup(directoryServer.Close)
+
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng)
+	cfg.IDProviderType = "remote"
+	cfg.IDProviderURL = directoryServer.URL
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	sess, err := session.NewSessionForTest(cfg, true, &mocks.ChClient{}, chainSetup)
+	require.NoError(t, err)
+
+	t.Run("happy_get_peerID", func(t *testing.T) {
+		peerID, err := sess.GetPeerID(peerIDs[0].Alias)
+		require.NoError(t, err)
+		assert.True(t, local.PeerIDEqual(peerID, peerIDs[0]))
+	})
+
+	t.Run("happy_add_peerID", func(t *testing.T) {
+		require.NoError(t, sess.AddPeerID(peerIDs[1]))
+
+		idProvider, err := remote.NewIDProvider(directoryServer.URL, 0, ethereumtest.NewTestWalletBackend())
+		require.NoError(t, err)
+		peerID, isPresent := idProvider.ReadByAlias(peerIDs[1].Alias)
+		assert.True(t, isPresent)
+		assert.True(t, local.PeerIDEqual(peerID, peerIDs[1]))
+		_, isPresent = idProvider.ReadByAlias(perun.OwnAlias)
+		assert.False(t, isPresent, "own peer ID should not be added to the directory")
+	})
+}
+
+func Test_Session_ListPeerIDs(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	openSession, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+	closedSession, _, _ := newSessionWMockChClient(t, false, peerIDs...)
+
+	t.Run("happy", func(t *testing.T) {
+		gotPeerIDs, err := openSession.ListPeerIDs()
+		require.NoError(t, err)
+		require.Len(t, gotPeerIDs, 3) // Includes own peer ID.
+		for _, peerID := range peerIDs {
+			assert.Contains(t, gotPeerIDs, peerID)
+		}
+		for i := 1; i < len(gotPeerIDs); i++ {
+			assert.Less(t, gotPeerIDs[i-1].Alias, gotPeerIDs[i].Alias)
+		}
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSession.ListPeerIDs()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		assert.Nil(t, err.AddInfo())
+	})
+}
+
+func Test_Session_UpdatePeerID(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(3))
+
+	t.Run("happy_comm_addr", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs[0])
+		updatedPeerID := peerIDs[0]
+		updatedPeerID.CommAddr = "127.0.0.1:6000"
+		chClient.On("Register", updatedPeerID.OffChainAddr, updatedPeerID.CommAddr).Return().Once()
+
+		require.NoError(t, sess.UpdatePeerID(updatedPeerID))
+		gotPeerID, err := sess.GetPeerID(updatedPeerID.Alias)
+		require.NoError(t, err)
+		assert.True(t, local.PeerIDEqual(updatedPeerID, gotPeerID))
+		chClient.AssertExpectations(t)
+	})
+
+	t.Run("happy_off_chain_addr_no_open_chs", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs[0])
+		updatedPeerID := peerIDs[1]
+		updatedPeerID.Alias = peerIDs[0].Alias
+		chClient.On("Register", mock.Anything, updatedPeerID.CommAddr).Return().Once()
+
+		require.NoError(t, sess.UpdatePeerID(updatedPeerID))
+		gotPeerID, err := sess.GetPeerID(updatedPeerID.Alias)
+		require.NoError(t, err)
+		assert.True(t, local.PeerIDEqual(updatedPeerID, gotPeerID))
+	})
+
+	t.Run("off_chain_addr_open_chs", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		chInfo := openChWithPeer(t, sess, chClient, peerIDs[0].Alias)
+		updatedPeerID := peerIDs[2]
+		updatedPeerID.Alias = peerIDs[0].Alias
+
+		err := sess.UpdatePeerID(updatedPeerID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		addInfo, ok := err.AddInfo().(perun.ErrInfoFailedPreCondUnclosedChs)
+		require.True(t, ok)
+		require.Len(t, addInfo.ChInfos, 1)
+		assert.Equal(t, chInfo.ChID, addInfo.ChInfos[0].ChID)
+	})
+
+	t.Run("own_peerID", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true, peerIDs[0])
+		ownPeerID := peerIDs[1]
+		ownPeerID.Alias = perun.OwnAlias
+
+		err := sess.UpdatePeerID(ownPeerID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNamePeerAlias, perun.OwnAlias)
+	})
+
+	t.Run("peerID_not_found", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true, peerIDs[0])
+
+		err := sess.UpdatePeerID(peerIDs[1])
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePeerID, peerIDs[1].Alias)
+	})
+
+	t.Run("invalid_off_chain_addr", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true, peerIDs[0])
+		updatedPeerID := peerIDs[0]
+		updatedPeerID.OffChainAddrString = "invalid-addr"
+
+		err := sess.UpdatePeerID(updatedPeerID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameOffChainAddr, "invalid-addr")
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, false, peerIDs[0])
+
+		err := sess.UpdatePeerID(peerIDs[0])
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		assert.Nil(t, err.AddInfo())
+	})
+}
+
+func Test_Session_DeletePeerID(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+
+	t.Run("happy", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+
+		require.NoError(t, sess.DeletePeerID(peerIDs[0].Alias))
+		_, err := sess.GetPeerID(peerIDs[0].Alias)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+
+	t.Run("open_chs", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		chInfo := openChWithPeer(t, sess, chClient, peerIDs[0].Alias)
+
+		err := sess.DeletePeerID(peerIDs[0].Alias)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		addInfo, ok := err.AddInfo().(perun.ErrInfoFailedPreCondUnclosedChs)
+		require.True(t, ok)
+		require.Len(t, addInfo.ChInfos, 1)
+		assert.Equal(t, chInfo.ChID, addInfo.ChInfos[0].ChID)
+
+		// Peers without open channels can be deleted.
+		require.NoError(t, sess.DeletePeerID(peerIDs[1].Alias))
+	})
+
+	t.Run("own_peerID", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+
+		err := sess.DeletePeerID(perun.OwnAlias)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNamePeerAlias, perun.OwnAlias)
+	})
+
+	t.Run("peerID_not_found", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+		unknownAlias := "unknown-alias"
+
+		err := sess.DeletePeerID(unknownAlias)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePeerID, unknownAlias)
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, false, peerIDs...)
+
+		err := sess.DeletePeerID(peerIDs[0].Alias)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		assert.Nil(t, err.AddInfo())
+	})
+}
+
+func Test_Session_GetPeerStatus(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openSession, chClient, _ := newSessionWMockChClient(t, true, peerIDs[0])
+	closedSession, _, _ := newSessionWMockChClient(t, false, peerIDs[0])
+	chClient.On("Register", mock.Anything, mock.Anything).Return()
+
+	t.Run("happy_peer_reachable", func(t *testing.T) {
+		// Peer responds to the ping with a pong.
+		chClient.On("SendAppMsg", mock.Anything, peerIDs[0].OffChainAddr, mock.Anything, mock.Anything).
+			Return(nil).Once().Run(func(args mock.Arguments) {
+			go openSession.HandleAppMsg(peerIDs[0].OffChainAddr, "perun-node/peer-status/pong", args.Get(3).([]byte))
+		})
+		peerStatus, err := openSession.GetPeerStatus(context.Background(), peerIDs[0].Alias)
+		require.NoError(t, err)
+		assert.True(t, peerStatus.Reachable)
+		assert.False(t, peerStatus.LastSeen.IsZero())
+	})
+
+	t.Run("happy_peer_not_reachable", func(t *testing.T) {
+		// Ping cannot be sent to the peer.
+		chClient.On("SendAppMsg", mock.Anything, peerIDs[0].OffChainAddr, mock.Anything, mock.Anything).
+			Return(assert.AnError).Once()
+		peerStatus, err := openSession.GetPeerStatus(context.Background(), peerIDs[0].Alias)
+		require.NoError(t, err)
+		assert.False(t, peerStatus.Reachable)
+	})
+
+	t.Run("peerID_not_found", func(t *testing.T) {
+		unknownAlias := "unknown-alias"
+		_, err := openSession.GetPeerStatus(context.Background(), unknownAlias)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePeerID, unknownAlias)
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSession.GetPeerStatus(context.Background(), peerIDs[0].Alias)
+		wantMessage := perun.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+		assert.Nil(t, err.AddInfo())
+	})
+}
+
+func Test_SubUnsubPeerStatus(t *testing.T) {
+	dummyNotifier := func(notif perun.PeerStatus) {}
+	peerIDs := newPeerIDs(t, uint(1))
+	openSession, chClient, _ := newSessionWMockChClient(t, true, peerIDs[0])
+	closedSession, _, _ := newSessionWMockChClient(t, false, peerIDs[0])
+	chClient.On("Register", mock.Anything, mock.Anything).Return()
+	chClient.On("SendAppMsg", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError)
+
+	// Note: All sub tests are written at the same level because each sub test modifies the state of session
+	// and the order of execution needs to be maintained.
+
+	// == SubTest 1: Sub successfully ==
+	err := openSession.SubPeerStatus(peerIDs[0].Alias, dummyNotifier)
+	require.NoError(t, err)
+
+	// == SubTest 2: Sub again, should error ==
+	err = openSession.SubPeerStatus(peerIDs[0].Alias, dummyNotifier)
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceExists)
+	peruntest.AssertErrInfoResourceExists(t, err.AddInfo(), perun.ResTypePeerStatusSub, peerIDs[0].Alias)
+
+	// == SubTest 3: Unsub successfully ==
+	err = openSession.UnsubPeerStatus(peerIDs[0].Alias)
+	require.NoError(t, err)
+
+	// == SubTest 4: Unsub again, should error ==
+	err = openSession.UnsubPeerStatus(peerIDs[0].Alias)
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePeerStatusSub, peerIDs[0].Alias)
+
+	t.Run("Sub_unknownPeer", func(t *testing.T) {
+		unknownAlias := "unknown-alias"
+		err = openSession.SubPeerStatus(unknownAlias, dummyNotifier)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePeerID, unknownAlias)
+	})
+
+	t.Run("Sub_sessionClosed", func(t *testing.T) {
+		err = closedSession.SubPeerStatus(peerIDs[0].Alias, dummyNotifier)
+		wantMessage := perun.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+		assert.Nil(t, err.AddInfo())
+	})
+
+	t.Run("Unsub_sessionClosed", func(t *testing.T) {
+		err = closedSession.UnsubPeerStatus(peerIDs[0].Alias)
+		wantMessage := perun.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+		assert.Nil(t, err.AddInfo())
+	})
+}
+
+func Test_Session_SendAppMsg(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	data := []byte("data")
+
+	t.Run("happy", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs[0])
+		chClient.On("Register", peerIDs[0].OffChainAddr, peerIDs[0].CommAddr).Return()
+		chClient.On("SendAppMsg", mock.Anything, peerIDs[0].OffChainAddr, "app", data).Return(nil)
+
+		err := sess.SendAppMsg(context.Background(), peerIDs[0].Alias, "app", data)
+		require.NoError(t, err)
+	})
+
+	t.Run("chClient_SendAppMsg_AnError", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs[0])
+		chClient.On("Register", peerIDs[0].OffChainAddr, peerIDs[0].CommAddr).Return()
+		chClient.On("SendAppMsg", mock.Anything, peerIDs[0].OffChainAddr, "app", data).Return(assert.AnError)
+
+		err := sess.SendAppMsg(context.Background(), peerIDs[0].Alias, "app", data)
+		peruntest.AssertAPIError(t, err, perun.ParticipantError, perun.ErrPeerRequestTimedOut)
+		peruntest.AssertErrInfoPeerRequestTimedOut(t, err.AddInfo(), peerIDs[0].Alias, sessiontest.ResponseTimeout.String())
+	})
+
+	t.Run("unknown_peer", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true)
+		err := sess.SendAppMsg(context.Background(), peerIDs[0].Alias, "app", data)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypePeerID, peerIDs[0].Alias)
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, false, peerIDs[0])
+		err := sess.SendAppMsg(context.Background(), peerIDs[0].Alias, "app", data)
+		wantMessage := perun.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+	})
+}
+
+func Test_SubUnsubAppMsgs(t *testing.T) {
+	dummyNotifier := func(notif perun.AppMsg) {}
+	openSession, _, _ := newSessionWMockChClient(t, true)
+	closedSession, _, _ := newSessionWMockChClient(t, false)
+
+	// Note: All sub tests are written at the same level because each sub test modifies the state of session
+	// and the order of execution needs to be maintained.
+
+	// == SubTest 1: Sub successfully ==
+	err := openSession.SubAppMsgs("app", dummyNotifier)
+	require.NoError(t, err)
+
+	// == SubTest 2: Sub again, should error ==
+	err = openSession.SubAppMsgs("app", dummyNotifier)
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceExists)
+	peruntest.AssertErrInfoResourceExists(t, err.AddInfo(), perun.ResTypeAppMsgSub, "app")
+
+	// == SubTest 3: Unsub successfully ==
+	err = openSession.UnsubAppMsgs("app")
+	require.NoError(t, err)
+
+	// == SubTest 4: Unsub again, should error ==
+	err = openSession.UnsubAppMsgs("app")
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeAppMsgSub, "app")
+
+	t.Run("Sub_sessionClosed", func(t *testing.T) {
+		err = closedSession.SubAppMsgs("app", dummyNotifier)
+		wantMessage := perun.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+	})
+
+	t.Run("Unsub_sessionClosed", func(t *testing.T) {
+		err = closedSession.UnsubAppMsgs("app")
+		wantMessage := perun.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+	})
+}
+
+func Test_Session_HandleAppMsg(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	sess, _, _ := newSessionWMockChClient(t, true, peerIDs[0])
+	notifs := make(chan perun.AppMsg, 1)
+	require.NoError(t, sess.SubAppMsgs("app", func(notif perun.AppMsg) {
+		notifs <- notif
+	}))
+
+	t.Run("happy", func(t *testing.T) {
+		sess.HandleAppMsg(peerIDs[0].OffChainAddr, "app", []byte("data"))
+		select {
+		case notif := <-notifs:
+			assert.Equal(t, perun.AppMsg{PeerAlias: peerIDs[0].Alias, Data: []byte("data")}, notif)
+		case <-time.After(time.Second):
+			t.Fatal("app message not notified")
+		}
+	})
+
+	t.Run("dropped", func(t *testing.T) {
+		sess.HandleAppMsg(peerIDs[1].OffChainAddr, "app", []byte("data"))       // Unknown peer.
+		sess.HandleAppMsg(peerIDs[0].OffChainAddr, "other-app", []byte("data")) // No subscription.
+		select {
+		case <-notifs:
+			t.Fatal("app message should be dropped")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+// openChWithPeer opens a channel with the peer in the session, using the mock
+// channel client.
+func openChWithPeer(t *testing.T, sess perun.SessionAPI, chClient *mocks.ChClient, peerAlias string) perun.ChInfo {
+	t.Helper()
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerAlias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	app := perun.App{
+		Def:  pchannel.NoApp(),
+		Data: pchannel.NoData(),
+	}
+	pch, _ := newMockPCh()
+	pch.On("State").Return(makeState(t, openingBalInfo, false))
+	chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil).Once()
+	chClient.On("Register", mock.Anything, mock.Anything).Return().Once()
+
+	chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+	require.NoError(t, err)
+	return chInfo
+}
+
 func Test_Session_OpenCh(t *testing.T) {
 	peerIDs := newPeerIDs(t, uint(2))
 	validOpeningBalInfo := perun.BalInfo{
@@ -187,6 +619,93 @@ func Test_Session_OpenCh(t *testing.T) {
 		require.NotZero(t, chInfo)
 	})
 
+	t.Run("happy_hd_wallet_fresh_part_addrs", func(t *testing.T) {
+		session, chClient := newSessionWHDWallet(t, peerIDs...)
+		partAddrs := []pwallet.Address{}
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
+		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil).Run(func(args mock.Arguments) {
+			proposal, ok := args.Get(1).(*pclient.LedgerChannelProposalMsg)
+			require.True(t, ok)
+			partAddrs = append(partAddrs, proposal.Participant)
+		})
+
+		for i := 0; i < 2; i++ {
+			_, err := session.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
+			require.NoError(t, err)
+		}
+		require.Len(t, partAddrs, 2)
+		assert.False(t, partAddrs[0].Equal(partAddrs[1]))
+		_, offChainAddr := ethereumtest.NewHDUserAddrsT(t, ethereumtest.HDMnemonic)
+		for i := range partAddrs {
+			assert.False(t, partAddrs[i].Equal(offChainAddr))
+		}
+	})
+
+	multiPartyOpeningBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{peerIDs[0].Alias, perun.OwnAlias, peerIDs[1].Alias},
+		Bals:       [][]string{{"1", "2", "3"}},
+	}
+
+	t.Run("happy_3_multi_party", func(t *testing.T) {
+		balInfo := copyBalInfo(multiPartyOpeningBalInfo)
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, balInfo, false))
+		session, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+		chClient.On("Register", mock.Anything, mock.Anything).Return()
+
+		chInfo, err := session.OpenCh(context.Background(), balInfo, app, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{perun.OwnAlias, peerIDs[0].Alias, peerIDs[1].Alias}, chInfo.BalInfo.Parts)
+		chClient.AssertNumberOfCalls(t, "Register", 2)
+	})
+
+	t.Run("too_few_parts", func(t *testing.T) {
+		balInfo := perun.BalInfo{
+			Currencies: []string{currency.ETHSymbol},
+			Parts:      []string{perun.OwnAlias},
+			Bals:       [][]string{{"1"}},
+		}
+		session, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+
+		_, err := session.OpenCh(context.Background(), balInfo, app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNamePeerAlias, perun.OwnAlias)
+	})
+
+	t.Run("bals_parts_length_mismatch", func(t *testing.T) {
+		balInfo := copyBalInfo(multiPartyOpeningBalInfo)
+		balInfo.Bals = [][]string{{"1", "2"}}
+		session, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+
+		_, err := session.OpenCh(context.Background(), balInfo, app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameAmount, "1,2")
+	})
+
+	t.Run("chClient_proposeChannel_PeersNotFunded_multi_party", func(t *testing.T) {
+		// In the sanitized opening balance, own alias is at index 0 and peers follow it in the given order.
+		fundingTimeoutError := pchannel.FundingTimeoutError{
+			Errors: []*pchannel.AssetFundingError{{
+				Asset:         pchannel.Index(0),
+				TimedOutPeers: []pchannel.Index{1, 2},
+			}},
+		}
+		ch, _ := newMockPCh()
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, fundingTimeoutError)
+
+		_, err := sess.OpenCh(context.Background(), copyBalInfo(multiPartyOpeningBalInfo), app, 10)
+
+		peerAliases := peerIDs[0].Alias + "," + peerIDs[1].Alias
+		peruntest.AssertAPIError(t, err, perun.ParticipantError, perun.ErrPeerNotFunded, "proposing channel")
+		peruntest.AssertErrInfoPeerNotFunded(t, err.AddInfo(), peerAliases)
+	})
+
 	t.Run("session_closed", func(t *testing.T) {
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, false, peerIDs...)
@@ -364,6 +883,24 @@ func Test_Session_OpenCh(t *testing.T) {
 		peruntest.AssertErrInfoTxTimedOut(t, err.AddInfo(), txType, txID, txTimeout)
 	})
 
+	t.Run("chClient_proposeChannel_FundingTxReorged", func(t *testing.T) {
+		txType := pethchannel.Fund.String()
+		txID := "0xabcd"
+		fundingTxReorgedError := blockchain.NewTxReorgedError(txType, txID, pclient.TxTimedoutError{
+			TxType: txType,
+			TxID:   txID,
+		})
+		ch, _ := newMockPCh()
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, fundingTxReorgedError)
+
+		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
+
+		peruntest.AssertAPIError(t, err, perun.ProtocolFatalError, perun.ErrTxReorged, "proposing channel")
+		peruntest.AssertErrInfoTxReorged(t, err.AddInfo(), txType, txID)
+	})
+
 	t.Run("chClient_proposeChannel_ChainNotReachable", func(t *testing.T) {
 		chainURL := ethereumtest.ChainURL
 		chainNotReachableError := pclient.ChainNotReachableError{}
@@ -379,6 +916,163 @@ func Test_Session_OpenCh(t *testing.T) {
 	})
 }
 
+func Test_Session_OpenVirtualCh(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2)) // peerIDs[0] is the intermediary.
+	app := perun.App{
+		Def:  pchannel.NoApp(),
+		Data: pchannel.NoData(),
+	}
+	validOpeningBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{peerIDs[1].Alias, perun.OwnAlias},
+		Bals:       [][]string{{"0.5", "0.5"}},
+	}
+	var peerParentChID pchannel.ID
+	rand.Read(peerParentChID[:])
+	peerParentChIDHex := fmt.Sprintf("%x", peerParentChID)
+
+	t.Run("happy", func(t *testing.T) {
+		sess, chClient, parentChID := newSessionWParentCh(t, peerIDs)
+		parents := perun.VirtualChParents{ChID: parentChID, PeerChID: peerParentChIDHex, PeerIdx: 0}
+
+		var gotProposal *pclient.VirtualChannelProposalMsg
+		isVirtualChProposal := mock.MatchedBy(func(proposal pclient.ChannelProposal) bool {
+			var ok bool
+			gotProposal, ok = proposal.(*pclient.VirtualChannelProposalMsg)
+			return ok
+		})
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
+		chClient.On("ProposeChannel", mock.Anything, isVirtualChProposal).Return(pch, nil)
+		chClient.On("Register", mock.Anything, mock.Anything).Return()
+
+		chInfo, err := sess.OpenVirtualCh(context.Background(), parents, copyBalInfo(validOpeningBalInfo), app, 10)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%x", pch.ID()), chInfo.ChID)
+
+		require.NotNil(t, gotProposal)
+		assert.Equal(t, parentChID, fmt.Sprintf("%x", gotProposal.Parents[0]))
+		assert.Equal(t, peerParentChID, gotProposal.Parents[1])
+		assert.Equal(t, [][]pchannel.Index{{0, 1}, {1, 0}}, gotProposal.IndexMaps)
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, false, peerIDs...)
+
+		_, err := sess.OpenVirtualCh(context.Background(), perun.VirtualChParents{}, validOpeningBalInfo, app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition,
+			perun.ErrSessionClosed.Error())
+	})
+
+	t.Run("more_than_two_parts", func(t *testing.T) {
+		sess, _, parentChID := newSessionWParentCh(t, peerIDs)
+		parents := perun.VirtualChParents{ChID: parentChID, PeerChID: peerParentChIDHex}
+		balInfo := perun.BalInfo{
+			Currencies: []string{currency.ETHSymbol},
+			Parts:      []string{perun.OwnAlias, peerIDs[0].Alias, peerIDs[1].Alias},
+			Bals:       [][]string{{"1", "1", "1"}},
+		}
+
+		_, err := sess.OpenVirtualCh(context.Background(), parents, balInfo, app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNamePeerAlias, strings.Join(balInfo.Parts, ","))
+	})
+
+	t.Run("unknown_parent_ch", func(t *testing.T) {
+		sess, _, _ := newSessionWParentCh(t, peerIDs)
+		parents := perun.VirtualChParents{ChID: "unknown-ch-id", PeerChID: peerParentChIDHex}
+
+		_, err := sess.OpenVirtualCh(context.Background(), parents, copyBalInfo(validOpeningBalInfo), app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeChannel, parents.ChID)
+	})
+
+	t.Run("currencies_mismatch", func(t *testing.T) {
+		sess, _, parentChID := newSessionWParentCh(t, peerIDs)
+		parents := perun.VirtualChParents{ChID: parentChID, PeerChID: peerParentChIDHex}
+		balInfo := copyBalInfo(validOpeningBalInfo)
+		balInfo.Currencies = []string{currency.ETHSymbol, currency.ETHSymbol}
+		balInfo.Bals = [][]string{{"0.5", "0.5"}, {"0.5", "0.5"}}
+
+		_, err := sess.OpenVirtualCh(context.Background(), parents, balInfo, app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNameCurrency, "ETH,ETH")
+	})
+
+	t.Run("parent_ch_not_two_party", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		parentBalInfo := perun.BalInfo{
+			Currencies: []string{currency.ETHSymbol},
+			Parts:      []string{perun.OwnAlias, peerIDs[0].Alias, peerIDs[1].Alias},
+			Bals:       [][]string{{"1", "1", "1"}},
+		}
+		parentPCh, _ := newMockPCh()
+		parentPCh.On("State").Return(makeState(t, parentBalInfo, false))
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(parentPCh, nil).Once()
+		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		parentChInfo, err := sess.OpenCh(context.Background(), parentBalInfo, app, 10)
+		require.NoError(t, err)
+		parents := perun.VirtualChParents{ChID: parentChInfo.ChID, PeerChID: peerParentChIDHex}
+
+		_, err = sess.OpenVirtualCh(context.Background(), parents, copyBalInfo(validOpeningBalInfo), app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, "two participants")
+	})
+
+	t.Run("invalid_peer_parent_ch_id", func(t *testing.T) {
+		sess, _, parentChID := newSessionWParentCh(t, peerIDs)
+		parents := perun.VirtualChParents{ChID: parentChID, PeerChID: "abcd"}
+
+		_, err := sess.OpenVirtualCh(context.Background(), parents, copyBalInfo(validOpeningBalInfo), app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNamePeerChID, parents.PeerChID)
+	})
+
+	t.Run("invalid_peer_idx", func(t *testing.T) {
+		sess, _, parentChID := newSessionWParentCh(t, peerIDs)
+		parents := perun.VirtualChParents{ChID: parentChID, PeerChID: peerParentChIDHex, PeerIdx: 2}
+
+		_, err := sess.OpenVirtualCh(context.Background(), parents, copyBalInfo(validOpeningBalInfo), app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), perun.ArgNamePeerIdx, "2")
+	})
+
+	t.Run("chClient_proposeChannel_AnError", func(t *testing.T) {
+		sess, chClient, parentChID := newSessionWParentCh(t, peerIDs)
+		parents := perun.VirtualChParents{ChID: parentChID, PeerChID: peerParentChIDHex}
+		pch, _ := newMockPCh()
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, assert.AnError)
+		chClient.On("Register", mock.Anything, mock.Anything).Return()
+
+		_, err := sess.OpenVirtualCh(context.Background(), parents, copyBalInfo(validOpeningBalInfo), app, 10)
+		peruntest.AssertAPIError(t, err, perun.InternalError, perun.ErrUnknownInternal)
+	})
+}
+
+// newSessionWParentCh sets up a session with a ledger channel between the user
+// and peerIDs[0], that can be used as a parent for virtual channels.
+func newSessionWParentCh(t *testing.T, peerIDs []perun.PeerID) (*session.Session, *mocks.ChClient, string) {
+	t.Helper()
+	sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	pch, _ := newMockPCh()
+	pch.On("State").Return(makeState(t, openingBalInfo, false))
+	pch.On("Idx").Return(pchannel.Index(0))
+	chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil).Once()
+	chClient.On("Register", mock.Anything, mock.Anything).Return().Once()
+
+	app := perun.App{
+		Def:  pchannel.NoApp(),
+		Data: pchannel.NoData(),
+	}
+	chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+	require.NoError(t, err)
+	return sess, chClient, chInfo.ChID
+}
+
 func Test_Session_HandleProposalWInterface(t *testing.T) {
 	peerIDs := newPeerIDs(t, uint(1))
 
@@ -488,6 +1182,43 @@ func Test_HandleProposalWInterface_Sub(t *testing.T) {
 	})
 }
 
+func Test_HandleProposalWInterface_Policy(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+	cfg.Policy.Proposals.RejectOthers = true // User should not contribute to the channel, as no limit is set.
+
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	s, err := session.NewSessionForTest(cfg, true, &mocks.ChClient{}, chainSetup)
+	require.NoError(t, err)
+	ownPeerID, err := s.GetPeerID(perun.OwnAlias)
+	require.NoError(t, err)
+	chProposal := newVirtualChProposal(t, ownPeerID, peerIDs[0], chainSetup.AssetETH)
+
+	rejected := make(chan struct{})
+	responder := &mocks.ChProposalResponder{}
+	responder.On("Reject", mock.Anything, "rejected by policy").Return(nil).Run(func(mock.Arguments) {
+		close(rejected)
+	})
+	notifs := make(chan perun.ChProposalNotif, 1)
+	require.NoError(t, s.SubChProposals(func(notif perun.ChProposalNotif) { notifs <- notif }))
+
+	s.HandleProposalWInterface(chProposal, responder)
+	select {
+	case notif := <-notifs:
+		assert.Equal(t, perun.AutoResponseRejected, notif.AutoResponse)
+		assert.Zero(t, notif.Expiry)
+	case <-time.After(2 * time.Second):
+		t.Fatal("notification not received")
+	}
+	select {
+	case <-rejected:
+	case <-time.After(2 * time.Second):
+		t.Fatal("proposal not rejected")
+	}
+}
+
 func Test_HandleProposalWInterface_Respond(t *testing.T) {
 	peerIDs := newPeerIDs(t, uint(1)) // Aliases of peerIDs are their respective indices in the array.
 
@@ -655,6 +1386,83 @@ func Test_HandleProposalWInterface_Respond(t *testing.T) {
 	})
 }
 
+func Test_HandleProposalWInterface_RespondVirtual(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+
+	validOpeningBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{peerIDs[0].Alias, perun.OwnAlias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	isVirtualChProposalAcc := mock.MatchedBy(func(acc pclient.ChannelProposalAccept) bool {
+		_, ok := acc.(*pclient.VirtualChannelProposalAccMsg)
+		return ok
+	})
+
+	t.Run("happy_accept", func(t *testing.T) {
+		session, chProposal, chProposalID := newSessionWVirtualChProposal(t, peerIDs)
+		notifs := make(chan perun.ChProposalNotif, 1)
+		require.NoError(t, session.SubChProposals(func(notif perun.ChProposalNotif) { notifs <- notif }))
+
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
+		responder := &mocks.ChProposalResponder{}
+		responder.On("Accept", mock.Anything, isVirtualChProposalAcc).Return(pch, nil)
+		session.HandleProposalWInterface(chProposal, responder)
+
+		notif := <-notifs
+		wantParentChID := fmt.Sprintf("%x", chProposal.Parents[pclient.ProposeeIdx])
+		assert.Equal(t, wantParentChID, notif.ParentChID)
+
+		gotChInfo, err := session.RespondVirtualChProposal(context.Background(), chProposalID, true)
+		require.NoError(t, err)
+		assert.Equal(t, gotChInfo.ChID, fmt.Sprintf("%x", pch.ID()))
+	})
+
+	t.Run("happy_reject", func(t *testing.T) {
+		session, chProposal, chProposalID := newSessionWVirtualChProposal(t, peerIDs)
+
+		responder := &mocks.ChProposalResponder{}
+		responder.On("Reject", mock.Anything, mock.Anything).Return(nil)
+		session.HandleProposalWInterface(chProposal, responder)
+
+		_, err := session.RespondVirtualChProposal(context.Background(), chProposalID, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("respond_using_ledger_ch_api", func(t *testing.T) {
+		session, chProposal, chProposalID := newSessionWVirtualChProposal(t, peerIDs)
+
+		responder := &mocks.ChProposalResponder{}
+		responder.On("Reject", mock.Anything, mock.Anything).Return(nil)
+		session.HandleProposalWInterface(chProposal, responder)
+
+		_, err := session.RespondChProposal(context.Background(), chProposalID, false)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), perun.ResTypeProposal, chProposalID)
+
+		// Proposal should still be available for responding using the right API.
+		_, err = session.RespondVirtualChProposal(context.Background(), chProposalID, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("respond_to_ledger_ch_proposal", func(t *testing.T) {
+		session, virtualChProposal, _ := newSessionWVirtualChProposal(t, peerIDs)
+		chProposal, err := pclient.NewLedgerChannelProposal(10, virtualChProposal.Proposer,
+			virtualChProposal.InitBals, virtualChProposal.Peers,
+			pclient.WithApp(pchannel.NoApp(), pchannel.NoData()), pclient.WithRandomNonce())
+		require.NoError(t, err)
+		chProposalID := fmt.Sprintf("%x", chProposal.ProposalID)
+
+		responder := &mocks.ChProposalResponder{}
+		session.HandleProposalWInterface(chProposal, responder)
+
+		_, apiErr := session.RespondVirtualChProposal(context.Background(), chProposalID, true)
+		peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, apiErr.AddInfo(), perun.ResTypeProposal, chProposalID)
+	})
+}
+
 func Test_ProposeCh_GetChsInfo(t *testing.T) {
 	peerIDs := newPeerIDs(t, uint(2))
 	setupSession := func() (perun.SessionAPI, *mocks.ChClient) {
@@ -775,6 +1583,16 @@ func Test_ProposeCh_CloseSession(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, persistedChs, 0)
 	})
+	t.Run("error_closing_chClient", func(t *testing.T) {
+		session, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		chClient.On("Close", mock.Anything).Return(assert.AnError)
+
+		_, err := session.Close(false)
+		peruntest.AssertAPIError(t, err, perun.InternalError, perun.ErrUnknownInternal, "closing channel client")
+
+		_, err = session.Close(false)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, perun.ErrSessionClosed.Error())
+	})
 	t.Run("happy_force", func(t *testing.T) {
 		pch, _ := newMockPCh()
 		pch.On("Phase").Return(pchannel.Acting)
@@ -848,6 +1666,77 @@ func Test_Session_HandleUpdateWInterface(t *testing.T) {
 	// TODO: Test if upates are handled properly.
 }
 
+func Test_Session_SetChUpdateInterceptor(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	updatedBalInfo := copyBalInfo(openingBalInfo)
+	updatedBalInfo.Bals = [][]string{{"1.5", "1.5"}}
+
+	// Returns a session with a channel, for which a subscription to channel
+	// updates is made, and the channel notifications received on it.
+	newSessionWSubCh := func(t *testing.T) (*session.Session, *pclient.ChannelUpdate, chan perun.ChUpdateNotif) {
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		sess := newSessionWCh(t, peerIDs, copyBalInfo(openingBalInfo), pch)
+		ch, err := sess.GetCh(fmt.Sprintf("%x", pch.ID()))
+		require.NoError(t, err)
+
+		notifs := make(chan perun.ChUpdateNotif, 1)
+		require.NoError(t, ch.SubChUpdates(func(notif perun.ChUpdateNotif) {
+			notifs <- notif
+		}))
+		chUpdate := &pclient.ChannelUpdate{State: makeState(t, updatedBalInfo, false)}
+		chUpdate.State.ID = pch.ID()
+		chUpdate.State.Version = 1
+		return sess, chUpdate, notifs
+	}
+
+	t.Run("happy_intercepted", func(t *testing.T) {
+		sess, chUpdate, notifs := newSessionWSubCh(t)
+		intercepted := make(chan perun.ChUpdateNotif, 1)
+		sess.SetChUpdateInterceptor("app1", func(notif perun.ChUpdateNotif) bool {
+			return false
+		})
+		sess.SetChUpdateInterceptor("app2", func(notif perun.ChUpdateNotif) bool {
+			intercepted <- notif
+			return true
+		})
+
+		sess.HandleUpdateWInterface(makeState(t, openingBalInfo, false), *chUpdate, &mocks.ChUpdateResponder{})
+		select {
+		case notif := <-intercepted:
+			assert.Equal(t, updatedBalInfo.Bals, notif.ProposedChInfo.BalInfo.Bals)
+		case <-time.After(time.Second):
+			t.Fatal("update not intercepted")
+		}
+		select {
+		case <-notifs:
+			t.Fatal("intercepted update should not be notified")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("happy_not_intercepted", func(t *testing.T) {
+		sess, chUpdate, notifs := newSessionWSubCh(t)
+		sess.SetChUpdateInterceptor("app1", func(notif perun.ChUpdateNotif) bool {
+			return true
+		})
+		sess.SetChUpdateInterceptor("app1", nil) // Removes the interceptor.
+
+		sess.HandleUpdateWInterface(makeState(t, openingBalInfo, false), *chUpdate, &mocks.ChUpdateResponder{})
+		select {
+		case notif := <-notifs:
+			assert.Equal(t, updatedBalInfo.Bals, notif.ProposedChInfo.BalInfo.Bals)
+		case <-time.After(time.Second):
+			t.Fatal("update not notified")
+		}
+	})
+}
+
 func Test_Session_DeployAssetERC20(t *testing.T) {
 	session, _, chainSetup := newSessionWMockChClient(t, true)
 
@@ -901,11 +1790,26 @@ func newMockPCh() (*mocks.PChannel, chan time.Time) {
 	rand.Read(chID[:])
 	ch := &mocks.PChannel{}
 	ch.On("ID").Return(chID)
+	ch.On("OnUpdate", mock.Anything).Return()
 	watcherSignal := make(chan time.Time)
 	ch.On("Watch", mock.Anything).WaitUntil(watcherSignal).Return(nil)
 	return ch, watcherSignal
 }
 
+// copyBalInfo returns a deep copy of the bal info, as OpenCh rearranges the
+// entries in it in place.
+func copyBalInfo(balInfo perun.BalInfo) perun.BalInfo {
+	bals := make([][]string, len(balInfo.Bals))
+	for i := range balInfo.Bals {
+		bals[i] = append([]string{}, balInfo.Bals[i]...)
+	}
+	return perun.BalInfo{
+		Currencies: append([]string{}, balInfo.Currencies...),
+		Parts:      append([]string{}, balInfo.Parts...),
+		Bals:       bals,
+	}
+}
+
 func makeState(t *testing.T, balInfo perun.BalInfo, isFinal bool) *pchannel.State {
 	allocation, err := session.MakeAllocation(balInfo,
 		roContractRegistry(), currencytest.Registry())
@@ -948,6 +1852,37 @@ func newSessionWChProposal(t *testing.T, peerIDs []perun.PeerID) (
 	return session, chProposal, chProposalID
 }
 
+// newVirtualChProposal constructs a virtual channel proposal from the peer
+// using the asset deployed on the chain backend of the session. Because, the
+// addresses of the assets in roContractRegistry can differ from that of the
+// deployed assets.
+func newVirtualChProposal(t *testing.T, ownAddr, peer perun.PeerID, assetETH pwallet.Address,
+) *pclient.VirtualChannelProposalMsg {
+	asset := pethchannel.NewAssetFromAddress(pethwallet.AsEthAddr(assetETH))
+	allocation := pchannel.NewAllocation(2, asset)
+	allocation.SetAssetBalances(asset, []pchannel.Bal{big.NewInt(1e18), big.NewInt(2e18)})
+
+	var parents [2]pchannel.ID
+	rand.Read(parents[0][:])
+	rand.Read(parents[1][:])
+	proposal, err := pclient.NewVirtualChannelProposal(10, peer.OffChainAddr, allocation,
+		[]pwire.Address{peer.OffChainAddr, ownAddr.OffChainAddr}, parents[:], [][]pchannel.Index{{0, 1}, {1, 0}},
+		pclient.WithApp(pchannel.NoApp(), pchannel.NoData()), pclient.WithRandomNonce())
+	require.NoError(t, err)
+	return proposal
+}
+
+func newSessionWVirtualChProposal(t *testing.T, peerIDs []perun.PeerID) (
+	*session.Session, *pclient.VirtualChannelProposalMsg, string,
+) {
+	session, _, chainSetup := newSessionWMockChClient(t, true, peerIDs...)
+	ownPeerID, err := session.GetPeerID(perun.OwnAlias)
+	require.NoError(t, err)
+	chProposal := newVirtualChProposal(t, ownPeerID, peerIDs[0], chainSetup.AssetETH)
+	chProposalID := fmt.Sprintf("%x", chProposal.Base().ProposalID)
+	return session, chProposal, chProposalID
+}
+
 func newSessionWCh(t *testing.T, peerIDs []perun.PeerID, openingBalInfo perun.BalInfo,
 	ch session.PChannel,
 ) *session.Session {
@@ -986,3 +1921,47 @@ func roContractRegistry() perun.ROContractRegistry {
 	r.On("Asset", "ETH").Return(assetETH, true)
 	return r
 }
+
+func Test_Session_SetCloseHandler(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true)
+		chClient.On("Close", mock.Anything).Return(nil)
+		invoked := make(map[string]int)
+		sess.SetCloseHandler("handler1", func() {
+			// Session APIs can be used within the handler.
+			_, err := sess.ListPaymentSchedules()
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+			invoked["handler1"]++
+		})
+		sess.SetCloseHandler("handler2", func() { invoked["handler2"]++ })
+		sess.SetCloseHandler("handler2", nil)
+
+		_, err := sess.Close(false)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"handler1": 1}, invoked)
+	})
+	t.Run("not_invoked_when_close_fails", func(t *testing.T) {
+		peerIDs := newPeerIDs(t, uint(1))
+		balInfo := perun.BalInfo{
+			Currencies: []string{currency.ETHSymbol},
+			Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+			Bals:       [][]string{{"1", "2"}},
+		}
+		pch, _ := newMockPCh()
+		pch.On("Phase").Return(pchannel.Registering)
+		pch.On("State").Return(makeState(t, balInfo, false))
+		sess := newSessionWCh(t, peerIDs, balInfo, pch)
+		invoked := false
+		sess.SetCloseHandler("handler", func() { invoked = true })
+
+		_, err := sess.Close(true)
+		require.Error(t, err)
+		assert.False(t, invoked)
+	})
+	t.Run("session_closed", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, false)
+		invoked := false
+		sess.SetCloseHandler("handler", func() { invoked = true })
+		assert.True(t, invoked)
+	})
+}
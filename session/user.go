@@ -17,11 +17,12 @@
 package session
 
 import (
-	"fmt"
-
+	"github.com/pkg/errors"
 	pwallet "perun.network/go-perun/wallet"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/internal/secret"
 )
 
 // User represents a participant in the off-chain network that uses a session on this node for sending transactions.
@@ -50,12 +51,10 @@ func NewUnlockedUser(wb perun.WalletBackend, cfg UserConfig) (User, perun.APIErr
 		return User{}, perun.NewAPIErrInvalidConfig(err, "offChainAddr", cfg.OffChainAddr)
 	}
 	if u.OnChain, err = newCred(wb, cfg.OnChainWallet, onChainAddr); err != nil {
-		value := fmt.Sprintf("%s, %s", cfg.OnChainWallet.KeystorePath, cfg.OnChainWallet.Password)
-		return User{}, perun.NewAPIErrInvalidConfig(err, "onChainWallet", value)
+		return User{}, perun.NewAPIErrInvalidConfig(err, "onChainWallet", walletConfigValue(cfg.OnChainWallet))
 	}
 	if u.OffChain, err = newCred(wb, cfg.OffChainWallet, offChainAddr); err != nil {
-		value := fmt.Sprintf("%s, %s", cfg.OffChainWallet.KeystorePath, cfg.OffChainWallet.Password)
-		return User{}, perun.NewAPIErrInvalidConfig(err, "offChainWallet", value)
+		return User{}, perun.NewAPIErrInvalidConfig(err, "offChainWallet", walletConfigValue(cfg.OffChainWallet))
 	}
 
 	u.PeerID.Alias = perun.OwnAlias
@@ -68,8 +67,27 @@ func NewUnlockedUser(wb perun.WalletBackend, cfg UserConfig) (User, perun.APIErr
 }
 
 // newCred initilizes the wallet and unlocks the account.
+//
+// If the signer URL or the mnemonic is configured, the wallet uses the
+// external signer or the keys derived from the mnemonic respectively, and the
+// passed wallet backend is not used.
 func newCred(wb perun.WalletBackend, cfg WalletConfig, addr pwallet.Address) (perun.Credential, error) {
-	w, err := wb.NewWallet(cfg.KeystorePath, cfg.Password)
+	keystore := cfg.KeystorePath
+	switch {
+	case cfg.SignerURL != "":
+		wb, keystore = ethereum.NewExternalWalletBackend(), cfg.SignerURL
+	case cfg.Mnemonic != "":
+		mnemonic, err := secret.Resolve(cfg.Mnemonic)
+		if err != nil {
+			return perun.Credential{}, errors.WithMessage(err, "resolving mnemonic")
+		}
+		wb, keystore = ethereum.NewHDWalletBackend(), mnemonic
+	}
+	password, err := secret.Resolve(cfg.Password)
+	if err != nil {
+		return perun.Credential{}, errors.WithMessage(err, "resolving password")
+	}
+	w, err := wb.NewWallet(keystore, password)
 	if err != nil {
 		return perun.Credential{}, err
 	}
@@ -81,6 +99,19 @@ func newCred(wb perun.WalletBackend, cfg WalletConfig, addr pwallet.Address) (pe
 		Addr:     addr,
 		Wallet:   w,
 		Keystore: cfg.KeystorePath,
-		Password: cfg.Password,
+		Password: password,
 	}, nil
 }
+
+// walletConfigValue returns the value of the wallet config for reporting in
+// the errors. Password and mnemonic are never included, as they could be in
+// clear text.
+func walletConfigValue(cfg WalletConfig) string {
+	switch {
+	case cfg.SignerURL != "":
+		return cfg.SignerURL
+	case cfg.Mnemonic != "":
+		return "mnemonic"
+	}
+	return cfg.KeystorePath
+}
@@ -18,6 +18,8 @@ package session_test
 
 import (
 	"math/rand"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +27,7 @@ import (
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/peruntest"
 	"github.com/hyperledger-labs/perun-node/session"
 	"github.com/hyperledger-labs/perun-node/session/sessiontest"
 )
@@ -41,6 +44,147 @@ func Test_New_Happy(t *testing.T) {
 	compareUserWithCfg(t, gotUser, userCfgCopy)
 }
 
+func Test_New_ExternalSigner(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	wb, userCfg := sessiontest.NewUserConfigT(t, rng, 0)
+	ws := ethereumtest.NewWalletSetupT(t, rng, 2)
+	signerURL := ethereumtest.NewExternalSignerT(t, ws)
+
+	userCfg.OnChainAddr = ws.Accs[0].Address().String()
+	userCfg.OffChainAddr = ws.Accs[1].Address().String()
+	userCfg.OnChainWallet = session.WalletConfig{SignerURL: signerURL}
+	userCfg.OffChainWallet = session.WalletConfig{SignerURL: signerURL}
+	userCfg.PartAddrs = nil
+
+	t.Run("happy", func(t *testing.T) {
+		gotUser, err := session.NewUnlockedUser(wb, userCfg)
+		require.NoError(t, err)
+		compareUserWithCfg(t, gotUser, userCfg)
+
+		acc, unlockErr := gotUser.OffChain.Wallet.Unlock(gotUser.OffChain.Addr)
+		require.NoError(t, unlockErr)
+		_, signErr := acc.SignData([]byte("perun-node"))
+		require.NoError(t, signErr)
+	})
+	t.Run("missing_on-chain_address", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainAddr = ethereumtest.NewRandomAddress(rng).String()
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "onChainWallet", signerURL)
+	})
+	t.Run("invalid_off-chain_signer_url", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OffChainWallet.SignerURL = "invalid-signer-url"
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "offChainWallet", userCfgCopy.OffChainWallet.SignerURL)
+	})
+}
+
+func Test_New_PasswordSources(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	wb, userCfg := sessiontest.NewUserConfigT(t, rng, 0)
+	userCfg.PartAddrs = nil
+
+	t.Run("happy_env", func(t *testing.T) {
+		t.Setenv("PERUN_TEST_PASSWORD", "") // Password for test accounts is always empty string.
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainWallet.Password = "env:PERUN_TEST_PASSWORD"
+		userCfgCopy.OffChainWallet.Password = "env:PERUN_TEST_PASSWORD"
+		gotUser, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.NoError(t, err)
+		compareUserWithCfg(t, gotUser, userCfgCopy)
+		assert.Empty(t, gotUser.OnChain.Password)
+	})
+	t.Run("happy_file", func(t *testing.T) {
+		passwordFile := filepath.Join(t.TempDir(), "password")
+		require.NoError(t, os.WriteFile(passwordFile, []byte("\n"), 0o600))
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainWallet.Password = "file:" + passwordFile
+		userCfgCopy.OffChainWallet.Password = "file:" + passwordFile
+		gotUser, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.NoError(t, err)
+		compareUserWithCfg(t, gotUser, userCfgCopy)
+		assert.Empty(t, gotUser.OffChain.Password)
+	})
+	t.Run("invalid_password_from_env", func(t *testing.T) {
+		t.Setenv("PERUN_TEST_PASSWORD", "invalid-password")
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainWallet.Password = "env:PERUN_TEST_PASSWORD"
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "onChainWallet", userCfgCopy.OnChainWallet.KeystorePath)
+		assert.NotContains(t, err.Error(), "invalid-password")
+	})
+	t.Run("missing_env", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainWallet.Password = "env:PERUN_TEST_MISSING_PASSWORD"
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "onChainWallet", userCfgCopy.OnChainWallet.KeystorePath)
+	})
+	t.Run("missing_file", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OffChainWallet.Password = "file:" + filepath.Join(t.TempDir(), "missing")
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "offChainWallet", userCfgCopy.OffChainWallet.KeystorePath)
+	})
+	t.Run("prompt", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainWallet.Password = "prompt"
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+	})
+}
+
+func Test_New_HDWallet(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	wb, userCfg := sessiontest.NewUserConfigT(t, rng, 0)
+	onChainAddr, offChainAddr := ethereumtest.NewHDUserAddrsT(t, ethereumtest.HDMnemonic)
+	t.Setenv("PERUN_TEST_MNEMONIC", ethereumtest.HDMnemonic)
+
+	userCfg.OnChainAddr = onChainAddr.String()
+	userCfg.OffChainAddr = offChainAddr.String()
+	userCfg.OnChainWallet = session.WalletConfig{Mnemonic: "env:PERUN_TEST_MNEMONIC"}
+	userCfg.OffChainWallet = session.WalletConfig{Mnemonic: "env:PERUN_TEST_MNEMONIC"}
+	userCfg.PartAddrs = nil
+
+	t.Run("happy", func(t *testing.T) {
+		gotUser, err := session.NewUnlockedUser(wb, userCfg)
+		require.NoError(t, err)
+		compareUserWithCfg(t, gotUser, userCfg)
+
+		acc, unlockErr := gotUser.OffChain.Wallet.Unlock(gotUser.OffChain.Addr)
+		require.NoError(t, unlockErr)
+		_, signErr := acc.SignData([]byte("perun-node"))
+		require.NoError(t, signErr)
+	})
+	t.Run("missing_on-chain_address", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainAddr = ethereumtest.NewRandomAddress(rng).String()
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "onChainWallet", "mnemonic")
+	})
+	t.Run("invalid_off-chain_mnemonic", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OffChainWallet.Mnemonic = "perun node secret words"
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "offChainWallet", "mnemonic")
+		assert.NotContains(t, err.Error(), userCfgCopy.OffChainWallet.Mnemonic)
+	})
+	t.Run("missing_mnemonic_env", func(t *testing.T) {
+		userCfgCopy := userCfg
+		userCfgCopy.OnChainWallet.Mnemonic = "env:PERUN_TEST_MISSING_MNEMONIC"
+		_, err := session.NewUnlockedUser(wb, userCfgCopy)
+		require.Error(t, err)
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "onChainWallet", "mnemonic")
+	})
+}
+
 func compareUserWithCfg(t *testing.T, gotUser session.User, userCfg session.UserConfig) {
 	require.NotZero(t, gotUser)
 
@@ -91,12 +235,18 @@ func Test_New_Unhappy(t *testing.T) {
 		userCfgCopy.OnChainWallet.Password = "invalid-password"
 		_, err := session.NewUnlockedUser(wb, userCfgCopy)
 		require.Error(t, err)
+		wantValue := userCfgCopy.OnChainWallet.KeystorePath
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "onChainWallet", wantValue)
+		assert.NotContains(t, err.Error(), userCfgCopy.OnChainWallet.Password)
 	})
 	t.Run("invalid_off-chain_password", func(t *testing.T) {
 		userCfgCopy := userCfg
 		userCfgCopy.OffChainWallet.Password = "invalid-password"
 		_, err := session.NewUnlockedUser(wb, userCfgCopy)
 		require.Error(t, err)
+		wantValue := userCfgCopy.OffChainWallet.KeystorePath
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "offChainWallet", wantValue)
+		assert.NotContains(t, err.Error(), userCfgCopy.OffChainWallet.Password)
 	})
 	t.Run("invalid_on-chain_keystore", func(t *testing.T) {
 		userCfgCopy := userCfg